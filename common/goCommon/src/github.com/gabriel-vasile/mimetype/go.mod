@@ -0,0 +1,5 @@
+module github.com/gabriel-vasile/mimetype
+
+go 1.20
+
+require golang.org/x/net v0.8.0
@@ -0,0 +1,16 @@
+<?xml version="1.0" encoding="UTF-8"?>
+<wfs:FeatureCollection xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:wfs="http://www.opengis.net/wfs/2.0" xmlns:gml="http://www.opengis.net/gml/3.2" xmlns:LR="www.landregistry.gov.uk" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" numberMatched="25697" numberReturned="25697" timeStamp="2019-04-07T09:37:45.328Z" xsi:schemaLocation="http://www.opengis.net/gml/3.2 http://192.168.4.121:8080/geoserver/schemas/gml/3.2.1/gml.xsd http://www.opengis.net/wfs/2.0 http://192.168.4.121:8080/geoserver/schemas/wfs/2.0/wfs.xsd www.landregistry.gov.uk http://192.168.4.121:8080/geoserver/LR/wfs?service=WFS&amp;version=2.0.0&amp;request=DescribeFeatureType&amp;typeName=LR%3APREDEFINED">
+    <gml:Polygon>
+        <gml:outerBoundaryIs>
+                <gml:LinearRing>
+                        <gml:coordinates>0,0 100,0 100,100 0,100 0,0</gml:coordinates>
+                </gml:LinearRing>
+        </gml:outerBoundaryIs>
+    </gml:Polygon>
+    <gml:Point>
+        <gml:coordinates>100,200</gml:coordinates>
+    </gml:Point>
+    <gml:LineString>
+       <gml:coordinates>100,200 150,300</gml:coordinates>
+    </gml:LineString>
+</wfs:FeatureCollection>
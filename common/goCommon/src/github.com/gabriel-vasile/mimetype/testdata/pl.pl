@@ -0,0 +1,2 @@
+#!/usr/bin/perl
+print "Olá, Mundo!\n";
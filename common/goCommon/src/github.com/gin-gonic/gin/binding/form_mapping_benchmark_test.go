@@ -1,4 +1,4 @@
-// Copyright 2019 Gin Core Team.  All rights reserved.
+// Copyright 2019 Gin Core Team. All rights reserved.
 // Use of this source code is governed by a MIT style
 // license that can be found in the LICENSE file.
 
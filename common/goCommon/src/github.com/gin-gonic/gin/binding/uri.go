@@ -1,4 +1,4 @@
-// Copyright 2018 Gin Core Team.  All rights reserved.
+// Copyright 2018 Gin Core Team. All rights reserved.
 // Use of this source code is governed by a MIT style
 // license that can be found in the LICENSE file.
 
@@ -10,8 +10,8 @@ func (uriBinding) Name() string {
 	return "uri"
 }
 
-func (uriBinding) BindUri(m map[string][]string, obj interface{}) error {
-	if err := mapUri(obj, m); err != nil {
+func (uriBinding) BindUri(m map[string][]string, obj any) error {
+	if err := mapURI(obj, m); err != nil {
 		return err
 	}
 	return validate(obj)
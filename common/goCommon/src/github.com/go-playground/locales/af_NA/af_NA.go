@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "$", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "$", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyNegativePrefix: "(",
 		currencyNegativeSuffix: ")",
 		monthsAbbreviated:      []string{"", "Jan.", "Feb.", "Mrt.", "Apr.", "Mei", "Jun.", "Jul.", "Aug.", "Sep.", "Okt.", "Nov.", "Des."},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"v.C.", "n.C."},
 		erasNarrow:             []string{"v.C.", "n.C."},
 		erasWide:               []string{"voor Christus", "na Christus"},
-		timezones:              map[string]string{"AWDT": "Westelike Australiese dagligtyd", "AST": "Atlantiese standaardtyd", "EDT": "Noord-Amerikaanse oostelike dagligtyd", "ACWDT": "Sentraal-westelike Australiese dagligtyd", "MESZ": "Sentraal-Europese somertyd", "HEPM": "Sint-Pierre en Miquelon-dagligtyd", "ART": "Argentinië-standaardtyd", "ChST": "Chamorro-standaardtyd", "SRT": "Suriname-tyd", "CLT": "Chili-standaardtyd", "AEST": "Oostelike Australiese standaardtyd", "AEDT": "Oostelike Australiese dagligtyd", "WAT": "Wes-Afrika-standaardtyd", "BOT": "Bolivia-tyd", "HENOMX": "Noordwes-Meksiko-dagligtyd", "MST": "MST", "ACWST": "Sentraal-westelike Australiese standaard-tyd", "HKST": "Hongkong-somertyd", "CDT": "Noord-Amerikaanse sentrale dagligtyd", "ECT": "Ecuador-tyd", "HEEG": "Oos-Groenland-somertyd", "WIT": "Oos-Indonesië-tyd", "OESZ": "Oos-Europese somertyd", "EST": "Noord-Amerikaanse oostelike standaardtyd", "HEPMX": "Meksikaanse Pasifiese dagligtyd", "NZDT": "Nieu-Seeland-dagligtyd", "HNPMX": "Meksikaanse Pasifiese standaardtyd", "WESZ": "Wes-Europese somertyd", "HEOG": "Wes-Groenland-somertyd", "MEZ": "Sentraal-Europese standaardtyd", "HKT": "Hongkong-standaardtyd", "GMT": "Greenwich-tyd", "HECU": "Kuba-dagligtyd", "AKST": "Alaska-standaardtyd", "COT": "Colombië-standaardtyd", "CHAST": "Chatham-standaardtyd", "PST": "Pasifiese standaardtyd", "AWST": "Westelike Australiese standaardtyd", "ADT": "Atlantiese dagligtyd", "SAST": "Suid-Afrika-standaardtyd", "WARST": "Wes-Argentinië-somertyd", "CLST": "Chili-somertyd", "TMT": "Turkmenistan-standaardtyd", "SGT": "Singapoer-standaardtyd", "HADT": "Hawaii-Aleoete-dagligtyd", "GYT": "Guyana-tyd", "WIB": "Wes-Indonesië-tyd", "HNEG": "Oos-Groenland-standaardtyd", "LHST": "Lord Howe-standaardtyd", "WART": "Wes-Argentinië-standaardtyd", "OEZ": "Oos-Europese standaardtyd", "∅∅∅": "Amasone-somertyd", "CST": "Noord-Amerikaanse sentrale standaardtyd", "CHADT": "Chatham-dagligtyd", "HNCU": "Kuba-standaardtyd", "ACDT": "Sentraal-Australiese dagligtyd", "HAT": "Newfoundland-dagligtyd", "VET": "Venezuela-tyd", "UYST": "Uruguay-somertyd", "BT": "Bhoetan-tyd", "WAST": "Wes-Afrika-somertyd", "JST": "Japan-standaardtyd", "MYT": "Maleisië-tyd", "HNOG": "Wes-Groenland-standaardtyd", "IST": "Indië-standaardtyd", "LHDT": "Lord Howe-dagligtyd", "HNNOMX": "Noordwes-Meksiko-standaardtyd", "CAT": "Sentraal-Afrika-tyd", "HNPM": "Sint-Pierre en Miquelon-standaardtyd", "PDT": "Pasifiese dagligtyd", "EAT": "Oos-Afrika-tyd", "HAST": "Hawaii-Aleoete-standaardtyd", "ARST": "Argentinië-somertyd", "HNT": "Newfoundland-standaardtyd", "MDT": "MDT", "TMST": "Turkmenistan-somertyd", "NZST": "Nieu-Seeland-standaardtyd", "WITA": "Sentraal-Indonesiese tyd", "COST": "Colombië-somertyd", "WEZ": "Wes-Europese standaardtyd", "GFT": "Frans-Guiana-tyd", "AKDT": "Alaska-dagligtyd", "ACST": "Sentraal-Australiese standaardtyd", "UYT": "Uruguay-standaardtyd", "JDT": "Japan-dagligtyd"},
+		timezones:              map[string]string{"ACDT": "Sentraal-Australiese dagligtyd", "ACST": "Sentraal-Australiese standaardtyd", "ACWDT": "Sentraal-westelike Australiese dagligtyd", "ACWST": "Sentraal-westelike Australiese standaard-tyd", "ADT": "Atlantiese dagligtyd", "AEDT": "Oostelike Australiese dagligtyd", "AEST": "Oostelike Australiese standaardtyd", "AKDT": "Alaska-dagligtyd", "AKST": "Alaska-standaardtyd", "ARST": "Argentinië-somertyd", "ART": "Argentinië-standaardtyd", "AST": "Atlantiese standaardtyd", "AWDT": "Westelike Australiese dagligtyd", "AWST": "Westelike Australiese standaardtyd", "BOT": "Bolivia-tyd", "BT": "Bhoetan-tyd", "CAT": "Sentraal-Afrika-tyd", "CDT": "Noord-Amerikaanse sentrale dagligtyd", "CHADT": "Chatham-dagligtyd", "CHAST": "Chatham-standaardtyd", "CLST": "Chili-somertyd", "CLT": "Chili-standaardtyd", "COST": "Colombia-somertyd", "COT": "Colombië-standaardtyd", "CST": "Noord-Amerikaanse sentrale standaardtyd", "ChST": "Chamorro-standaardtyd", "EAT": "Oos-Afrika-tyd", "ECT": "Ecuador-tyd", "EDT": "Noord-Amerikaanse oostelike dagligtyd", "EST": "Noord-Amerikaanse oostelike standaardtyd", "GFT": "Frans-Guiana-tyd", "GMT": "Greenwich-tyd", "GST": "Persiese Golf-standaardtyd", "GYT": "Guyana-tyd", "HADT": "Hawaii-Aleoete-dagligtyd", "HAST": "Hawaii-Aleoete-standaardtyd", "HAT": "Newfoundland-dagligtyd", "HECU": "Kuba-dagligtyd", "HEEG": "Oos-Groenland-somertyd", "HENOMX": "Noordwes-Meksiko-dagligtyd", "HEOG": "Wes-Groenland-somertyd", "HEPM": "Sint-Pierre en Miquelon-dagligtyd", "HEPMX": "Meksikaanse Pasifiese dagligtyd", "HKST": "Hongkong-somertyd", "HKT": "Hongkong-standaardtyd", "HNCU": "Kuba-standaardtyd", "HNEG": "Oos-Groenland-standaardtyd", "HNNOMX": "Noordwes-Meksiko-standaardtyd", "HNOG": "Wes-Groenland-standaardtyd", "HNPM": "Sint-Pierre en Miquelon-standaardtyd", "HNPMX": "Meksikaanse Pasifiese standaardtyd", "HNT": "Newfoundland-standaardtyd", "IST": "Indië-standaardtyd", "JDT": "Japan-dagligtyd", "JST": "Japan-standaardtyd", "LHDT": "Lord Howe-dagligtyd", "LHST": "Lord Howe-standaardtyd", "MDT": "Noord-Amerikaanse berg-dagligtyd", "MESZ": "Sentraal-Europese somertyd", "MEZ": "Sentraal-Europese standaardtyd", "MST": "Noord-Amerikaanse berg-standaardtyd", "MYT": "Maleisië-tyd", "NZDT": "Nieu-Seeland-dagligtyd", "NZST": "Nieu-Seeland-standaardtyd", "OESZ": "Oos-Europese somertyd", "OEZ": "Oos-Europese standaardtyd", "PDT": "Pasifiese dagligtyd", "PST": "Pasifiese standaardtyd", "SAST": "Suid-Afrika-standaardtyd", "SGT": "Singapoer-standaardtyd", "SRT": "Suriname-tyd", "TMST": "Turkmenistan-somertyd", "TMT": "Turkmenistan-standaardtyd", "UYST": "Uruguay-somertyd", "UYT": "Uruguay-standaardtyd", "VET": "Venezuela-tyd", "WARST": "Wes-Argentinië-somertyd", "WART": "Wes-Argentinië-standaardtyd", "WAST": "Wes-Afrika-somertyd", "WAT": "Wes-Afrika-standaardtyd", "WESZ": "Wes-Europese somertyd", "WEZ": "Wes-Europese standaardtyd", "WIB": "Wes-Indonesië-tyd", "WIT": "Oos-Indonesië-tyd", "WITA": "Sentraal-Indonesiese tyd", "∅∅∅": "Asore-somertyd"},
 	}
 }
 
@@ -501,11 +501,13 @@ func (af *af_NA) FmtTimeShort(t time.Time) string {
 
 	b := make([]byte, 0, 32)
 
-	if t.Hour() < 10 {
-		b = append(b, '0')
+	h := t.Hour()
+
+	if h > 12 {
+		h -= 12
 	}
 
-	b = strconv.AppendInt(b, int64(t.Hour()), 10)
+	b = strconv.AppendInt(b, int64(h), 10)
 	b = append(b, af.timeSeparator...)
 
 	if t.Minute() < 10 {
@@ -513,6 +515,13 @@ func (af *af_NA) FmtTimeShort(t time.Time) string {
 	}
 
 	b = strconv.AppendInt(b, int64(t.Minute()), 10)
+	b = append(b, []byte{0x20}...)
+
+	if t.Hour() < 12 {
+		b = append(b, af.periodsAbbreviated[0]...)
+	} else {
+		b = append(b, af.periodsAbbreviated[1]...)
+	}
 
 	return string(b)
 }
@@ -522,11 +531,13 @@ func (af *af_NA) FmtTimeMedium(t time.Time) string {
 
 	b := make([]byte, 0, 32)
 
-	if t.Hour() < 10 {
-		b = append(b, '0')
+	h := t.Hour()
+
+	if h > 12 {
+		h -= 12
 	}
 
-	b = strconv.AppendInt(b, int64(t.Hour()), 10)
+	b = strconv.AppendInt(b, int64(h), 10)
 	b = append(b, af.timeSeparator...)
 
 	if t.Minute() < 10 {
@@ -541,6 +552,13 @@ func (af *af_NA) FmtTimeMedium(t time.Time) string {
 	}
 
 	b = strconv.AppendInt(b, int64(t.Second()), 10)
+	b = append(b, []byte{0x20}...)
+
+	if t.Hour() < 12 {
+		b = append(b, af.periodsAbbreviated[0]...)
+	} else {
+		b = append(b, af.periodsAbbreviated[1]...)
+	}
 
 	return string(b)
 }
@@ -550,11 +568,13 @@ func (af *af_NA) FmtTimeLong(t time.Time) string {
 
 	b := make([]byte, 0, 32)
 
-	if t.Hour() < 10 {
-		b = append(b, '0')
+	h := t.Hour()
+
+	if h > 12 {
+		h -= 12
 	}
 
-	b = strconv.AppendInt(b, int64(t.Hour()), 10)
+	b = strconv.AppendInt(b, int64(h), 10)
 	b = append(b, af.timeSeparator...)
 
 	if t.Minute() < 10 {
@@ -571,6 +591,14 @@ func (af *af_NA) FmtTimeLong(t time.Time) string {
 	b = strconv.AppendInt(b, int64(t.Second()), 10)
 	b = append(b, []byte{0x20}...)
 
+	if t.Hour() < 12 {
+		b = append(b, af.periodsAbbreviated[0]...)
+	} else {
+		b = append(b, af.periodsAbbreviated[1]...)
+	}
+
+	b = append(b, []byte{0x20}...)
+
 	tz, _ := t.Zone()
 	b = append(b, tz...)
 
@@ -582,11 +610,13 @@ func (af *af_NA) FmtTimeFull(t time.Time) string {
 
 	b := make([]byte, 0, 32)
 
-	if t.Hour() < 10 {
-		b = append(b, '0')
+	h := t.Hour()
+
+	if h > 12 {
+		h -= 12
 	}
 
-	b = strconv.AppendInt(b, int64(t.Hour()), 10)
+	b = strconv.AppendInt(b, int64(h), 10)
 	b = append(b, af.timeSeparator...)
 
 	if t.Minute() < 10 {
@@ -603,6 +633,14 @@ func (af *af_NA) FmtTimeFull(t time.Time) string {
 	b = strconv.AppendInt(b, int64(t.Second()), 10)
 	b = append(b, []byte{0x20}...)
 
+	if t.Hour() < 12 {
+		b = append(b, af.periodsAbbreviated[0]...)
+	} else {
+		b = append(b, af.periodsAbbreviated[1]...)
+	}
+
+	b = append(b, []byte{0x20}...)
+
 	tz, _ := t.Zone()
 
 	if btz, ok := af.timezones[tz]; ok {
@@ -56,7 +56,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "₧", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "₧", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativePrefix: "(",
 		currencyNegativeSuffix: " )",
@@ -67,13 +67,13 @@ func New() locales.Translator {
 		daysNarrow:             []string{"dg", "dl", "dt", "dc", "dj", "dv", "ds"},
 		daysShort:              []string{"dg.", "dl.", "dt.", "dc.", "dj.", "dv.", "ds."},
 		daysWide:               []string{"diumenge", "dilluns", "dimarts", "dimecres", "dijous", "divendres", "dissabte"},
-		periodsAbbreviated:     []string{"a. m.", "p. m."},
-		periodsNarrow:          []string{"a. m.", "p. m."},
-		periodsWide:            []string{"a. m.", "p. m."},
+		periodsAbbreviated:     []string{"a.\u00a0m.", "p.\u00a0m."},
+		periodsNarrow:          []string{"a.\u00a0m.", "p.\u00a0m."},
+		periodsWide:            []string{"a.\u00a0m.", "p.\u00a0m."},
 		erasAbbreviated:        []string{"aC", "dC"},
 		erasNarrow:             []string{"aC", "dC"},
 		erasWide:               []string{"abans de Crist", "després de Crist"},
-		timezones:              map[string]string{"LHST": "Hora estàndard de Lord Howe", "ACWST": "Hora estàndard d’Austràlia centre-occidental", "HECU": "Hora d’estiu de Cuba", "HNPM": "Hora estàndard de Saint-Pierre i Miquelon", "HADT": "Hora d’estiu de Hawaii-Aleutianes", "HKST": "Hora d’estiu de Hong Kong", "LHDT": "Horari d’estiu de Lord Howe", "HNPMX": "Hora estàndard del Pacífic de Mèxic", "ADT": "Hora d’estiu de l’Atlàntic", "GMT": "Hora del Meridià de Greenwich", "CHAST": "Hora estàndard de Chatham", "HNT": "Hora estàndard de Terranova", "CAT": "Hora de l’Àfrica Central", "EAT": "Hora de l’Àfrica Oriental", "COST": "Hora d’estiu de Colòmbia", "CHADT": "Hora d’estiu de Chatham", "PST": "Hora estàndard del Pacífic", "MEZ": "Hora estàndard del Centre d’Europa", "WARST": "Hora d’estiu de l’oest de l’Argentina", "AWST": "Hora estàndard d’Austràlia Occidental", "AWDT": "Hora d’estiu d’Austràlia Occidental", "OESZ": "Hora d’estiu de l’Est d’Europa", "PDT": "Hora d’estiu del Pacífic", "HNNOMX": "Hora estàndard del nord-oest de Mèxic", "MST": "Hora estàndard de Macau", "MDT": "Hora d’estiu de Macau", "HAST": "Hora estàndard de Hawaii-Aleutianes", "ART": "Hora estàndard de l’Argentina", "GYT": "Hora de Guyana", "MESZ": "Hora d’estiu del Centre d’Europa", "VET": "Hora de Veneçuela", "HNEG": "Hora estàndard de l’Est de Grenlàndia", "WAST": "Hora d’estiu de l’Àfrica Occidental", "JST": "Hora estàndard del Japó", "HEPMX": "Hora d’estiu del Pacífic de Mèxic", "WIB": "Hora de l’oest d’Indonèsia", "SAST": "Hora estàndard del sud de l’Àfrica", "NZST": "Hora estàndard de Nova Zelanda", "ACWDT": "Hora d’estiu d’Austràlia centre-occidental", "WART": "Hora estàndard de l’oest de l’Argentina", "CLST": "Hora d’estiu de Xile", "WEZ": "Hora estàndard de l’Oest d’Europa", "AKST": "Hora estàndard d’Alaska", "EST": "Hora estàndard oriental d’Amèrica del Nord", "ACST": "Hora estàndard d’Austràlia Central", "WIT": "Hora de l’est d’Indonèsia", "TMST": "Hora d’estiu del Turkmenistan", "ECT": "Hora de l’Equador", "HKT": "Hora estàndard de Hong Kong", "TMT": "Hora estàndard del Turkmenistan", "OEZ": "Hora estàndard de l’Est d’Europa", "CDT": "Hora d’estiu central d’Amèrica del Nord", "WAT": "Hora estàndard de l’Àfrica Occidental", "BOT": "Hora de Bolívia", "JDT": "Hora d’estiu del Japó", "NZDT": "Hora d’estiu de Nova Zelanda", "∅∅∅": "Hora d’estiu de les Açores", "CLT": "Hora estàndard de Xile", "EDT": "Hora d’estiu oriental d’Amèrica del Nord", "ARST": "Hora d’estiu de l’Argentina", "HNCU": "Hora estàndard de Cuba", "MYT": "Hora de Malàisia", "AKDT": "Hora d’estiu d’Alaska", "WITA": "Hora central d’Indonèsia", "HAT": "Hora d’estiu de Terranova", "WESZ": "Hora d’estiu de l’Oest d’Europa", "HEOG": "Hora d’estiu de l’Oest de Grenlàndia", "ACDT": "Hora d’estiu d’Austràlia Central", "IST": "Hora estàndard de l’Índia", "AEDT": "Hora d’estiu d’Austràlia Oriental", "CST": "Hora estàndard central d’Amèrica del Nord", "BT": "Hora de Bhutan", "SGT": "Hora de Singapur", "HEEG": "Hora d’estiu de l’Est de Grenlàndia", "SRT": "Hora de Surinam", "UYST": "Hora d’estiu de l’Uruguai", "AST": "Hora estàndard de l’Atlàntic", "GFT": "Hora de la Guaiana Francesa", "HNOG": "Hora estàndard de l’Oest de Grenlàndia", "HENOMX": "Hora d’estiu del nord-oest de Mèxic", "UYT": "Hora estàndard de l’Uruguai", "ChST": "Hora de Chamorro", "AEST": "Hora estàndard d’Austràlia Oriental", "HEPM": "Hora d’estiu de Saint-Pierre i Miquelon", "COT": "Hora estàndard de Colòmbia"},
+		timezones:              map[string]string{"ACDT": "Hora d’estiu d’Austràlia Central", "ACST": "Hora estàndard d’Austràlia Central", "ACWDT": "Hora d’estiu d’Austràlia centre-occidental", "ACWST": "Hora estàndard d’Austràlia centre-occidental", "ADT": "Hora d’estiu de l’Atlàntic", "AEDT": "Hora d’estiu d’Austràlia Oriental", "AEST": "Hora estàndard d’Austràlia Oriental", "AKDT": "Hora d’estiu d’Alaska", "AKST": "Hora estàndard d’Alaska", "ARST": "Hora d’estiu de l’Argentina", "ART": "Hora estàndard de l’Argentina", "AST": "Hora estàndard de l’Atlàntic", "AWDT": "Hora d’estiu d’Austràlia Occidental", "AWST": "Hora estàndard d’Austràlia Occidental", "BOT": "Hora de Bolívia", "BT": "Hora de Bhutan", "CAT": "Hora de l’Àfrica Central", "CDT": "Hora d’estiu central d’Amèrica del Nord", "CHADT": "Hora d’estiu de Chatham", "CHAST": "Hora estàndard de Chatham", "CLST": "Hora d’estiu de Xile", "CLT": "Hora estàndard de Xile", "COST": "Hora d’estiu de Colòmbia", "COT": "Hora estàndard de Colòmbia", "CST": "Hora estàndard central d’Amèrica del Nord", "ChST": "Hora de Chamorro", "EAT": "Hora de l’Àfrica Oriental", "ECT": "Hora de l’Equador", "EDT": "Hora d’estiu oriental d’Amèrica del Nord", "EST": "Hora estàndard oriental d’Amèrica del Nord", "GFT": "Hora de la Guaiana Francesa", "GMT": "Hora del Meridià de Greenwich", "GST": "Hora del Golf", "GYT": "Hora de Guyana", "HADT": "Hora d’estiu de Hawaii-Aleutianes", "HAST": "Hora estàndard de Hawaii-Aleutianes", "HAT": "Hora d’estiu de Terranova", "HECU": "Hora d’estiu de Cuba", "HEEG": "Hora d’estiu de l’Est de Groenlàndia", "HENOMX": "Hora d’estiu del nord-oest de Mèxic", "HEOG": "Hora d’estiu de l’Oest de Groenlàndia", "HEPM": "Hora d’estiu de Saint-Pierre-et-Miquelon", "HEPMX": "Hora d’estiu del Pacífic de Mèxic", "HKST": "Hora d’estiu de Hong Kong", "HKT": "Hora estàndard de Hong Kong", "HNCU": "Hora estàndard de Cuba", "HNEG": "Hora estàndard de l’Est de Groenlàndia", "HNNOMX": "Hora estàndard del nord-oest de Mèxic", "HNOG": "Hora estàndard de l’Oest de Groenlàndia", "HNPM": "Hora estàndard de Saint-Pierre-et-Miquelon", "HNPMX": "Hora estàndard del Pacífic de Mèxic", "HNT": "Hora estàndard de Terranova", "IST": "Hora de l’Índia", "JDT": "Hora d’estiu del Japó", "JST": "Hora estàndard del Japó", "LHDT": "Horari d’estiu de Lord Howe", "LHST": "Hora estàndard de Lord Howe", "MDT": "Hora d’estiu de muntanya d’Amèrica del Nord", "MESZ": "Hora d’estiu del Centre d’Europa", "MEZ": "Hora estàndard del Centre d’Europa", "MST": "Hora estàndard de muntanya d’Amèrica del Nord", "MYT": "Hora de Malàisia", "NZDT": "Hora d’estiu de Nova Zelanda", "NZST": "Hora estàndard de Nova Zelanda", "OESZ": "Hora d’estiu de l’Est d’Europa", "OEZ": "Hora estàndard de l’Est d’Europa", "PDT": "Hora d’estiu del Pacífic d’Amèrica del Nord", "PST": "Hora estàndard del Pacífic d’Amèrica del Nord", "SAST": "Hora estàndard del sud de l’Àfrica", "SGT": "Hora de Singapur", "SRT": "Hora de Surinam", "TMST": "Hora d’estiu del Turkmenistan", "TMT": "Hora estàndard del Turkmenistan", "UYST": "Hora d’estiu de l’Uruguai", "UYT": "Hora estàndard de l’Uruguai", "VET": "Hora de Veneçuela", "WARST": "Hora d’estiu de l’oest de l’Argentina", "WART": "Hora estàndard de l’oest de l’Argentina", "WAST": "Hora d’estiu de l’Àfrica Occidental", "WAT": "Hora estàndard de l’Àfrica Occidental", "WESZ": "Hora d’estiu de l’Oest d’Europa", "WEZ": "Hora estàndard de l’Oest d’Europa", "WIB": "Hora de l’oest d’Indonèsia", "WIT": "Hora de l’est d’Indonèsia", "WITA": "Hora central d’Indonèsia", "∅∅∅": "Hora d’estiu de les Açores"},
 	}
 }
 
@@ -56,7 +56,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ".",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "mk", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "mk", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		percentSuffix:          " ",
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
@@ -73,7 +73,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"eKr.", "jKr."},
 		erasNarrow:             []string{"eKr", "jKr"},
 		erasWide:               []string{"ennen Kristuksen syntymää", "jälkeen Kristuksen syntymän"},
-		timezones:              map[string]string{"ACDT": "Keski-Australian kesäaika", "OEZ": "Itä-Euroopan normaaliaika", "AEDT": "Itä-Australian kesäaika", "WEZ": "Länsi-Euroopan normaaliaika", "HNOG": "Länsi-Grönlannin normaaliaika", "HEOG": "Länsi-Grönlannin kesäaika", "AWST": "Länsi-Australian normaaliaika", "ARST": "Argentiinan kesäaika", "NZDT": "Uuden-Seelannin kesäaika", "HADT": "Havaijin-Aleuttien kesäaika", "GMT": "Greenwichin normaaliaika", "HNPMX": "Meksikon Tyynenmeren normaaliaika", "AST": "Kanadan Atlantin normaaliaika", "JDT": "Japanin kesäaika", "CST": "Yhdysvaltain keskinen normaaliaika", "AEST": "Itä-Australian normaaliaika", "MDT": "Macaon kesäaika", "MEZ": "Keski-Euroopan normaaliaika", "HEPM": "Saint-Pierren ja Miquelonin kesäaika", "PST": "Yhdysvaltain Tyynenmeren normaaliaika", "ACWST": "Läntisen Keski-Australian normaaliaika", "IST": "Intian aika", "TMST": "Turkmenistanin kesäaika", "COT": "Kolumbian normaaliaika", "GYT": "Guyanan aika", "ChST": "Tšamorron aika", "WAT": "Länsi-Afrikan normaaliaika", "SGT": "Singaporen aika", "AKDT": "Alaskan kesäaika", "EST": "Yhdysvaltain itäinen normaaliaika", "HKST": "Hongkongin kesäaika", "HNPM": "Saint-Pierren ja Miquelonin normaaliaika", "HAST": "Havaijin-Aleuttien normaaliaika", "CHAST": "Chathamin normaaliaika", "CHADT": "Chathamin kesäaika", "GFT": "Ranskan Guayanan aika", "WAST": "Länsi-Afrikan kesäaika", "HEPMX": "Meksikon Tyynenmeren kesäaika", "BT": "Bhutanin aika", "HNEG": "Itä-Grönlannin normaaliaika", "LHDT": "Lord Howen kesäaika", "HAT": "Newfoundlandin kesäaika", "WIT": "Itä-Indonesian aika", "EAT": "Itä-Afrikan aika", "WESZ": "Länsi-Euroopan kesäaika", "JST": "Japanin normaaliaika", "NZST": "Uuden-Seelannin normaaliaika", "AKST": "Alaskan normaaliaika", "WITA": "Keski-Indonesian aika", "SRT": "Surinamen aika", "TMT": "Turkmenistanin normaaliaika", "CLST": "Chilen kesäaika", "SAST": "Etelä-Afrikan aika", "BOT": "Bolivian aika", "MESZ": "Keski-Euroopan kesäaika", "ART": "Argentiinan normaaliaika", "CDT": "Yhdysvaltain keskinen kesäaika", "MYT": "Malesian aika", "HKT": "Hongkongin normaaliaika", "HENOMX": "Luoteis-Meksikon kesäaika", "OESZ": "Itä-Euroopan kesäaika", "HECU": "Kuuban kesäaika", "HEEG": "Itä-Grönlannin kesäaika", "LHST": "Lord Howen normaaliaika", "HNT": "Newfoundlandin normaaliaika", "CAT": "Keski-Afrikan aika", "COST": "Kolumbian kesäaika", "ACST": "Keski-Australian normaaliaika", "WARST": "Länsi-Argentiinan kesäaika", "VET": "Venezuelan aika", "CLT": "Chilen normaaliaika", "HNCU": "Kuuban normaaliaika", "ACWDT": "Läntisen Keski-Australian kesäaika", "∅∅∅": "Azorien kesäaika", "HNNOMX": "Luoteis-Meksikon normaaliaika", "MST": "Macaon normaaliaika", "PDT": "Yhdysvaltain Tyynenmeren kesäaika", "AWDT": "Länsi-Australian kesäaika", "ADT": "Kanadan Atlantin kesäaika", "EDT": "Yhdysvaltain itäinen kesäaika", "WART": "Länsi-Argentiinan normaaliaika", "UYT": "Uruguayn normaaliaika", "UYST": "Uruguayn kesäaika", "WIB": "Länsi-Indonesian aika", "ECT": "Ecuadorin aika"},
+		timezones:              map[string]string{"ACDT": "Keski-Australian kesäaika", "ACST": "Keski-Australian normaaliaika", "ACWDT": "Läntisen Keski-Australian kesäaika", "ACWST": "Läntisen Keski-Australian normaaliaika", "ADT": "Kanadan Atlantin kesäaika", "AEDT": "Itä-Australian kesäaika", "AEST": "Itä-Australian normaaliaika", "AKDT": "Alaskan kesäaika", "AKST": "Alaskan normaaliaika", "ARST": "Argentiinan kesäaika", "ART": "Argentiinan normaaliaika", "AST": "Kanadan Atlantin normaaliaika", "AWDT": "Länsi-Australian kesäaika", "AWST": "Länsi-Australian normaaliaika", "BOT": "Bolivian aika", "BT": "Bhutanin aika", "CAT": "Keski-Afrikan aika", "CDT": "Yhdysvaltain keskinen kesäaika", "CHADT": "Chathamin kesäaika", "CHAST": "Chathamin normaaliaika", "CLST": "Chilen kesäaika", "CLT": "Chilen normaaliaika", "COST": "Kolumbian kesäaika", "COT": "Kolumbian normaaliaika", "CST": "Yhdysvaltain keskinen normaaliaika", "ChST": "Tšamorron aika", "EAT": "Itä-Afrikan aika", "ECT": "Ecuadorin aika", "EDT": "Yhdysvaltain itäinen kesäaika", "EST": "Yhdysvaltain itäinen normaaliaika", "GFT": "Ranskan Guayanan aika", "GMT": "Greenwichin normaaliaika", "GST": "Arabiemiirikuntien normaaliaika", "GYT": "Guyanan aika", "HADT": "Havaijin-Aleuttien kesäaika", "HAST": "Havaijin-Aleuttien normaaliaika", "HAT": "Newfoundlandin kesäaika", "HECU": "Kuuban kesäaika", "HEEG": "Itä-Grönlannin kesäaika", "HENOMX": "Luoteis-Meksikon kesäaika", "HEOG": "Länsi-Grönlannin kesäaika", "HEPM": "Saint-Pierren ja Miquelonin kesäaika", "HEPMX": "Meksikon Tyynenmeren kesäaika", "HKST": "Hongkongin kesäaika", "HKT": "Hongkongin normaaliaika", "HNCU": "Kuuban normaaliaika", "HNEG": "Itä-Grönlannin normaaliaika", "HNNOMX": "Luoteis-Meksikon normaaliaika", "HNOG": "Länsi-Grönlannin normaaliaika", "HNPM": "Saint-Pierren ja Miquelonin normaaliaika", "HNPMX": "Meksikon Tyynenmeren normaaliaika", "HNT": "Newfoundlandin normaaliaika", "IST": "Intian aika", "JDT": "Japanin kesäaika", "JST": "Japanin normaaliaika", "LHDT": "Lord Howen kesäaika", "LHST": "Lord Howen normaaliaika", "MDT": "Kalliovuorten kesäaika", "MESZ": "Keski-Euroopan kesäaika", "MEZ": "Keski-Euroopan normaaliaika", "MST": "Kalliovuorten normaaliaika", "MYT": "Malesian aika", "NZDT": "Uuden-Seelannin kesäaika", "NZST": "Uuden-Seelannin normaaliaika", "OESZ": "Itä-Euroopan kesäaika", "OEZ": "Itä-Euroopan normaaliaika", "PDT": "Yhdysvaltain Tyynenmeren kesäaika", "PST": "Yhdysvaltain Tyynenmeren normaaliaika", "SAST": "Etelä-Afrikan aika", "SGT": "Singaporen aika", "SRT": "Surinamen aika", "TMST": "Turkmenistanin kesäaika", "TMT": "Turkmenistanin normaaliaika", "UYST": "Uruguayn kesäaika", "UYT": "Uruguayn normaaliaika", "VET": "Venezuelan aika", "WARST": "Länsi-Argentiinan kesäaika", "WART": "Länsi-Argentiinan normaaliaika", "WAST": "Länsi-Afrikan kesäaika", "WAT": "Länsi-Afrikan normaaliaika", "WESZ": "Länsi-Euroopan kesäaika", "WEZ": "Länsi-Euroopan normaaliaika", "WIB": "Länsi-Indonesian aika", "WIT": "Itä-Indonesian aika", "WITA": "Keski-Indonesian aika", "∅∅∅": "Brasilian kesäaika"},
 	}
 }
 
@@ -57,7 +57,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "A$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "kr", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "A$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "kr", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		percentSuffix:          " ",
 		currencyPositiveSuffix: " ",
 		currencyNegativePrefix: "(",
@@ -75,7 +75,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"f.Kr.", "e.Kr."},
 		erasNarrow:             []string{"fKr", "eKr"},
 		erasWide:               []string{"fyri Krist", "eftir Krist"},
-		timezones:              map[string]string{"HENOMX": "Northwest Mexico summartíð", "CLT": "Kili vanlig tíð", "HEPMX": "Mexican Pacific summartíð", "WEZ": "Vesturevropa vanlig tíð", "ECT": "Ekvador tíð", "EST": "Eastern vanlig tíð", "MESZ": "Miðevropa summartíð", "WART": "Vestur Argentina vanlig tíð", "HAT": "Newfoundland summartíð", "VET": "Venesuela tíð", "WARST": "Vestur Argentina summartíð", "SRT": "Surinam tíð", "CLST": "Kili summartíð", "HNCU": "Cuba vanlig tíð", "PDT": "Pacific summartíð", "BT": "Butan tíð", "GFT": "Franska Gujana tíð", "ACWST": "miðvestur Avstralia vanlig tíð", "HEEG": "Eystur grønlendsk summartíð", "HKST": "Hong Kong summartíð", "MDT": "MDT", "AEDT": "eystur Avstralia summartíð", "BOT": "Bolivia tíð", "JST": "Japan vanlig tíð", "ACST": "mið Avstralia vanlig tíð", "WIT": "Eystur Indonesia tíð", "TMT": "Turkmenistan vanlig tíð", "COST": "Kolombia summartíð", "GMT": "Greenwich Mean tíð", "HNNOMX": "Northwest Mexico vanlig tíð", "CAT": "Miðafrika tíð", "HADT": "Hawaii-Aleutian summartíð", "COT": "Kolombia vanlig tíð", "CDT": "Central summartíð", "OESZ": "Eysturevropa summartíð", "∅∅∅": "Azorurnar summartíð", "HAST": "Hawaii-Aleutian vanlig tíð", "CHADT": "Chatham summartíð", "HNPMX": "Mexican Pacific vanlig tíð", "MEZ": "Miðevropa vanlig tíð", "AST": "Atlantic vanlig tíð", "AEST": "eystur Avstralia vanlig tíð", "NZDT": "Nýsæland summartíð", "EDT": "Eastern summartíð", "HEOG": "Vestur grønlendsk summartíð", "HKT": "Hong Kong vanlig tíð", "HNPM": "St. Pierre & Miquelon vanlig tíð", "OEZ": "Eysturevropa vanlig tíð", "UYST": "Uruguai summartíð", "AWDT": "vestur Avstralia summartíð", "ACWDT": "miðvestur Avstralia summartíð", "LHDT": "Lord Howe summartíð", "WITA": "Mið Indonesia tíð", "ADT": "Atlantic summartíð", "HEPM": "St. Pierre & Miquelon summartíð", "MST": "MST", "CST": "Central vanlig tíð", "PST": "Pacific vanlig tíð", "WIB": "Vestur Indonesia tíð", "AKDT": "Alaska summartíð", "SGT": "Singapor tíð", "HNEG": "Eystur grønlendsk vanlig tíð", "LHST": "Lord Howe vanlig tíð", "HNT": "Newfoundland vanlig tíð", "UYT": "Uruguai vanlig tíð", "ChST": "Chamorro vanlig tíð", "EAT": "Eysturafrika tíð", "CHAST": "Chatham vanlig tíð", "AWST": "vestur Avstralia vanlig tíð", "SAST": "Suðurafrika vanlig tíð", "WESZ": "Vesturevropa summartíð", "HNOG": "Vestur grønlendsk vanlig tíð", "GYT": "Gujana tíð", "HECU": "Cuba summartíð", "WAT": "Vesturafrika vanlig tíð", "NZST": "Nýsæland vanlig tíð", "JDT": "Japan summartíð", "AKST": "Alaska vanlig tíð", "ACDT": "mið Avstralia summartíð", "IST": "India tíð", "TMST": "Turkmenistan summartíð", "ART": "Argentina vanlig tíð", "ARST": "Argentina summartíð", "WAST": "Vesturafrika summartíð", "MYT": "Malaisia tíð"},
+		timezones:              map[string]string{"ACDT": "mið Avstralia summartíð", "ACST": "mið Avstralia vanlig tíð", "ACWDT": "miðvestur Avstralia summartíð", "ACWST": "miðvestur Avstralia vanlig tíð", "ADT": "Atlantic summartíð", "AEDT": "eystur Avstralia summartíð", "AEST": "eystur Avstralia vanlig tíð", "AKDT": "Alaska summartíð", "AKST": "Alaska vanlig tíð", "ARST": "Argentina summartíð", "ART": "Argentina vanlig tíð", "AST": "Atlantic vanlig tíð", "AWDT": "vestur Avstralia summartíð", "AWST": "vestur Avstralia vanlig tíð", "BOT": "Bolivia tíð", "BT": "Butan tíð", "CAT": "Miðafrika tíð", "CDT": "Central summartíð", "CHADT": "Chatham summartíð", "CHAST": "Chatham vanlig tíð", "CLST": "Kili summartíð", "CLT": "Kili vanlig tíð", "COST": "Kolombia summartíð", "COT": "Kolombia vanlig tíð", "CST": "Central vanlig tíð", "ChST": "Chamorro vanlig tíð", "EAT": "Eysturafrika tíð", "ECT": "Ekvador tíð", "EDT": "Eastern summartíð", "EST": "Eastern vanlig tíð", "GFT": "Franska Gujana tíð", "GMT": "Greenwich Mean tíð", "GST": "Gulf vanlig tíð", "GYT": "Gujana tíð", "HADT": "Hawaii-Aleutian summartíð", "HAST": "Hawaii-Aleutian vanlig tíð", "HAT": "Newfoundland summartíð", "HECU": "Cuba summartíð", "HEEG": "Eystur grønlendsk summartíð", "HENOMX": "Northwest Mexico summartíð", "HEOG": "Vestur grønlendsk summartíð", "HEPM": "St. Pierre & Miquelon summartíð", "HEPMX": "Mexican Pacific summartíð", "HKST": "Hong Kong summartíð", "HKT": "Hong Kong vanlig tíð", "HNCU": "Cuba vanlig tíð", "HNEG": "Eystur grønlendsk vanlig tíð", "HNNOMX": "Northwest Mexico vanlig tíð", "HNOG": "Vestur grønlendsk vanlig tíð", "HNPM": "St. Pierre & Miquelon vanlig tíð", "HNPMX": "Mexican Pacific vanlig tíð", "HNT": "Newfoundland vanlig tíð", "IST": "India tíð", "JDT": "Japan summartíð", "JST": "Japan vanlig tíð", "LHDT": "Lord Howe summartíð", "LHST": "Lord Howe vanlig tíð", "MDT": "Mountain summartíð", "MESZ": "Miðevropa summartíð", "MEZ": "Miðevropa vanlig tíð", "MST": "Mountain vanlig tíð", "MYT": "Malaisia tíð", "NZDT": "Nýsæland summartíð", "NZST": "Nýsæland vanlig tíð", "OESZ": "Eysturevropa summartíð", "OEZ": "Eysturevropa vanlig tíð", "PDT": "Pacific summartíð", "PST": "Pacific vanlig tíð", "SAST": "Suðurafrika vanlig tíð", "SGT": "Singapor tíð", "SRT": "Surinam tíð", "TMST": "Turkmenistan summartíð", "TMT": "Turkmenistan vanlig tíð", "UYST": "Uruguai summartíð", "UYT": "Uruguai vanlig tíð", "VET": "Venesuela tíð", "WARST": "Vestur Argentina summartíð", "WART": "Vestur Argentina vanlig tíð", "WAST": "Vesturafrika summartíð", "WAT": "Vesturafrika vanlig tíð", "WESZ": "Vesturevropa summartíð", "WEZ": "Vesturevropa vanlig tíð", "WIB": "Vestur Indonesia tíð", "WIT": "Eystur Indonesia tíð", "WITA": "Mið Indonesia tíð", "∅∅∅": "Amasona summartíð"},
 	}
 }
 
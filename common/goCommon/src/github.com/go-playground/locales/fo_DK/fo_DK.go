@@ -57,7 +57,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "kr.", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "kr.", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		percentSuffix:          " ",
 		currencyPositiveSuffix: " ",
 		currencyNegativePrefix: "(",
@@ -75,7 +75,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"f.Kr.", "e.Kr."},
 		erasNarrow:             []string{"fKr", "eKr"},
 		erasWide:               []string{"fyri Krist", "eftir Krist"},
-		timezones:              map[string]string{"EST": "Eastern vanlig tíð", "ACDT": "mið Avstralia summartíð", "HKST": "Hong Kong summartíð", "HAST": "Hawaii-Aleutian vanlig tíð", "SAST": "Suðurafrika vanlig tíð", "WEZ": "Vesturevropa vanlig tíð", "GFT": "Franska Gujana tíð", "SGT": "Singapor tíð", "HNEG": "Eystur grønlendsk vanlig tíð", "HEPM": "St. Pierre & Miquelon summartíð", "CLST": "Kili summartíð", "CHADT": "Chatham summartíð", "WESZ": "Vesturevropa summartíð", "CST": "Central vanlig tíð", "AKST": "Alaska vanlig tíð", "MESZ": "Miðevropa summartíð", "WART": "Vestur Argentina vanlig tíð", "HNNOMX": "Northwest Mexico vanlig tíð", "WIT": "Eystur Indonesia tíð", "OEZ": "Eysturevropa vanlig tíð", "BT": "Butan tíð", "ACWST": "miðvestur Avstralia vanlig tíð", "HNPM": "St. Pierre & Miquelon vanlig tíð", "UYT": "Uruguai vanlig tíð", "CLT": "Kili vanlig tíð", "MYT": "Malaisia tíð", "AKDT": "Alaska summartíð", "EDT": "Eastern summartíð", "ACWDT": "miðvestur Avstralia summartíð", "LHST": "Lord Howe vanlig tíð", "VET": "Venesuela tíð", "NZDT": "Nýsæland summartíð", "HNCU": "Cuba vanlig tíð", "UYST": "Uruguai summartíð", "AEST": "eystur Avstralia vanlig tíð", "JDT": "Japan summartíð", "TMST": "Turkmenistan summartíð", "ART": "Argentina vanlig tíð", "COT": "Kolombia vanlig tíð", "COST": "Kolombia summartíð", "GYT": "Gujana tíð", "HEOG": "Vestur grønlendsk summartíð", "PDT": "Pacific summartíð", "HEPMX": "Mexican Pacific summartíð", "LHDT": "Lord Howe summartíð", "HENOMX": "Northwest Mexico summartíð", "MST": "MST", "HKT": "Hong Kong vanlig tíð", "OESZ": "Eysturevropa summartíð", "CDT": "Central summartíð", "PST": "Pacific vanlig tíð", "AWDT": "vestur Avstralia summartíð", "AST": "Atlantic vanlig tíð", "CHAST": "Chatham vanlig tíð", "AEDT": "eystur Avstralia summartíð", "HEEG": "Eystur grønlendsk summartíð", "HNT": "Newfoundland vanlig tíð", "HAT": "Newfoundland summartíð", "MDT": "MDT", "TMT": "Turkmenistan vanlig tíð", "HADT": "Hawaii-Aleutian summartíð", "WAST": "Vesturafrika summartíð", "WIB": "Vestur Indonesia tíð", "BOT": "Bolivia tíð", "WITA": "Mið Indonesia tíð", "AWST": "vestur Avstralia vanlig tíð", "NZST": "Nýsæland vanlig tíð", "JST": "Japan vanlig tíð", "HNOG": "Vestur grønlendsk vanlig tíð", "IST": "India tíð", "ADT": "Atlantic summartíð", "SRT": "Surinam tíð", "CAT": "Miðafrika tíð", "EAT": "Eysturafrika tíð", "GMT": "Greenwich Mean tíð", "ChST": "Chamorro vanlig tíð", "HECU": "Cuba summartíð", "HNPMX": "Mexican Pacific vanlig tíð", "WAT": "Vesturafrika vanlig tíð", "ECT": "Ekvador tíð", "ACST": "mið Avstralia vanlig tíð", "MEZ": "Miðevropa vanlig tíð", "∅∅∅": "Azorurnar summartíð", "WARST": "Vestur Argentina summartíð", "ARST": "Argentina summartíð"},
+		timezones:              map[string]string{"ACDT": "mið Avstralia summartíð", "ACST": "mið Avstralia vanlig tíð", "ACWDT": "miðvestur Avstralia summartíð", "ACWST": "miðvestur Avstralia vanlig tíð", "ADT": "Atlantic summartíð", "AEDT": "eystur Avstralia summartíð", "AEST": "eystur Avstralia vanlig tíð", "AKDT": "Alaska summartíð", "AKST": "Alaska vanlig tíð", "ARST": "Argentina summartíð", "ART": "Argentina vanlig tíð", "AST": "Atlantic vanlig tíð", "AWDT": "vestur Avstralia summartíð", "AWST": "vestur Avstralia vanlig tíð", "BOT": "Bolivia tíð", "BT": "Butan tíð", "CAT": "Miðafrika tíð", "CDT": "Central summartíð", "CHADT": "Chatham summartíð", "CHAST": "Chatham vanlig tíð", "CLST": "Kili summartíð", "CLT": "Kili vanlig tíð", "COST": "Kolombia summartíð", "COT": "Kolombia vanlig tíð", "CST": "Central vanlig tíð", "ChST": "Chamorro vanlig tíð", "EAT": "Eysturafrika tíð", "ECT": "Ekvador tíð", "EDT": "Eastern summartíð", "EST": "Eastern vanlig tíð", "GFT": "Franska Gujana tíð", "GMT": "Greenwich Mean tíð", "GST": "Gulf vanlig tíð", "GYT": "Gujana tíð", "HADT": "Hawaii-Aleutian summartíð", "HAST": "Hawaii-Aleutian vanlig tíð", "HAT": "Newfoundland summartíð", "HECU": "Cuba summartíð", "HEEG": "Eystur grønlendsk summartíð", "HENOMX": "Northwest Mexico summartíð", "HEOG": "Vestur grønlendsk summartíð", "HEPM": "St. Pierre & Miquelon summartíð", "HEPMX": "Mexican Pacific summartíð", "HKST": "Hong Kong summartíð", "HKT": "Hong Kong vanlig tíð", "HNCU": "Cuba vanlig tíð", "HNEG": "Eystur grønlendsk vanlig tíð", "HNNOMX": "Northwest Mexico vanlig tíð", "HNOG": "Vestur grønlendsk vanlig tíð", "HNPM": "St. Pierre & Miquelon vanlig tíð", "HNPMX": "Mexican Pacific vanlig tíð", "HNT": "Newfoundland vanlig tíð", "IST": "India tíð", "JDT": "Japan summartíð", "JST": "Japan vanlig tíð", "LHDT": "Lord Howe summartíð", "LHST": "Lord Howe vanlig tíð", "MDT": "Mountain summartíð", "MESZ": "Miðevropa summartíð", "MEZ": "Miðevropa vanlig tíð", "MST": "Mountain vanlig tíð", "MYT": "Malaisia tíð", "NZDT": "Nýsæland summartíð", "NZST": "Nýsæland vanlig tíð", "OESZ": "Eysturevropa summartíð", "OEZ": "Eysturevropa vanlig tíð", "PDT": "Pacific summartíð", "PST": "Pacific vanlig tíð", "SAST": "Suðurafrika vanlig tíð", "SGT": "Singapor tíð", "SRT": "Surinam tíð", "TMST": "Turkmenistan summartíð", "TMT": "Turkmenistan vanlig tíð", "UYST": "Uruguai summartíð", "UYT": "Uruguai vanlig tíð", "VET": "Venesuela tíð", "WARST": "Vestur Argentina summartíð", "WART": "Vestur Argentina vanlig tíð", "WAST": "Vesturafrika summartíð", "WAT": "Vesturafrika vanlig tíð", "WESZ": "Vesturevropa summartíð", "WEZ": "Vesturevropa vanlig tíð", "WIB": "Vestur Indonesia tíð", "WIT": "Eystur Indonesia tíð", "WITA": "Mið Indonesia tíð", "∅∅∅": "Brasilia summartíð"},
 	}
 }
 
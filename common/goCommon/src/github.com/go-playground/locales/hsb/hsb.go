@@ -56,7 +56,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "zł", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "zł", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		percentSuffix:          " ",
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
@@ -73,7 +73,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"př.Chr.n.", "po Chr.n."},
 		erasNarrow:             []string{"", ""},
 		erasWide:               []string{"před Chrystowym narodźenjom", "po Chrystowym narodźenju"},
-		timezones:              map[string]string{"HEPM": "lětni čas kupow St. Pierre a Miquelon", "SRT": "surinamski čas", "TMT": "turkmenski standardny čas", "UYT": "uruguayski standardny čas", "MST": "sewjeroameriski hórski standardny čas", "SAST": "južnoafriski čas", "MYT": "malajziski čas", "HAT": "nowofundlandski lětni čas", "HNCU": "kubaski standardny čas", "HAST": "hawaiisko-aleutski standardny čas", "ART": "argentinski standardny čas", "AKDT": "alaskaski lětni čas", "HENOMX": "mexiski sewjerozapadny lětni čas", "EAT": "wuchodoafriski čas", "OESZ": "wuchodoeuropski lětni čas", "CLT": "chilski standardny čas", "ADT": "atlantiski lětni čas", "JST": "japanski standardny čas", "ECT": "ekwadorski čas", "CAT": "centralnoafriski čas", "HECU": "kubaski lětni čas", "AWDT": "zapadoawstralski lětni čas", "WAT": "zapadoafriski standardny čas", "NZDT": "nowoseelandski lětni čas", "BOT": "boliwiski čas", "IST": "indiski čas", "GYT": "guyanski čas", "CDT": "sewjeroameriski centralny lětni čas", "AEST": "wuchodoawstralski standardny čas", "BT": "bhutanski čas", "AKST": "alaskaski standardny čas", "AEDT": "wuchodoawstralski lětni čas", "WIB": "zapadoindoneski čas", "SGT": "Singapurski čas", "ACWST": "srjedźozapadny awstralski standardny čas", "VET": "venezuelski čas", "WITA": "srjedźoindoneski čas", "CLST": "chilski lětni čas", "UYST": "uruguayski lětni čas", "CST": "sewjeroameriski centralny standardny čas", "AWST": "zapadoawstralski standardny čas", "NZST": "nowoseelandski standardny čas", "HKST": "Hongkongski lětni čas", "ACDT": "srjedźoawstralski lětni čas", "HNT": "nowofundlandski standardny čas", "HNPM": "standardny čas kupow St. Pierre a Miquelon", "LHST": "standardny čas kupy Lord-Howe", "TMST": "turkmenski lětni čas", "MEZ": "srjedźoeuropski standardny čas", "WIT": "wuchodoindoneski", "CHAST": "chathamski standardny čas", "PST": "sewjeroameriski pacifiski standardny čas", "JDT": "japanski lětni čas", "GFT": "francoskoguyanski čas", "HNOG": "zapadogrönlandski standardny čas", "WAST": "zapadoafriski lětni čas", "HNEG": "wuchodogrönlandski standardny čas", "OEZ": "wuchodoeuropski standardny čas", "PDT": "sewjeroameriski pacifiski lětni čas", "WESZ": "zapadoeuropski lětni čas", "EST": "sewjeroameriski wuchodny standardny čas", "EDT": "sewjeroameriski wuchodny lětni čas", "HNNOMX": "mexiski sewjerozapadny standardny čas", "COT": "kolumbiski standardny čas", "ChST": "chamorroski čas", "MDT": "sewjeroameriski hórski lětni čas", "ACWDT": "sjedźozapadny awstralski lětni čas", "HEEG": "wuchodogrönlandski lětni čas", "MESZ": "srjedźoeuropski lětni čas", "CHADT": "chathamski lětni čas", "AST": "atlantiski standardny čas", "∅∅∅": "∅∅∅", "ACST": "srjedźoawstralski standardny čas", "ARST": "argentinski lětni čas", "HADT": "hawaiisko-aleutski lětni čas", "COST": "kolumbiski lětni čas", "WEZ": "zapadoeuropski standardny čas", "HEOG": "zapadogrönlandski lětni čas", "WART": "zapadoargentinski standardny čas", "WARST": "zapadoargentinski lětni čas", "GMT": "Greenwichski čas", "HNPMX": "mexiski pacifiski standardny čas", "HEPMX": "mexiski pacifiski lětni čas", "HKT": "Hongkongski standardny čas", "LHDT": "lětni čas kupy Lord-Howe"},
+		timezones:              map[string]string{"ACDT": "srjedźoawstralski lětni čas", "ACST": "srjedźoawstralski standardny čas", "ACWDT": "sjedźozapadny awstralski lětni čas", "ACWST": "srjedźozapadny awstralski standardny čas", "ADT": "atlantiski lětni čas", "AEDT": "wuchodoawstralski lětni čas", "AEST": "wuchodoawstralski standardny čas", "AKDT": "alaskaski lětni čas", "AKST": "alaskaski standardny čas", "ARST": "argentinski lětni čas", "ART": "argentinski standardny čas", "AST": "atlantiski standardny čas", "AWDT": "zapadoawstralski lětni čas", "AWST": "zapadoawstralski standardny čas", "BOT": "boliwiski čas", "BT": "bhutanski čas", "CAT": "centralnoafriski čas", "CDT": "sewjeroameriski centralny lětni čas", "CHADT": "chathamski lětni čas", "CHAST": "chathamski standardny čas", "CLST": "chilski lětni čas", "CLT": "chilski standardny čas", "COST": "kolumbiski lětni čas", "COT": "kolumbiski standardny čas", "CST": "sewjeroameriski centralny standardny čas", "ChST": "chamorroski čas", "EAT": "wuchodoafriski čas", "ECT": "ekwadorski čas", "EDT": "sewjeroameriski wuchodny lětni čas", "EST": "sewjeroameriski wuchodny standardny čas", "GFT": "francoskoguyanski čas", "GMT": "Greenwichski čas", "GST": "čas Persiskeho golfa", "GYT": "guyanski čas", "HADT": "hawaiisko-aleutski lětni čas", "HAST": "hawaiisko-aleutski standardny čas", "HAT": "nowofundlandski lětni čas", "HECU": "kubaski lětni čas", "HEEG": "wuchodogrönlandski lětni čas", "HENOMX": "mexiski sewjerozapadny lětni čas", "HEOG": "zapadogrönlandski lětni čas", "HEPM": "lětni čas kupow St. Pierre a Miquelon", "HEPMX": "mexiski pacifiski lětni čas", "HKST": "Hongkongski lětni čas", "HKT": "Hongkongski standardny čas", "HNCU": "kubaski standardny čas", "HNEG": "wuchodogrönlandski standardny čas", "HNNOMX": "mexiski sewjerozapadny standardny čas", "HNOG": "zapadogrönlandski standardny čas", "HNPM": "standardny čas kupow St. Pierre a Miquelon", "HNPMX": "mexiski pacifiski standardny čas", "HNT": "nowofundlandski standardny čas", "IST": "indiski čas", "JDT": "japanski lětni čas", "JST": "japanski standardny čas", "LHDT": "lětni čas kupy Lord-Howe", "LHST": "standardny čas kupy Lord-Howe", "MDT": "sewjeroameriski hórski lětni čas", "MESZ": "srjedźoeuropski lětni čas", "MEZ": "srjedźoeuropski standardny čas", "MST": "sewjeroameriski hórski standardny čas", "MYT": "malajziski čas", "NZDT": "nowoseelandski lětni čas", "NZST": "nowoseelandski standardny čas", "OESZ": "wuchodoeuropski lětni čas", "OEZ": "wuchodoeuropski standardny čas", "PDT": "sewjeroameriski pacifiski lětni čas", "PST": "sewjeroameriski pacifiski standardny čas", "SAST": "južnoafriski čas", "SGT": "Singapurski čas", "SRT": "surinamski čas", "TMST": "turkmenski lětni čas", "TMT": "turkmenski standardny čas", "UYST": "uruguayski lětni čas", "UYT": "uruguayski standardny čas", "VET": "venezuelski čas", "WARST": "zapadoargentinski lětni čas", "WART": "zapadoargentinski standardny čas", "WAST": "zapadoafriski lětni čas", "WAT": "zapadoafriski standardny čas", "WESZ": "zapadoeuropski lětni čas", "WEZ": "zapadoeuropski standardny čas", "WIB": "zapadoindoneski čas", "WIT": "wuchodoindoneski", "WITA": "srjedźoindoneski čas", "∅∅∅": "Brasiliski lětni čas"},
 	}
 }
 
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "Ft", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "Ft", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "jan.", "febr.", "márc.", "ápr.", "máj.", "jún.", "júl.", "aug.", "szept.", "okt.", "nov.", "dec."},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"i. e.", "i. sz."},
 		erasNarrow:             []string{"ie.", "isz."},
 		erasWide:               []string{"Krisztus előtt", "időszámításunk szerint"},
-		timezones:              map[string]string{"HKST": "hongkongi nyári idő", "WARST": "nyugat-argentínai nyári idő", "COST": "kolumbiai nyári idő", "OEZ": "kelet-európai téli idő", "HNPMX": "mexikói csendes-óceáni zónaidő", "CDT": "középső államokbeli nyári idő", "AWST": "nyugat-ausztráliai téli idő", "ECT": "ecuadori téli idő", "MEZ": "közép-európai téli idő", "∅∅∅": "azori nyári idő", "WESZ": "nyugat-európai nyári idő", "GFT": "francia-guyanai idő", "ACST": "közép-ausztráliai téli idő", "ACWST": "közép-nyugat-ausztráliai téli idő", "WITA": "közép-indonéziai idő", "HEPM": "Saint-Pierre és Miquelon-i nyári idő", "CLST": "chilei nyári idő", "PDT": "csendes-óceáni nyári idő", "BT": "butáni idő", "NZDT": "új-zélandi nyári idő", "HEEG": "kelet-grönlandi nyári idő", "HNT": "új-fundlandi zónaidő", "HAT": "új-fundlandi nyári idő", "TMT": "türkmenisztáni téli idő", "ChST": "chamorrói téli idő", "SAST": "dél-afrikai téli idő", "WAST": "nyugat-afrikai nyári idő", "BOT": "bolíviai téli idő", "AKST": "alaszkai zónaidő", "EDT": "keleti államokbeli nyári idő", "ACWDT": "közép-nyugat-ausztráliai nyári idő", "MESZ": "közép-európai nyári idő", "LHST": "Lord Howe-szigeti téli idő", "LHDT": "Lord Howe-szigeti nyári idő", "HADT": "hawaii-aleuti nyári idő", "EST": "keleti államokbeli zónaidő", "HENOMX": "északnyugat-mexikói nyári idő", "WIT": "kelet-indonéziai idő", "OESZ": "kelet-európai nyári idő", "HKT": "hongkongi téli idő", "WART": "nyugat-argentínai téli idő", "MST": "Macaui zónaidő", "HEPMX": "mexikói csendes-óceáni nyári idő", "AST": "atlanti-óceáni zónaidő", "AEDT": "kelet-ausztráliai nyári idő", "TMST": "türkmenisztáni nyári idő", "CAT": "közép-afrikai téli idő", "EAT": "kelet-afrikai téli idő", "NZST": "új-zélandi téli idő", "MYT": "malajziai idő", "HNEG": "kelet-grönlandi téli idő", "GMT": "greenwichi középidő, téli idő", "HNOG": "nyugat-grönlandi téli idő", "COT": "kolumbiai téli idő", "PST": "csendes-óceáni zónaidő", "ART": "argentínai téli idő", "CHAST": "chathami téli idő", "CHADT": "chathami nyári idő", "JDT": "japán nyári idő", "HEOG": "nyugat-grönlandi nyári idő", "CLT": "chilei téli idő", "UYT": "uruguayi téli idő", "HNCU": "kubai téli idő", "SGT": "szingapúri téli idő", "ACDT": "közép-ausztráliai nyári idő", "HNNOMX": "északnyugat-mexikói zónaidő", "GYT": "guyanai téli idő", "CST": "középső államokbeli zónaidő", "WAT": "nyugat-afrikai téli idő", "IST": "indiai téli idő", "VET": "venezuelai idő", "SRT": "szurinámi idő", "HAST": "hawaii-aleuti téli idő", "HECU": "kubai nyári idő", "AWDT": "nyugat-ausztráliai nyári idő", "WIB": "nyugat-indonéziai téli idő", "WEZ": "nyugat-európai téli idő", "JST": "japán téli idő", "AKDT": "alaszkai nyári idő", "HNPM": "Saint-Pierre és Miquelon-i zónaidő", "MDT": "Macaui nyári idő", "ARST": "argentínai nyári idő", "UYST": "uruguayi nyári idő", "ADT": "atlanti-óceáni nyári idő", "AEST": "kelet-ausztráliai téli idő"},
+		timezones:              map[string]string{"ACDT": "közép-ausztráliai nyári idő", "ACST": "közép-ausztráliai téli idő", "ACWDT": "közép-nyugat-ausztráliai nyári idő", "ACWST": "közép-nyugat-ausztráliai téli idő", "ADT": "atlanti-óceáni nyári idő", "AEDT": "kelet-ausztráliai nyári idő", "AEST": "kelet-ausztráliai téli idő", "AKDT": "alaszkai nyári idő", "AKST": "alaszkai zónaidő", "ARST": "argentínai nyári idő", "ART": "argentínai téli idő", "AST": "atlanti-óceáni zónaidő", "AWDT": "nyugat-ausztráliai nyári idő", "AWST": "nyugat-ausztráliai téli idő", "BOT": "bolíviai téli idő", "BT": "butáni idő", "CAT": "közép-afrikai téli idő", "CDT": "középső államokbeli nyári idő", "CHADT": "chathami nyári idő", "CHAST": "chathami téli idő", "CLST": "chilei nyári idő", "CLT": "chilei téli idő", "COST": "kolumbiai nyári idő", "COT": "kolumbiai téli idő", "CST": "középső államokbeli zónaidő", "ChST": "chamorrói téli idő", "EAT": "kelet-afrikai téli idő", "ECT": "ecuadori téli idő", "EDT": "keleti államokbeli nyári idő", "EST": "keleti államokbeli zónaidő", "GFT": "francia-guyanai idő", "GMT": "greenwichi középidő, téli idő", "GST": "öbölbeli téli idő", "GYT": "guyanai téli idő", "HADT": "hawaii-aleuti nyári idő", "HAST": "hawaii-aleuti téli idő", "HAT": "új-fundlandi nyári idő", "HECU": "kubai nyári idő", "HEEG": "kelet-grönlandi nyári idő", "HENOMX": "északnyugat-mexikói nyári idő", "HEOG": "nyugat-grönlandi nyári idő", "HEPM": "Saint-Pierre és Miquelon-i nyári idő", "HEPMX": "mexikói csendes-óceáni nyári idő", "HKST": "hongkongi nyári idő", "HKT": "hongkongi téli idő", "HNCU": "kubai téli idő", "HNEG": "kelet-grönlandi téli idő", "HNNOMX": "északnyugat-mexikói zónaidő", "HNOG": "nyugat-grönlandi téli idő", "HNPM": "Saint-Pierre és Miquelon-i zónaidő", "HNPMX": "mexikói csendes-óceáni zónaidő", "HNT": "új-fundlandi zónaidő", "IST": "indiai téli idő", "JDT": "japán nyári idő", "JST": "japán téli idő", "LHDT": "Lord Howe-szigeti nyári idő", "LHST": "Lord Howe-szigeti téli idő", "MDT": "hegyvidéki nyári idő", "MESZ": "közép-európai nyári idő", "MEZ": "közép-európai téli idő", "MST": "hegyvidéki zónaidő", "MYT": "malajziai idő", "NZDT": "új-zélandi nyári idő", "NZST": "új-zélandi téli idő", "OESZ": "kelet-európai nyári idő", "OEZ": "kelet-európai téli idő", "PDT": "csendes-óceáni nyári idő", "PST": "csendes-óceáni zónaidő", "SAST": "dél-afrikai téli idő", "SGT": "szingapúri téli idő", "SRT": "szurinámi idő", "TMST": "türkmenisztáni nyári idő", "TMT": "türkmenisztáni téli idő", "UYST": "uruguayi nyári idő", "UYT": "uruguayi téli idő", "VET": "venezuelai idő", "WARST": "nyugat-argentínai nyári idő", "WART": "nyugat-argentínai téli idő", "WAST": "nyugat-afrikai nyári idő", "WAT": "nyugat-afrikai téli idő", "WESZ": "nyugat-európai nyári idő", "WEZ": "nyugat-európai téli idő", "WIB": "nyugat-indonéziai téli idő", "WIT": "kelet-indonéziai idő", "WITA": "közép-indonéziai idő", "∅∅∅": "azori nyári idő"},
 	}
 }
 
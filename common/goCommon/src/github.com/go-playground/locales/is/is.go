@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "jan.", "feb.", "mar.", "apr.", "maí", "jún.", "júl.", "ágú.", "sep.", "okt.", "nóv.", "des."},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"f.Kr.", "e.Kr."},
 		erasNarrow:             []string{"f.k.", "e.k."},
 		erasWide:               []string{"fyrir Krist", "eftir Krist"},
-		timezones:              map[string]string{"HEOG": "Sumartími á Vestur-Grænlandi", "ACDT": "Sumartími í Mið-Ástralíu", "MST": "MST", "MESZ": "Sumartími í Mið-Evrópu", "LHST": "Staðaltími á Lord Howe-eyju", "CST": "Staðaltími í miðhluta Bandaríkjanna og Kanada", "SAST": "Suður-Afríkutími", "HNEG": "Staðaltími á Austur-Grænlandi", "HEEG": "Sumartími á Austur-Grænlandi", "ACWST": "Staðaltími í miðvesturhluta Ástralíu", "HNPM": "Staðaltími á Sankti Pierre og Miquelon", "OEZ": "Staðaltími í Austur-Evrópu", "OESZ": "Sumartími í Austur-Evrópu", "CHAST": "Staðaltími í Chatham", "ADT": "Sumartími á Atlantshafssvæðinu", "AKDT": "Sumartími í Alaska", "ACWDT": "Sumartími í miðvesturhluta Ástralíu", "HKT": "Staðaltími í Hong Kong", "HAT": "Sumartími á Nýfundnalandi", "PST": "Staðaltími á Kyrrahafssvæðinu", "UYT": "Staðaltími í Úrúgvæ", "WIB": "Vestur-Indónesíutími", "JDT": "Sumartími í Japan", "EDT": "Sumartími í austurhluta Bandaríkjanna og Kanada", "∅∅∅": "Sumartími á Asóreyjum", "TMT": "Staðaltími í Túrkmenistan", "JST": "Staðaltími í Japan", "EAT": "Austur-Afríkutími", "AEST": "Staðaltími í Austur-Ástralíu", "BOT": "Bólivíutími", "WART": "Staðaltími í Vestur-Argentínu", "VET": "Venesúelatími", "HNNOMX": "Staðaltími í Norðvestur-Mexíkó", "WIT": "Austur-Indónesíutími", "COT": "Staðaltími í Kólumbíu", "SGT": "Singapúrtími", "EST": "Staðaltími í austurhluta Bandaríkjanna og Kanada", "LHDT": "Sumartími á Lord Howe-eyju", "HECU": "Sumartími á Kúbu", "HAST": "Staðaltími á Havaí og Aleúta", "GYT": "Gvæjanatími", "UYST": "Sumartími í Úrúgvæ", "GMT": "Greenwich-staðaltími", "CHADT": "Sumartími í Chatham", "NZDT": "Sumartími á Nýja-Sjálandi", "HEPM": "Sumartími á Sankti Pierre og Miquelon", "HENOMX": "Sumartími í Norðvestur-Mexíkó", "CLT": "Staðaltími í Síle", "HNPMX": "Staðaltími í Mexíkó á Kyrrahafssvæðinu", "WESZ": "Sumartími í Vestur-Evrópu", "MYT": "Malasíutími", "CDT": "Sumartími í miðhluta Bandaríkjanna og Kanada", "PDT": "Sumartími á Kyrrahafssvæðinu", "AWST": "Staðaltími í Vestur-Ástralíu", "COST": "Sumartími í Kólumbíu", "ChST": "Chamorro-staðaltími", "HNCU": "Staðaltími á Kúbu", "WAST": "Sumartími í Vestur-Afríku", "WEZ": "Staðaltími í Vestur-Evrópu", "MEZ": "Staðaltími í Mið-Evrópu", "IST": "Indlandstími", "WARST": "Sumartími í Vestur-Argentínu", "HNT": "Staðaltími á Nýfundnalandi", "SRT": "Súrinamtími", "AST": "Staðaltími á Atlantshafssvæðinu", "GFT": "Tími í Frönsku Gvæjana", "ECT": "Ekvadortími", "HADT": "Sumartími á Havaí og Aleúta", "AWDT": "Sumartími í Vestur-Ástralíu", "HKST": "Sumartími í Hong Kong", "CAT": "Mið-Afríkutími", "ARST": "Sumartími í Argentínu", "WAT": "Staðaltími í Vestur-Afríku", "NZST": "Staðaltími á Nýja-Sjálandi", "AKST": "Staðaltími í Alaska", "HNOG": "Staðaltími á Vestur-Grænlandi", "ACST": "Staðaltími í Mið-Ástralíu", "CLST": "Sumartími í Síle", "ART": "Staðaltími í Argentínu", "HEPMX": "Sumartími í Mexíkó á Kyrrahafssvæðinu", "AEDT": "Sumartími í Austur-Ástralíu", "BT": "Bútantími", "WITA": "Mið-Indónesíutími", "MDT": "MDT", "TMST": "Sumartími í Túrkmenistan"},
+		timezones:              map[string]string{"ACDT": "Sumartími í Mið-Ástralíu", "ACST": "Staðaltími í Mið-Ástralíu", "ACWDT": "Sumartími í miðvesturhluta Ástralíu", "ACWST": "Staðaltími í miðvesturhluta Ástralíu", "ADT": "Sumartími á Atlantshafssvæðinu", "AEDT": "Sumartími í Austur-Ástralíu", "AEST": "Staðaltími í Austur-Ástralíu", "AKDT": "Sumartími í Alaska", "AKST": "Staðaltími í Alaska", "ARST": "Sumartími í Argentínu", "ART": "Staðaltími í Argentínu", "AST": "Staðaltími á Atlantshafssvæðinu", "AWDT": "Sumartími í Vestur-Ástralíu", "AWST": "Staðaltími í Vestur-Ástralíu", "BOT": "Bólivíutími", "BT": "Bútantími", "CAT": "Mið-Afríkutími", "CDT": "Sumartími í miðhluta Bandaríkjanna og Kanada", "CHADT": "Sumartími í Chatham", "CHAST": "Staðaltími í Chatham", "CLST": "Sumartími í Síle", "CLT": "Staðaltími í Síle", "COST": "Sumartími í Kólumbíu", "COT": "Staðaltími í Kólumbíu", "CST": "Staðaltími í miðhluta Bandaríkjanna og Kanada", "ChST": "Chamorro-staðaltími", "EAT": "Austur-Afríkutími", "ECT": "Ekvadortími", "EDT": "Sumartími í austurhluta Bandaríkjanna og Kanada", "EST": "Staðaltími í austurhluta Bandaríkjanna og Kanada", "GFT": "Tími í Frönsku Gvæjana", "GMT": "Greenwich-staðaltími", "GST": "Staðaltími við Persaflóa", "GYT": "Gvæjanatími", "HADT": "Sumartími á Havaí og Aleúta", "HAST": "Staðaltími á Havaí og Aleúta", "HAT": "Sumartími á Nýfundnalandi", "HECU": "Sumartími á Kúbu", "HEEG": "Sumartími á Austur-Grænlandi", "HENOMX": "Sumartími í Norðvestur-Mexíkó", "HEOG": "Sumartími á Vestur-Grænlandi", "HEPM": "Sumartími á Sankti Pierre og Miquelon", "HEPMX": "Sumartími í Mexíkó á Kyrrahafssvæðinu", "HKST": "Sumartími í Hong Kong", "HKT": "Staðaltími í Hong Kong", "HNCU": "Staðaltími á Kúbu", "HNEG": "Staðaltími á Austur-Grænlandi", "HNNOMX": "Staðaltími í Norðvestur-Mexíkó", "HNOG": "Staðaltími á Vestur-Grænlandi", "HNPM": "Staðaltími á Sankti Pierre og Miquelon", "HNPMX": "Staðaltími í Mexíkó á Kyrrahafssvæðinu", "HNT": "Staðaltími á Nýfundnalandi", "IST": "Indlandstími", "JDT": "Sumartími í Japan", "JST": "Staðaltími í Japan", "LHDT": "Sumartími á Lord Howe-eyju", "LHST": "Staðaltími á Lord Howe-eyju", "MDT": "Sumartími í Klettafjöllum", "MESZ": "Sumartími í Mið-Evrópu", "MEZ": "Staðaltími í Mið-Evrópu", "MST": "Staðaltími í Klettafjöllum", "MYT": "Malasíutími", "NZDT": "Sumartími á Nýja-Sjálandi", "NZST": "Staðaltími á Nýja-Sjálandi", "OESZ": "Sumartími í Austur-Evrópu", "OEZ": "Staðaltími í Austur-Evrópu", "PDT": "Sumartími á Kyrrahafssvæðinu", "PST": "Staðaltími á Kyrrahafssvæðinu", "SAST": "Suður-Afríkutími", "SGT": "Singapúrtími", "SRT": "Súrinamtími", "TMST": "Sumartími í Túrkmenistan", "TMT": "Staðaltími í Túrkmenistan", "UYST": "Sumartími í Úrúgvæ", "UYT": "Staðaltími í Úrúgvæ", "VET": "Venesúelatími", "WARST": "Sumartími í Vestur-Argentínu", "WART": "Staðaltími í Vestur-Argentínu", "WAST": "Sumartími í Vestur-Afríku", "WAT": "Staðaltími í Vestur-Afríku", "WESZ": "Sumartími í Vestur-Evrópu", "WEZ": "Staðaltími í Vestur-Evrópu", "WIB": "Vestur-Indónesíutími", "WIT": "Austur-Indónesíutími", "WITA": "Mið-Indónesíutími", "∅∅∅": "Sumartími í Brasilíu"},
 	}
 }
 
@@ -101,8 +101,8 @@ func (is *is) CardinalPluralRule(num float64, v uint64) locales.PluralRule {
 	n := math.Abs(num)
 	i := int64(n)
 	t := locales.T(n, v)
-	iMod10 := i % 10
 	iMod100 := i % 100
+	iMod10 := i % 10
 
 	if (t == 0 && iMod10 == 1 && iMod100 != 11) || (t != 0) {
 		return locales.PluralRuleOne
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "₾", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "₾", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "იან", "თებ", "მარ", "აპრ", "მაი", "ივნ", "ივლ", "აგვ", "სექ", "ოქტ", "ნოე", "დეკ"},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"ძვ. წ.", "ახ. წ."},
 		erasNarrow:             []string{"", ""},
 		erasWide:               []string{"ძველი წელთაღრიცხვით", "ახალი წელთაღრიცხვით"},
-		timezones:              map[string]string{"WESZ": "დასავლეთ ევროპის ზაფხულის დრო", "HNNOMX": "ჩრდილო-დასავლეთ მექსიკის დრო", "CLT": "ჩილეს სტანდარტული დრო", "CLST": "ჩილეს ზაფხულის დრო", "ChST": "ჩამოროს დრო", "PST": "ჩრდილოეთ ამერიკის წყნარი ოკეანის სტანდარტული დრო", "NZST": "ახალი ზელანდიის სტანდარტული დრო", "HNOG": "დასავლეთ გრენლანდიის სტანდარტული დრო", "VET": "ვენესუელის დრო", "WITA": "ცენტრალური ინდონეზიის დრო", "ART": "არგენტინის სტანდარტული დრო", "HEPMX": "მექსიკის წყნარი ოკეანის ზაფხულის დრო", "WAT": "დასავლეთ აფრიკის სტანდარტული დრო", "JDT": "იაპონიის ზაფხულის დრო", "HEEG": "აღმოსავლეთ გრენლანდიის ზაფხულის დრო", "WARST": "დასავლეთ არგენტინის ზაფხულის დრო", "HNT": "ნიუფაუნდლენდის სტანდარტული დრო", "CAT": "ცენტრალური აფრიკის დრო", "ACST": "ავსტრალიის ცენტრალური სტანდარტული დრო", "MDT": "MDT", "COT": "კოლუმბიის სტანდარტული დრო", "CDT": "ჩრდილოეთ ამერიკის ცენტრალური ზაფხულის დრო", "HEOG": "დასავლეთ გრენლანდიის ზაფხულის დრო", "HKT": "ჰონკონგის სტანდარტული დრო", "WART": "დასავლეთ არგენტინის სტანდარტული დრო", "GYT": "გაიანის დრო", "UYT": "ურუგვაის სტანდარტული დრო", "NZDT": "ახალი ზელანდიის ზაფხულის დრო", "MEZ": "ცენტრალური ევროპის სტანდარტული დრო", "LHST": "ლორდ-ჰაუს სტანდარტული დრო", "AWST": "დასავლეთ ავსტრალიის სტანდარტული დრო", "HNCU": "კუბის სტანდარტული დრო", "ECT": "ეკვადორის დრო", "EDT": "ჩრდილოეთ ამერიკის აღმოსავლეთის ზაფხულის დრო", "ACDT": "ავსტრალიის ცენტრალური ზაფხულის დრო", "LHDT": "ლორდ-ჰაუს ზაფხულის დრო", "HAT": "ნიუფაუნდლენდის ზაფხულის დრო", "HADT": "ჰავაისა და ალეუტის ზაფხულის დრო", "MYT": "მალაიზიის დრო", "MST": "MST", "CHAST": "ჩატემის სტანდარტული დრო", "CHADT": "ჩატემის ზაფხულის დრო", "WEZ": "დასავლეთ ევროპის სტანდარტული დრო", "WIB": "დასავლეთ ინდონეზიის დრო", "SGT": "სინგაპურის დრო", "MESZ": "ცენტრალური ევროპის ზაფხულის დრო", "TMST": "თურქმენეთის ზაფხულის დრო", "HAST": "ჰავაისა და ალეუტის სტანდარტული დრო", "AEDT": "აღმოსავლეთ ავსტრალიის ზაფხულის დრო", "AKST": "ალასკის სტანდარტული დრო", "AKDT": "ალასკის ზაფხულის დრო", "ACWST": "ცენტრალური და დასავლეთ ავსტრალიის სტანდარტული დრო", "CST": "ჩრდილოეთ ამერიკის ცენტრალური სტანდარტული დრო", "PDT": "ჩრდილოეთ ამერიკის წყნარი ოკეანის ზაფხულის დრო", "AEST": "აღმოსავლეთ ავსტრალიის სტანდარტული დრო", "BT": "ბუტანის დრო", "EST": "ჩრდილოეთ ამერიკის აღმოსავლეთის სტანდარტული დრო", "HENOMX": "ჩრდილო-დასავლეთ მექსიკის ზაფხულის დრო", "AST": "ატლანტიკის ოკეანის სტანდარტული დრო", "ADT": "ატლანტიკის ოკეანის ზაფხულის დრო", "OEZ": "აღმოსავლეთ ევროპის სტანდარტული დრო", "OESZ": "აღმოსავლეთ ევროპის ზაფხულის დრო", "∅∅∅": "∅∅∅", "BOT": "ბოლივიის დრო", "HNEG": "აღმოსავლეთ გრენლანდიის სტანდარტული დრო", "HNPM": "სენ-პიერის და მიკელონის სტანდარტული დრო", "EAT": "აღმოსავლეთ აფრიკის დრო", "WIT": "აღმოსავლეთ ინდონეზიის დრო", "GMT": "გრინვიჩის საშუალო დრო", "SAST": "სამხრეთ აფრიკის დრო", "GFT": "საფრანგეთის გვიანის დრო", "TMT": "თურქმენეთის სტანდარტული დრო", "ARST": "არგენტინის ზაფხულის დრო", "WAST": "დასავლეთ აფრიკის ზაფხულის დრო", "JST": "იაპონიის სტანდარტული დრო", "ACWDT": "ცენტრალური და დასავლეთ ავსტრალიის ზაფხულის დრო", "HKST": "ჰონკონგის ზაფხულის დრო", "IST": "ინდოეთის დრო", "SRT": "სურინამის დრო", "COST": "კოლუმბიის ზაფხულის დრო", "UYST": "ურუგვაის ზაფხულის დრო", "HEPM": "სენ-პიერის და მიკელონის ზაფხულის დრო", "HECU": "კუბის ზაფხულის დრო", "AWDT": "დასავლეთ ავსტრალიის ზაფხულის დრო", "HNPMX": "მექსიკის წყნარი ოკეანის სტანდარტული დრო"},
+		timezones:              map[string]string{"ACDT": "ავსტრალიის ცენტრალური ზაფხულის დრო", "ACST": "ავსტრალიის ცენტრალური სტანდარტული დრო", "ACWDT": "ცენტრალური და დასავლეთ ავსტრალიის ზაფხულის დრო", "ACWST": "ცენტრალური და დასავლეთ ავსტრალიის სტანდარტული დრო", "ADT": "ატლანტიკის ოკეანის ზაფხულის დრო", "AEDT": "აღმოსავლეთ ავსტრალიის ზაფხულის დრო", "AEST": "აღმოსავლეთ ავსტრალიის სტანდარტული დრო", "AKDT": "ალასკის ზაფხულის დრო", "AKST": "ალასკის სტანდარტული დრო", "ARST": "არგენტინის ზაფხულის დრო", "ART": "არგენტინის სტანდარტული დრო", "AST": "ატლანტიკის ოკეანის სტანდარტული დრო", "AWDT": "დასავლეთ ავსტრალიის ზაფხულის დრო", "AWST": "დასავლეთ ავსტრალიის სტანდარტული დრო", "BOT": "ბოლივიის დრო", "BT": "ბუტანის დრო", "CAT": "ცენტრალური აფრიკის დრო", "CDT": "ჩრდილოეთ ამერიკის ცენტრალური ზაფხულის დრო", "CHADT": "ჩატემის ზაფხულის დრო", "CHAST": "ჩატემის სტანდარტული დრო", "CLST": "ჩილეს ზაფხულის დრო", "CLT": "ჩილეს სტანდარტული დრო", "COST": "კოლუმბიის ზაფხულის დრო", "COT": "კოლუმბიის სტანდარტული დრო", "CST": "ჩრდილოეთ ამერიკის ცენტრალური სტანდარტული დრო", "ChST": "ჩამოროს დრო", "EAT": "აღმოსავლეთ აფრიკის დრო", "ECT": "ეკვადორის დრო", "EDT": "ჩრდილოეთ ამერიკის აღმოსავლეთის ზაფხულის დრო", "EST": "ჩრდილოეთ ამერიკის აღმოსავლეთის სტანდარტული დრო", "GFT": "საფრანგეთის გვიანის დრო", "GMT": "გრინვიჩის საშუალო დრო", "GST": "სპარსეთის ყურის სტანდარტული დრო", "GYT": "გაიანის დრო", "HADT": "ჰავაისა და ალეუტის ზაფხულის დრო", "HAST": "ჰავაისა და ალეუტის სტანდარტული დრო", "HAT": "ნიუფაუნდლენდის ზაფხულის დრო", "HECU": "კუბის ზაფხულის დრო", "HEEG": "აღმოსავლეთ გრენლანდიის ზაფხულის დრო", "HENOMX": "ჩრდილო-დასავლეთ მექსიკის ზაფხულის დრო", "HEOG": "დასავლეთ გრენლანდიის ზაფხულის დრო", "HEPM": "სენ-პიერის და მიკელონის ზაფხულის დრო", "HEPMX": "მექსიკის წყნარი ოკეანის ზაფხულის დრო", "HKST": "ჰონკონგის ზაფხულის დრო", "HKT": "ჰონკონგის სტანდარტული დრო", "HNCU": "კუბის სტანდარტული დრო", "HNEG": "აღმოსავლეთ გრენლანდიის სტანდარტული დრო", "HNNOMX": "ჩრდილო-დასავლეთ მექსიკის დრო", "HNOG": "დასავლეთ გრენლანდიის სტანდარტული დრო", "HNPM": "სენ-პიერის და მიკელონის სტანდარტული დრო", "HNPMX": "მექსიკის წყნარი ოკეანის სტანდარტული დრო", "HNT": "ნიუფაუნდლენდის სტანდარტული დრო", "IST": "ინდოეთის დრო", "JDT": "იაპონიის ზაფხულის დრო", "JST": "იაპონიის სტანდარტული დრო", "LHDT": "ლორდ-ჰაუს ზაფხულის დრო", "LHST": "ლორდ-ჰაუს სტანდარტული დრო", "MDT": "ჩრდილოეთ ამერიკის მაუნთინის ზაფხულის დრო", "MESZ": "ცენტრალური ევროპის ზაფხულის დრო", "MEZ": "ცენტრალური ევროპის სტანდარტული დრო", "MST": "ჩრდილოეთ ამერიკის მაუნთინის სტანდარტული დრო", "MYT": "მალაიზიის დრო", "NZDT": "ახალი ზელანდიის ზაფხულის დრო", "NZST": "ახალი ზელანდიის სტანდარტული დრო", "OESZ": "აღმოსავლეთ ევროპის ზაფხულის დრო", "OEZ": "აღმოსავლეთ ევროპის სტანდარტული დრო", "PDT": "ჩრდილოეთ ამერიკის წყნარი ოკეანის ზაფხულის დრო", "PST": "ჩრდილოეთ ამერიკის წყნარი ოკეანის სტანდარტული დრო", "SAST": "სამხრეთ აფრიკის დრო", "SGT": "სინგაპურის დრო", "SRT": "სურინამის დრო", "TMST": "თურქმენეთის ზაფხულის დრო", "TMT": "თურქმენეთის სტანდარტული დრო", "UYST": "ურუგვაის ზაფხულის დრო", "UYT": "ურუგვაის სტანდარტული დრო", "VET": "ვენესუელის დრო", "WARST": "დასავლეთ არგენტინის ზაფხულის დრო", "WART": "დასავლეთ არგენტინის სტანდარტული დრო", "WAST": "დასავლეთ აფრიკის ზაფხულის დრო", "WAT": "დასავლეთ აფრიკის სტანდარტული დრო", "WESZ": "დასავლეთ ევროპის ზაფხულის დრო", "WEZ": "დასავლეთ ევროპის სტანდარტული დრო", "WIB": "დასავლეთ ინდონეზიის დრო", "WIT": "აღმოსავლეთ ინდონეზიის დრო", "WITA": "ცენტრალური ინდონეზიის დრო", "∅∅∅": "პერუს ზაფხულის დრო"},
 	}
 }
 
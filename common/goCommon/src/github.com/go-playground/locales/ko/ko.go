@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyNegativePrefix: "(",
 		currencyNegativeSuffix: ")",
 		monthsAbbreviated:      []string{"", "1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"BC", "AD"},
 		erasNarrow:             []string{"", ""},
 		erasWide:               []string{"기원전", "서기"},
-		timezones:              map[string]string{"CLST": "칠레 하계 표준시", "CHADT": "채텀 하계 표준시", "PDT": "미 태평양 하계 표준시", "SAST": "남아프리카 시간", "NZST": "뉴질랜드 표준시", "WARST": "아르헨티나 서부 하계 표준시", "COST": "콜롬비아 하계 표준시", "PST": "미 태평양 표준시", "AKST": "알래스카 표준시", "MEZ": "중부 유럽 표준시", "WAST": "서아프리카 하계 표준시", "HNEG": "그린란드 동부 표준시", "HNOG": "그린란드 서부 표준시", "HAT": "뉴펀들랜드 하계 표준시", "WAT": "서아프리카 표준시", "EST": "미 동부 표준시", "TMST": "투르크메니스탄 하계 표준시", "ART": "아르헨티나 표준시", "GMT": "그리니치 표준시", "AWDT": "오스트레일리아 서부 하계 표준시", "AEST": "오스트레일리아 동부 표준시", "AEDT": "오스트레일리아 동부 하계 표준시", "ACST": "오스트레일리아 중부 표준시", "ACWST": "오스트레일리아 중서부 표준시", "HKST": "홍콩 하계 표준시", "WART": "아르헨티나 서부 표준시", "HNNOMX": "멕시코 북서부 표준시", "AST": "대서양 표준시", "ACWDT": "오스트레일리아 중서부 하계 표준시", "WITA": "중부 인도네시아 시간", "TMT": "투르크메니스탄 표준시", "HEPMX": "멕시코 태평양 하계 표준시", "JST": "일본 표준시", "EDT": "미 동부 하계 표준시", "LHST": "로드 하우 표준시", "LHDT": "로드 하우 하계 표준시", "HENOMX": "멕시코 북서부 하계 표준시", "EAT": "동아프리카 시간", "HAST": "하와이 알류샨 표준시", "COT": "콜롬비아 표준시", "GFT": "프랑스령 가이아나 시간", "ECT": "에콰도르 시간", "∅∅∅": "아마존 하계 표준시", "AWST": "오스트레일리아 서부 표준시", "VET": "베네수엘라 시간", "UYST": "우루과이 하계 표준시", "BOT": "볼리비아 시간", "SGT": "싱가포르 표준시", "HEOG": "그린란드 서부 하계 표준시", "HEPM": "세인트피에르 미클롱 하계 표준시", "CLT": "칠레 표준시", "HNT": "뉴펀들랜드 표준시", "MST": "마카오 표준 시간", "CAT": "중앙아프리카 시간", "ARST": "아르헨티나 하계 표준시", "WESZ": "서유럽 하계 표준시", "IST": "인도 표준시", "OEZ": "동유럽 표준시", "ADT": "미 대서양 하계 표준시", "ACDT": "오스트레일리아 중부 하계 표준시", "GYT": "가이아나 시간", "HNPM": "세인트피에르 미클롱 표준시", "WEZ": "서유럽 표준시", "WIB": "서부 인도네시아 시간", "WIT": "동부 인도네시아 시간", "OESZ": "동유럽 하계 표준시", "ChST": "차모로 시간", "HECU": "쿠바 하계 표준시", "CST": "미 중부 표준시", "CDT": "미 중부 하계 표준시", "BT": "부탄 시간", "AKDT": "알래스카 하계 표준시", "HEEG": "그린란드 동부 하계 표준시", "MDT": "마카오 하계 표준시", "MYT": "말레이시아 시간", "HKT": "홍콩 표준시", "JDT": "일본 하계 표준시", "NZDT": "뉴질랜드 하계 표준시", "SRT": "수리남 시간", "HADT": "하와이 알류샨 하계 표준시", "UYT": "우루과이 표준시", "CHAST": "채텀 표준시", "HNCU": "쿠바 표준시", "HNPMX": "멕시코 태평양 표준시", "MESZ": "중부 유럽 하계 표준시"},
+		timezones:              map[string]string{"ACDT": "오스트레일리아 중부 하계 표준시", "ACST": "오스트레일리아 중부 표준시", "ACWDT": "오스트레일리아 중서부 하계 표준시", "ACWST": "오스트레일리아 중서부 표준시", "ADT": "대서양 하계 표준시", "AEDT": "오스트레일리아 동부 하계 표준시", "AEST": "오스트레일리아 동부 표준시", "AKDT": "알래스카 하계 표준시", "AKST": "알래스카 표준시", "ARST": "아르헨티나 하계 표준시", "ART": "아르헨티나 표준시", "AST": "대서양 표준시", "AWDT": "오스트레일리아 서부 하계 표준시", "AWST": "오스트레일리아 서부 표준시", "BOT": "볼리비아 시간", "BT": "부탄 시간", "CAT": "중앙아프리카 시간", "CDT": "미 중부 하계 표준시", "CHADT": "채텀 하계 표준시", "CHAST": "채텀 표준시", "CLST": "칠레 하계 표준시", "CLT": "칠레 표준시", "COST": "콜롬비아 하계 표준시", "COT": "콜롬비아 표준시", "CST": "미 중부 표준시", "ChST": "차모로 시간", "EAT": "동아프리카 시간", "ECT": "에콰도르 시간", "EDT": "미 동부 하계 표준시", "EST": "미 동부 표준시", "GFT": "프랑스령 가이아나 시간", "GMT": "그리니치 표준시", "GST": "걸프만 표준시", "GYT": "가이아나 시간", "HADT": "하와이 알류샨 하계 표준시", "HAST": "하와이 알류샨 표준시", "HAT": "뉴펀들랜드 하계 표준시", "HECU": "쿠바 하계 표준시", "HEEG": "그린란드 동부 하계 표준시", "HENOMX": "멕시코 북서부 하계 표준시", "HEOG": "그린란드 서부 하계 표준시", "HEPM": "세인트피에르 미클롱 하계 표준시", "HEPMX": "멕시코 태평양 하계 표준시", "HKST": "홍콩 하계 표준시", "HKT": "홍콩 표준시", "HNCU": "쿠바 표준시", "HNEG": "그린란드 동부 표준시", "HNNOMX": "멕시코 북서부 표준시", "HNOG": "그린란드 서부 표준시", "HNPM": "세인트피에르 미클롱 표준시", "HNPMX": "멕시코 태평양 표준시", "HNT": "뉴펀들랜드 표준시", "IST": "인도 표준시", "JDT": "일본 하계 표준시", "JST": "일본 표준시", "LHDT": "로드 하우 하계 표준시", "LHST": "로드 하우 표준시", "MDT": "미 산지 하계 표준시", "MESZ": "중부 유럽 하계 표준시", "MEZ": "중부 유럽 표준시", "MST": "미 산악 표준시", "MYT": "말레이시아 시간", "NZDT": "뉴질랜드 하계 표준시", "NZST": "뉴질랜드 표준시", "OESZ": "동유럽 하계 표준시", "OEZ": "동유럽 표준시", "PDT": "미 태평양 하계 표준시", "PST": "미 태평양 표준시", "SAST": "남아프리카 시간", "SGT": "싱가포르 표준시", "SRT": "수리남 시간", "TMST": "투르크메니스탄 하계 표준시", "TMT": "투르크메니스탄 표준시", "UYST": "우루과이 하계 표준시", "UYT": "우루과이 표준시", "VET": "베네수엘라 시간", "WARST": "아르헨티나 서부 하계 표준시", "WART": "아르헨티나 서부 표준시", "WAST": "서아프리카 하계 표준시", "WAT": "서아프리카 표준시", "WESZ": "서유럽 하계 표준시", "WEZ": "서유럽 표준시", "WIB": "서부 인도네시아 시간", "WIT": "동부 인도네시아 시간", "WITA": "중부 인도네시아 시간", "∅∅∅": "브라질리아 하계 표준시"},
 	}
 }
 
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "сом", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "сом", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "Db", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "янв.", "фев.", "мар.", "апр.", "май", "июн.", "июл.", "авг.", "сен.", "окт.", "ноя.", "дек."},
@@ -63,15 +63,15 @@ func New() locales.Translator {
 		monthsWide:             []string{"", "январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
 		daysAbbreviated:        []string{"жек.", "дүй.", "шейш.", "шарш.", "бейш.", "жума", "ишм."},
 		daysNarrow:             []string{"Ж", "Д", "Ш", "Ш", "Б", "Ж", "И"},
-		daysShort:              []string{"жек.", "дүй.", "шейш.", "шарш.", "бейш.", "жума", "ишм."},
+		daysShort:              []string{"жш.", "дш.", "шш.", "шр.", "бш.", "жм.", "иш."},
 		daysWide:               []string{"жекшемби", "дүйшөмбү", "шейшемби", "шаршемби", "бейшемби", "жума", "ишемби"},
 		periodsAbbreviated:     []string{"тң", "тк"},
 		periodsNarrow:          []string{"тң", "тк"},
 		periodsWide:            []string{"таңкы", "түштөн кийинки"},
 		erasAbbreviated:        []string{"б.з.ч.", "б.з."},
 		erasNarrow:             []string{"б.з.ч.", "б.з."},
-		erasWide:               []string{"", ""},
-		timezones:              map[string]string{"WEZ": "Батыш Европа кышкы убакыты", "WIB": "Батыш Индонезия убактысы", "ACST": "Австралия борбордук кышкы убакыты", "EST": "Түндүк Америка, чыгыш кышкы убактысы", "HENOMX": "Түндүк-чыгыш Мексика жайкы убактысы", "EAT": "Чыгыш Африка убактысы", "CDT": "Түндүк Америка, борбордук жайкы убакыт", "JST": "Жапон кышкы убакыты", "HEOG": "Батыш Гренландия жайкы убактысы", "LHST": "Лорд Хау кышкы убакыты", "WAT": "Батыш Африка кышкы убакыты", "WESZ": "Батыш Европа жайкы убактысы", "BOT": "Боливия убактысы", "WART": "Батыш Аргентина кышкы убактысы", "HNPM": "Сен Пьер жана Микелон кышкы убактысы", "HEPM": "Сен Пьер жана Микелон жайкы убактысы", "SRT": "Суринаме убактысы", "HNEG": "Чыгыш Гренландия кышкы убактысы", "MDT": "MDT", "CAT": "Борбордук Африка убактысы", "ARST": "Аргентина жайкы убактысы", "AEST": "Австралия чыгыш кышкы убакыты", "ChST": "Чаморро убактысы", "PDT": "Түндүк Америка, Тынч океан жайкы убактысы", "HNPMX": "Мексика, Тынч океан кышкы убактысы", "ACDT": "Австралия борбордук жайкы убактысы", "LHDT": "Лорд Хау жайкы убактысы", "HNNOMX": "Түндүк-чыгыш Мексика кышкы убактысы", "CLST": "Чили жайкы убактысы", "UYT": "Уругвай кышкы убактысы", "GYT": "Гвиана убактысы", "PST": "Түндүк Америка, Тынч океан кышкы убактысы", "WAST": "Батыш Африка жайкы убактысы", "WARST": "Батыш Аргентина жайкы убактысы", "HNT": "Нюфаундлэнд кышкы убактысы", "HAT": "Нюфаундлэнд жайкы убактысы", "CLT": "Чили кышкы убактысы", "WIT": "Чыгыш Индонезия убактысы", "HKST": "Гонконг жайкы убактысы", "MST": "MST", "ART": "Аргентина кышкы убактысы", "OESZ": "Чыгыш Европа жайкы убактысы", "UYST": "Уругвай жайкы убактысы", "SGT": "Сингапур убактысы", "EDT": "Түндүк Америка, чыгыш жайкы убактысы", "HEEG": "Чыгыш Гренландия жайкы убактысы", "HKT": "Гонконг кышкы убакыты", "OEZ": "Чыгыш Европа кышкы убакыты", "CHADT": "Чатам жайкы убактысы", "AEDT": "Австралия чыгыш жайкы убактысы", "COT": "Колумбия кышкы убактысы", "CHAST": "Чатам кышкы убакыт", "HEPMX": "Мексика, Тынч океан жайкы убактысы", "BT": "Бутан убактысы", "AKST": "Аляска кышкы убактысы", "ACWST": "Австралия борбордук батыш кышкы убакыты", "TMT": "Түркмөнстан кышкы убакыты", "TMST": "Түркмөнстан жайкы убактысы", "ADT": "Атлантика жайкы убактысы", "HECU": "Куба жайкы убактысы", "AST": "Атлантика кышкы убактысы", "NZDT": "Жаңы Зеландия жайкы убакыты", "GFT": "Француз Гвиана убактысы", "AKDT": "Аляска жайкы убактысы", "ACWDT": "Австралия борбордук чыгыш жайкы убактысы", "∅∅∅": "Азорс жайкы убактысы", "CST": "Түндүк Америка, борбордук кышкы убактысы", "SAST": "Түштүк Африка убактысы", "NZST": "Жаӊы Зеландия кышкы убакыты", "ECT": "Экуадор убактысы", "IST": "Индия убактысы", "VET": "Венесуэла убактысы", "AWST": "Австралия батыш кышкы убакыты", "MYT": "Малайзия убактысы", "WITA": "Борбордук Индонезия убактысы", "GMT": "GMT, кышкы убакыты", "HNOG": "Батыш Гренландия кышкы убактысы", "MEZ": "Борбордук Европа кышкы убакыты", "HAST": "Гавайи-Алеут кышкы убактысы", "HNCU": "Куба кышкы убактысы", "JDT": "Жапон жайкы убактысы", "COST": "Колумбия жайкы убактысы", "MESZ": "Борбордук Европа жайкы убактысы", "HADT": "Гавайи-Алеут жайкы убактысы", "AWDT": "Австралия батыш жайкы убактысы"},
+		erasWide:               []string{"биздин заманга чейин", "биздин заман"},
+		timezones:              map[string]string{"ACDT": "Австралия борбордук жайкы убактысы", "ACST": "Австралия борбордук кышкы убакыты", "ACWDT": "Австралия борбордук чыгыш жайкы убактысы", "ACWST": "Австралия борбордук батыш кышкы убакыты", "ADT": "Атлантика жайкы убактысы", "AEDT": "Австралия чыгыш жайкы убактысы", "AEST": "Австралия чыгыш кышкы убакыты", "AKDT": "Аляска жайкы убактысы", "AKST": "Аляска кышкы убактысы", "ARST": "Аргентина жайкы убактысы", "ART": "Аргентина кышкы убактысы", "AST": "Атлантика кышкы убактысы", "AWDT": "Австралия батыш жайкы убактысы", "AWST": "Австралия батыш кышкы убакыты", "BOT": "Боливия убактысы", "BT": "Бутан убактысы", "CAT": "Борбордук Африка убактысы", "CDT": "Түндүк Америка, борбордук жайкы убакыт", "CHADT": "Чатам жайкы убактысы", "CHAST": "Чатам кышкы убакыт", "CLST": "Чили жайкы убактысы", "CLT": "Чили кышкы убактысы", "COST": "Колумбия жайкы убактысы", "COT": "Колумбия кышкы убактысы", "CST": "Түндүк Америка, борбордук кышкы убактысы", "ChST": "Чаморро убактысы", "EAT": "Чыгыш Африка убактысы", "ECT": "Экуадор убактысы", "EDT": "Түндүк Америка, чыгыш жайкы убактысы", "EST": "Түндүк Америка, чыгыш кышкы убактысы", "GFT": "Француз Гвиана убактысы", "GMT": "Гринвич боюнча орточо убакыт", "GST": "Булуңдун стандарттык убакыты", "GYT": "Гвиана убактысы", "HADT": "Гавайи-Алеут жайкы убактысы", "HAST": "Гавайи-Алеут кышкы убактысы", "HAT": "Нюфаундлэнд жайкы убактысы", "HECU": "Куба жайкы убактысы", "HEEG": "Чыгыш Гренландия жайкы убактысы", "HENOMX": "Түндүк-чыгыш Мексика жайкы убактысы", "HEOG": "Батыш Гренландия жайкы убактысы", "HEPM": "Сен Пьер жана Микелон жайкы убактысы", "HEPMX": "Мексика, Тынч океан жайкы убактысы", "HKST": "Гонконг жайкы убактысы", "HKT": "Гонконг кышкы убакыты", "HNCU": "Куба кышкы убактысы", "HNEG": "Чыгыш Гренландия кышкы убактысы", "HNNOMX": "Түндүк-чыгыш Мексика кышкы убактысы", "HNOG": "Батыш Гренландия кышкы убактысы", "HNPM": "Сен Пьер жана Микелон кышкы убактысы", "HNPMX": "Мексика, Тынч океан кышкы убактысы", "HNT": "Нюфаундлэнд кышкы убактысы", "IST": "Индия убактысы", "JDT": "Жапон жайкы убактысы", "JST": "Жапон кышкы убакыты", "LHDT": "Лорд Хау жайкы убактысы", "LHST": "Лорд Хау кышкы убакыты", "MDT": "Түндүк Америка, тоо жайкы убактысы", "MESZ": "Борбордук Европа жайкы убактысы", "MEZ": "Борбордук Европа кышкы убакыты", "MST": "Түндүк Америка, тоо кышкы убактысы", "MYT": "Малайзия убактысы", "NZDT": "Жаңы Зеландия жайкы убакыты", "NZST": "Жаңы Зеландия кышкы убакыты", "OESZ": "Чыгыш Европа жайкы убактысы", "OEZ": "Чыгыш Европа кышкы убакыты", "PDT": "Түндүк Америка, Тынч океан жайкы убактысы", "PST": "Түндүк Америка, Тынч океан кышкы убактысы", "SAST": "Түштүк Африка убактысы", "SGT": "Сингапур убактысы", "SRT": "Суринаме убактысы", "TMST": "Түркмөнстан жайкы убактысы", "TMT": "Түркмөнстан кышкы убакыты", "UYST": "Уругвай жайкы убактысы", "UYT": "Уругвай кышкы убактысы", "VET": "Венесуэла убактысы", "WARST": "Батыш Аргентина жайкы убактысы", "WART": "Батыш Аргентина кышкы убактысы", "WAST": "Батыш Африка жайкы убактысы", "WAT": "Батыш Африка кышкы убакыты", "WESZ": "Батыш Европа жайкы убактысы", "WEZ": "Батыш Европа кышкы убакыты", "WIB": "Батыш Индонезия убактысы", "WIT": "Чыгыш Индонезия убактысы", "WITA": "Борбордук Индонезия убактысы", "∅∅∅": "Перу жайкы убактысы"},
 	}
 }
 
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "янв.", "фев.", "мар.", "апр.", "май", "июн.", "июл.", "авг.", "сен.", "окт.", "ноя.", "дек."},
@@ -63,15 +63,15 @@ func New() locales.Translator {
 		monthsWide:             []string{"", "январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
 		daysAbbreviated:        []string{"жек.", "дүй.", "шейш.", "шарш.", "бейш.", "жума", "ишм."},
 		daysNarrow:             []string{"Ж", "Д", "Ш", "Ш", "Б", "Ж", "И"},
-		daysShort:              []string{"жек.", "дүй.", "шейш.", "шарш.", "бейш.", "жума", "ишм."},
+		daysShort:              []string{"жш.", "дш.", "шш.", "шр.", "бш.", "жм.", "иш."},
 		daysWide:               []string{"жекшемби", "дүйшөмбү", "шейшемби", "шаршемби", "бейшемби", "жума", "ишемби"},
 		periodsAbbreviated:     []string{"тң", "тк"},
 		periodsNarrow:          []string{"тң", "тк"},
 		periodsWide:            []string{"таңкы", "түштөн кийинки"},
 		erasAbbreviated:        []string{"б.з.ч.", "б.з."},
 		erasNarrow:             []string{"б.з.ч.", "б.з."},
-		erasWide:               []string{"", ""},
-		timezones:              map[string]string{"MEZ": "Борбордук Европа кышкы убакыты", "AWDT": "Австралия батыш жайкы убактысы", "NZDT": "Жаңы Зеландия жайкы убакыты", "ADT": "Атлантика жайкы убактысы", "MYT": "Малайзия убактысы", "HEEG": "Чыгыш Гренландия жайкы убактысы", "MESZ": "Борбордук Европа жайкы убактысы", "CLT": "Чили кышкы убактысы", "HAT": "Нюфаундлэнд жайкы убактысы", "HEPMX": "Мексика, Тынч океан жайкы убактысы", "WAST": "Батыш Африка жайкы убактысы", "BOT": "Боливия убактысы", "UYT": "Уругвай кышкы убактысы", "AST": "Атлантика кышкы убактысы", "AEDT": "Австралия чыгыш жайкы убактысы", "CDT": "Түндүк Америка, борбордук жайкы убакыт", "WEZ": "Батыш Европа кышкы убакыты", "SGT": "Сингапур убактысы", "HNT": "Нюфаундлэнд кышкы убактысы", "CHADT": "Чатам жайкы убактысы", "COST": "Колумбия жайкы убактысы", "WESZ": "Батыш Европа жайкы убактысы", "HNEG": "Чыгыш Гренландия кышкы убактысы", "HNPM": "Сен Пьер жана Микелон кышкы убактысы", "COT": "Колумбия кышкы убактысы", "AWST": "Австралия батыш кышкы убакыты", "NZST": "Жаӊы Зеландия кышкы убакыты", "JDT": "Жапон жайкы убактысы", "EST": "Түндүк Америка, чыгыш кышкы убактысы", "HENOMX": "Түндүк-чыгыш Мексика жайкы убактысы", "GYT": "Гвиана убактысы", "WARST": "Батыш Аргентина жайкы убактысы", "WITA": "Борбордук Индонезия убактысы", "TMT": "Түркмөнстан кышкы убакыты", "HNCU": "Куба кышкы убактысы", "WAT": "Батыш Африка кышкы убакыты", "JST": "Жапон кышкы убакыты", "ACWDT": "Австралия борбордук чыгыш жайкы убактысы", "IST": "Индия убактысы", "OESZ": "Чыгыш Европа жайкы убактысы", "UYST": "Уругвай жайкы убактысы", "HECU": "Куба жайкы убактысы", "PDT": "Түндүк Америка, Тынч океан жайкы убактысы", "WIB": "Батыш Индонезия убактысы", "ECT": "Экуадор убактысы", "HEOG": "Батыш Гренландия жайкы убактысы", "MST": "MST", "HEPM": "Сен Пьер жана Микелон жайкы убактысы", "ARST": "Аргентина жайкы убактысы", "CHAST": "Чатам кышкы убакыт", "SAST": "Түштүк Африка убактысы", "EDT": "Түндүк Америка, чыгыш жайкы убактысы", "ACST": "Австралия борбордук кышкы убакыты", "ACDT": "Австралия борбордук жайкы убактысы", "OEZ": "Чыгыш Европа кышкы убакыты", "AKST": "Аляска кышкы убактысы", "HNOG": "Батыш Гренландия кышкы убактысы", "VET": "Венесуэла убактысы", "TMST": "Түркмөнстан жайкы убактысы", "HAST": "Гавайи-Алеут кышкы убактысы", "HADT": "Гавайи-Алеут жайкы убактысы", "GFT": "Француз Гвиана убактысы", "HKT": "Гонконг кышкы убакыты", "LHDT": "Лорд Хау жайкы убактысы", "EAT": "Чыгыш Африка убактысы", "SRT": "Суринаме убактысы", "ART": "Аргентина кышкы убактысы", "GMT": "GMT, кышкы убакыты", "CST": "Түндүк Америка, борбордук кышкы убактысы", "BT": "Бутан убактысы", "AKDT": "Аляска жайкы убактысы", "WART": "Батыш Аргентина кышкы убактысы", "MDT": "MDT", "ChST": "Чаморро убактысы", "AEST": "Австралия чыгыш кышкы убакыты", "LHST": "Лорд Хау кышкы убакыты", "HNNOMX": "Түндүк-чыгыш Мексика кышкы убактысы", "CAT": "Борбордук Африка убактысы", "CLST": "Чили жайкы убактысы", "WIT": "Чыгыш Индонезия убактысы", "ACWST": "Австралия борбордук батыш кышкы убакыты", "HKST": "Гонконг жайкы убактысы", "∅∅∅": "Азорс жайкы убактысы", "PST": "Түндүк Америка, Тынч океан кышкы убактысы", "HNPMX": "Мексика, Тынч океан кышкы убактысы"},
+		erasWide:               []string{"биздин заманга чейин", "биздин заман"},
+		timezones:              map[string]string{"ACDT": "Австралия борбордук жайкы убактысы", "ACST": "Австралия борбордук кышкы убакыты", "ACWDT": "Австралия борбордук чыгыш жайкы убактысы", "ACWST": "Австралия борбордук батыш кышкы убакыты", "ADT": "Атлантика жайкы убактысы", "AEDT": "Австралия чыгыш жайкы убактысы", "AEST": "Австралия чыгыш кышкы убакыты", "AKDT": "Аляска жайкы убактысы", "AKST": "Аляска кышкы убактысы", "ARST": "Аргентина жайкы убактысы", "ART": "Аргентина кышкы убактысы", "AST": "Атлантика кышкы убактысы", "AWDT": "Австралия батыш жайкы убактысы", "AWST": "Австралия батыш кышкы убакыты", "BOT": "Боливия убактысы", "BT": "Бутан убактысы", "CAT": "Борбордук Африка убактысы", "CDT": "Түндүк Америка, борбордук жайкы убакыт", "CHADT": "Чатам жайкы убактысы", "CHAST": "Чатам кышкы убакыт", "CLST": "Чили жайкы убактысы", "CLT": "Чили кышкы убактысы", "COST": "Колумбия жайкы убактысы", "COT": "Колумбия кышкы убактысы", "CST": "Түндүк Америка, борбордук кышкы убактысы", "ChST": "Чаморро убактысы", "EAT": "Чыгыш Африка убактысы", "ECT": "Экуадор убактысы", "EDT": "Түндүк Америка, чыгыш жайкы убактысы", "EST": "Түндүк Америка, чыгыш кышкы убактысы", "GFT": "Француз Гвиана убактысы", "GMT": "Гринвич боюнча орточо убакыт", "GST": "Булуңдун стандарттык убакыты", "GYT": "Гвиана убактысы", "HADT": "Гавайи-Алеут жайкы убактысы", "HAST": "Гавайи-Алеут кышкы убактысы", "HAT": "Нюфаундлэнд жайкы убактысы", "HECU": "Куба жайкы убактысы", "HEEG": "Чыгыш Гренландия жайкы убактысы", "HENOMX": "Түндүк-чыгыш Мексика жайкы убактысы", "HEOG": "Батыш Гренландия жайкы убактысы", "HEPM": "Сен Пьер жана Микелон жайкы убактысы", "HEPMX": "Мексика, Тынч океан жайкы убактысы", "HKST": "Гонконг жайкы убактысы", "HKT": "Гонконг кышкы убакыты", "HNCU": "Куба кышкы убактысы", "HNEG": "Чыгыш Гренландия кышкы убактысы", "HNNOMX": "Түндүк-чыгыш Мексика кышкы убактысы", "HNOG": "Батыш Гренландия кышкы убактысы", "HNPM": "Сен Пьер жана Микелон кышкы убактысы", "HNPMX": "Мексика, Тынч океан кышкы убактысы", "HNT": "Нюфаундлэнд кышкы убактысы", "IST": "Индия убактысы", "JDT": "Жапон жайкы убактысы", "JST": "Жапон кышкы убакыты", "LHDT": "Лорд Хау жайкы убактысы", "LHST": "Лорд Хау кышкы убакыты", "MDT": "Түндүк Америка, тоо жайкы убактысы", "MESZ": "Борбордук Европа жайкы убактысы", "MEZ": "Борбордук Европа кышкы убакыты", "MST": "Түндүк Америка, тоо кышкы убактысы", "MYT": "Малайзия убактысы", "NZDT": "Жаңы Зеландия жайкы убакыты", "NZST": "Жаңы Зеландия кышкы убакыты", "OESZ": "Чыгыш Европа жайкы убактысы", "OEZ": "Чыгыш Европа кышкы убакыты", "PDT": "Түндүк Америка, Тынч океан жайкы убактысы", "PST": "Түндүк Америка, Тынч океан кышкы убактысы", "SAST": "Түштүк Африка убактысы", "SGT": "Сингапур убактысы", "SRT": "Суринаме убактысы", "TMST": "Түркмөнстан жайкы убактысы", "TMT": "Түркмөнстан кышкы убакыты", "UYST": "Уругвай жайкы убактысы", "UYT": "Уругвай кышкы убактысы", "VET": "Венесуэла убактысы", "WARST": "Батыш Аргентина жайкы убактысы", "WART": "Батыш Аргентина кышкы убактысы", "WAST": "Батыш Африка жайкы убактысы", "WAT": "Батыш Африка кышкы убакыты", "WESZ": "Батыш Европа жайкы убактысы", "WEZ": "Батыш Европа кышкы убакыты", "WIB": "Батыш Индонезия убактысы", "WIT": "Чыгыш Индонезия убактысы", "WITA": "Борбордук Индонезия убактысы", "∅∅∅": "Перу жайкы убактысы"},
 	}
 }
 
@@ -56,7 +56,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "KM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "KM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativePrefix: "(",
 		currencyNegativeSuffix: " )",
@@ -73,7 +73,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"p. n. e.", "n. e."},
 		erasNarrow:             []string{"p.n.e.", "n.e."},
 		erasWide:               []string{"pre nove ere", "nove ere"},
-		timezones:              map[string]string{"∅∅∅": "Amazon, letnje vreme", "CAT": "Centralno-afričko vreme", "UYT": "Urugvaj, standardno vreme", "ChST": "Čamoro vreme", "ACDT": "Australijsko centralno letnje vreme", "HKT": "Hong Kong, standardno vreme", "HEPM": "Sen Pjer i Mikelon, letnje vreme", "MST": "Makao standardno vreme", "UYST": "Urugvaj, letnje vreme", "HNPMX": "Meksički Pacifik, standardno vreme", "WESZ": "Zapadnoevropsko letnje vreme", "HNPM": "Sen Pjer i Mikelon, standardno vreme", "ART": "Argentina, standardno vreme", "CLT": "Čile, standardno vreme", "CLST": "Čile, letnje vreme", "WIT": "Istočno-indonezijsko vreme", "COST": "Kolumbija, letnje vreme", "AWDT": "Australijsko zapadno letnje vreme", "AKST": "Aljaska, standardno vreme", "EDT": "Severnoameričko istočno letnje vreme", "SRT": "Surinam vreme", "CST": "Severnoameričko centralno standardno vreme", "JST": "Japansko standardno vreme", "HEOG": "Zapadni Grenland, letnje vreme", "HNNOMX": "Severozapadni Meksiko, standardno vreme", "HAST": "Havajsko-aleutsko standardno vreme", "AST": "Atlantsko standardno vreme", "HEEG": "Istočni Grenland, letnje vreme", "LHST": "Lord Hov, standardno vreme", "HNCU": "Kuba, standardno vreme", "NZDT": "Novi Zeland, letnje vreme", "MESZ": "Srednjeevropsko letnje vreme", "BT": "Butan vreme", "HKST": "Hong Kong, letnje vreme", "WITA": "Centralno-indonezijsko vreme", "ECT": "Ekvador vreme", "HNEG": "Istočni Grenland, standardno vreme", "HAT": "Njufaundlend, letnje vreme", "VET": "Venecuela vreme", "ADT": "Atlantsko letnje vreme", "COT": "Kolumbija, standardno vreme", "CHADT": "Čatam, letnje vreme", "HECU": "Kuba, letnje vreme", "AEDT": "Australijsko istočno letnje vreme", "GFT": "Francuska Gvajana vreme", "WARST": "Zapadna Argentina, letnje vreme", "HNT": "Njufaundlend, standardno vreme", "MDT": "Makao letnje računanje vremena", "GYT": "Gvajana vreme", "HEPMX": "Meksički Pacifik, letnje vreme", "WAST": "Zapadno-afričko letnje vreme", "JDT": "Japansko letnje vreme", "EST": "Severnoameričko istočno standardno vreme", "MEZ": "Srednjeevropsko standardno vreme", "TMT": "Turkmenistan, standardno vreme", "OESZ": "Istočnoevropsko letnje vreme", "ARST": "Argentina, letnje vreme", "GMT": "Srednje vreme po Griniču", "PST": "Severnoameričko pacifičko standardno vreme", "NZST": "Novi Zeland, standardno vreme", "HENOMX": "Severozapadni Meksiko, letnje vreme", "OEZ": "Istočnoevropsko standardno vreme", "AWST": "Australijsko zapadno standardno vreme", "WIB": "Zapadno-indonezijsko vreme", "HNOG": "Zapadni Grenland, standardno vreme", "IST": "Indijsko standardno vreme", "EAT": "Istočno-afričko vreme", "PDT": "Severnoameričko pacifičko letnje vreme", "AEST": "Australijsko istočno standardno vreme", "WAT": "Zapadno-afričko standardno vreme", "ACWST": "Australijsko centralno zapadno standardno vreme", "WART": "Zapadna Argentina, standardno vreme", "TMST": "Turkmenistan, letnje vreme", "CDT": "Severnoameričko centralno letnje vreme", "SAST": "Južno-afričko vreme", "MYT": "Malezija vreme", "SGT": "Singapur, standardno vreme", "ACST": "Australijsko centralno standardno vreme", "LHDT": "Lord Hov, letnje vreme", "CHAST": "Čatam, standardno vreme", "WEZ": "Zapadnoevropsko standardno vreme", "BOT": "Bolivija vreme", "AKDT": "Aljaska, letnje vreme", "ACWDT": "Australijsko centralno zapadno letnje vreme", "HADT": "Havajsko-aleutsko letnje vreme"},
+		timezones:              map[string]string{"ACDT": "Australijsko centralno letnje vreme", "ACST": "Australijsko centralno standardno vreme", "ACWDT": "Australijsko centralno zapadno letnje vreme", "ACWST": "Australijsko centralno zapadno standardno vreme", "ADT": "Atlantsko letnje vreme", "AEDT": "Australijsko istočno letnje vreme", "AEST": "Australijsko istočno standardno vreme", "AKDT": "Aljaska, letnje vreme", "AKST": "Aljaska, standardno vreme", "ARST": "Argentina, letnje vreme", "ART": "Argentina, standardno vreme", "AST": "Atlantsko standardno vreme", "AWDT": "Australijsko zapadno letnje vreme", "AWST": "Australijsko zapadno standardno vreme", "BOT": "Bolivija vreme", "BT": "Butan vreme", "CAT": "Centralno-afričko vreme", "CDT": "Severnoameričko centralno letnje vreme", "CHADT": "Čatam, letnje vreme", "CHAST": "Čatam, standardno vreme", "CLST": "Čile, letnje vreme", "CLT": "Čile, standardno vreme", "COST": "Kolumbija, letnje vreme", "COT": "Kolumbija, standardno vreme", "CST": "Severnoameričko centralno standardno vreme", "ChST": "Čamoro vreme", "EAT": "Istočno-afričko vreme", "ECT": "Ekvador vreme", "EDT": "Severnoameričko istočno letnje vreme", "EST": "Severnoameričko istočno standardno vreme", "GFT": "Francuska Gvajana vreme", "GMT": "Srednje vreme po Griniču", "GST": "Zalivsko vreme", "GYT": "Gvajana vreme", "HADT": "Havajsko-aleutsko letnje vreme", "HAST": "Havajsko-aleutsko standardno vreme", "HAT": "Njufaundlend, letnje vreme", "HECU": "Kuba, letnje vreme", "HEEG": "Istočni Grenland, letnje vreme", "HENOMX": "Severozapadni Meksiko, letnje vreme", "HEOG": "Zapadni Grenland, letnje vreme", "HEPM": "Sen Pjer i Mikelon, letnje vreme", "HEPMX": "Meksički Pacifik, letnje vreme", "HKST": "Hong Kong, letnje vreme", "HKT": "Hong Kong, standardno vreme", "HNCU": "Kuba, standardno vreme", "HNEG": "Istočni Grenland, standardno vreme", "HNNOMX": "Severozapadni Meksiko, standardno vreme", "HNOG": "Zapadni Grenland, standardno vreme", "HNPM": "Sen Pjer i Mikelon, standardno vreme", "HNPMX": "Meksički Pacifik, standardno vreme", "HNT": "Njufaundlend, standardno vreme", "IST": "Indijsko standardno vreme", "JDT": "Japansko letnje vreme", "JST": "Japansko standardno vreme", "LHDT": "Lord Hov, letnje vreme", "LHST": "Lord Hov, standardno vreme", "MDT": "Severnoameričko planinsko letnje vreme", "MESZ": "Srednjeevropsko letnje vreme", "MEZ": "Srednjeevropsko standardno vreme", "MST": "Severnoameričko planinsko standardno vreme", "MYT": "Malezija vreme", "NZDT": "Novi Zeland, letnje vreme", "NZST": "Novi Zeland, standardno vreme", "OESZ": "Istočnoevropsko letnje vreme", "OEZ": "Istočnoevropsko standardno vreme", "PDT": "Severnoameričko pacifičko letnje vreme", "PST": "Severnoameričko pacifičko standardno vreme", "SAST": "Južno-afričko vreme", "SGT": "Singapur, standardno vreme", "SRT": "Surinam vreme", "TMST": "Turkmenistan, letnje vreme", "TMT": "Turkmenistan, standardno vreme", "UYST": "Urugvaj, letnje vreme", "UYT": "Urugvaj, standardno vreme", "VET": "Venecuela vreme", "WARST": "Zapadna Argentina, letnje vreme", "WART": "Zapadna Argentina, standardno vreme", "WAST": "Zapadno-afričko letnje vreme", "WAT": "Zapadno-afričko standardno vreme", "WESZ": "Zapadnoevropsko letnje vreme", "WEZ": "Zapadnoevropsko standardno vreme", "WIB": "Zapadno-indonezijsko vreme", "WIT": "Istočno-indonezijsko vreme", "WITA": "Centralno-indonezijsko vreme", "∅∅∅": "Azori, letnje vreme"},
 	}
 }
 
@@ -103,8 +103,8 @@ func (sr *sr_Latn) CardinalPluralRule(num float64, v uint64) locales.PluralRule
 	n := math.Abs(num)
 	i := int64(n)
 	f := locales.F(n, v)
-	iMod10 := i % 10
 	iMod100 := i % 100
+	iMod10 := i % 10
 	fMod10 := f % 10
 	fMod100 := f % 100
 
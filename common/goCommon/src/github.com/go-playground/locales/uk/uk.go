@@ -22,7 +22,7 @@ type uk struct {
 	timeSeparator          string
 	inifinity              string
 	currencies             []string // idx = enum of currency code
-	currencyNegativePrefix string
+	currencyPositiveSuffix string
 	currencyNegativeSuffix string
 	monthsAbbreviated      []string
 	monthsNarrow           []string
@@ -55,9 +55,9 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "₴", "крб.", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
-		currencyNegativePrefix: "(",
-		currencyNegativeSuffix: ")",
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "₴", "крб.", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencyPositiveSuffix: " ",
+		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "січ.", "лют.", "бер.", "квіт.", "трав.", "черв.", "лип.", "серп.", "вер.", "жовт.", "лист.", "груд."},
 		monthsNarrow:           []string{"", "с", "л", "б", "к", "т", "ч", "л", "с", "в", "ж", "л", "г"},
 		monthsWide:             []string{"", "січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"до н. е.", "н. е."},
 		erasNarrow:             []string{"до н.е.", "н.е."},
 		erasWide:               []string{"до нашої ери", "нашої ери"},
-		timezones:              map[string]string{"MESZ": "за центральноєвропейським літнім часом", "HNNOMX": "за стандартним північнозахідним часом у Мексиці", "MST": "MST", "UYST": "за літнім часом в Уруґваї", "CHAST": "за стандартним часом на архіпелазі Чатем", "AWST": "за стандартним західноавстралійським часом", "JST": "за японським стандартним часом", "HNOG": "за стандартним західним часом у Ґренландії", "ChST": "за часом на Північних Маріанських островах", "HECU": "за літнім часом на Кубі", "BOT": "за болівійським часом", "HNEG": "за стандартним східним часом у Ґренландії", "HKT": "за стандартним часом у Гонконзі", "CLT": "за стандартним чилійським часом", "GYT": "за часом у Ґаяні", "SAST": "за південноафриканським часом", "WESZ": "за західноєвропейським літнім часом", "NZST": "за стандартним часом у Новій Зеландії", "WARST": "за літнім за західноаргентинським часом", "TMST": "за літнім часом у Туркменістані", "CHADT": "за літнім часом на архіпелазі Чатем", "ACWST": "за стандартним центральнозахідним австралійським часом", "LHST": "за стандартним часом на острові Лорд-Хау", "OEZ": "за східноєвропейським стандартним часом", "HNPMX": "за стандартним тихоокеанським часом у Мексиці", "AEDT": "за літнім східноавстралійським часом", "WAT": "за західноафриканським стандартним часом", "MYT": "за часом у Малайзії", "AKDT": "за літнім часом на Алясці", "WART": "за стандартним західноаргентинським часом", "UYT": "за стандартним часом в Уруґваї", "AEST": "за стандартним східноавстралійським часом", "WEZ": "за західноєвропейським стандартним часом", "AKST": "за стандартним часом на Алясці", "ACWDT": "за літнім центральнозахідним австралійським часом", "MEZ": "за центральноєвропейським стандартним часом", "WIT": "за східноіндонезійським часом", "CDT": "за північноамериканським центральним літнім часом", "WAST": "за західноафриканським літнім часом", "WITA": "за центральноіндонезійським часом", "COT": "за стандартним колумбійським часом", "GFT": "за часом Французької Гвіани", "SGT": "за часом у Сінґапурі", "HKST": "за літнім часом у Гонконзі", "HNPM": "за стандартним часом на островах Сен-П’єр і Мікелон", "COST": "за літнім колумбійським часом", "ART": "за стандартним аргентинським часом", "JDT": "за японським літнім часом", "EST": "за північноамериканським східним стандартним часом", "IST": "за індійським стандартним часом", "LHDT": "за літнім часом на острові Лорд-Хау", "CLST": "за літнім чилійським часом", "CST": "за північноамериканським центральним стандартним часом", "PDT": "за північноамериканським тихоокеанським літнім часом", "BT": "за часом у Бутані", "NZDT": "за літнім часом у Новій Зеландії", "ADT": "за атлантичним літнім часом", "ECT": "за часом в Еквадорі", "HAST": "за стандартним гавайсько-алеутським часом", "GMT": "за Ґрінвічем", "HNCU": "за стандартним часом на Кубі", "AWDT": "за літнім західноавстралійським часом", "HEPMX": "за літнім тихоокеанським часом у Мексиці", "AST": "за атлантичним стандартним часом", "ACST": "за стандартним центральноавстралійським часом", "HEOG": "за літнім західним часом у Ґренландії", "HEPM": "за літнім часом на островах Сен-П’єр і Мікелон", "TMT": "за стандартним часом у Туркменістані", "OESZ": "за східноєвропейським літнім часом", "∅∅∅": "за літнім часом на Амазонці", "ACDT": "за літнім центральноавстралійським часом", "VET": "за часом у Венесуелі", "SRT": "за часом у Суринамі", "CAT": "за центральноафриканським часом", "HEEG": "за літнім східним часом у Ґренландії", "HAT": "за літнім часом у Ньюфаундленд", "HENOMX": "за літнім північнозахідним часом у Мексиці", "HADT": "за літнім гавайсько-алеутським часом", "WIB": "за західноіндонезійським часом", "EDT": "за північноамериканським східним літнім часом", "MDT": "MDT", "EAT": "за східноафриканським часом", "ARST": "за літнім аргентинським часом", "PST": "за північноамериканським тихоокеанським стандартним часом", "HNT": "за стандартним часом на острові Ньюфаундленд"},
+		timezones:              map[string]string{"ACDT": "за літнім центральноавстралійським часом", "ACST": "за стандартним центральноавстралійським часом", "ACWDT": "за літнім центральнозахідним австралійським часом", "ACWST": "за стандартним центральнозахідним австралійським часом", "ADT": "за атлантичним літнім часом", "AEDT": "за літнім східноавстралійським часом", "AEST": "за стандартним східноавстралійським часом", "AKDT": "за літнім часом на Алясці", "AKST": "за стандартним часом на Алясці", "ARST": "за літнім аргентинським часом", "ART": "за стандартним аргентинським часом", "AST": "за атлантичним стандартним часом", "AWDT": "за літнім західноавстралійським часом", "AWST": "за стандартним західноавстралійським часом", "BOT": "за болівійським часом", "BT": "за часом у Бутані", "CAT": "за центральноафриканським часом", "CDT": "за північноамериканським центральним літнім часом", "CHADT": "за літнім часом на архіпелазі Чатем", "CHAST": "за стандартним часом на архіпелазі Чатем", "CLST": "за літнім чилійським часом", "CLT": "за стандартним чилійським часом", "COST": "за літнім колумбійським часом", "COT": "за стандартним колумбійським часом", "CST": "за північноамериканським центральним стандартним часом", "ChST": "за часом на Північних Маріанських островах", "EAT": "за східноафриканським часом", "ECT": "за часом в Еквадорі", "EDT": "за північноамериканським східним літнім часом", "EST": "за північноамериканським східним стандартним часом", "GFT": "за часом Французької Ґвіани", "GMT": "за Ґрінвічем", "GST": "за часом Перської затоки", "GYT": "за часом у Ґаяні", "HADT": "за літнім гавайсько-алеутським часом", "HAST": "за стандартним гавайсько-алеутським часом", "HAT": "за літнім часом у Ньюфаундленд", "HECU": "за літнім часом на Кубі", "HEEG": "за літнім східним часом у Ґренландії", "HENOMX": "за літнім північнозахідним часом у Мексиці", "HEOG": "за літнім західним часом у Ґренландії", "HEPM": "за літнім часом на островах Сен-П’єр і Мікелон", "HEPMX": "за літнім тихоокеанським часом у Мексиці", "HKST": "за літнім часом у Гонконзі", "HKT": "за стандартним часом у Гонконзі", "HNCU": "за стандартним часом на Кубі", "HNEG": "за стандартним східним часом у Ґренландії", "HNNOMX": "за стандартним північнозахідним часом у Мексиці", "HNOG": "за стандартним західним часом у Ґренландії", "HNPM": "за стандартним часом на островах Сен-П’єр і Мікелон", "HNPMX": "за стандартним тихоокеанським часом у Мексиці", "HNT": "за стандартним часом на острові Ньюфаундленд", "IST": "за індійським стандартним часом", "JDT": "за японським літнім часом", "JST": "за японським стандартним часом", "LHDT": "за літнім часом на острові Лорд-Хау", "LHST": "за стандартним часом на острові Лорд-Хау", "MDT": "за північноамериканським гірським літнім часом", "MESZ": "за центральноєвропейським літнім часом", "MEZ": "за центральноєвропейським стандартним часом", "MST": "за північноамериканським гірським стандартним часом", "MYT": "за часом у Малайзії", "NZDT": "за літнім часом у Новій Зеландії", "NZST": "за стандартним часом у Новій Зеландії", "OESZ": "за східноєвропейським літнім часом", "OEZ": "за східноєвропейським стандартним часом", "PDT": "за північноамериканським тихоокеанським літнім часом", "PST": "за північноамериканським тихоокеанським стандартним часом", "SAST": "за південноафриканським часом", "SGT": "за часом у Сінґапурі", "SRT": "за часом у Суринамі", "TMST": "за літнім часом у Туркменістані", "TMT": "за стандартним часом у Туркменістані", "UYST": "за літнім часом в Уруґваї", "UYT": "за стандартним часом в Уруґваї", "VET": "за часом у Венесуелі", "WARST": "за літнім за західноаргентинським часом", "WART": "за стандартним західноаргентинським часом", "WAST": "за західноафриканським літнім часом", "WAT": "за західноафриканським стандартним часом", "WESZ": "за західноєвропейським літнім часом", "WEZ": "за західноєвропейським стандартним часом", "WIB": "за західноіндонезійським часом", "WIT": "за східноіндонезійським часом", "WITA": "за центральноіндонезійським часом", "∅∅∅": "за літнім часом на Амазонці"},
 	}
 }
 
@@ -100,8 +100,8 @@ func (uk *uk) CardinalPluralRule(num float64, v uint64) locales.PluralRule {
 
 	n := math.Abs(num)
 	i := int64(n)
-	iMod10 := i % 10
 	iMod100 := i % 100
+	iMod10 := i % 10
 
 	if v == 0 && iMod10 == 1 && iMod100 != 11 {
 		return locales.PluralRuleOne
@@ -334,7 +334,7 @@ func (uk *uk) FmtCurrency(num float64, v uint64, currency currency.Type) string
 
 	s := strconv.FormatFloat(math.Abs(num), 'f', int(v), 64)
 	symbol := uk.currencies[currency]
-	l := len(s) + len(symbol) + 2 + 2*len(s[:len(s)-int(v)-1])/3
+	l := len(s) + len(symbol) + 4 + 2*len(s[:len(s)-int(v)-1])/3
 	count := 0
 	inWhole := v == 0
 	b := make([]byte, 0, l)
@@ -381,6 +381,8 @@ func (uk *uk) FmtCurrency(num float64, v uint64, currency currency.Type) string
 		}
 	}
 
+	b = append(b, uk.currencyPositiveSuffix...)
+
 	b = append(b, symbol...)
 
 	return string(b)
@@ -421,7 +423,7 @@ func (uk *uk) FmtAccounting(num float64, v uint64, currency currency.Type) strin
 
 	if num < 0 {
 
-		b = append(b, uk.currencyNegativePrefix[0])
+		b = append(b, uk.minus[0])
 
 	}
 
@@ -446,6 +448,7 @@ func (uk *uk) FmtAccounting(num float64, v uint64, currency currency.Type) strin
 		b = append(b, symbol...)
 	} else {
 
+		b = append(b, uk.currencyPositiveSuffix...)
 		b = append(b, symbol...)
 	}
 
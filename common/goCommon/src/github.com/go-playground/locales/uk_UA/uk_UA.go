@@ -22,7 +22,7 @@ type uk_UA struct {
 	timeSeparator          string
 	inifinity              string
 	currencies             []string // idx = enum of currency code
-	currencyNegativePrefix string
+	currencyPositiveSuffix string
 	currencyNegativeSuffix string
 	monthsAbbreviated      []string
 	monthsNarrow           []string
@@ -55,9 +55,9 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
-		currencyNegativePrefix: "(",
-		currencyNegativeSuffix: ")",
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencyPositiveSuffix: " ",
+		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "січ.", "лют.", "бер.", "квіт.", "трав.", "черв.", "лип.", "серп.", "вер.", "жовт.", "лист.", "груд."},
 		monthsNarrow:           []string{"", "с", "л", "б", "к", "т", "ч", "л", "с", "в", "ж", "л", "г"},
 		monthsWide:             []string{"", "січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
@@ -71,7 +71,7 @@ func New() locales.Translator {
 		erasAbbreviated:        []string{"до н. е.", "н. е."},
 		erasNarrow:             []string{"до н.е.", "н.е."},
 		erasWide:               []string{"до нашої ери", "нашої ери"},
-		timezones:              map[string]string{"ARST": "за літнім аргентинським часом", "WAST": "за західноафриканським літнім часом", "BOT": "за болівійським часом", "EDT": "за північноамериканським східним літнім часом", "VET": "за часом у Венесуелі", "CLT": "за стандартним чилійським часом", "HAST": "за стандартним гавайсько-алеутським часом", "EST": "за північноамериканським східним стандартним часом", "PDT": "за північноамериканським тихоокеанським літнім часом", "HEEG": "за літнім східним часом у Ґренландії", "EAT": "за східноафриканським часом", "GYT": "за часом у Ґаяні", "CST": "за північноамериканським центральним стандартним часом", "AKDT": "за літнім часом на Алясці", "ECT": "за часом в Еквадорі", "HNPM": "за стандартним часом на островах Сен-П’єр і Мікелон", "SRT": "за часом у Суринамі", "TMST": "за літнім часом у Туркменістані", "NZDT": "за літнім часом у Новій Зеландії", "WITA": "за центральноіндонезійським часом", "ACST": "за стандартним центральноавстралійським часом", "ACWDT": "за літнім центральнозахідним австралійським часом", "MESZ": "за центральноєвропейським літнім часом", "LHDT": "за літнім часом на острові Лорд-Хау", "UYST": "за літнім часом в Уруґваї", "HNCU": "за стандартним часом на Кубі", "HEPMX": "за літнім тихоокеанським часом у Мексиці", "ACWST": "за стандартним центральнозахідним австралійським часом", "LHST": "за стандартним часом на острові Лорд-Хау", "ChST": "за часом на Північних Маріанських островах", "CDT": "за північноамериканським центральним літнім часом", "UYT": "за стандартним часом в Уруґваї", "CHADT": "за літнім часом на архіпелазі Чатем", "AEDT": "за літнім східноавстралійським часом", "HKST": "за літнім часом у Гонконзі", "MST": "MST", "OESZ": "за східноєвропейським літнім часом", "∅∅∅": "за літнім часом на Амазонці", "PST": "за північноамериканським тихоокеанським стандартним часом", "AEST": "за стандартним східноавстралійським часом", "WIB": "за західноіндонезійським часом", "JDT": "за японським літнім часом", "IST": "за індійським стандартним часом", "CAT": "за центральноафриканським часом", "HADT": "за літнім гавайсько-алеутським часом", "HEPM": "за літнім часом на островах Сен-П’єр і Мікелон", "COST": "за літнім колумбійським часом", "ADT": "за атлантичним літнім часом", "SAST": "за південноафриканським часом", "WAT": "за західноафриканським стандартним часом", "BT": "за часом у Бутані", "NZST": "за стандартним часом у Новій Зеландії", "CLST": "за літнім чилійським часом", "COT": "за стандартним колумбійським часом", "JST": "за японським стандартним часом", "ACDT": "за літнім центральноавстралійським часом", "CHAST": "за стандартним часом на архіпелазі Чатем", "AWST": "за стандартним західноавстралійським часом", "HNPMX": "за стандартним тихоокеанським часом у Мексиці", "AST": "за атлантичним стандартним часом", "MYT": "за часом у Малайзії", "SGT": "за часом у Сінґапурі", "MDT": "MDT", "ART": "за стандартним аргентинським часом", "HNEG": "за стандартним східним часом у Ґренландії", "WARST": "за літнім за західноаргентинським часом", "AWDT": "за літнім західноавстралійським часом", "AKST": "за стандартним часом на Алясці", "HKT": "за стандартним часом у Гонконзі", "OEZ": "за східноєвропейським стандартним часом", "MEZ": "за центральноєвропейським стандартним часом", "WART": "за стандартним західноаргентинським часом", "HNNOMX": "за стандартним північнозахідним часом у Мексиці", "HECU": "за літнім часом на Кубі", "HEOG": "за літнім західним часом у Ґренландії", "GFT": "за часом Французької Гвіани", "HNT": "за стандартним часом на острові Ньюфаундленд", "WIT": "за східноіндонезійським часом", "WEZ": "за західноєвропейським стандартним часом", "WESZ": "за західноєвропейським літнім часом", "HNOG": "за стандартним західним часом у Ґренландії", "HAT": "за літнім часом у Ньюфаундленд", "HENOMX": "за літнім північнозахідним часом у Мексиці", "TMT": "за стандартним часом у Туркменістані", "GMT": "за Ґрінвічем"},
+		timezones:              map[string]string{"ACDT": "за літнім центральноавстралійським часом", "ACST": "за стандартним центральноавстралійським часом", "ACWDT": "за літнім центральнозахідним австралійським часом", "ACWST": "за стандартним центральнозахідним австралійським часом", "ADT": "за атлантичним літнім часом", "AEDT": "за літнім східноавстралійським часом", "AEST": "за стандартним східноавстралійським часом", "AKDT": "за літнім часом на Алясці", "AKST": "за стандартним часом на Алясці", "ARST": "за літнім аргентинським часом", "ART": "за стандартним аргентинським часом", "AST": "за атлантичним стандартним часом", "AWDT": "за літнім західноавстралійським часом", "AWST": "за стандартним західноавстралійським часом", "BOT": "за болівійським часом", "BT": "за часом у Бутані", "CAT": "за центральноафриканським часом", "CDT": "за північноамериканським центральним літнім часом", "CHADT": "за літнім часом на архіпелазі Чатем", "CHAST": "за стандартним часом на архіпелазі Чатем", "CLST": "за літнім чилійським часом", "CLT": "за стандартним чилійським часом", "COST": "за літнім колумбійським часом", "COT": "за стандартним колумбійським часом", "CST": "за північноамериканським центральним стандартним часом", "ChST": "за часом на Північних Маріанських островах", "EAT": "за східноафриканським часом", "ECT": "за часом в Еквадорі", "EDT": "за північноамериканським східним літнім часом", "EST": "за північноамериканським східним стандартним часом", "GFT": "за часом Французької Ґвіани", "GMT": "за Ґрінвічем", "GST": "за часом Перської затоки", "GYT": "за часом у Ґаяні", "HADT": "за літнім гавайсько-алеутським часом", "HAST": "за стандартним гавайсько-алеутським часом", "HAT": "за літнім часом у Ньюфаундленд", "HECU": "за літнім часом на Кубі", "HEEG": "за літнім східним часом у Ґренландії", "HENOMX": "за літнім північнозахідним часом у Мексиці", "HEOG": "за літнім західним часом у Ґренландії", "HEPM": "за літнім часом на островах Сен-П’єр і Мікелон", "HEPMX": "за літнім тихоокеанським часом у Мексиці", "HKST": "за літнім часом у Гонконзі", "HKT": "за стандартним часом у Гонконзі", "HNCU": "за стандартним часом на Кубі", "HNEG": "за стандартним східним часом у Ґренландії", "HNNOMX": "за стандартним північнозахідним часом у Мексиці", "HNOG": "за стандартним західним часом у Ґренландії", "HNPM": "за стандартним часом на островах Сен-П’єр і Мікелон", "HNPMX": "за стандартним тихоокеанським часом у Мексиці", "HNT": "за стандартним часом на острові Ньюфаундленд", "IST": "за індійським стандартним часом", "JDT": "за японським літнім часом", "JST": "за японським стандартним часом", "LHDT": "за літнім часом на острові Лорд-Хау", "LHST": "за стандартним часом на острові Лорд-Хау", "MDT": "за північноамериканським гірським літнім часом", "MESZ": "за центральноєвропейським літнім часом", "MEZ": "за центральноєвропейським стандартним часом", "MST": "за північноамериканським гірським стандартним часом", "MYT": "за часом у Малайзії", "NZDT": "за літнім часом у Новій Зеландії", "NZST": "за стандартним часом у Новій Зеландії", "OESZ": "за східноєвропейським літнім часом", "OEZ": "за східноєвропейським стандартним часом", "PDT": "за північноамериканським тихоокеанським літнім часом", "PST": "за північноамериканським тихоокеанським стандартним часом", "SAST": "за південноафриканським часом", "SGT": "за часом у Сінґапурі", "SRT": "за часом у Суринамі", "TMST": "за літнім часом у Туркменістані", "TMT": "за стандартним часом у Туркменістані", "UYST": "за літнім часом в Уруґваї", "UYT": "за стандартним часом в Уруґваї", "VET": "за часом у Венесуелі", "WARST": "за літнім за західноаргентинським часом", "WART": "за стандартним західноаргентинським часом", "WAST": "за західноафриканським літнім часом", "WAT": "за західноафриканським стандартним часом", "WESZ": "за західноєвропейським літнім часом", "WEZ": "за західноєвропейським стандартним часом", "WIB": "за західноіндонезійським часом", "WIT": "за східноіндонезійським часом", "WITA": "за центральноіндонезійським часом", "∅∅∅": "за літнім часом на Амазонці"},
 	}
 }
 
@@ -334,7 +334,7 @@ func (uk *uk_UA) FmtCurrency(num float64, v uint64, currency currency.Type) stri
 
 	s := strconv.FormatFloat(math.Abs(num), 'f', int(v), 64)
 	symbol := uk.currencies[currency]
-	l := len(s) + len(symbol) + 2 + 2*len(s[:len(s)-int(v)-1])/3
+	l := len(s) + len(symbol) + 4 + 2*len(s[:len(s)-int(v)-1])/3
 	count := 0
 	inWhole := v == 0
 	b := make([]byte, 0, l)
@@ -381,6 +381,8 @@ func (uk *uk_UA) FmtCurrency(num float64, v uint64, currency currency.Type) stri
 		}
 	}
 
+	b = append(b, uk.currencyPositiveSuffix...)
+
 	b = append(b, symbol...)
 
 	return string(b)
@@ -421,7 +423,7 @@ func (uk *uk_UA) FmtAccounting(num float64, v uint64, currency currency.Type) st
 
 	if num < 0 {
 
-		b = append(b, uk.currencyNegativePrefix[0])
+		b = append(b, uk.minus[0])
 
 	}
 
@@ -446,6 +448,7 @@ func (uk *uk_UA) FmtAccounting(num float64, v uint64, currency currency.Type) st
 		b = append(b, symbol...)
 	} else {
 
+		b = append(b, uk.currencyPositiveSuffix...)
 		b = append(b, symbol...)
 	}
 
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "؉",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "A$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "сўм", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "A$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "сўм", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "янв", "фев", "мар", "апр", "май", "июн", "июл", "авг", "сен", "окт", "ноя", "дек"},
@@ -63,15 +63,15 @@ func New() locales.Translator {
 		monthsWide:             []string{"", "январ", "феврал", "март", "апрел", "май", "июн", "июл", "август", "сентябр", "октябр", "ноябр", "декабр"},
 		daysAbbreviated:        []string{"якш", "душ", "сеш", "чор", "пай", "жум", "шан"},
 		daysNarrow:             []string{"Я", "Д", "С", "Ч", "П", "Ж", "Ш"},
-		daysShort:              []string{"Як", "Ду", "Се", "Чо", "Па", "Жу", "Ша"},
+		daysShort:              []string{"як", "ду", "се", "чо", "па", "жу", "ша"},
 		daysWide:               []string{"якшанба", "душанба", "сешанба", "чоршанба", "пайшанба", "жума", "шанба"},
 		periodsAbbreviated:     []string{"ТО", "ТК"},
 		periodsNarrow:          []string{"ТО", "ТК"},
 		periodsWide:            []string{"ТО", "ТК"},
-		erasAbbreviated:        []string{"", ""},
+		erasAbbreviated:        []string{"м.а.", "милодий"},
 		erasNarrow:             []string{"", ""},
-		erasWide:               []string{"", ""},
-		timezones:              map[string]string{"EAT": "Шарқий Африка вақти", "WAT": "Ғарбий Африка стандарт вақти", "HNEG": "Шарқий Гренландия стандарт вақти", "WART": "Ғарбий Аргентина стандарт вақти", "WITA": "Марказий Индонезия вақти", "MST": "MST", "ARST": "Аргентина ёзги вақти", "BT": "Бутан вақти", "NZDT": "Янги Зеландия кундузги вақти", "SGT": "Сингапур вақти", "OEZ": "Шарқий Европа стандарт вақти", "GYT": "Гайана вақти", "CHAST": "Чатхам стандарт вақти", "WAST": "Ғарбий Африка ёзги вақти", "JDT": "Япония кундузги вақти", "AKDT": "Аляска кундузги вақти", "TMT": "Туркманистон стандарт вақти", "AWDT": "Ғарбий Австралия кундузги вақти", "HNNOMX": "Shimoli-g‘arbiy Meksika standart vaqti", "AEDT": "Шарқий Австралия кундузги вақти", "WIT": "Шарқий Индонезия вақти", "CST": "Шимолий Америка марказий стандарт вақти", "∅∅∅": "∅∅∅", "JST": "Япония стандарт вақти", "ACWST": "Марказий Австралия Ғарбий стандарт вақти", "UYT": "Уругвай стандарт вақти", "CHADT": "Чатхам кундузги вақти", "GFT": "Француз Гвианаси вақти", "CAT": "Марказий Африка вақти", "HADT": "Гавайи-алеут кундузги вақти", "HENOMX": "Shimoli-g‘arbiy Meksika yozgi vaqti", "AEST": "Шарқий Австралия стандарт вақти", "HNOG": "Ғарбий Гренландия стандарт вақти", "ACWDT": "Марказий Австралия Ғарбий кундузги вақти", "IST": "Ҳиндистон вақти", "OESZ": "Шарқий Европа ёзги вақти", "HEPMX": "Meksika Tinch okeani yozgi vaqti", "ADT": "Атлантика кундузги вақти", "NZST": "Янги Зеландия стандарт вақти", "WARST": "Ғарбий Аргентина ёзги вақти", "COT": "Колумбия стандарт вақти", "LHST": "Лорд Хове стандарт вақти", "LHDT": "Лорд Хове кундузги вақти", "SRT": "Суринам вақти", "TMST": "Туркманистон ёзги вақти", "CLT": "Чили стандарт вақти", "EDT": "Шимолий Америка шарқий кундузги вақти", "MEZ": "Марказий Европа стандарт вақти", "HKT": "Гонконг стандарт вақти", "ART": "Аргентина стандарт вақти", "UYST": "Уругвай ёзги вақти", "HNCU": "Куба стандарт вақти", "HAT": "Ньюфаундленд кундузги вақти", "HEPM": "Сент-Пьер ва Микелон кундузги вақти", "CDT": "Шимолий Америка марказий кундузги вақти", "PDT": "Шимолий Америка тинч океани кундузги вақти", "AST": "Атлантика стандарт вақти", "WIB": "Ғарбий Индонезия вақти", "MYT": "Малайзия вақти", "MESZ": "Марказий Европа ёзги вақти", "HEOG": "Ғарбий Гренландия ёзги вақти", "ACST": "Марказий Австралия стандарт вақти", "HNT": "Ньюфаундленд стандарт вақти", "ChST": "Каморро вақти", "WESZ": "Ғарбий Европа ёзги вақти", "BOT": "Боливия вақти", "HEEG": "Шарқий Гренландия ёзги вақти", "COST": "Колумбия ёзги вақти", "SAST": "Жанубий Африка вақти", "AKST": "Аляска стандарт вақти", "CLST": "Чили ёзги вақти", "HKST": "Гонконг ёзги вақти", "MDT": "MDT", "HAST": "Гавайи-алеут стандарт вақти", "HECU": "Куба кундузги вақти", "PST": "Шимолий Америка тинч океани стандарт вақти", "WEZ": "Ғарбий Европа стандарт вақти", "ECT": "Эквадор вақти", "ACDT": "Марказий Австралия кундузги вақти", "AWST": "Ғарбий Австралия стандарт вақти", "VET": "Венесуэла вақти", "HNPM": "Сент-Пьер ва Микелон стандарт вақти", "EST": "Шимолий Америка шарқий стандарт вақти", "GMT": "Гринвич вақти", "HNPMX": "Meksika Tinch okeani standart vaqti"},
+		erasWide:               []string{"милоддан аввалги", "милодий"},
+		timezones:              map[string]string{"ACDT": "Марказий Австралия кундузги вақти", "ACST": "Марказий Австралия стандарт вақти", "ACWDT": "Марказий Австралия Ғарбий кундузги вақти", "ACWST": "Марказий Австралия Ғарбий стандарт вақти", "ADT": "Атлантика кундузги вақти", "AEDT": "Шарқий Австралия кундузги вақти", "AEST": "Шарқий Австралия стандарт вақти", "AKDT": "Аляска кундузги вақти", "AKST": "Аляска стандарт вақти", "ARST": "Аргентина ёзги вақти", "ART": "Аргентина стандарт вақти", "AST": "Атлантика стандарт вақти", "AWDT": "Ғарбий Австралия кундузги вақти", "AWST": "Ғарбий Австралия стандарт вақти", "BOT": "Боливия вақти", "BT": "Бутан вақти", "CAT": "Марказий Африка вақти", "CDT": "Шимолий Америка марказий кундузги вақти", "CHADT": "Чатхам кундузги вақти", "CHAST": "Чатхам стандарт вақти", "CLST": "Чили ёзги вақти", "CLT": "Чили стандарт вақти", "COST": "Колумбия ёзги вақти", "COT": "Колумбия стандарт вақти", "CST": "Шимолий Америка марказий стандарт вақти", "ChST": "Каморро вақти", "EAT": "Шарқий Африка вақти", "ECT": "Эквадор вақти", "EDT": "Шимолий Америка шарқий кундузги вақти", "EST": "Шимолий Америка шарқий стандарт вақти", "GFT": "Француз Гвианаси вақти", "GMT": "Гринвич вақти", "GST": "Кўрфаз вақти", "GYT": "Гайана вақти", "HADT": "Гавайи-алеут кундузги вақти", "HAST": "Гавайи-алеут стандарт вақти", "HAT": "Ньюфаундленд кундузги вақти", "HECU": "Куба кундузги вақти", "HEEG": "Шарқий Гренландия ёзги вақти", "HENOMX": "Shimoli-g‘arbiy Meksika yozgi vaqti", "HEOG": "Ғарбий Гренландия ёзги вақти", "HEPM": "Сент-Пьер ва Микелон кундузги вақти", "HEPMX": "Meksika Tinch okeani yozgi vaqti", "HKST": "Гонконг ёзги вақти", "HKT": "Гонконг стандарт вақти", "HNCU": "Куба стандарт вақти", "HNEG": "Шарқий Гренландия стандарт вақти", "HNNOMX": "Shimoli-g‘arbiy Meksika standart vaqti", "HNOG": "Ғарбий Гренландия стандарт вақти", "HNPM": "Сент-Пьер ва Микелон стандарт вақти", "HNPMX": "Meksika Tinch okeani standart vaqti", "HNT": "Ньюфаундленд стандарт вақти", "IST": "Ҳиндистон вақти", "JDT": "Япония кундузги вақти", "JST": "Япония стандарт вақти", "LHDT": "Лорд Хове кундузги вақти", "LHST": "Лорд Хове стандарт вақти", "MDT": "Шимолий Америка тоғ кундузги вақти", "MESZ": "Марказий Европа ёзги вақти", "MEZ": "Марказий Европа стандарт вақти", "MST": "Шимолий Америка тоғ стандарт вақти", "MYT": "Малайзия вақти", "NZDT": "Янги Зеландия кундузги вақти", "NZST": "Янги Зеландия стандарт вақти", "OESZ": "Шарқий Европа ёзги вақти", "OEZ": "Шарқий Европа стандарт вақти", "PDT": "Шимолий Америка тинч океани кундузги вақти", "PST": "Шимолий Америка тинч океани стандарт вақти", "SAST": "Жанубий Африка вақти", "SGT": "Сингапур вақти", "SRT": "Суринам вақти", "TMST": "Туркманистон ёзги вақти", "TMT": "Туркманистон стандарт вақти", "UYST": "Уругвай ёзги вақти", "UYT": "Уругвай стандарт вақти", "VET": "Венесуэла вақти", "WARST": "Ғарбий Аргентина ёзги вақти", "WART": "Ғарбий Аргентина стандарт вақти", "WAST": "Ғарбий Африка ёзги вақти", "WAT": "Ғарбий Африка стандарт вақти", "WESZ": "Ғарбий Европа ёзги вақти", "WEZ": "Ғарбий Европа стандарт вақти", "WIB": "Ғарбий Индонезия вақти", "WIT": "Шарқий Индонезия вақти", "WITA": "Марказий Индонезия вақти", "∅∅∅": "Азор ёзги вақти"},
 	}
 }
 
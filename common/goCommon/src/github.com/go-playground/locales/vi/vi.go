@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CN¥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "₩", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "฿", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "thg 1", "thg 2", "thg 3", "thg 4", "thg 5", "thg 6", "thg 7", "thg 8", "thg 9", "thg 10", "thg 11", "thg 12"},
@@ -68,10 +68,10 @@ func New() locales.Translator {
 		periodsAbbreviated:     []string{"SA", "CH"},
 		periodsNarrow:          []string{"s", "c"},
 		periodsWide:            []string{"SA", "CH"},
-		erasAbbreviated:        []string{"", ""},
+		erasAbbreviated:        []string{"Trước CN", "sau CN"},
 		erasNarrow:             []string{"tr. CN", "sau CN"},
-		erasWide:               []string{"", ""},
-		timezones:              map[string]string{"ACDT": "Giờ Mùa Hè Miền Trung Australia", "VET": "Giờ Venezuela", "HNNOMX": "Giờ Chuẩn Tây Bắc Mexico", "ChST": "Giờ Chamorro", "HKT": "Giờ Chuẩn Hồng Kông", "HKST": "Giờ Mùa Hè Hồng Kông", "OESZ": "Giờ mùa hè Đông Âu", "COT": "Giờ Chuẩn Colombia", "AST": "Giờ Chuẩn Đại Tây Dương", "UYT": "Giờ Chuẩn Uruguay", "WAST": "Giờ Mùa Hè Tây Phi", "HNEG": "Giờ Chuẩn Miền Đông Greenland", "MESZ": "Giờ mùa hè Trung Âu", "MST": "Giờ Chuẩn Ma Cao", "CLST": "Giờ Mùa Hè Chile", "TMST": "Giờ Mùa Hè Turkmenistan", "GYT": "Giờ Guyana", "WIB": "Giờ Miền Tây Indonesia", "JST": "Giờ Chuẩn Nhật Bản", "ACWDT": "Giờ Mùa Hè Miền Trung Tây Australia", "HNOG": "Giờ Chuẩn Miền Tây Greenland", "HEOG": "Giờ Mùa Hè Miền Tây Greenland", "WART": "Giờ chuẩn miền tây Argentina", "HEEG": "Giờ Mùa Hè Miền Đông Greenland", "HNPM": "Giờ Chuẩn St. Pierre và Miquelon", "WIT": "Giờ Miền Đông Indonesia", "JDT": "Giờ Mùa Hè Nhật Bản", "EST": "Giờ chuẩn miền Đông", "COST": "Giờ Mùa Hè Colombia", "CHAST": "Giờ Chuẩn Chatham", "HEPMX": "Giờ Mùa Hè Thái Bình Dương Mexico", "SAST": "Giờ Chuẩn Nam Phi", "WAT": "Giờ Chuẩn Tây Phi", "MYT": "Giờ Malaysia", "LHST": "Giờ Chuẩn Lord Howe", "WITA": "Giờ Miền Trung Indonesia", "CAT": "Giờ Trung Phi", "ART": "Giờ Chuẩn Argentina", "AWDT": "Giờ Mùa Hè Miền Tây Australia", "BT": "Giờ Bhutan", "EAT": "Giờ Đông Phi", "OEZ": "Giờ chuẩn Đông Âu", "HADT": "Giờ Mùa Hè Hawaii-Aleut", "ARST": "Giờ Mùa Hè Argentina", "AWST": "Giờ Chuẩn Miền Tây Australia", "MEZ": "Giờ chuẩn Trung Âu", "UYST": "Giờ Mùa Hè Uruguay", "AEDT": "Giờ Mùa Hè Miền Đông Australia", "WEZ": "Giờ Chuẩn Tây Âu", "WESZ": "Giờ mùa hè Tây Âu", "NZST": "Giờ Chuẩn New Zealand", "ECT": "Giờ Ecuador", "IST": "Giờ Chuẩn Ấn Độ", "SRT": "Giờ Suriname", "SGT": "Giờ Singapore", "TMT": "Giờ Chuẩn Turkmenistan", "CDT": "Giờ mùa hè miền Trung", "HNPMX": "Giờ Chuẩn Thái Bình Dương Mexico", "NZDT": "Giờ Mùa Hè New Zealand", "AKST": "Giờ Chuẩn Alaska", "HAT": "Giờ Mùa Hè Newfoundland", "HENOMX": "Giờ Mùa Hè Tây Bắc Mexico", "MDT": "Giờ Mùa Hè Ma Cao", "CST": "Giờ chuẩn miền Trung", "EDT": "Giờ mùa hè miền Đông", "LHDT": "Giờ Mùa Hè Lord Howe", "HNT": "Giờ Chuẩn Newfoundland", "HEPM": "Giờ Mùa Hè Saint Pierre và Miquelon", "HAST": "Giờ Chuẩn Hawaii-Aleut", "BOT": "Giờ Bolivia", "GFT": "Giờ Guiana thuộc Pháp", "ACWST": "Giờ Chuẩn Miền Trung Tây Australia", "GMT": "Giờ Trung bình Greenwich", "PST": "Giờ chuẩn Thái Bình Dương", "ADT": "Giờ mùa hè Đại Tây Dương", "AKDT": "Giờ Mùa Hè Alaska", "ACST": "Giờ Chuẩn Miền Trung Australia", "∅∅∅": "Giờ Mùa Hè Azores", "HECU": "Giờ Mùa Hè Cuba", "AEST": "Giờ Chuẩn Miền Đông Australia", "WARST": "Giờ mùa hè miền tây Argentina", "CLT": "Giờ Chuẩn Chile", "CHADT": "Giờ Mùa Hè Chatham", "HNCU": "Giờ Chuẩn Cuba", "PDT": "Giờ mùa hè Thái Bình Dương"},
+		erasWide:               []string{"Trước CN", "sau CN"},
+		timezones:              map[string]string{"ACDT": "Giờ Mùa Hè Miền Trung Australia", "ACST": "Giờ Chuẩn Miền Trung Australia", "ACWDT": "Giờ Mùa Hè Miền Trung Tây Australia", "ACWST": "Giờ Chuẩn Miền Trung Tây Australia", "ADT": "Giờ mùa hè Đại Tây Dương", "AEDT": "Giờ Mùa Hè Miền Đông Australia", "AEST": "Giờ Chuẩn Miền Đông Australia", "AKDT": "Giờ Mùa Hè Alaska", "AKST": "Giờ Chuẩn Alaska", "ARST": "Giờ Mùa Hè Argentina", "ART": "Giờ Chuẩn Argentina", "AST": "Giờ Chuẩn Đại Tây Dương", "AWDT": "Giờ Mùa Hè Miền Tây Australia", "AWST": "Giờ Chuẩn Miền Tây Australia", "BOT": "Giờ Bolivia", "BT": "Giờ Bhutan", "CAT": "Giờ Trung Phi", "CDT": "Giờ mùa hè miền Trung", "CHADT": "Giờ Mùa Hè Chatham", "CHAST": "Giờ Chuẩn Chatham", "CLST": "Giờ Mùa Hè Chile", "CLT": "Giờ Chuẩn Chile", "COST": "Giờ Mùa Hè Colombia", "COT": "Giờ Chuẩn Colombia", "CST": "Giờ chuẩn miền Trung", "ChST": "Giờ Chamorro", "EAT": "Giờ Đông Phi", "ECT": "Giờ Ecuador", "EDT": "Giờ mùa hè miền Đông", "EST": "Giờ chuẩn miền Đông", "GFT": "Giờ Guiana thuộc Pháp", "GMT": "Giờ Trung bình Greenwich", "GST": "Giờ Chuẩn Vùng Vịnh", "GYT": "Giờ Guyana", "HADT": "Giờ Mùa Hè Hawaii-Aleut", "HAST": "Giờ Chuẩn Hawaii-Aleut", "HAT": "Giờ Mùa Hè Newfoundland", "HECU": "Giờ Mùa Hè Cuba", "HEEG": "Giờ Mùa Hè Miền Đông Greenland", "HENOMX": "Giờ Mùa Hè Tây Bắc Mexico", "HEOG": "Giờ Mùa Hè Miền Tây Greenland", "HEPM": "Giờ Mùa Hè Saint Pierre và Miquelon", "HEPMX": "Giờ Mùa Hè Thái Bình Dương Mexico", "HKST": "Giờ Mùa Hè Hồng Kông", "HKT": "Giờ Chuẩn Hồng Kông", "HNCU": "Giờ Chuẩn Cuba", "HNEG": "Giờ Chuẩn Miền Đông Greenland", "HNNOMX": "Giờ Chuẩn Tây Bắc Mexico", "HNOG": "Giờ Chuẩn Miền Tây Greenland", "HNPM": "Giờ Chuẩn St. Pierre và Miquelon", "HNPMX": "Giờ Chuẩn Thái Bình Dương Mexico", "HNT": "Giờ Chuẩn Newfoundland", "IST": "Giờ Chuẩn Ấn Độ", "JDT": "Giờ Mùa Hè Nhật Bản", "JST": "Giờ Chuẩn Nhật Bản", "LHDT": "Giờ Mùa Hè Lord Howe", "LHST": "Giờ Chuẩn Lord Howe", "MDT": "Giờ mùa hè miền núi", "MESZ": "Giờ mùa hè Trung Âu", "MEZ": "Giờ chuẩn Trung Âu", "MST": "Giờ chuẩn miền núi", "MYT": "Giờ Malaysia", "NZDT": "Giờ Mùa Hè New Zealand", "NZST": "Giờ Chuẩn New Zealand", "OESZ": "Giờ mùa hè Đông Âu", "OEZ": "Giờ chuẩn Đông Âu", "PDT": "Giờ mùa hè Thái Bình Dương", "PST": "Giờ chuẩn Thái Bình Dương", "SAST": "Giờ Chuẩn Nam Phi", "SGT": "Giờ Singapore", "SRT": "Giờ Suriname", "TMST": "Giờ Mùa Hè Turkmenistan", "TMT": "Giờ Chuẩn Turkmenistan", "UYST": "Giờ Mùa Hè Uruguay", "UYT": "Giờ Chuẩn Uruguay", "VET": "Giờ Venezuela", "WARST": "Giờ mùa hè miền tây Argentina", "WART": "Giờ chuẩn miền tây Argentina", "WAST": "Giờ Mùa Hè Tây Phi", "WAT": "Giờ Chuẩn Tây Phi", "WESZ": "Giờ mùa hè Tây Âu", "WEZ": "Giờ Chuẩn Tây Âu", "WIB": "Giờ Miền Tây Indonesia", "WIT": "Giờ Miền Đông Indonesia", "WITA": "Giờ Miền Trung Indonesia", "∅∅∅": "Giờ Mùa Hè Azores"},
 	}
 }
 
@@ -55,7 +55,7 @@ func New() locales.Translator {
 		perMille:               "‰",
 		timeSeparator:          ":",
 		inifinity:              "∞",
-		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:             []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AUD", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "BRL", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "CNY", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "EUR", "FIM", "FJD", "FKP", "FRF", "GBP", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HKD", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "ILS", "INR", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MXN", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZD", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "TWD", "TZS", "UAH", "UAK", "UGS", "UGX", "USD", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "VND", "VNN", "VUV", "WST", "XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XEU", "XFO", "XFU", "XOF", "XPD", "XPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		currencyPositiveSuffix: " ",
 		currencyNegativeSuffix: " ",
 		monthsAbbreviated:      []string{"", "thg 1", "thg 2", "thg 3", "thg 4", "thg 5", "thg 6", "thg 7", "thg 8", "thg 9", "thg 10", "thg 11", "thg 12"},
@@ -68,10 +68,10 @@ func New() locales.Translator {
 		periodsAbbreviated:     []string{"SA", "CH"},
 		periodsNarrow:          []string{"s", "c"},
 		periodsWide:            []string{"SA", "CH"},
-		erasAbbreviated:        []string{"", ""},
+		erasAbbreviated:        []string{"Trước CN", "sau CN"},
 		erasNarrow:             []string{"tr. CN", "sau CN"},
-		erasWide:               []string{"", ""},
-		timezones:              map[string]string{"HNOG": "Giờ Chuẩn Miền Tây Greenland", "IST": "Giờ Chuẩn Ấn Độ", "HNT": "Giờ Chuẩn Newfoundland", "HADT": "Giờ Mùa Hè Hawaii-Aleut", "AKDT": "Giờ Mùa Hè Alaska", "WAT": "Giờ Chuẩn Tây Phi", "SGT": "Giờ Singapore", "VET": "Giờ Venezuela", "EAT": "Giờ Đông Phi", "ChST": "Giờ Chamorro", "MDT": "Giờ mùa hè miền núi", "EDT": "Giờ mùa hè miền Đông", "WIT": "Giờ Miền Đông Indonesia", "TMST": "Giờ Mùa Hè Turkmenistan", "JST": "Giờ Chuẩn Nhật Bản", "GFT": "Giờ Guiana thuộc Pháp", "BT": "Giờ Bhutan", "HEEG": "Giờ Mùa Hè Miền Đông Greenland", "HKST": "Giờ Mùa Hè Hồng Kông", "HECU": "Giờ Mùa Hè Cuba", "WIB": "Giờ Miền Tây Indonesia", "OESZ": "Giờ mùa hè Đông Âu", "HNCU": "Giờ Chuẩn Cuba", "AWDT": "Giờ Mùa Hè Miền Tây Australia", "WARST": "Giờ mùa hè miền tây Argentina", "WEZ": "Giờ Chuẩn Tây Âu", "MYT": "Giờ Malaysia", "JDT": "Giờ Mùa Hè Nhật Bản", "AKST": "Giờ Chuẩn Alaska", "HEOG": "Giờ Mùa Hè Miền Tây Greenland", "HEPM": "Giờ Mùa Hè Saint Pierre và Miquelon", "OEZ": "Giờ chuẩn Đông Âu", "PDT": "Giờ mùa hè Thái Bình Dương", "WESZ": "Giờ mùa hè Tây Âu", "WAST": "Giờ Mùa Hè Tây Phi", "ECT": "Giờ Ecuador", "MESZ": "Giờ mùa hè Trung Âu", "SRT": "Giờ Suriname", "UYT": "Giờ Chuẩn Uruguay", "HEPMX": "Giờ Mùa Hè Thái Bình Dương Mexico", "BOT": "Giờ Bolivia", "NZST": "Giờ Chuẩn New Zealand", "HNEG": "Giờ Chuẩn Miền Đông Greenland", "ACST": "Giờ Chuẩn Miền Trung Australia", "ACDT": "Giờ Mùa Hè Miền Trung Australia", "WART": "Giờ chuẩn miền tây Argentina", "HENOMX": "Giờ Mùa Hè Tây Bắc Mexico", "CST": "Giờ chuẩn miền Trung", "UYST": "Giờ Mùa Hè Uruguay", "HNPM": "Giờ Chuẩn St. Pierre và Miquelon", "WITA": "Giờ Miền Trung Indonesia", "ART": "Giờ Chuẩn Argentina", "HAST": "Giờ Chuẩn Hawaii-Aleut", "EST": "Giờ chuẩn miền Đông", "SAST": "Giờ Chuẩn Nam Phi", "GMT": "Giờ Trung bình Greenwich", "CHADT": "Giờ Mùa Hè Chatham", "AWST": "Giờ Chuẩn Miền Tây Australia", "GYT": "Giờ Guyana", "ACWDT": "Giờ Mùa Hè Miền Trung Tây Australia", "∅∅∅": "Giờ Mùa Hè Acre", "MEZ": "Giờ chuẩn Trung Âu", "HAT": "Giờ Mùa Hè Newfoundland", "HNNOMX": "Giờ Chuẩn Tây Bắc Mexico", "TMT": "Giờ Chuẩn Turkmenistan", "AEST": "Giờ Chuẩn Miền Đông Australia", "NZDT": "Giờ Mùa Hè New Zealand", "CAT": "Giờ Trung Phi", "CHAST": "Giờ Chuẩn Chatham", "HNPMX": "Giờ Chuẩn Thái Bình Dương Mexico", "PST": "Giờ chuẩn Thái Bình Dương", "HKT": "Giờ Chuẩn Hồng Kông", "CLT": "Giờ Chuẩn Chile", "CLST": "Giờ Mùa Hè Chile", "COT": "Giờ Chuẩn Colombia", "COST": "Giờ Mùa Hè Colombia", "CDT": "Giờ mùa hè miền Trung", "AST": "Giờ Chuẩn Đại Tây Dương", "ADT": "Giờ mùa hè Đại Tây Dương", "AEDT": "Giờ Mùa Hè Miền Đông Australia", "ACWST": "Giờ Chuẩn Miền Trung Tây Australia", "LHST": "Giờ Chuẩn Lord Howe", "LHDT": "Giờ Mùa Hè Lord Howe", "ARST": "Giờ Mùa Hè Argentina", "MST": "Giờ chuẩn miền núi"},
+		erasWide:               []string{"Trước CN", "sau CN"},
+		timezones:              map[string]string{"ACDT": "Giờ Mùa Hè Miền Trung Australia", "ACST": "Giờ Chuẩn Miền Trung Australia", "ACWDT": "Giờ Mùa Hè Miền Trung Tây Australia", "ACWST": "Giờ Chuẩn Miền Trung Tây Australia", "ADT": "Giờ mùa hè Đại Tây Dương", "AEDT": "Giờ Mùa Hè Miền Đông Australia", "AEST": "Giờ Chuẩn Miền Đông Australia", "AKDT": "Giờ Mùa Hè Alaska", "AKST": "Giờ Chuẩn Alaska", "ARST": "Giờ Mùa Hè Argentina", "ART": "Giờ Chuẩn Argentina", "AST": "Giờ Chuẩn Đại Tây Dương", "AWDT": "Giờ Mùa Hè Miền Tây Australia", "AWST": "Giờ Chuẩn Miền Tây Australia", "BOT": "Giờ Bolivia", "BT": "Giờ Bhutan", "CAT": "Giờ Trung Phi", "CDT": "Giờ mùa hè miền Trung", "CHADT": "Giờ Mùa Hè Chatham", "CHAST": "Giờ Chuẩn Chatham", "CLST": "Giờ Mùa Hè Chile", "CLT": "Giờ Chuẩn Chile", "COST": "Giờ Mùa Hè Colombia", "COT": "Giờ Chuẩn Colombia", "CST": "Giờ chuẩn miền Trung", "ChST": "Giờ Chamorro", "EAT": "Giờ Đông Phi", "ECT": "Giờ Ecuador", "EDT": "Giờ mùa hè miền Đông", "EST": "Giờ chuẩn miền Đông", "GFT": "Giờ Guiana thuộc Pháp", "GMT": "Giờ Trung bình Greenwich", "GST": "Giờ Chuẩn Vùng Vịnh", "GYT": "Giờ Guyana", "HADT": "Giờ Mùa Hè Hawaii-Aleut", "HAST": "Giờ Chuẩn Hawaii-Aleut", "HAT": "Giờ Mùa Hè Newfoundland", "HECU": "Giờ Mùa Hè Cuba", "HEEG": "Giờ Mùa Hè Miền Đông Greenland", "HENOMX": "Giờ Mùa Hè Tây Bắc Mexico", "HEOG": "Giờ Mùa Hè Miền Tây Greenland", "HEPM": "Giờ Mùa Hè Saint Pierre và Miquelon", "HEPMX": "Giờ Mùa Hè Thái Bình Dương Mexico", "HKST": "Giờ Mùa Hè Hồng Kông", "HKT": "Giờ Chuẩn Hồng Kông", "HNCU": "Giờ Chuẩn Cuba", "HNEG": "Giờ Chuẩn Miền Đông Greenland", "HNNOMX": "Giờ Chuẩn Tây Bắc Mexico", "HNOG": "Giờ Chuẩn Miền Tây Greenland", "HNPM": "Giờ Chuẩn St. Pierre và Miquelon", "HNPMX": "Giờ Chuẩn Thái Bình Dương Mexico", "HNT": "Giờ Chuẩn Newfoundland", "IST": "Giờ Chuẩn Ấn Độ", "JDT": "Giờ Mùa Hè Nhật Bản", "JST": "Giờ Chuẩn Nhật Bản", "LHDT": "Giờ Mùa Hè Lord Howe", "LHST": "Giờ Chuẩn Lord Howe", "MDT": "Giờ mùa hè miền núi", "MESZ": "Giờ mùa hè Trung Âu", "MEZ": "Giờ chuẩn Trung Âu", "MST": "Giờ chuẩn miền núi", "MYT": "Giờ Malaysia", "NZDT": "Giờ Mùa Hè New Zealand", "NZST": "Giờ Chuẩn New Zealand", "OESZ": "Giờ mùa hè Đông Âu", "OEZ": "Giờ chuẩn Đông Âu", "PDT": "Giờ mùa hè Thái Bình Dương", "PST": "Giờ chuẩn Thái Bình Dương", "SAST": "Giờ Chuẩn Nam Phi", "SGT": "Giờ Singapore", "SRT": "Giờ Suriname", "TMST": "Giờ Mùa Hè Turkmenistan", "TMT": "Giờ Chuẩn Turkmenistan", "UYST": "Giờ Mùa Hè Uruguay", "UYT": "Giờ Chuẩn Uruguay", "VET": "Giờ Venezuela", "WARST": "Giờ mùa hè miền tây Argentina", "WART": "Giờ chuẩn miền tây Argentina", "WAST": "Giờ Mùa Hè Tây Phi", "WAT": "Giờ Chuẩn Tây Phi", "WESZ": "Giờ mùa hè Tây Âu", "WEZ": "Giờ Chuẩn Tây Âu", "WIB": "Giờ Miền Tây Indonesia", "WIT": "Giờ Miền Đông Indonesia", "WITA": "Giờ Miền Trung Indonesia", "∅∅∅": "Giờ Mùa Hè Brasilia"},
 	}
 }
 
@@ -53,7 +53,7 @@ func New() locales.Translator {
 		perMille:           "‰",
 		timeSeparator:      ":",
 		inifinity:          "∞",
-		currencies:         []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "￥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "￦", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "STN", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UZS", "VEB", "VEF", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
+		currencies:         []string{"ADP", "AED", "AFA", "AFN", "ALK", "ALL", "AMD", "ANG", "AOA", "AOK", "AON", "AOR", "ARA", "ARL", "ARM", "ARP", "ARS", "ATS", "AU$", "AWG", "AZM", "AZN", "BAD", "BAM", "BAN", "BBD", "BDT", "BEC", "BEF", "BEL", "BGL", "BGM", "BGN", "BGO", "BHD", "BIF", "BMD", "BND", "BOB", "BOL", "BOP", "BOV", "BRB", "BRC", "BRE", "R$", "BRN", "BRR", "BRZ", "BSD", "BTN", "BUK", "BWP", "BYB", "BYN", "BYR", "BZD", "CA$", "CDF", "CHE", "CHF", "CHW", "CLE", "CLF", "CLP", "CNH", "CNX", "￥", "COP", "COU", "CRC", "CSD", "CSK", "CUC", "CUP", "CVE", "CYP", "CZK", "DDM", "DEM", "DJF", "DKK", "DOP", "DZD", "ECS", "ECV", "EEK", "EGP", "ERN", "ESA", "ESB", "ESP", "ETB", "€", "FIM", "FJD", "FKP", "FRF", "£", "GEK", "GEL", "GHC", "GHS", "GIP", "GMD", "GNF", "GNS", "GQE", "GRD", "GTQ", "GWE", "GWP", "GYD", "HK$", "HNL", "HRD", "HRK", "HTG", "HUF", "IDR", "IEP", "ILP", "ILR", "₪", "₹", "IQD", "IRR", "ISJ", "ISK", "ITL", "JMD", "JOD", "JP¥", "KES", "KGS", "KHR", "KMF", "KPW", "KRH", "KRO", "￦", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL", "LTL", "LTT", "LUC", "LUF", "LUL", "LVL", "LVR", "LYD", "MAD", "MAF", "MCF", "MDC", "MDL", "MGA", "MGF", "MKD", "MKN", "MLF", "MMK", "MNT", "MOP", "MRO", "MRU", "MTL", "MTP", "MUR", "MVP", "MVR", "MWK", "MX$", "MXP", "MXV", "MYR", "MZE", "MZM", "MZN", "NAD", "NGN", "NIC", "NIO", "NLG", "NOK", "NPR", "NZ$", "OMR", "PAB", "PEI", "PEN", "PES", "PGK", "PHP", "PKR", "PLN", "PLZ", "PTE", "PYG", "QAR", "RHD", "ROL", "RON", "RSD", "RUB", "RUR", "RWF", "SAR", "SBD", "SCR", "SDD", "SDG", "SDP", "SEK", "SGD", "SHP", "SIT", "SKK", "SLL", "SOS", "SRD", "SRG", "SSP", "STD", "Db", "SUR", "SVC", "SYP", "SZL", "THB", "TJR", "TJS", "TMM", "TMT", "TND", "TOP", "TPE", "TRL", "TRY", "TTD", "NT$", "TZS", "UAH", "UAK", "UGS", "UGX", "US$", "USN", "USS", "UYI", "UYP", "UYU", "UYW", "UZS", "VEB", "VEF", "VES", "₫", "VNN", "VUV", "WST", "FCFA", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "EC$", "XDR", "XEU", "XFO", "XFU", "CFA", "XPD", "CFPF", "XPT", "XRE", "XSU", "XTS", "XUA", "XXX", "YDD", "YER", "YUD", "YUM", "YUN", "YUR", "ZAL", "ZAR", "ZMK", "ZMW", "ZRN", "ZRZ", "ZWD", "ZWL", "ZWR"},
 		monthsAbbreviated:  []string{"", "1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
 		monthsNarrow:       []string{"", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
 		monthsWide:         []string{"", "一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
@@ -67,7 +67,7 @@ func New() locales.Translator {
 		erasAbbreviated:    []string{"西元前", "西元"},
 		erasNarrow:         []string{"西元前", "西元"},
 		erasWide:           []string{"西元前", "西元"},
-		timezones:          map[string]string{"HECU": "古巴夏令时间", "ACDT": "澳洲中部夏令时间", "WART": "阿根廷西部标准时间", "IST": "印度标准时间", "HADT": "夏威夷-阿留申夏令时间", "ChST": "查莫洛时间", "HEPM": "圣皮埃尔和密克隆群岛夏令时间", "SRT": "苏利南时间", "WARST": "阿根廷西部夏令时间", "WIB": "印尼西部时间", "WAST": "西非夏令时间", "ACWDT": "澳洲中西部夏令时间", "ACST": "澳洲中部标准时间", "MEZ": "中欧标准时间", "LHDT": "豪勋爵岛夏令时间", "WIT": "印尼东部时间", "GMT": "格林威治标准时间", "HAT": "纽芬兰夏令时间", "COT": "哥伦比亚标准时间", "AEDT": "澳洲东部夏令时间", "JST": "日本标准时间", "JDT": "日本夏令时间", "AKDT": "阿拉斯加夏令时间", "LHST": "豪勋爵岛标准时间", "ART": "阿根廷标准时间", "ARST": "阿根廷夏令时间", "HNPMX": "墨西哥太平洋标准时间", "AST": "大西洋标准时间", "ADT": "大西洋夏令时间", "MYT": "马来西亚时间", "BT": "不丹时间", "EDT": "东部夏令时间", "CAT": "中非时间", "UYST": "乌拉圭夏令时间", "MESZ": "中欧夏令时间", "VET": "委内瑞拉时间", "HEEG": "格陵兰东部夏令时间", "HKT": "香港标准时间", "HKST": "香港夏令时间", "MDT": "澳门夏令时间", "SAST": "南非标准时间", "WEZ": "西欧标准时间", "WAT": "西非标准时间", "WITA": "印尼中部时间", "HNNOMX": "墨西哥西北部标准时间", "TMST": "土库曼夏令时间", "CHADT": "查坦群岛夏令时间", "MST": "澳门标准时间", "HNOG": "格陵兰西部标准时间", "CHAST": "查坦群岛标准时间", "PDT": "太平洋夏令时间", "NZDT": "纽西兰夏令时间", "∅∅∅": "亚马逊夏令时间", "GYT": "盖亚那时间", "ACWST": "澳洲中西部标准时间", "HNT": "纽芬兰标准时间", "WESZ": "西欧夏令时间", "GFT": "法属圭亚那时间", "HNCU": "古巴标准时间", "HEPMX": "墨西哥太平洋夏令时间", "PST": "太平洋标准时间", "HENOMX": "墨西哥西北部夏令时间", "CLST": "智利夏令时间", "HAST": "夏威夷-阿留申标准时间", "AWST": "澳洲西部标准时间", "CST": "中部标准时间", "CDT": "中部夏令时间", "SGT": "新加坡标准时间", "EAT": "东非时间", "OESZ": "东欧夏令时间", "AKST": "阿拉斯加标准时间", "HNPM": "圣皮埃尔和密克隆群岛标准时间", "OEZ": "东欧标准时间", "AEST": "澳洲东部标准时间", "COST": "哥伦比亚夏令时间", "AWDT": "澳洲西部夏令时间", "CLT": "智利标准时间", "HNEG": "格陵兰东部标准时间", "BOT": "玻利维亚时间", "NZST": "纽西兰标准时间", "ECT": "厄瓜多时间", "HEOG": "格陵兰西部夏令时间", "EST": "东部标准时间", "TMT": "土库曼标准时间", "UYT": "乌拉圭标准时间"},
+		timezones:          map[string]string{"ACDT": "澳洲中部夏令时间", "ACST": "澳洲中部标准时间", "ACWDT": "澳洲中西部夏令时间", "ACWST": "澳洲中西部标准时间", "ADT": "大西洋夏令时间", "AEDT": "澳洲东部夏令时间", "AEST": "澳洲东部标准时间", "AKDT": "阿拉斯加夏令时间", "AKST": "阿拉斯加标准时间", "ARST": "阿根廷夏令时间", "ART": "阿根廷标准时间", "AST": "大西洋标准时间", "AWDT": "澳洲西部夏令时间", "AWST": "澳洲西部标准时间", "BOT": "玻利维亚时间", "BT": "不丹时间", "CAT": "中非时间", "CDT": "中部夏令时间", "CHADT": "查坦群岛夏令时间", "CHAST": "查坦群岛标准时间", "CLST": "智利夏令时间", "CLT": "智利标准时间", "COST": "哥伦比亚夏令时间", "COT": "哥伦比亚标准时间", "CST": "中部标准时间", "ChST": "查莫洛时间", "EAT": "东非时间", "ECT": "厄瓜多时间", "EDT": "东部夏令时间", "EST": "东部标准时间", "GFT": "法属圭亚那时间", "GMT": "格林威治标准时间", "GST": "波斯湾海域标准时间", "GYT": "盖亚那时间", "HADT": "夏威夷-阿留申夏令时间", "HAST": "夏威夷-阿留申标准时间", "HAT": "纽芬兰夏令时间", "HECU": "古巴夏令时间", "HEEG": "格陵兰东部夏令时间", "HENOMX": "墨西哥西北部夏令时间", "HEOG": "格陵兰西部夏令时间", "HEPM": "圣皮埃尔和密克隆群岛夏令时间", "HEPMX": "墨西哥太平洋夏令时间", "HKST": "香港夏令时间", "HKT": "香港标准时间", "HNCU": "古巴标准时间", "HNEG": "格陵兰东部标准时间", "HNNOMX": "墨西哥西北部标准时间", "HNOG": "格陵兰西部标准时间", "HNPM": "圣皮埃尔和密克隆群岛标准时间", "HNPMX": "墨西哥太平洋标准时间", "HNT": "纽芬兰标准时间", "IST": "印度标准时间", "JDT": "日本夏令时间", "JST": "日本标准时间", "LHDT": "豪勋爵岛夏令时间", "LHST": "豪勋爵岛标准时间", "MDT": "山区夏令时间", "MESZ": "中欧夏令时间", "MEZ": "中欧标准时间", "MST": "山区标准时间", "MYT": "马来西亚时间", "NZDT": "纽西兰夏令时间", "NZST": "纽西兰标准时间", "OESZ": "东欧夏令时间", "OEZ": "东欧标准时间", "PDT": "太平洋夏令时间", "PST": "太平洋标准时间", "SAST": "南非标准时间", "SGT": "新加坡标准时间", "SRT": "苏利南时间", "TMST": "土库曼夏令时间", "TMT": "土库曼标准时间", "UYST": "乌拉圭夏令时间", "UYT": "乌拉圭标准时间", "VET": "委内瑞拉时间", "WARST": "阿根廷西部夏令时间", "WART": "阿根廷西部标准时间", "WAST": "西非夏令时间", "WAT": "西非标准时间", "WESZ": "西欧夏令时间", "WEZ": "西欧标准时间", "WIB": "印尼西部时间", "WIT": "印尼东部时间", "WITA": "印尼中部时间", "∅∅∅": "亚速尔群岛夏令时间"},
 	}
 }
 
@@ -1,5 +1,5 @@
 module github.com/go-playground/universal-translator
 
-go 1.13
+go 1.18
 
-require github.com/go-playground/locales v0.13.0
+require github.com/go-playground/locales v0.14.1
@@ -1,11 +1,18 @@
 module github.com/go-playground/validator/v10
 
-go 1.13
+go 1.18
 
 require (
-	github.com/go-playground/assert/v2 v2.0.1
-	github.com/go-playground/locales v0.13.0
-	github.com/go-playground/universal-translator v0.17.0
-	github.com/leodido/go-urn v1.2.0
-	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	github.com/gabriel-vasile/mimetype v1.4.2
+	github.com/go-playground/assert/v2 v2.2.0
+	github.com/go-playground/locales v0.14.1
+	github.com/go-playground/universal-translator v0.18.1
+	github.com/leodido/go-urn v1.2.4
+	golang.org/x/crypto v0.7.0
+	golang.org/x/text v0.8.0
+)
+
+require (
+	golang.org/x/net v0.8.0 // indirect
+	golang.org/x/sys v0.6.0 // indirect
 )
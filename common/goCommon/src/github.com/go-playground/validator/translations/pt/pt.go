@@ -1178,6 +1178,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser um UUID versão 5 válido",
 			override:    false,
 		},
+		{
+			tag:         "ulid",
+			translation: "{0} deve ser um ULID válido",
+			override:    false,
+		},
 		{
 			tag:         "ascii",
 			translation: "{0} deve conter apenas caracteres ascii",
@@ -1351,6 +1356,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return t
 			},
 		},
+		{
+			tag:         "image",
+			translation: "{0} deve ser uma imagem válida",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
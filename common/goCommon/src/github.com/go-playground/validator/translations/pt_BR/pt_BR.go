@@ -26,7 +26,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 	}{
 		{
 			tag:         "required",
-			translation: "{0} é um campo requerido",
+			translation: "{0} é um campo obrigatório",
 			override:    false,
 		},
 		{
@@ -1173,6 +1173,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser um UUID versão 5 válido",
 			override:    false,
 		},
+		{
+			tag:         "ulid",
+			translation: "{0} deve ser uma ULID válida",
+			override:    false,
+		},
 		{
 			tag:         "ascii",
 			translation: "{0} deve conter apenas caracteres ascii",
@@ -1311,6 +1316,21 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "boolean",
+			translation: "{0} deve ser um valor booleano válido",
+			override:    false,
+		},
+		{
+			tag:         "image",
+			translation: "{0} deve ser uma imagen válido",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} deve ser um identificador cve válido",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,20 +29,44 @@ func benchmarkRedisClient(ctx context.Context, poolSize int) *redis.Client {
 
 func BenchmarkRedisPing(b *testing.B) {
 	ctx := context.Background()
-	client := benchmarkRedisClient(ctx, 10)
-	defer client.Close()
+	rdb := benchmarkRedisClient(ctx, 10)
+	defer rdb.Close()
 
 	b.ResetTimer()
 
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
-			if err := client.Ping(ctx).Err(); err != nil {
+			if err := rdb.Ping(ctx).Err(); err != nil {
 				b.Fatal(err)
 			}
 		}
 	})
 }
 
+func BenchmarkSetGoroutines(b *testing.B) {
+	ctx := context.Background()
+	rdb := benchmarkRedisClient(ctx, 10)
+	defer rdb.Close()
+
+	for i := 0; i < b.N; i++ {
+		var wg sync.WaitGroup
+
+		for i := 0; i < 1000; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				err := rdb.Set(ctx, "hello", "world", 0).Err()
+				if err != nil {
+					panic(err)
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+}
+
 func BenchmarkRedisGetNil(b *testing.B) {
 	ctx := context.Background()
 	client := benchmarkRedisClient(ctx, 10)
@@ -208,6 +234,45 @@ func BenchmarkZAdd(b *testing.B) {
 	})
 }
 
+func BenchmarkXRead(b *testing.B) {
+	ctx := context.Background()
+	client := benchmarkRedisClient(ctx, 10)
+	defer client.Close()
+
+	args := redis.XAddArgs{
+		Stream: "1",
+		ID:     "*",
+		Values: map[string]string{"uno": "dos"},
+	}
+
+	lenStreams := 16
+	streams := make([]string, 0, lenStreams)
+	for i := 0; i < lenStreams; i++ {
+		streams = append(streams, strconv.Itoa(i))
+	}
+	for i := 0; i < lenStreams; i++ {
+		streams = append(streams, "0")
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			client.XAdd(ctx, &args)
+
+			err := client.XRead(ctx, &redis.XReadArgs{
+				Streams: streams,
+				Count:   1,
+				Block:   time.Second,
+			}).Err()
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 var clientSink *redis.Client
 
 func BenchmarkWithContext(b *testing.B) {
@@ -276,6 +341,32 @@ func BenchmarkClusterPing(b *testing.B) {
 	})
 }
 
+func BenchmarkClusterDoInt(b *testing.B) {
+	if testing.Short() {
+		b.Skip("skipping in short mode")
+	}
+
+	ctx := context.Background()
+	cluster := newClusterScenario()
+	if err := startCluster(ctx, cluster); err != nil {
+		b.Fatal(err)
+	}
+	defer cluster.Close()
+
+	client := cluster.newClusterClient(ctx, redisClusterOptions())
+	defer client.Close()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			err := client.Do(ctx, "SET", 10, 10).Err()
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 func BenchmarkClusterSetString(b *testing.B) {
 	if testing.Short() {
 		b.Skip("skipping in short mode")
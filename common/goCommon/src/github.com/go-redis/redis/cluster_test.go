@@ -9,11 +9,11 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-redis/redis/v8"
-	"github.com/go-redis/redis/v8/internal/hashtag"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/go-redis/redis/v8/internal/hashtag"
 )
 
 type clusterScenario struct {
@@ -344,6 +344,50 @@ var _ = Describe("ClusterClient", func() {
 			})
 		})
 
+		It("distributes scripts when using Script Load", func() {
+			client.ScriptFlush(ctx)
+
+			script := redis.NewScript(`return 'Unique script'`)
+
+			script.Load(ctx, client)
+
+			client.ForEachShard(ctx, func(ctx context.Context, shard *redis.Client) error {
+				defer GinkgoRecover()
+
+				val, _ := script.Exists(ctx, shard).Result()
+				Expect(val[0]).To(Equal(true))
+				return nil
+			})
+		})
+
+		It("checks all shards when using Script Exists", func() {
+			client.ScriptFlush(ctx)
+
+			script := redis.NewScript(`return 'First script'`)
+			lostScriptSrc := `return 'Lost script'`
+			lostScript := redis.NewScript(lostScriptSrc)
+
+			script.Load(ctx, client)
+			client.Do(ctx, "script", "load", lostScriptSrc)
+
+			val, _ := client.ScriptExists(ctx, script.Hash(), lostScript.Hash()).Result()
+
+			Expect(val).To(Equal([]bool{true, false}))
+		})
+
+		It("flushes scripts from all shards when using ScriptFlush", func() {
+			script := redis.NewScript(`return 'Unnecessary script'`)
+			script.Load(ctx, client)
+
+			val, _ := client.ScriptExists(ctx, script.Hash()).Result()
+			Expect(val).To(Equal([]bool{true}))
+
+			client.ScriptFlush(ctx)
+
+			val, _ = client.ScriptExists(ctx, script.Hash()).Result()
+			Expect(val).To(Equal([]bool{false}))
+		})
+
 		It("supports Watch", func() {
 			var incr func(string) error
 
@@ -820,14 +864,14 @@ var _ = Describe("ClusterClient", func() {
 
 			client.AddHook(&hook{
 				beforeProcessPipeline: func(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
-					Expect(cmds).To(HaveLen(1))
-					Expect(cmds[0].String()).To(Equal("ping: "))
+					Expect(cmds).To(HaveLen(3))
+					Expect(cmds[1].String()).To(Equal("ping: "))
 					stack = append(stack, "cluster.BeforeProcessPipeline")
 					return ctx, nil
 				},
 				afterProcessPipeline: func(ctx context.Context, cmds []redis.Cmder) error {
-					Expect(cmds).To(HaveLen(1))
-					Expect(cmds[0].String()).To(Equal("ping: PONG"))
+					Expect(cmds).To(HaveLen(3))
+					Expect(cmds[1].String()).To(Equal("ping: PONG"))
 					stack = append(stack, "cluster.AfterProcessPipeline")
 					return nil
 				},
@@ -1020,6 +1064,66 @@ var _ = Describe("ClusterClient", func() {
 
 		assertClusterClient()
 	})
+
+	Describe("ClusterClient with ClusterSlots with multiple nodes per slot", func() {
+		BeforeEach(func() {
+			failover = true
+
+			opt = redisClusterOptions()
+			opt.ReadOnly = true
+			opt.ClusterSlots = func(ctx context.Context) ([]redis.ClusterSlot, error) {
+				slots := []redis.ClusterSlot{{
+					Start: 0,
+					End:   4999,
+					Nodes: []redis.ClusterNode{{
+						Addr: ":8220",
+					}, {
+						Addr: ":8223",
+					}},
+				}, {
+					Start: 5000,
+					End:   9999,
+					Nodes: []redis.ClusterNode{{
+						Addr: ":8221",
+					}, {
+						Addr: ":8224",
+					}},
+				}, {
+					Start: 10000,
+					End:   16383,
+					Nodes: []redis.ClusterNode{{
+						Addr: ":8222",
+					}, {
+						Addr: ":8225",
+					}},
+				}}
+				return slots, nil
+			}
+			client = cluster.newClusterClient(ctx, opt)
+
+			err := client.ForEachMaster(ctx, func(ctx context.Context, master *redis.Client) error {
+				return master.FlushDB(ctx).Err()
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ForEachSlave(ctx, func(ctx context.Context, slave *redis.Client) error {
+				Eventually(func() int64 {
+					return client.DBSize(ctx).Val()
+				}, 30*time.Second).Should(Equal(int64(0)))
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			failover = false
+
+			err := client.Close()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		assertClusterClient()
+	})
 })
 
 var _ = Describe("ClusterClient without nodes", func() {
@@ -1,9 +0,0 @@
-module github.com/go-redis/redis/extra/rediscmd
-
-go 1.15
-
-require (
-	github.com/go-redis/redis/v8 v8.4.2
-	github.com/onsi/ginkgo v1.14.2
-	github.com/onsi/gomega v1.10.4
-)
@@ -3,10 +3,10 @@ package redis_test
 import (
 	"fmt"
 
-	"github.com/go-redis/redis/v8"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/go-redis/redis/v8"
 )
 
 var _ = Describe("ScanIterator", func() {
@@ -3,16 +3,17 @@ package redis_test
 import (
 	"net"
 
-	"github.com/go-redis/redis/v8"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/go-redis/redis/v8"
 )
 
 var _ = Describe("Sentinel", func() {
 	var client *redis.Client
 	var master *redis.Client
 	var masterPort string
+	var sentinel *redis.SentinelClient
 
 	BeforeEach(func() {
 		client = redis.NewFailoverClient(&redis.FailoverOptions{
@@ -22,7 +23,7 @@ var _ = Describe("Sentinel", func() {
 		})
 		Expect(client.FlushDB(ctx).Err()).NotTo(HaveOccurred())
 
-		sentinel := redis.NewSentinelClient(&redis.Options{
+		sentinel = redis.NewSentinelClient(&redis.Options{
 			Addr:       ":" + sentinelPort1,
 			MaxRetries: -1,
 		})
@@ -51,6 +52,7 @@ var _ = Describe("Sentinel", func() {
 	AfterEach(func() {
 		_ = client.Close()
 		_ = master.Close()
+		_ = sentinel.Close()
 	})
 
 	It("should facilitate failover", func() {
@@ -63,8 +65,28 @@ var _ = Describe("Sentinel", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(val).To(Equal("master"))
 
+		// Verify master->slaves sync.
+		var slavesAddr []string
+		Eventually(func() []string {
+			slavesAddr = redis.GetSlavesAddrByName(ctx, sentinel, sentinelName)
+			return slavesAddr
+		}, "15s", "100ms").Should(HaveLen(2))
+		Eventually(func() bool {
+			sync := true
+			for _, addr := range slavesAddr {
+				slave := redis.NewClient(&redis.Options{
+					Addr:       addr,
+					MaxRetries: -1,
+				})
+				sync = slave.Get(ctx, "foo").Val() == "master"
+				_ = slave.Close()
+			}
+			return sync
+		}, "15s", "100ms").Should(BeTrue())
+
 		// Create subscription.
-		ch := client.Subscribe(ctx, "foo").Channel()
+		pub := client.Subscribe(ctx, "foo")
+		ch := pub.Channel()
 
 		// Kill master.
 		err = master.Shutdown(ctx).Err()
@@ -86,6 +108,7 @@ var _ = Describe("Sentinel", func() {
 		}, "15s", "100ms").Should(Receive(&msg))
 		Expect(msg.Channel).To(Equal("foo"))
 		Expect(msg.Payload).To(Equal("hello"))
+		Expect(pub.Close()).NotTo(HaveOccurred())
 
 		_, err = startRedis(masterPort)
 		Expect(err).NotTo(HaveOccurred())
@@ -189,3 +212,76 @@ var _ = Describe("NewFailoverClusterClient", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 })
+
+var _ = Describe("SentinelAclAuth", func() {
+	const (
+		aclSentinelUsername = "sentinel-user"
+		aclSentinelPassword = "sentinel-pass"
+	)
+
+	var client *redis.Client
+	var sentinel *redis.SentinelClient
+	var sentinels = func() []*redisProcess {
+		return []*redisProcess{sentinel1, sentinel2, sentinel3}
+	}
+
+	BeforeEach(func() {
+		authCmd := redis.NewStatusCmd(ctx, "ACL", "SETUSER", aclSentinelUsername, "ON",
+			">"+aclSentinelPassword, "-@all", "+auth", "+client|getname", "+client|id", "+client|setname",
+			"+command", "+hello", "+ping", "+role", "+sentinel|get-master-addr-by-name", "+sentinel|master",
+			"+sentinel|myid", "+sentinel|replicas", "+sentinel|sentinels")
+
+		for _, process := range sentinels() {
+			err := process.Client.Process(ctx, authCmd)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       sentinelName,
+			SentinelAddrs:    sentinelAddrs,
+			MaxRetries:       -1,
+			SentinelUsername: aclSentinelUsername,
+			SentinelPassword: aclSentinelPassword,
+		})
+
+		Expect(client.FlushDB(ctx).Err()).NotTo(HaveOccurred())
+
+		sentinel = redis.NewSentinelClient(&redis.Options{
+			Addr:       sentinelAddrs[0],
+			MaxRetries: -1,
+			Username:   aclSentinelUsername,
+			Password:   aclSentinelPassword,
+		})
+
+		_, err := sentinel.GetMasterAddrByName(ctx, sentinelName).Result()
+		Expect(err).NotTo(HaveOccurred())
+
+		// Wait until sentinels are picked up by each other.
+		for _, process := range sentinels() {
+			Eventually(func() string {
+				return process.Info(ctx).Val()
+			}, "15s", "100ms").Should(ContainSubstring("sentinels=3"))
+		}
+	})
+
+	AfterEach(func() {
+		unauthCommand := redis.NewStatusCmd(ctx, "ACL", "DELUSER", aclSentinelUsername)
+
+		for _, process := range sentinels() {
+			err := process.Client.Process(ctx, unauthCommand)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		_ = client.Close()
+		_ = sentinel.Close()
+	})
+
+	It("should still facilitate operations", func() {
+		err := client.Set(ctx, "wow", "acl-auth", 0).Err()
+		Expect(err).NotTo(HaveOccurred())
+
+		val, err := client.Get(ctx, "wow").Result()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(val).To(Equal("acl-auth"))
+	})
+})
@@ -0,0 +1,11 @@
+package typeInfo
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// TypeInfoI defines the interface for TypeInfo Implementation
+type TypeInfoI interface {
+	Enter(node ast.Node)
+	Leave(node ast.Node)
+}
@@ -61,11 +61,9 @@ func (m *machine) Parse(input []byte) (*URN, error) {
 	m.err = nil
 	m.tolower = []int{}
 	output := &URN{}
-
 	{
 		m.cs = start
 	}
-
 	{
 		if (m.p) == (m.pe) {
 			goto _testEof
@@ -1674,7 +1672,6 @@ func (m *machine) Parse(input []byte) (*URN, error) {
 				{
 					goto st46
 				}
-
 			}
 		}
 
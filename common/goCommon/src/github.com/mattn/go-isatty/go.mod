@@ -1,5 +1,5 @@
 module github.com/mattn/go-isatty
 
-go 1.12
+go 1.15
 
-require golang.org/x/sys v0.0.0-20200116001909-b77594299b42
+require golang.org/x/sys v0.6.0
@@ -1,3 +1,4 @@
+//go:build appengine || js || nacl || wasm
 // +build appengine js nacl wasm
 
 package isatty
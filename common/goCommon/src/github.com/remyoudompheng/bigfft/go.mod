@@ -0,0 +1,3 @@
+module github.com/remyoudompheng/bigfft
+
+go 1.12
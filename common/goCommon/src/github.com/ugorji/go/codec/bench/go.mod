@@ -1,5 +0,0 @@
-module github.com/ugorji/go/codec/bench
-
-go 1.11
-
-require github.com/ugorji/go/codec v1.2.2
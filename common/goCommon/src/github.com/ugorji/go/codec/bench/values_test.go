@@ -1 +0,0 @@
-../values_test.go
\ No newline at end of file
@@ -7,15 +7,22 @@ _tests() {
     local vet="" # TODO: make it off
     local gover=$( ${gocmd} version | cut -f 3 -d ' ' )
     [[ $( ${gocmd} version ) == *"gccgo"* ]] && zcover=0
+    [[ $( ${gocmd} version ) == *"gollvm"* ]] && zcover=0
     case $gover in
         go1.[7-9]*|go1.1[0-9]*|go2.*|devel*) true ;;
         *) return 1
     esac
-    # note that codecgen requires fastpath, so you cannot do "codecgen notfastpath"
-    # we test the following permutations: fastpath/unsafe, !fastpath/!unsafe, codecgen/unsafe
-    ## local a=( "" "safe"  "notfastpath safe" "codecgen" )
-    echo "TestCodecSuite: (fastpath/unsafe), (!fastpath/!unsafe), (codecgen/unsafe)"
-    local a=( "" "notfastpath safe"  "codecgen" )
+    # note that codecgen requires fastpath, so you cannot do "codecgen codec.notfastpath"
+    # we test the following permutations wnich all execute different code paths as below.
+    echo "TestCodecSuite: (fastpath/unsafe), (!fastpath/unsafe), (fastpath/!unsafe), (!fastpath/!unsafe), (codecgen/unsafe)"
+    local echo=1
+    local nc=2 # count
+    local cpus="1,$(nproc)"
+    # if using the race detector, then set nc to
+    if [[ " ${zargs[@]} " =~ "-race" ]]; then
+        cpus="$(nproc)"
+    fi
+    local a=( "" "codec.notfastpath" "codec.safe" "codec.notfastpath codec.safe"  "codecgen" )
     local b=()
     local c=()
     for i in "${a[@]}"
@@ -25,21 +32,23 @@ _tests() {
         [[ "$zcover" == "1" ]] && c=( -coverprofile "${i2// /-}.cov.out" )
         true &&
             ${gocmd} vet -printfuncs "errorf" "$@" &&
-            ${gocmd} test ${zargs[*]} ${ztestargs[*]} -vet "$vet" -tags "alltests $i" -run "TestCodecSuite" "${c[@]}" "$@" &
+            if [[ "$echo" == 1 ]]; then set -o xtrace; fi &&
+            ${gocmd} test ${zargs[*]} ${ztestargs[*]} -vet "$vet" -tags "alltests $i" -count $nc -cpu $cpus -run "TestCodecSuite" "${c[@]}" "$@" &
+        if [[ "$echo" == 1 ]]; then set +o xtrace; fi
         b+=("${i2// /-}.cov.out")
         [[ "$zwait" == "1" ]] && wait
             
         # if [[ "$?" != 0 ]]; then return 1; fi
     done
     if [[ "$zextra" == "1" ]]; then
-        [[ "$zwait" == "1" ]] && echo ">>>> TAGS: 'notfastpath x'; RUN: 'Test.*X$'"
+        [[ "$zwait" == "1" ]] && echo ">>>> TAGS: 'codec.notfastpath x'; RUN: 'Test.*X$'"
         [[ "$zcover" == "1" ]] && c=( -coverprofile "x.cov.out" )
-        ${gocmd} test ${zargs[*]} ${ztestargs[*]} -vet "$vet" -tags "notfastpath x" -run 'Test.*X$' "${c[@]}" &
+        ${gocmd} test ${zargs[*]} ${ztestargs[*]} -vet "$vet" -tags "codec.notfastpath x" -count $nc -run 'Test.*X$' "${c[@]}" &
         b+=("x.cov.out")
         [[ "$zwait" == "1" ]] && wait
     fi
     wait
-    # go tool cover is not supported for gccgo.
+    # go tool cover is not supported for gccgo, gollvm, other non-standard go compilers
     [[ "$zcover" == "1" ]] &&
         command -v gocovmerge &&
         gocovmerge "${b[@]}" > __merge.cov.out &&
@@ -140,6 +149,14 @@ func (${i}RvSlice) Len() int { return 0 }
 func (${i}RvSlice) Less(i, j int) bool { return false }
 func (${i}RvSlice) Swap(i, j int) {}
 
+type ${i}Intf struct { v ${i2}; i interface{} }
+
+type ${i}IntfSlice []${i}Intf
+
+func (${i}IntfSlice) Len() int { return 0 }
+func (${i}IntfSlice) Less(i, j int) bool { return false }
+func (${i}IntfSlice) Swap(i, j int) {}
+
 EOF
     done
 
@@ -147,7 +164,7 @@ EOF
         shared_test.go > bench/shared_test.go
 
     # explicitly return 0 if this passes, else return 1
-    local btags="notfastpath safe codecgen.exec"
+    local btags="codec.notfastpath codec.safe codecgen.exec"
     rm -f sort-slice.generated.go fast-path.generated.go gen-helper.generated.go mammoth_generated_test.go mammoth2_generated_test.go
     
     cat > gen-from-tmpl.sort-slice.generated.go <<EOF
@@ -203,7 +220,7 @@ _codegenerators() {
         fi &&
         $c8 -rt 'codecgen' -t 'codecgen generated' -o "values_codecgen${c5}" -d 19780 "$zfin" "$zfin2" &&
         cp mammoth2_generated_test.go $c9 &&
-        $c8 -t 'codecgen,!notfastpath generated,!notfastpath' -o "mammoth2_codecgen${c5}" -d 19781 "mammoth2_generated_test.go" &&
+        $c8 -t 'codecgen,!codec.notfastpath,!codec.notmammoth generated,!codec.notfastpath,!codec.notmammoth' -o "mammoth2_codecgen${c5}" -d 19781 "mammoth2_generated_test.go" &&
         rm -f $c9 &&
         echo "generators done!" 
 }
@@ -290,8 +307,10 @@ _usage() {
     
     cat <<EOF
 primary usage: $0 
-    -[tesow m n l d]   -> [t=tests (e=extra, s=short, o=cover, w=wait), m=make, n=inlining diagnostics, l=mid-stack inlining, d=race detector]
-    -v                   -> v=verbose
+    -t[esow]   -> t=tests [e=extra, s=short, o=cover, w=wait]
+    -[md]      -> [m=make, d=race detector]
+    -[n l i]   -> [n=inlining diagnostics, l=mid-stack inlining, i=check inlining for path (path)]
+    -v         -> v=verbose
 EOF
     if [[ "$(type -t _usage_run)" = "function" ]]; then _usage_run ; fi
 }
@@ -312,7 +331,7 @@ _main() {
     local gocmd=${MYGOCMD:-go}
     
     OPTIND=1
-    while getopts ":cetmnrgpfvlyzdsowxb:" flag
+    while getopts ":cetmnrgpfvldsowkxyzi" flag
     do
         case "x$flag" in
             'xo') zcover=1 ;;
@@ -324,7 +343,7 @@ _main() {
             'xl') zargs+=("-gcflags"); zargs+=("-l=4") ;;
             'xn') zargs+=("-gcflags"); zargs+=("-m=2") ;;
             'xd') zargs+=("-race") ;;
-            'xb') x='b'; zbenchflags=${OPTARG} ;;
+            # 'xi') x='i'; zbenchflags=${OPTARG} ;;
             x\?) _usage; return 1 ;;
             *) x=$flag ;;
         esac
@@ -341,7 +360,8 @@ _main() {
         'xx') _analyze_checks "$@" ;;
         'xy') _analyze_debug_types "$@" ;;
         'xz') _analyze_do_inlining_and_more "$@" ;;
-        'xb') _bench "$@" ;;
+        'xk') _go_compiler_validation_suite ;;
+        'xi') _check_inlining_one "$@" ;;
     esac
     # unset zforce zargs zbenchflags
 }
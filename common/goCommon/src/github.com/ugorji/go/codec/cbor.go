@@ -5,7 +5,9 @@ package codec
 
 import (
 	"math"
+	"reflect"
 	"time"
+	"unicode/utf8"
 )
 
 // major
@@ -117,9 +119,9 @@ func cbordesc(bd byte) (s string) {
 
 type cborEncDriver struct {
 	noBuiltInTypes
+	encDriverNoState
 	encDriverNoopContainerWriter
 	h *CborHandle
-	// x [8]byte
 
 	e Encoder
 }
@@ -215,11 +217,10 @@ func (e *cborEncDriver) EncodeTime(t time.Time) {
 	}
 }
 
-func (e *cborEncDriver) EncodeExt(rv interface{}, xtag uint64, ext Ext) {
+func (e *cborEncDriver) EncodeExt(rv interface{}, basetype reflect.Type, xtag uint64, ext Ext) {
 	e.encUint(uint64(xtag), cborBaseTag)
 	if ext == SelfExt {
-		rv2 := baseRV(rv)
-		e.e.encodeValue(rv2, e.h.fnNoExt(rvType(rv2)))
+		e.e.encodeValue(baseRV(rv), e.h.fnNoExt(basetype))
 	} else if v := ext.ConvertExt(rv); v == nil {
 		e.EncodeNil()
 	} else {
@@ -266,11 +267,11 @@ func (e *cborEncDriver) WriteArrayEnd() {
 }
 
 func (e *cborEncDriver) EncodeString(v string) {
+	bb := cborBaseString
 	if e.h.StringToRaw {
-		e.EncodeStringBytesRaw(bytesView(v))
-		return
+		bb = cborBaseBytes
 	}
-	e.encStringBytesS(cborBaseString, v)
+	e.encStringBytesS(bb, v)
 }
 
 func (e *cborEncDriver) EncodeStringBytesRaw(v []byte) {
@@ -318,11 +319,11 @@ func (e *cborEncDriver) encStringBytesS(bb byte, v string) {
 
 type cborDecDriver struct {
 	decDriverNoopContainerReader
-	h      *CborHandle
-	bdRead bool
-	bd     byte
-	st     bool // skip tags
-	_      bool // found nil
+	decDriverNoopNumberHelper
+	h *CborHandle
+	bdAndBdread
+	st bool // skip tags
+	_  bool // found nil
 	noBuiltInTypes
 	d Decoder
 }
@@ -331,6 +332,10 @@ func (d *cborDecDriver) decoder() *Decoder {
 	return &d.d
 }
 
+func (d *cborDecDriver) descBd() string {
+	return sprintf("%v (%s)", d.bd, cbordesc(d.bd))
+}
+
 func (d *cborDecDriver) readNextBd() {
 	d.bd = d.d.decRd.readn1()
 	d.bdRead = true
@@ -418,30 +423,6 @@ func (d *cborDecDriver) decUint() (ui uint64) {
 	return
 }
 
-func (d *cborDecDriver) decCheckInteger() (neg bool) {
-	if d.st {
-		d.skipTags()
-	}
-	major := d.bd >> 5
-	if major == cborMajorUint {
-	} else if major == cborMajorNegInt {
-		neg = true
-	} else {
-		d.d.errorf("invalid integer %x (%s); got major %v, expected %v or %v",
-			d.bd, cbordesc(d.bd), major, cborMajorUint, cborMajorNegInt)
-	}
-	return
-}
-
-func cborDecInt64(ui uint64, neg bool) (i int64) {
-	if neg {
-		i = -(chkOvf.SignedIntV(ui + 1))
-	} else {
-		i = chkOvf.SignedIntV(ui)
-	}
-	return
-}
-
 func (d *cborDecDriver) decLen() int {
 	return int(d.decUint())
 }
@@ -470,24 +451,55 @@ func (d *cborDecDriver) decAppendIndefiniteBytes(bs []byte) []byte {
 	return bs
 }
 
+func (d *cborDecDriver) decFloat() (f float64, ok bool) {
+	ok = true
+	switch d.bd {
+	case cborBdFloat16:
+		f = float64(math.Float32frombits(halfFloatToFloatBits(bigen.Uint16(d.d.decRd.readn2()))))
+	case cborBdFloat32:
+		f = float64(math.Float32frombits(bigen.Uint32(d.d.decRd.readn4())))
+	case cborBdFloat64:
+		f = math.Float64frombits(bigen.Uint64(d.d.decRd.readn8()))
+	default:
+		ok = false
+	}
+	return
+}
+
+func (d *cborDecDriver) decInteger() (ui uint64, neg, ok bool) {
+	ok = true
+	switch d.bd >> 5 {
+	case cborMajorUint:
+		ui = d.decUint()
+	case cborMajorNegInt:
+		ui = d.decUint()
+		neg = true
+	default:
+		ok = false
+	}
+	return
+}
+
 func (d *cborDecDriver) DecodeInt64() (i int64) {
 	if d.advanceNil() {
 		return
 	}
-	neg := d.decCheckInteger()
-	ui := d.decUint()
+	if d.st {
+		d.skipTags()
+	}
+	i = decNegintPosintFloatNumberHelper{&d.d}.int64(d.decInteger())
 	d.bdRead = false
-	return cborDecInt64(ui, neg)
+	return
 }
 
 func (d *cborDecDriver) DecodeUint64() (ui uint64) {
 	if d.advanceNil() {
 		return
 	}
-	if d.decCheckInteger() {
-		d.d.errorf("cannot assign negative signed value to unsigned type")
+	if d.st {
+		d.skipTags()
 	}
-	ui = d.decUint()
+	ui = decNegintPosintFloatNumberHelper{&d.d}.uint64(d.decInteger())
 	d.bdRead = false
 	return
 }
@@ -499,23 +511,7 @@ func (d *cborDecDriver) DecodeFloat64() (f float64) {
 	if d.st {
 		d.skipTags()
 	}
-	switch d.bd {
-	case cborBdFloat16:
-		f = float64(math.Float32frombits(halfFloatToFloatBits(bigen.Uint16(d.d.decRd.readn2()))))
-	case cborBdFloat32:
-		f = float64(math.Float32frombits(bigen.Uint32(d.d.decRd.readn4())))
-	case cborBdFloat64:
-		f = math.Float64frombits(bigen.Uint64(d.d.decRd.readn8()))
-	default:
-		major := d.bd >> 5
-		if major == cborMajorUint {
-			f = float64(cborDecInt64(d.decUint(), false))
-		} else if major == cborMajorNegInt {
-			f = float64(cborDecInt64(d.decUint(), true))
-		} else {
-			d.d.errorf("invalid float descriptor; got %d/%s, expected float16/32/64 or (-)int", d.bd, cbordesc(d.bd))
-		}
-	}
+	f = decNegintPosintFloatNumberHelper{&d.d}.float64(d.decFloat())
 	d.bdRead = false
 	return
 }
@@ -572,7 +568,8 @@ func (d *cborDecDriver) ReadArrayStart() (length int) {
 	return d.decLen()
 }
 
-func (d *cborDecDriver) DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte) {
+func (d *cborDecDriver) DecodeBytes(bs []byte) (bsOut []byte) {
+	d.d.decByteState = decByteStateNone
 	if d.advanceNil() {
 		return
 	}
@@ -582,20 +579,16 @@ func (d *cborDecDriver) DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte) {
 	if d.bd == cborBdIndefiniteBytes || d.bd == cborBdIndefiniteString {
 		d.bdRead = false
 		if bs == nil {
-			if zerocopy {
-				return d.decAppendIndefiniteBytes(d.d.b[:0])
-			}
-			return d.decAppendIndefiniteBytes(zeroByteSlice)
+			d.d.decByteState = decByteStateReuseBuf
+			return d.decAppendIndefiniteBytes(d.d.b[:0])
 		}
 		return d.decAppendIndefiniteBytes(bs[:0])
 	}
 	if d.bd == cborBdIndefiniteArray {
 		d.bdRead = false
-		if zerocopy && len(bs) == 0 {
-			bs = d.d.b[:]
-		}
 		if bs == nil {
-			bs = []byte{}
+			d.d.decByteState = decByteStateReuseBuf
+			bs = d.d.b[:0]
 		} else {
 			bs = bs[:0]
 		}
@@ -606,29 +599,42 @@ func (d *cborDecDriver) DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte) {
 	}
 	if d.bd>>5 == cborMajorArray {
 		d.bdRead = false
-		if zerocopy && len(bs) == 0 {
+		if bs == nil {
+			d.d.decByteState = decByteStateReuseBuf
 			bs = d.d.b[:]
 		}
 		slen := d.decLen()
-		bs = usableByteSlice(bs, slen)
+		var changed bool
+		if bs, changed = usableByteSlice(bs, slen); changed {
+			d.d.decByteState = decByteStateNone
+		}
 		for i := 0; i < len(bs); i++ {
 			bs[i] = uint8(chkOvf.UintV(d.DecodeUint64(), 8))
 		}
+		for i := len(bs); i < slen; i++ {
+			bs = append(bs, uint8(chkOvf.UintV(d.DecodeUint64(), 8)))
+		}
 		return bs
 	}
 	clen := d.decLen()
 	d.bdRead = false
-	if d.d.bytes && (zerocopy || d.h.ZeroCopy) {
+	if d.d.zerocopy() {
+		d.d.decByteState = decByteStateZerocopy
 		return d.d.decRd.rb.readx(uint(clen))
 	}
-	if zerocopy && len(bs) == 0 {
+	if bs == nil {
+		d.d.decByteState = decByteStateReuseBuf
 		bs = d.d.b[:]
 	}
 	return decByteSlice(d.d.r(), clen, d.h.MaxInitLen, bs)
 }
 
 func (d *cborDecDriver) DecodeStringAsBytes() (s []byte) {
-	return d.DecodeBytes(d.d.b[:], true)
+	s = d.DecodeBytes(nil)
+	if d.h.ValidateUnicode && !utf8.Valid(s) {
+		d.d.errorf("DecodeStringAsBytes: invalid UTF-8: %s", s)
+	}
+	return
 }
 
 func (d *cborDecDriver) DecodeTime() (t time.Time) {
@@ -659,7 +665,7 @@ func (d *cborDecDriver) decodeTime(xtag uint64) (t time.Time) {
 	return
 }
 
-func (d *cborDecDriver) DecodeExt(rv interface{}, xtag uint64, ext Ext) {
+func (d *cborDecDriver) DecodeExt(rv interface{}, basetype reflect.Type, xtag uint64, ext Ext) {
 	if d.advanceNil() {
 		return
 	}
@@ -675,8 +681,7 @@ func (d *cborDecDriver) DecodeExt(rv interface{}, xtag uint64, ext Ext) {
 	} else if xtag != realxtag {
 		d.d.errorf("Wrong extension tag. Got %b. Expecting: %v", realxtag, xtag)
 	} else if ext == SelfExt {
-		rv2 := baseRV(rv)
-		d.d.decodeValue(rv2, d.h.fnNoExt(rvType(rv2)))
+		d.d.decodeValue(baseRV(rv), d.h.fnNoExt(basetype))
 	} else {
 		d.d.interfaceExtConvertAndDecode(rv, ext)
 	}
@@ -704,10 +709,10 @@ func (d *cborDecDriver) DecodeNaked() {
 		n.v = valueTypeInt
 		n.i = d.DecodeInt64()
 	case cborMajorBytes:
-		fauxUnionReadRawBytes(d, &d.d, n, d.h.RawToString)
+		d.d.fauxUnionReadRawBytes(false)
 	case cborMajorString:
 		n.v = valueTypeString
-		n.s = string(d.DecodeStringAsBytes())
+		n.s = d.d.stringZC(d.DecodeStringAsBytes())
 	case cborMajorArray:
 		n.v = valueTypeArray
 		decodeFurther = true
@@ -777,53 +782,61 @@ func (d *cborDecDriver) uintBytes() (v []byte, ui uint64) {
 	return
 }
 
-func (d *cborDecDriver) nextValueBytes(start []byte) (v []byte) {
+func (d *cborDecDriver) nextValueBytes(v0 []byte) (v []byte) {
 	if !d.bdRead {
 		d.readNextBd()
 	}
-	v = append(start, d.bd)
+	v = v0
+	var h = decNextValueBytesHelper{d: &d.d}
+	var cursor = d.d.rb.c - 1
+	h.append1(&v, d.bd)
 	v = d.nextValueBytesBdReadR(v)
 	d.bdRead = false
+	h.bytesRdV(&v, cursor)
 	return
 }
 
 func (d *cborDecDriver) nextValueBytesR(v0 []byte) (v []byte) {
 	d.readNextBd()
-	v = append(v0, d.bd)
+	v = v0
+	var h = decNextValueBytesHelper{d: &d.d}
+	h.append1(&v, d.bd)
 	return d.nextValueBytesBdReadR(v)
 }
 
 func (d *cborDecDriver) nextValueBytesBdReadR(v0 []byte) (v []byte) {
 	v = v0
+	var h = decNextValueBytesHelper{d: &d.d}
+
 	var bs []byte
 	var ui uint64
 
 	switch d.bd >> 5 {
 	case cborMajorUint, cborMajorNegInt:
 		bs, _ = d.uintBytes()
-		v = append(v, bs...)
+		h.appendN(&v, bs...)
 	case cborMajorString, cborMajorBytes:
 		if d.bd == cborBdIndefiniteBytes || d.bd == cborBdIndefiniteString {
 			for {
 				d.readNextBd()
-				v = append(v, d.bd)
+				h.append1(&v, d.bd)
 				if d.bd == cborBdBreak {
 					break
 				}
 				bs, ui = d.uintBytes()
-				v = append(v, bs...)
-				v = append(v, d.d.decRd.readx(uint(ui))...)
+				h.appendN(&v, bs...)
+				h.appendN(&v, d.d.decRd.readx(uint(ui))...)
 			}
 		} else {
 			bs, ui = d.uintBytes()
-			v = append(v, bs...)
-			v = append(v, d.d.decRd.readx(uint(ui))...)
+			h.appendN(&v, bs...)
+			h.appendN(&v, d.d.decRd.readx(uint(ui))...)
 		}
 	case cborMajorArray:
 		if d.bd == cborBdIndefiniteArray {
 			for {
 				d.readNextBd()
-				v = append(v, d.bd)
+				h.append1(&v, d.bd)
 				if d.bd == cborBdBreak {
 					break
 				}
@@ -831,7 +844,7 @@ func (d *cborDecDriver) nextValueBytesBdReadR(v0 []byte) (v []byte) {
 			}
 		} else {
 			bs, ui = d.uintBytes()
-			v = append(v, bs...)
+			h.appendN(&v, bs...)
 			for i := uint64(0); i < ui; i++ {
 				v = d.nextValueBytesR(v)
 			}
@@ -840,7 +853,7 @@ func (d *cborDecDriver) nextValueBytesBdReadR(v0 []byte) (v []byte) {
 		if d.bd == cborBdIndefiniteMap {
 			for {
 				d.readNextBd()
-				v = append(v, d.bd)
+				h.append1(&v, d.bd)
 				if d.bd == cborBdBreak {
 					break
 				}
@@ -849,7 +862,7 @@ func (d *cborDecDriver) nextValueBytesBdReadR(v0 []byte) (v []byte) {
 			}
 		} else {
 			bs, ui = d.uintBytes()
-			v = append(v, bs...)
+			h.appendN(&v, bs...)
 			for i := uint64(0); i < ui; i++ {
 				v = d.nextValueBytesR(v)
 				v = d.nextValueBytesR(v)
@@ -857,17 +870,17 @@ func (d *cborDecDriver) nextValueBytesBdReadR(v0 []byte) (v []byte) {
 		}
 	case cborMajorTag:
 		bs, _ = d.uintBytes()
-		v = append(v, bs...)
+		h.appendN(&v, bs...)
 		v = d.nextValueBytesR(v)
 	case cborMajorSimpleOrFloat:
 		switch d.bd {
 		case cborBdNil, cborBdUndefined, cborBdFalse, cborBdTrue: // pass
 		case cborBdFloat16:
-			v = append(v, d.d.decRd.readx(2)...)
+			h.appendN(&v, d.d.decRd.readx(2)...)
 		case cborBdFloat32:
-			v = append(v, d.d.decRd.readx(4)...)
+			h.appendN(&v, d.d.decRd.readx(4)...)
 		case cborBdFloat64:
-			v = append(v, d.d.decRd.readx(8)...)
+			h.appendN(&v, d.d.decRd.readx(8)...)
 		default:
 			d.d.errorf("nextValueBytes: Unrecognized d.bd: 0x%x", d.bd)
 		}
@@ -935,12 +948,8 @@ func (h *CborHandle) newDecDriver() decDriver {
 	return d
 }
 
-func (e *cborEncDriver) reset() {
-}
-
 func (d *cborDecDriver) reset() {
-	d.bd = 0
-	d.bdRead = false
+	d.bdAndBdread.reset()
 	d.st = d.h.SkipUnexpectedTags
 }
 
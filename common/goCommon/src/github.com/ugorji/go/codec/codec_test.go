@@ -3,14 +3,6 @@
 
 package codec
 
-// FIXING CORRECT FILE/LINE NUMBERS IN Logf
-//
-// We get wrong file/line numbers for calls to test(Marshal|Unmarshal|DeepEqual)Err helper functions.
-// This is solved by t.Helper function, but this only exists from go1.9+.
-//
-// For now, we don't include t.Helper, so the tests can be run (without a suite)
-// in go 1.4+
-
 // NAMING CONVENTION FOR TESTS
 //
 // function and variable/const names here fit a simple naming convention
@@ -21,6 +13,14 @@ package codec
 // - testXXX variables and constants are only used in tests.
 // - shared functions/vars/consts are testShared...
 // - fnBenchmarkXX and fnTestXXX can be used as needed.
+//
+// - each TestXXX must only call testSetup once.
+// - each test with a prefix __doTest is a dependent helper function,
+//   which MUST not call testSetup itself.
+//   doTestXXX or TestXXX may call it.
+
+// if we are testing in parallel,
+// then we don't want to share much state: testBytesFreeList, etc.
 
 import (
 	"bufio"
@@ -38,7 +38,6 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
-	"runtime"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -50,7 +49,19 @@ func init() {
 	testPreInitFns = append(testPreInitFns, testInit)
 }
 
-const testRecoverPanicToErr = true
+const testRecoverPanicToErr = !debugging
+
+// tests which check for errors will fail if testRecoverPanicToErr=false (debugging=true).
+// Consequently, skip them.
+var testSkipIfNotRecoverPanicToErrMsg = "tests checks for errors, and testRecoverPanicToErr=false"
+
+func testPanicToErr(h errDecorator, err *error) {
+	// Note: This method MUST be called directly from defer i.e. defer testPanicToErr ...
+	// else it seems the recover is not fully handled
+	if x := recover(); x != nil {
+		panicValToErr(h, x, err)
+	}
+}
 
 var testBytesFreeList bytesFreelist
 
@@ -94,6 +105,26 @@ type testIntfMapT2 struct {
 
 func (x testIntfMapT2) GetIntfMapV() string { return x.IntfMapV }
 
+type testMissingFieldsMap struct {
+	m map[string]interface{}
+}
+
+func (mf *testMissingFieldsMap) CodecMissingField(field []byte, value interface{}) bool {
+	if mf.m == nil {
+		mf.m = map[string]interface{}{}
+	}
+
+	(mf.m)[string(field)] = value
+
+	return true
+}
+
+func (mf *testMissingFieldsMap) CodecMissingFields() map[string]interface{} {
+	return mf.m
+}
+
+var _ MissingFielder = (*testMissingFieldsMap)(nil)
+
 var testErrWriterErr = errors.New("testErrWriterErr")
 
 type testErrWriter struct{}
@@ -130,6 +161,8 @@ var (
 
 	// set this when running using bufio, etc
 	testSkipRPCTests = false
+
+	testSkipRPCTestsMsg = "testSkipRPCTests=true"
 )
 
 var (
@@ -149,9 +182,15 @@ var (
 
 	table []interface{} // main items we encode
 	// will encode a float32 as float64, or large int as uint
-	testRpcInt = new(TestRpcInt)
+
+	testRpcServer = rpc.NewServer()
+	testRpcInt    = new(TestRpcInt)
 )
 
+func init() {
+	testRpcServer.Register(testRpcInt)
+}
+
 var wrapInt64Typ = reflect.TypeOf(wrapInt64(0))
 var wrapBytesTyp = reflect.TypeOf(wrapBytes(nil))
 
@@ -216,12 +255,23 @@ type TestRpcABC struct {
 }
 
 type TestRpcInt struct {
-	i int
+	i int64
 }
 
-func (r *TestRpcInt) Update(n int, res *int) error      { r.i = n; *res = r.i; return nil }
-func (r *TestRpcInt) Square(ignore int, res *int) error { *res = r.i * r.i; return nil }
-func (r *TestRpcInt) Mult(n int, res *int) error        { *res = r.i * n; return nil }
+func (r *TestRpcInt) Update(n int, res *int) error {
+	atomic.StoreInt64(&r.i, int64(n))
+	*res = n
+	return nil
+}
+func (r *TestRpcInt) Square(ignore int, res *int) error {
+	i := int(atomic.LoadInt64(&r.i))
+	*res = i * i
+	return nil
+}
+func (r *TestRpcInt) Mult(n int, res *int) error {
+	*res = int(atomic.LoadInt64(&r.i)) * n
+	return nil
+}
 func (r *TestRpcInt) EchoStruct(arg TestRpcABC, res *string) error {
 	*res = fmt.Sprintf("%#v", arg)
 	return nil
@@ -254,12 +304,15 @@ func (x *testUnixNanoTimeExt) ReadExt(v interface{}, bs []byte) {
 	ui := bigenstd.Uint64(bs)
 	*v2 = time.Unix(0, int64(ui)).UTC()
 }
-func (x *testUnixNanoTimeExt) ConvertExt(v interface{}) interface{} {
+
+type testUnixNanoTimeInterfaceExt struct{}
+
+func (x testUnixNanoTimeInterfaceExt) ConvertExt(v interface{}) interface{} {
 	v2 := v.(*time.Time) // structs are encoded by passing the ptr
 	return v2.UTC().UnixNano()
 }
 
-func (x *testUnixNanoTimeExt) UpdateExt(dest interface{}, v interface{}) {
+func (x testUnixNanoTimeInterfaceExt) UpdateExt(dest interface{}, v interface{}) {
 	tt := dest.(*time.Time)
 	*tt = time.Unix(0, v.(int64)).UTC()
 	// switch v2 := v.(type) {
@@ -329,9 +382,9 @@ func (x *wrapBytesExt) UpdateExt(dest interface{}, v interface{}) {
 
 // timeExt is an extension handler for time.Time, that uses binc model for encoding/decoding time.
 // we used binc model, as that is the only custom time representation that we designed ourselves.
-type timeExt struct{}
+type timeBytesExt struct{}
 
-func (x timeExt) WriteExt(v interface{}) (bs []byte) {
+func (x timeBytesExt) WriteExt(v interface{}) (bs []byte) {
 	return bincEncodeTime(*(v.(*time.Time)))
 	// return bincEncodeTime(v.(time.Time))
 	// switch v2 := v.(type) {
@@ -344,18 +397,21 @@ func (x timeExt) WriteExt(v interface{}) (bs []byte) {
 	// }
 	// return
 }
-func (x timeExt) ReadExt(v interface{}, bs []byte) {
+func (x timeBytesExt) ReadExt(v interface{}, bs []byte) {
 	tt, err := bincDecodeTime(bs)
 	if err != nil {
 		panic(err)
 	}
 	*(v.(*time.Time)) = tt
 }
-func (x timeExt) ConvertExt(v interface{}) interface{} {
-	return x.WriteExt(v)
+
+type timeInterfaceExt struct{}
+
+func (x timeInterfaceExt) ConvertExt(v interface{}) interface{} {
+	return timeBytesExt{}.WriteExt(v)
 }
-func (x timeExt) UpdateExt(v interface{}, src interface{}) {
-	x.ReadExt(v, src.([]byte))
+func (x timeInterfaceExt) UpdateExt(v interface{}, src interface{}) {
+	timeBytesExt{}.ReadExt(v, src.([]byte))
 }
 
 // ----
@@ -382,24 +438,34 @@ func (x testUintToBytesExt) UpdateExt(v interface{}, src interface{}) {
 
 func testSetupNoop() {}
 
+func testSetup(t *testing.T, h *Handle) (fn func()) {
+	return testSetupWithChecks(t, h, true)
+}
+
 // testSetup will ensure testInitAll is run, and then
 // return a function that should be deferred to run at the end
 // of the test.
 //
 // This function can track how much time a test took,
 // or recover from panic's and fail the test appropriately.
-func testSetup(t *testing.T) (fn func()) {
+func testSetupWithChecks(t *testing.T, h *Handle, allowParallel bool) (fn func()) {
 	testOnce.Do(testInitAll)
-	// in case an error is seen, recover it here.
-	fnRecoverPanic := func() {
-		if x := recover(); x != nil {
-			var err error
-			panicValToErr(errDecoratorDef{}, x, &err)
-			t.Logf("recovered error: %v", err)
-			t.FailNow()
+	if allowParallel && testUseParallel {
+		t.Parallel()
+		if h != nil {
+			*h = testHandleCopy(*h)
 		}
 	}
+	// in case an error is seen, recover it here.
 	if testRecoverPanicToErr {
+		fnRecoverPanic := func() {
+			if x := recover(); x != nil {
+				var err error
+				panicValToErr(errDecoratorDef{}, x, &err)
+				t.Logf("recovered error: %v", err)
+				t.FailNow()
+			}
+		}
 		fn = fnRecoverPanic
 	}
 	if fn == nil {
@@ -408,12 +474,14 @@ func testSetup(t *testing.T) (fn func()) {
 	return
 }
 
+func testBasicHandle(h Handle) *BasicHandle { return h.getBasicHandle() }
+
 func testCodecEncode(ts interface{}, bsIn []byte, fn func([]byte) *bytes.Buffer, h Handle, useMust bool) (bs []byte, err error) {
-	return testSharedCodecEncode(ts, bsIn, fn, h, basicHandle(h), useMust)
+	return testSharedCodecEncode(ts, bsIn, fn, h, testBasicHandle(h), useMust)
 }
 
 func testCodecDecode(bs []byte, ts interface{}, h Handle, useMust bool) (err error) {
-	return testSharedCodecDecode(bs, ts, h, basicHandle(h), useMust)
+	return testSharedCodecDecode(bs, ts, h, testBasicHandle(h), useMust)
 }
 
 func testCheckErr(t *testing.T, err error) {
@@ -424,6 +492,7 @@ func testCheckErr(t *testing.T, err error) {
 }
 
 func testCheckEqual(t *testing.T, v1 interface{}, v2 interface{}, desc string) {
+	t.Helper()
 	if err := deepEqual(v1, v2); err != nil {
 		t.Logf("Not Equal: %s: %v", desc, err)
 		if testVerbose {
@@ -437,7 +506,8 @@ func testInit() {
 	gob.Register(new(TestStrucFlex))
 
 	for _, v := range testHandles {
-		bh := basicHandle(v)
+		bh := testBasicHandle(v)
+		bh.clearInited() // so it is reinitialized next time around
 		// pre-fill them first
 		bh.EncodeOptions = testEncodeOptions
 		bh.DecodeOptions = testDecodeOptions
@@ -448,7 +518,7 @@ func testInit() {
 		bh.MaxInitLen = testMaxInitLen
 	}
 
-	var tTimeExt timeExt
+	var tTimeExt timeBytesExt
 	var tBytesExt wrapBytesExt
 	var tI64Ext wrapInt64Ext
 	var tUintToBytesExt testUintToBytesExt
@@ -457,12 +527,12 @@ func testInit() {
 	// and use on some places for testSimpleH e.g. for time.Time and wrapInt64
 	var (
 		myExtEncFn = func(x BytesExt, rv reflect.Value) (bs []byte, err error) {
-			defer panicToErr(errDecoratorDef{}, &err)
+			defer testPanicToErr(errDecoratorDef{}, &err)
 			bs = x.WriteExt(rv.Interface())
 			return
 		}
 		myExtDecFn = func(x BytesExt, rv reflect.Value, bs []byte) (err error) {
-			defer panicToErr(errDecoratorDef{}, &err)
+			defer testPanicToErr(errDecoratorDef{}, &err)
 			x.ReadExt(rv.Interface(), bs)
 			return
 		}
@@ -482,8 +552,8 @@ func testInit() {
 	// However, we add these here to ensure nothing happens.
 	chkErr(testSimpleH.AddExt(timeTyp, 1, timeExtEncFn, timeExtDecFn))
 	// testBincH.SetBytesExt(timeTyp, 1, timeExt{}) // time is builtin for binc
-	chkErr(testMsgpackH.SetBytesExt(timeTyp, 1, timeExt{}))
-	chkErr(testCborH.SetInterfaceExt(timeTyp, 1, &testUnixNanoTimeExt{}))
+	chkErr(testMsgpackH.SetBytesExt(timeTyp, 1, timeBytesExt{}))
+	chkErr(testCborH.SetInterfaceExt(timeTyp, 1, testUnixNanoTimeInterfaceExt{}))
 	// testJsonH.SetInterfaceExt(timeTyp, 1, &testUnixNanoTimeExt{})
 
 	// Add extensions for the testSelfExt
@@ -537,6 +607,8 @@ func testInit() {
 		float32(3232.0),
 		float64(6464.0),
 		float64(6464646464.0),
+		complex64(complex(160.0, 0)),
+		complex128(complex(1616, 0)),
 		false,
 		true,
 		"null",
@@ -706,6 +778,10 @@ func testVerifyVal(v interface{}, f testVerifyFlag, h Handle) (v2 interface{}) {
 		v2 = float64(iv)
 	case float64:
 		v2 = float64(iv)
+	case complex64:
+		v2 = float64(float32(real(iv)))
+	case complex128:
+		v2 = float64(real(iv))
 	case []interface{}:
 		m2 := make([]interface{}, len(iv))
 		for j, vj := range iv {
@@ -789,12 +865,42 @@ func testVerifyVal(v interface{}, f testVerifyFlag, h Handle) (v2 interface{}) {
 }
 
 func testReleaseBytes(bs []byte) {
-	testBytesFreeList.put(bs)
+	if !testUseParallel {
+		testBytesFreeList.put(bs)
+	}
+}
+
+func testGetBytes() (bs []byte) {
+	if !testUseParallel {
+		bs = testBytesFreeList.get(64)
+	}
+	return
+}
+
+func testHandleCopy(h Handle) (h2 Handle) {
+	switch v := h.(type) {
+	case *JsonHandle:
+		v2 := *v
+		h2 = &v2
+	case *CborHandle:
+		v2 := *v
+		h2 = &v2
+	case *MsgpackHandle:
+		v2 := *v
+		h2 = &v2
+	case *SimpleHandle:
+		v2 := *v
+		h2 = &v2
+	case *BincHandle:
+		v2 := *v
+		h2 = &v2
+	}
+	return
 }
 
 func testMarshal(v interface{}, h Handle) (bs []byte, err error) {
 	// return testCodecEncode(v, nil, testByteBuf, h)
-	return testCodecEncode(v, testBytesFreeList.get(64), testByteBuf, h, false)
+	return testCodecEncode(v, testGetBytes(), testByteBuf, h, false)
 }
 
 func testUnmarshal(v interface{}, data []byte, h Handle) (err error) {
@@ -802,8 +908,8 @@ func testUnmarshal(v interface{}, data []byte, h Handle) (err error) {
 }
 
 func testMarshalErr(v interface{}, h Handle, t *testing.T, name string) (bs []byte) {
-	// t.Helper()
-	bs, err := testCodecEncode(v, testBytesFreeList.get(64), testByteBuf, h, true)
+	t.Helper()
+	bs, err := testCodecEncode(v, testGetBytes(), testByteBuf, h, true)
 	if err != nil {
 		t.Logf("%s: marshal failed: %v", name, err)
 		if testVerbose {
@@ -815,7 +921,7 @@ func testMarshalErr(v interface{}, h Handle, t *testing.T, name string) (bs []by
 }
 
 func testUnmarshalErr(v interface{}, data []byte, h Handle, t *testing.T, name string) {
-	// t.Helper()
+	t.Helper()
 	err := testCodecDecode(data, v, h, true)
 	if err != nil {
 		t.Logf("%s: unmarshal failed: %v", name, err)
@@ -827,7 +933,7 @@ func testUnmarshalErr(v interface{}, data []byte, h Handle, t *testing.T, name s
 }
 
 func testDeepEqualErr(v1, v2 interface{}, t *testing.T, name string) {
-	// t.Helper()
+	t.Helper()
 	if err := deepEqual(v1, v2); err == nil {
 		if testVerbose {
 			t.Logf("%s: values equal", name)
@@ -858,7 +964,7 @@ func testCodecTableOne(t *testing.T, testNil bool, h Handle,
 	//if testNil, then just test for when a pointer to a nil interface{} is passed. It should work.
 	//Current setup allows us test (at least manually) the nil interface or typed interface.
 	if testVerbose {
-		t.Logf("================ TestNil: %v ================\n", testNil)
+		t.Logf("================ TestNil: %v: %v entries ================\n", testNil, len(vs))
 	}
 
 	if mh, ok := h.(*MsgpackHandle); ok {
@@ -870,7 +976,7 @@ func testCodecTableOne(t *testing.T, testNil bool, h Handle,
 		mh.PositiveIntUnsigned = false
 	}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	for i, v0 := range vs {
 		if testVerbose {
 			t.Logf("..............................................")
@@ -878,30 +984,44 @@ func testCodecTableOne(t *testing.T, testNil bool, h Handle,
 		}
 		// if a TestStrucFlex and we are doing a testNil,
 		// ensure the fields which are not encodeable are set to nil appropriately
-		// i.e. MstrUi64TSelf
+		// i.e. TestStrucFlex.{MstrUi64TSelf, mapMsu2wss}
 		var mapMstrUi64TSelf map[stringUint64T]*stringUint64T
 		var mapMsu2wss map[stringUint64T]wrapStringSlice
+		// TestStrucFlex.{Msp2ss, Mip2ss} have pointer keys.
+		// When we encode and the decode back into the same value,
+		// the length of this map will effectively double, because
+		// each pointer has equal underlying value, but are separate entries in the map.
+		//
+		// Best way to compare is to store them, and then compare them later if needed.
+		var mapMsp2ss map[*string][]string
+		var mapMip2ss map[*uint64][]string
+
 		tsflex, _ := v0.(*TestStrucFlex)
-		if testNil && tsflex != nil {
+		if tsflex != nil {
 			mapMstrUi64TSelf = tsflex.MstrUi64TSelf
 			mapMsu2wss = tsflex.Msu2wss
-			tsflex.MstrUi64TSelf = nil
-			tsflex.Msu2wss = nil
+			mapMsp2ss = tsflex.Msp2ss
+			mapMip2ss = tsflex.Mip2ss
+			if testNil {
+				tsflex.MstrUi64TSelf = nil
+				tsflex.Msu2wss = nil
+			}
 		}
 		b0 := testMarshalErr(v0, h, t, "v0")
 		var b1 = b0
-		if len(b1) > 256 {
-			b1 = b1[:256]
+		if len(b0) > 1024 {
+			b1 = b0[:1024]
 		}
-		if testVerbose {
-			if h.isBinary() {
-				t.Logf("         Encoded bytes: len: %v, %v\n", len(b0), b1)
-			} else {
-				t.Logf("         Encoded string: len: %v, %s\n", len(b0), b0)
-				// println("########### encoded string: " + string(b0))
+		bytesorstr := "string"
+		if h.isBinary() {
+			bytesorstr = "bytes"
+			if len(b0) > 256 {
+				b1 = b0[:256]
 			}
 		}
-
+		if testVerbose {
+			t.Logf("         Encoded %s: type: %T, len/cap: %v/%v, %v, %s\n", bytesorstr, v0, len(b0), cap(b0), b1, "...")
+		}
 		// TestStrucFlex has many fields which will encode differently if SignedInteger - so skip
 		if _, ok := v0.(*TestStrucFlex); ok && bh.SignedInteger {
 			continue
@@ -909,34 +1029,43 @@ func testCodecTableOne(t *testing.T, testNil bool, h Handle,
 
 		var v1 interface{}
 		var err error
-		if testNil {
-			err = testUnmarshal(&v1, b0, h)
-			if tsflex != nil {
+
+		if tsflex != nil {
+			if testNil {
 				tsflex.MstrUi64TSelf = mapMstrUi64TSelf
 				tsflex.Msu2wss = mapMsu2wss
 			}
-		} else {
-			if v0 != nil {
-				v0rt := reflect.TypeOf(v0) // ptr
-				if v0rt.Kind() == reflect.Ptr {
-					err = testUnmarshal(v0, b0, h)
-					v1 = v0
-				} else {
-					rv1 := reflect.New(v0rt)
-					err = testUnmarshal(rv1.Interface(), b0, h)
-					v1 = rv1.Elem().Interface()
-					// v1 = reflect.Indirect(rv4i(v1)).Interface()
-				}
+			tsflex.Msp2ss = nil
+			tsflex.Mip2ss = nil
+		}
+
+		if testNil {
+			err = testUnmarshal(&v1, b0, h)
+		} else if v0 != nil {
+			v0rt := reflect.TypeOf(v0) // ptr
+			if v0rt.Kind() == reflect.Ptr {
+				err = testUnmarshal(v0, b0, h)
+				v1 = v0
+			} else {
+				rv1 := reflect.New(v0rt)
+				err = testUnmarshal(rv1.Interface(), b0, h)
+				v1 = rv1.Elem().Interface()
+				// v1 = reflect.Indirect(reflect.ValueOf(v1)).Interface()
 			}
 		}
 
+		if tsflex != nil {
+			// MARKER: consider compare tsflex.{Msp2ss, Mip2ss} to map{Msp2ss, Mip2ss}
+			_, _ = mapMsp2ss, mapMip2ss
+		}
+
 		if testVerbose {
 			t.Logf("         v1 returned: %T, %v %#v", v1, v1, v1)
 		}
 		// if v1 != nil {
 		//	t.Logf("         v1 returned: %T, %#v", v1, v1)
 		//	//we always indirect, because ptr to typed value may be passed (if not testNil)
-		//	v1 = reflect.Indirect(rv4i(v1)).Interface()
+		//	v1 = reflect.Indirect(reflect.ValueOf(v1)).Interface()
 		// }
 		if err != nil {
 			t.Logf("-------- Error: %v", err)
@@ -971,9 +1100,10 @@ func testCodecTableOne(t *testing.T, testNil bool, h Handle,
 }
 
 func doTestCodecTableOne(t *testing.T, h Handle) {
-	defer testSetup(t)()
-	// func TestMsgpackAllExperimental(t *testing.T) {
-	// dopts := testDecOpts(nil, nil, false, true, true),
+	// since this test modifies maps (and slices?), it should not be run in parallel,
+	// else we may get "concurrent modification/range/set" errors.
+
+	defer testSetupWithChecks(t, &h, false)()
 
 	numPrim, numMap, idxTime, idxMap := testTableNumPrimitives, testTableNumMaps, testTableIdxTime, testTableNumPrimitives+2
 
@@ -1000,7 +1130,7 @@ func doTestCodecTableOne(t *testing.T, h Handle) {
 	// testCodecTableOne(t, false, h, table[numPrim+1:], tableVerify[numPrim+1:])
 	// func TestMsgpackNilStringMap(t *testing.T) {
 	var oldMapType reflect.Type
-	v := basicHandle(h)
+	v := testBasicHandle(h)
 
 	oldMapType, v.MapType = v.MapType, testMapStrIntfTyp
 	// defer func() { v.MapType = oldMapType }()
@@ -1016,21 +1146,10 @@ func doTestCodecTableOne(t *testing.T, h Handle) {
 	//testCodecTableOne(t, true, h, table[17:18], tableTestNilVerify[17:18]) // do we need this?
 }
 
-func testEmptyTestStrucFlex() *TestStrucFlex {
-	var ts TestStrucFlex
-	// we initialize and start draining the chan, so that we can decode into it without it blocking due to no consumer
-	ts.Chstr = make(chan string, teststrucflexChanCap)
-	go func() {
-		for range ts.Chstr {
-		}
-	}() // drain it
-	return &ts
-}
-
 func doTestCodecMiscOne(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	var err error
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	b := testMarshalErr(32, h, t, "32")
 	// Cannot do this nil one, because faster type assertion decoding will panic
 	// var i *int32
@@ -1064,12 +1183,22 @@ func doTestCodecMiscOne(t *testing.T, h Handle) {
 			t.Logf("------- b: size: %v, value: %s", len(b), b1)
 		}
 	}
-	ts2 := testEmptyTestStrucFlex()
+
+	// ts2 := testEmptyTestStrucFlex()
+	var ts2 = new(TestStrucFlex)
+	// we initialize and start draining the chan, so that we can decode into it without it blocking due to no consumer
+	ts2.Chstr = make(chan string, teststrucflexChanCap)
+	go func() {
+		for range ts2.Chstr {
+		}
+	}() // drain it
+
 	testUnmarshalErr(ts2, b, h, t, "pointer-to-struct")
 	if ts2.I64 != math.MaxInt64*2/3 {
 		t.Logf("------- Unmarshal wrong. Expect I64 = 64. Got: %v", ts2.I64)
 		t.FailNow()
 	}
+	close(ts2.Chstr)
 	testReleaseBytes(b)
 
 	// Note: These will not work with SliceElementReset or InterfaceReset=true, so handle that.
@@ -1094,7 +1223,6 @@ func doTestCodecMiscOne(t *testing.T, h Handle) {
 		t.FailNow()
 	}
 
-	// log("m: %v, m2: %v, p: %v, p2: %v", m, m2, p, p2)
 	testCheckEqual(t, p, p2, "p=p2")
 	testCheckEqual(t, m, m2, "m=m2")
 	if err = deepEqual(p, p2); err == nil {
@@ -1153,7 +1281,7 @@ func doTestCodecMiscOne(t *testing.T, h Handle) {
 	}
 
 	// test byte array, even if empty (msgpack only)
-	if h == testMsgpackH {
+	if _, ok := h.(*MsgpackHandle); ok {
 		type ystruct struct {
 			Anarray []byte
 		}
@@ -1178,7 +1306,7 @@ func doTestCodecMiscOne(t *testing.T, h Handle) {
 }
 
 func doTestCodecEmbeddedPointer(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	type Z int
 	type A struct {
 		AnInt int
@@ -1198,7 +1326,7 @@ func doTestCodecEmbeddedPointer(t *testing.T, h Handle) {
 }
 
 func testCodecUnderlyingType(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// Manual Test.
 	// Run by hand, with accompanying printf.statements in fast-path.go
 	// to ensure that the fast functions are called.
@@ -1220,7 +1348,7 @@ func testCodecUnderlyingType(t *testing.T, h Handle) {
 }
 
 func doTestCodecChan(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// - send a slice []*int64 (sl1) into an chan (ch1) with cap > len(s1)
 	// - encode ch1 as a stream array
 	// - decode a chan (ch2), with cap > len(s1) from the stream array
@@ -1346,13 +1474,19 @@ func doTestCodecChan(t *testing.T, h Handle) {
 			t.FailNow()
 		}
 	}
-
 }
 
 func doTestCodecRpcOne(t *testing.T, rr Rpc, h Handle, doRequest bool, exitSleepMs time.Duration) (port int) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	if testSkipRPCTests {
-		return
+		t.Skip(testSkipRPCTestsMsg)
+	}
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+
+	if mh, ok := h.(*MsgpackHandle); ok && mh.SliceElementReset {
+		t.Skipf("skipping ... MsgpackRpcSpec does not handle SliceElementReset - needs investigation")
 	}
 
 	if jsonH, ok := h.(*JsonHandle); ok && !jsonH.TermWhitespace {
@@ -1360,100 +1494,115 @@ func doTestCodecRpcOne(t *testing.T, rr Rpc, h Handle, doRequest bool, exitSleep
 		defer func() { jsonH.TermWhitespace = false }()
 	}
 
-	srv := rpc.NewServer()
-	srv.Register(testRpcInt)
+	// srv := rpc.NewServer()
+	// srv.Register(testRpcInt)
+	srv := testRpcServer
+
 	ln, err := net.Listen("tcp", "127.0.0.1:0") // listen on ipv4 localhost
-	if testVerbose {
-		t.Logf("connFn: addr: %v, network: %v, port: %v", ln.Addr(), ln.Addr().Network(), (ln.Addr().(*net.TCPAddr)).Port)
-	}
-	// log("listener: %v", ln.Addr())
 	testCheckErr(t, err)
 	port = (ln.Addr().(*net.TCPAddr)).Port
+	if testVerbose {
+		t.Logf("connFn: addr: %v, network: %v, port: %v", ln.Addr(), ln.Addr().Network(), port)
+	}
 	// var opts *DecoderOptions
 	// opts := testDecOpts
 	// opts.MapType = mapStrIntfTyp
 	serverExitChan := make(chan bool, 1)
 	var serverExitFlag uint64
 	serverFn := func() {
+		var conns []net.Conn
+		var svrcodecs []rpc.ServerCodec
+		defer func() {
+			for i := range conns {
+				svrcodecs[i].Close()
+				conns[i].Close()
+			}
+			ln.Close()
+			serverExitChan <- true
+		}()
 		for {
 			conn1, err1 := ln.Accept()
-			// if err1 != nil {
-			// 	//fmt.Printf("accept err1: %v\n", err1)
-			// 	continue
-			// }
 			if atomic.LoadUint64(&serverExitFlag) == 1 {
-				serverExitChan <- true
 				if conn1 != nil {
 					conn1.Close()
 				}
-				return // exit serverFn goroutine
+				break
+			}
+			if err1 != nil {
+				// fmt.Printf("accept err1: %v\n", err1)
+				if testVerbose {
+					t.Logf("rpc error accepting connection: %v", err1)
+				}
+				continue
 			}
-			if err1 == nil && conn1 != nil {
+			if conn1 != nil {
 				sc := rr.ServerCodec(testReadWriteCloser(conn1), h)
-				srv.ServeCodec(sc)
+				conns = append(conns, conn1)
+				svrcodecs = append(svrcodecs, sc)
+				go srv.ServeCodec(sc)
 			}
 		}
 	}
 
-	clientFn := func(cc rpc.ClientCodec) {
-		cl := rpc.NewClientWithCodec(cc)
-		defer cl.Close()
-		//	defer func() { println("##### client closing"); cl.Close() }()
-		var up, sq, mult int
-		var rstr string
-		// log("Calling client")
-		testCheckErr(t, cl.Call("TestRpcInt.Update", 5, &up))
-		// log("Called TestRpcInt.Update")
-		testCheckEqual(t, testRpcInt.i, 5, "testRpcInt.i=5")
-		testCheckEqual(t, up, 5, "up=5")
-		testCheckErr(t, cl.Call("TestRpcInt.Square", 1, &sq))
-		testCheckEqual(t, sq, 25, "sq=25")
-		testCheckErr(t, cl.Call("TestRpcInt.Mult", 20, &mult))
-		testCheckEqual(t, mult, 100, "mult=100")
-		testCheckErr(t, cl.Call("TestRpcInt.EchoStruct", TestRpcABC{"Aa", "Bb", "Cc"}, &rstr))
-		testCheckEqual(t, rstr, fmt.Sprintf("%#v", TestRpcABC{"Aa", "Bb", "Cc"}), "rstr=")
-		testCheckErr(t, cl.Call("TestRpcInt.Echo123", []string{"A1", "B2", "C3"}, &rstr))
-		testCheckEqual(t, rstr, fmt.Sprintf("%#v", []string{"A1", "B2", "C3"}), "rstr=")
-	}
-
 	connFn := func() (bs net.Conn) {
-		// log("calling f1")
 		bs, err2 := net.Dial(ln.Addr().Network(), ln.Addr().String())
 		testCheckErr(t, err2)
 		return
 	}
 
 	exitFn := func() {
+		if atomic.LoadUint64(&serverExitFlag) == 1 {
+			return
+		}
 		atomic.StoreUint64(&serverExitFlag, 1)
 		bs := connFn()
+		defer bs.Close()
 		<-serverExitChan
-		bs.Close()
+		// atomic.StoreUint64(&serverExitFlag, 0)
 		// serverExitChan <- true
 	}
 
 	go serverFn()
-	runtime.Gosched()
+
+	// runtime.Gosched()
+
 	if exitSleepMs == 0 {
-		defer ln.Close()
 		defer exitFn()
-	}
-	if doRequest {
-		bs := connFn()
-		cc := rr.ClientCodec(testReadWriteCloser(bs), h)
-		clientFn(cc)
-	}
-	if exitSleepMs != 0 {
+	} else {
 		go func() {
-			defer ln.Close()
 			time.Sleep(exitSleepMs)
 			exitFn()
 		}()
 	}
+
+	if doRequest {
+		func() {
+			bs := connFn()
+			defer bs.Close()
+			cc := rr.ClientCodec(testReadWriteCloser(bs), h)
+			defer cc.Close()
+			cl := rpc.NewClientWithCodec(cc)
+			defer cl.Close()
+			var up, sq, mult int
+			var rstr string
+			testCheckErr(t, cl.Call("TestRpcInt.Update", 5, &up))
+			testCheckEqual(t, atomic.LoadInt64(&testRpcInt.i), int64(5), "testRpcInt.i=5")
+			testCheckEqual(t, up, 5, "up=5")
+			testCheckErr(t, cl.Call("TestRpcInt.Square", 1, &sq))
+			testCheckEqual(t, sq, 25, "sq=25")
+			testCheckErr(t, cl.Call("TestRpcInt.Mult", 20, &mult))
+			testCheckEqual(t, mult, 100, "mult=100")
+			testCheckErr(t, cl.Call("TestRpcInt.EchoStruct", TestRpcABC{"Aa", "Bb", "Cc"}, &rstr))
+			testCheckEqual(t, rstr, fmt.Sprintf("%#v", TestRpcABC{"Aa", "Bb", "Cc"}), "rstr=")
+			testCheckErr(t, cl.Call("TestRpcInt.Echo123", []string{"A1", "B2", "C3"}, &rstr))
+			testCheckEqual(t, rstr, fmt.Sprintf("%#v", []string{"A1", "B2", "C3"}), "rstr=")
+		}()
+	}
 	return
 }
 
 func doTestMapEncodeForCanonical(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// println("doTestMapEncodeForCanonical")
 	v1 := map[stringUint64T]interface{}{
 		stringUint64T{"a", 1}: 1,
@@ -1509,11 +1658,20 @@ func doTestMapEncodeForCanonical(t *testing.T, h Handle) {
 	if ch, ok := h.(*CborHandle); ok {
 		cborIndef = ch.IndefiniteLength
 	}
-	bh := basicHandle(h)
-	if !bh.Canonical {
-		bh.Canonical = true
-		defer func() { bh.Canonical = false }()
-	}
+
+	// if ch, ok := h.(*BincHandle); ok && ch.AsSymbols != 2 {
+	// 	defer func(u uint8) { ch.AsSymbols = u }(ch.AsSymbols)
+	// 	ch.AsSymbols = 2
+	// }
+
+	bh := testBasicHandle(h)
+
+	defer func(c, si bool) {
+		bh.Canonical = c
+		bh.SignedInteger = si
+	}(bh.Canonical, bh.SignedInteger)
+	bh.Canonical = true
+	// bh.SignedInteger = true
 
 	e1 := NewEncoderBytes(&b1, h)
 	e1.MustEncode(v1)
@@ -1532,14 +1690,14 @@ func doTestMapEncodeForCanonical(t *testing.T, h Handle) {
 	if !bytes.Equal(b1t, b2t) {
 		t.Logf("Unequal bytes of length: %v vs %v", len(b1t), len(b2t))
 		if testVerbose {
-			t.Logf("Unequal bytes: %v VS %v", b1t, b2t)
+			t.Logf("Unequal bytes: \n\t%v \n\tVS \n\t%v", b1t, b2t)
 		}
 		t.FailNow()
 	}
 }
 
 func doTestStdEncIntf(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	args := [][2]interface{}{
 		{&TestABC{"A", "BB", "CCC"}, new(TestABC)},
 		{&TestABC2{"AAA", "BB", "C"}, new(TestABC2)},
@@ -1562,7 +1720,10 @@ func doTestStdEncIntf(t *testing.T, h Handle) {
 }
 
 func doTestEncCircularRef(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
 	type T1 struct {
 		S string
 		B bool
@@ -1584,7 +1745,7 @@ func doTestEncCircularRef(t *testing.T, h Handle) {
 	var bs []byte
 	var err error
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	if !bh.CheckCircularRef {
 		bh.CheckCircularRef = true
 		defer func() { bh.CheckCircularRef = false }()
@@ -1621,7 +1782,7 @@ type (
 )
 
 func doTestAnonCycle(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	var x TestAnonCycleT1
 	x.S = "hello"
 	x.TestAnonCycleT2.S2 = "hello.2"
@@ -1630,21 +1791,33 @@ func doTestAnonCycle(t *testing.T, h Handle) {
 	// just check that you can get typeInfo for T1
 	rt := reflect.TypeOf((*TestAnonCycleT1)(nil)).Elem()
 	rtid := rt2id(rt)
-	pti := basicHandle(h).getTypeInfo(rtid, rt)
+	pti := testBasicHandle(h).getTypeInfo(rtid, rt)
 	if testVerbose {
 		t.Logf("[%s] pti: %v", h.Name(), pti)
 	}
 }
 
-func doTestErrWriter(t *testing.T, h Handle) {
-	defer testSetup(t)()
+func doTestAllErrWriter(t *testing.T, hh ...Handle) {
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, nil)()
+	for _, h := range hh {
+		if testUseParallel {
+			h = testHandleCopy(h)
+		}
+		__doTestErrWriter(t, h)
+	}
+}
+
+func __doTestErrWriter(t *testing.T, h Handle) {
 	name := h.Name()
 	var ew testErrWriter
 	w := bufio.NewWriterSize(&ew, 4)
 	enc := NewEncoder(w, h)
 	for i := 0; i < 4; i++ {
 		err := enc.Encode("ugorji")
-		if ev, ok := err.(encodeError); ok {
+		if ev, ok := err.(*codecError); ok {
 			err = ev.Cause()
 		}
 		if err != testErrWriterErr {
@@ -1654,23 +1827,22 @@ func doTestErrWriter(t *testing.T, h Handle) {
 	}
 }
 
-func doTestJsonLargeInteger(t *testing.T, v interface{}, ias uint8) {
-	defer testSetup(t)()
+func __doTestJsonLargeInteger(t *testing.T, v interface{}, ias uint8, jh *JsonHandle) {
 	if testVerbose {
-		t.Logf("Running doTestJsonLargeInteger: v: %#v, ias: %c", v, ias)
+		t.Logf("Running TestJsonLargeInteger: v: %#v, ias: %c", v, ias)
 	}
-	oldIAS := testJsonH.IntegerAsString
-	defer func() { testJsonH.IntegerAsString = oldIAS }()
-	testJsonH.IntegerAsString = ias
+	oldIAS := jh.IntegerAsString
+	defer func() { jh.IntegerAsString = oldIAS }()
+	jh.IntegerAsString = ias
 
 	var vu uint
 	var vi int
 	var vb bool
 	var b []byte
-	e := NewEncoderBytes(&b, testJsonH)
+	e := NewEncoderBytes(&b, jh)
 	e.MustEncode(v)
 	e.MustEncode(true)
-	d := NewDecoderBytes(b, testJsonH)
+	d := NewDecoderBytes(b, jh)
 	// below, we validate that the json string or number was encoded,
 	// then decode, and validate that the correct value was decoded.
 	fnStrChk := func() {
@@ -1728,8 +1900,8 @@ func doTestJsonLargeInteger(t *testing.T, v interface{}, ias uint8) {
 }
 
 func doTestRawValue(t *testing.T, h Handle) {
-	defer testSetup(t)()
-	bh := basicHandle(h)
+	defer testSetup(t, &h)()
+	bh := testBasicHandle(h)
 	if !bh.Raw {
 		bh.Raw = true
 		defer func() { bh.Raw = false }()
@@ -1777,7 +1949,7 @@ func doTestRawValue(t *testing.T, h Handle) {
 // We keep this unexported here, and put actual test in ext_dep_test.go.
 // This way, it can be excluded by excluding file completely.
 func doTestPythonGenStreams(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 
 	// time0 := time.Now()
 	// defer func() { xdebugf("python-gen-streams: %s: took: %v", h.Name(), time.Since(time0)) }()
@@ -1805,7 +1977,7 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 		t.FailNow()
 	}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 
 	defer func(tt reflect.Type) { bh.MapType = tt }(bh.MapType)
 	defer func(b bool) { bh.RawToString = b }(bh.RawToString)
@@ -1816,9 +1988,7 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 	oldMapType := bh.MapType
 	tablePythonVerify := testTableVerify(testVerifyForPython|testVerifyTimeAsInteger|testVerifyMapTypeStrIntf, h)
 	for i, v := range tablePythonVerify {
-		// if v == uint64(0) && h == testMsgpackH {
-		// 	v = int64(0)
-		// }
+		// if v == uint64(0) && h is a *MsgpackHandle: v = int64(0)
 		bh.MapType = oldMapType
 		//load up the golden file based on number
 		//decode it
@@ -1848,7 +2018,7 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 			continue
 		}
 		//no need to indirect, because we pass a nil ptr, so we already have the value
-		//if v1 != nil { v1 = reflect.Indirect(rv4i(v1)).Interface() }
+		//if v1 != nil { v1 = reflect.Indirect(reflect.ValueOf(v1)).Interface() }
 		if err = deepEqual(v, v1); err == nil {
 			if testVerbose {
 				t.Logf("++++++++ Objects match: %T, %v", v, v)
@@ -1857,8 +2027,8 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 			t.Logf("-------- FAIL: Objects do not match: %v. Source: %T. Decoded: %T", err, v, v1)
 			if testVerbose {
 				t.Logf("--------   GOLDEN: %#v", v)
-				// t.Logf("--------   DECODED: %#v <====> %#v", v1, reflect.Indirect(rv4i(v1)).Interface())
-				t.Logf("--------   DECODED: %#v <====> %#v", v1, reflect.Indirect(rv4i(v1)).Interface())
+				// t.Logf("--------   DECODED: %#v <====> %#v", v1, reflect.Indirect(reflect.ValueOf(v1)).Interface())
+				t.Logf("--------   DECODED: %#v <====> %#v", v1, reflect.Indirect(reflect.ValueOf(v1)).Interface())
 			}
 			t.FailNow()
 		}
@@ -1874,7 +2044,7 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 			}
 		} else {
 			xs := "--------"
-			if rv4i(v).Kind() == reflect.Map {
+			if reflect.ValueOf(v).Kind() == reflect.Map {
 				xs = "        "
 				if testVerbose {
 					t.Logf("%s FAIL - bytes do not match, but it's a map (ok - dependent on ordering): %v", xs, err)
@@ -1902,11 +2072,11 @@ func doTestPythonGenStreams(t *testing.T, h Handle) {
 //    - Go Service requires only one argument
 //    - Python Service allows multiple arguments
 
-func doTestMsgpackRpcSpecGoClientToPythonSvc(t *testing.T) {
+func doTestMsgpackRpcSpecGoClientToPythonSvc(t *testing.T, h Handle) {
 	if testSkipRPCTests {
-		return
+		t.Skip(testSkipRPCTestsMsg)
 	}
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 
 	// openPorts are between 6700 and 6800
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -1922,7 +2092,7 @@ func doTestMsgpackRpcSpecGoClientToPythonSvc(t *testing.T) {
 		bs, err2 = net.Dial("tcp", ":"+openPort)
 	}
 	testCheckErr(t, err2)
-	cc := MsgpackSpecRpc.ClientCodec(testReadWriteCloser(bs), testMsgpackH)
+	cc := MsgpackSpecRpc.ClientCodec(testReadWriteCloser(bs), h)
 	cl := rpc.NewClientWithCodec(cc)
 	defer cl.Close()
 	var rstr string
@@ -1934,14 +2104,14 @@ func doTestMsgpackRpcSpecGoClientToPythonSvc(t *testing.T) {
 	cmd.Process.Kill()
 }
 
-func doTestMsgpackRpcSpecPythonClientToGoSvc(t *testing.T) {
+func doTestMsgpackRpcSpecPythonClientToGoSvc(t *testing.T, h Handle) {
 	if testSkipRPCTests {
-		return
+		t.Skip(testSkipRPCTestsMsg)
 	}
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// seems 10ms is not enough for test run; set high to 1 second, and client will close when done
 	exitSleepDur := 1000 * time.Millisecond
-	port := doTestCodecRpcOne(t, MsgpackSpecRpc, testMsgpackH, false, exitSleepDur)
+	port := doTestCodecRpcOne(t, MsgpackSpecRpc, h, false, exitSleepDur)
 	cmd := exec.Command("python", "test.py", "rpc-client-go-service", strconv.Itoa(port))
 	var cmdout []byte
 	var err error
@@ -1955,7 +2125,7 @@ func doTestMsgpackRpcSpecPythonClientToGoSvc(t *testing.T) {
 }
 
 func doTestSwallowAndZero(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	v1 := newTestStrucFlex(testDepth, testNumRepeatString, false, false, testMapStringKeyOnly)
 	var b1 []byte
 
@@ -1972,12 +2142,12 @@ func doTestSwallowAndZero(t *testing.T, h Handle) {
 }
 
 func doTestRawExt(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	var b []byte
 	var v RawExt // interface{}
 	_, isJson := h.(*JsonHandle)
 	_, isCbor := h.(*CborHandle)
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	// isValuer := isJson || isCbor
 	// _ = isValuer
 	for _, r := range []RawExt{
@@ -2044,10 +2214,10 @@ func doTestRawExt(t *testing.T, h Handle) {
 // }
 
 func doTestMapStructKey(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	var b []byte
 	var v interface{} // map[stringUint64T]wrapUint64Slice // interface{}
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	m := map[stringUint64T]wrapUint64Slice{
 		stringUint64T{"55555", 55555}: []wrapUint64{12345},
 		stringUint64T{"333", 333}:     []wrapUint64{123},
@@ -2072,12 +2242,12 @@ func doTestMapStructKey(t *testing.T, h Handle) {
 }
 
 func doTestDecodeNilMapValue(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	type Struct struct {
 		Field map[uint16]map[uint32]struct{}
 	}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	defer func(t reflect.Type) {
 		bh.MapType = t
 	}(bh.MapType)
@@ -2103,12 +2273,10 @@ func doTestDecodeNilMapValue(t *testing.T, h Handle) {
 	}
 	testReleaseBytes(bs)
 
-	doTestDecodeNilMapEntryValue(t, h)
+	__doTestDecodeNilMapEntryValue(t, h)
 }
 
-func doTestDecodeNilMapEntryValue(t *testing.T, h Handle) {
-	defer testSetup(t)()
-
+func __doTestDecodeNilMapEntryValue(t *testing.T, h Handle) {
 	type Entry struct{}
 	type Entries struct {
 		Map map[string]*Entry
@@ -2144,7 +2312,7 @@ func doTestDecodeNilMapEntryValue(t *testing.T, h Handle) {
 }
 
 func doTestEmbeddedFieldPrecedence(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	type Embedded struct {
 		Field byte
 	}
@@ -2157,7 +2325,7 @@ func doTestEmbeddedFieldPrecedence(t *testing.T, h Handle) {
 		Embedded: Embedded{Field: 2},
 	}
 	_, isJsonHandle := h.(*JsonHandle)
-	handle := basicHandle(h)
+	handle := testBasicHandle(h)
 	oldMapType := handle.MapType
 	defer func() { handle.MapType = oldMapType }()
 
@@ -2187,7 +2355,7 @@ func doTestEmbeddedFieldPrecedence(t *testing.T, h Handle) {
 }
 
 func doTestLargeContainerLen(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 
 	// This test can take a while if run multiple times in a loop, as it creates
 	// large maps/slices. Use t.Short() appropriately to limit its execution time.
@@ -2201,7 +2369,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 		t.Skipf("skipping as cbor Indefinite Length doesn't use prefixed lengths")
 	}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 
 	var sizes []int
 	// sizes = []int{
@@ -2238,7 +2406,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 	testDeepEqualErr(m, m2, t, "-slices")
 
 	d, x := testSharedCodecDecoder(bs, h, bh)
-	bs2 := d.d.nextValueBytes(nil)
+	bs2 := d.d.nextValueBytes([]byte{})
 	testSharedCodecDecoderAfter(d, x, bh)
 	testDeepEqualErr(bs, bs2, t, "nextvaluebytes-slices")
 	// if len(bs2) != 0 || len(bs2) != len(bs) { }
@@ -2256,7 +2424,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 		testDeepEqualErr(mm, mm2, t, "-map")
 
 		d, x = testSharedCodecDecoder(bs, h, bh)
-		bs2 = d.d.nextValueBytes(nil)
+		bs2 = d.d.nextValueBytes([]byte{})
 		testSharedCodecDecoderAfter(d, x, bh)
 		testDeepEqualErr(bs, bs2, t, "nextvaluebytes-map")
 		testReleaseBytes(bs)
@@ -2304,7 +2472,8 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 	for _, i := range sizes {
 		var m1, m2 map[string]bool
 		m1 = make(map[string]bool, 1)
-		var s1 = stringView(in[:i])
+		// var s1 = stringView(in[:i])
+		var s1 = string(in[:i])
 		// fmt.Printf("testcontainerlen: large string: i: %v, |%s|\n", i, s1)
 		m1[s1] = true
 
@@ -2320,7 +2489,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 		testDeepEqualErr(m1, m2, t, "no-symbols-string")
 
 		d, x = testSharedCodecDecoder(out, h, bh)
-		bs2 = d.d.nextValueBytes(nil)
+		bs2 = d.d.nextValueBytes([]byte{})
 		testSharedCodecDecoderAfter(d, x, bh)
 		testDeepEqualErr(out, bs2, t, "nextvaluebytes-no-symbols-string")
 
@@ -2336,7 +2505,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 			testDeepEqualErr(m1, m2, t, "symbols-string")
 
 			d, x = testSharedCodecDecoder(out, h, bh)
-			bs2 = d.d.nextValueBytes(nil)
+			bs2 = d.d.nextValueBytes([]byte{})
 			testSharedCodecDecoderAfter(d, x, bh)
 			testDeepEqualErr(out, bs2, t, "nextvaluebytes-symbols-string")
 			hbinc.AsSymbols = 2
@@ -2351,7 +2520,7 @@ func doTestLargeContainerLen(t *testing.T, h Handle) {
 	testDeepEqualErr(xl, xl2, t, "-large-extension-bytes")
 
 	d, x = testSharedCodecDecoder(bs, h, bh)
-	bs2 = d.d.nextValueBytes(nil)
+	bs2 = d.d.nextValueBytes([]byte{})
 	testSharedCodecDecoderAfter(d, x, bh)
 	testDeepEqualErr(bs, bs2, t, "nextvaluebytes-large-extension-bytes")
 
@@ -2384,7 +2553,7 @@ func testRandomFillRV(v reflect.Value) {
 		testRandomFillRV(v.Elem())
 	case reflect.Interface:
 		if v.IsNil() {
-			v.Set(rv4i("nothing"))
+			v.Set(reflect.ValueOf("nothing"))
 		} else {
 			testRandomFillRV(v.Elem())
 		}
@@ -2427,6 +2596,8 @@ func testRandomFillRV(v reflect.Value) {
 		v.SetBool(fneg() == 1)
 	case reflect.Float32, reflect.Float64:
 		v.SetFloat(float64(fneg()) * float64(rand.Float32()))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(float64(fneg())*float64(rand.Float32()), 0))
 	case reflect.String:
 		// ensure this string can test the extent of json string decoding
 		v.SetString(strings.Repeat(strconv.FormatInt(rand.Int63n(99), 10), rand.Intn(8)) +
@@ -2436,35 +2607,8 @@ func testRandomFillRV(v reflect.Value) {
 	}
 }
 
-func doTestMammoth(t *testing.T, h Handle) {
-	defer testSetup(t)()
-	name := h.Name()
-	var b []byte
-
-	var m, m2 TestMammoth
-	testRandomFillRV(rv4i(&m).Elem())
-	b = testMarshalErr(&m, h, t, "mammoth-"+name)
-
-	testUnmarshalErr(&m2, b, h, t, "mammoth-"+name)
-	testDeepEqualErr(&m, &m2, t, "mammoth-"+name)
-	testReleaseBytes(b)
-
-	if testing.Short() {
-		t.Skipf("skipping rest of mammoth test in -short mode")
-	}
-
-	var mm, mm2 TestMammoth2Wrapper
-	testRandomFillRV(rv4i(&mm).Elem())
-	b = testMarshalErr(&mm, h, t, "mammoth2-"+name)
-	// os.Stderr.Write([]byte("\n\n\n\n" + string(b) + "\n\n\n\n"))
-	testUnmarshalErr(&mm2, b, h, t, "mammoth2-"+name)
-	testDeepEqualErr(&mm, &mm2, t, "mammoth2-"+name)
-	// testMammoth2(t, name, h)
-	testReleaseBytes(b)
-}
-
 func doTestTime(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	// test time which uses the time.go implementation (ie Binc)
 	var tt, tt2 time.Time
@@ -2483,7 +2627,7 @@ func doTestTime(t *testing.T, h Handle) {
 }
 
 func doTestUintToInt(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	var golden = [...]int64{
 		0, 1, 22, 333, 4444, 55555, 666666,
@@ -2549,7 +2693,16 @@ func doTestUintToInt(t *testing.T, h Handle) {
 }
 
 func doTestDifferentMapOrSliceType(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
+
+	if mh, ok := h.(*MsgpackHandle); ok {
+		defer func(b bool) { mh.RawToString = b }(mh.RawToString)
+		mh.RawToString = true
+	}
+
 	name := h.Name()
 
 	// - maptype, slicetype: diff from map[string]intf, map[intf]intf or []intf, etc
@@ -2557,7 +2710,7 @@ func doTestDifferentMapOrSliceType(t *testing.T, h Handle) {
 	//   To test, take a sequence of []byte and string, and decode into []string and []interface.
 	//   Also, decode into map[string]string, map[string]interface{}, map[interface{}]string
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	defer func(oldM, oldS reflect.Type) { bh.MapType, bh.SliceType = oldM, oldS }(bh.MapType, bh.SliceType)
 
 	// test for arrays first
@@ -2751,7 +2904,12 @@ func doTestDifferentMapOrSliceType(t *testing.T, h Handle) {
 }
 
 func doTestScalars(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
+
+	if mh, ok := h.(*MsgpackHandle); ok {
+		defer func(b bool) { mh.RawToString = b }(mh.RawToString)
+		mh.RawToString = true
+	}
 
 	// for each scalar:
 	// - encode its ptr
@@ -2764,7 +2922,7 @@ func doTestScalars(t *testing.T, h Handle) {
 	// - decode into new
 	// - compare to original
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	if !bh.Canonical {
 		bh.Canonical = true
 		defer func() { bh.Canonical = false }()
@@ -2852,10 +3010,10 @@ func doTestScalars(t *testing.T, h Handle) {
 }
 
 func doTestIntfMapping(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	rti := reflect.TypeOf((*testIntfMapI)(nil)).Elem()
-	defer func() { basicHandle(h).Intf2Impl(rti, nil) }()
+	defer func() { testBasicHandle(h).Intf2Impl(rti, nil) }()
 
 	type T9 struct {
 		I testIntfMapI
@@ -2866,7 +3024,7 @@ func doTestIntfMapping(t *testing.T, h Handle) {
 		&testIntfMapT1{"ABC \x41=\x42 \u2318 - \r \b \f - \u2028 and \u2029 ."},
 		testIntfMapT2{"DEF"},
 	} {
-		if err := basicHandle(h).Intf2Impl(rti, reflect.TypeOf(v)); err != nil {
+		if err := testBasicHandle(h).Intf2Impl(rti, reflect.TypeOf(v)); err != nil {
 			t.Logf("Error mapping %v to %T", rti, v)
 			t.FailNow()
 		}
@@ -2880,9 +3038,9 @@ func doTestIntfMapping(t *testing.T, h Handle) {
 }
 
 func doTestOmitempty(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
-	if basicHandle(h).StructToArray {
+	if testBasicHandle(h).StructToArray {
 		t.Skipf("skipping OmitEmpty test when StructToArray=true")
 	}
 	type T1 struct {
@@ -2903,12 +3061,12 @@ func doTestOmitempty(t *testing.T, h Handle) {
 }
 
 func doTestMissingFields(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	if codecgen {
 		t.Skipf("skipping Missing Fields tests as it is not honored by codecgen")
 	}
-	if basicHandle(h).StructToArray {
+	if testBasicHandle(h).StructToArray {
 		t.Skipf("skipping Missing Fields test when StructToArray=true")
 	}
 	// encode missingFielderT2, decode into missingFielderT1, encode it out again, decode into new missingFielderT2, compare
@@ -2935,10 +3093,51 @@ func doTestMissingFields(t *testing.T, h Handle) {
 	testDeepEqualErr(m4, map[string]string{"s1": "s111", "S2": "S222"}, t, name+"-missing-cmp-11")
 
 	testReleaseBytes(b1)
+
+	// test canonical interaction - with structs having some missing fields and some regular fields
+	bh := testBasicHandle(h)
+
+	defer func(c bool) {
+		bh.Canonical = c
+	}(bh.Canonical)
+	bh.Canonical = true
+
+	b1 = nil
+
+	var s1 = struct {
+		A int
+		B int
+		C int
+	}{1, 2, 3}
+
+	NewEncoderBytes(&b1, h).MustEncode(&s1)
+
+	var s2 = struct {
+		C int
+		testMissingFieldsMap
+	}{C: 3}
+	s2.testMissingFieldsMap = testMissingFieldsMap{
+		m: map[string]interface{}{
+			"A": 1,
+			"B": 2,
+		},
+	}
+
+	for i := 0; i < 16; i++ {
+		b2 = nil
+		NewEncoderBytes(&b2, h).MustEncode(&s2)
+
+		if !bytes.Equal(b1, b2) {
+			t.Fatalf("bytes differed:'%s' vs '%s'", b1, b2)
+		}
+	}
 }
 
 func doTestMaxDepth(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
 	name := h.Name()
 	type T struct {
 		I interface{} // value to encode
@@ -2993,11 +3192,11 @@ func doTestMaxDepth(t *testing.T, h Handle) {
 	table = append(table, T{m99, 215, true, nil})
 
 	defer func(n int16) {
-		basicHandle(h).MaxDepth = n
-	}(basicHandle(h).MaxDepth)
+		testBasicHandle(h).MaxDepth = n
+	}(testBasicHandle(h).MaxDepth)
 
 	for i, v := range table {
-		basicHandle(h).MaxDepth = v.M
+		testBasicHandle(h).MaxDepth = v.M
 		b1 := testMarshalErr(v.I, h, t, name+"-maxdepth-enc"+strconv.FormatInt(int64(i), 10))
 
 		var err error
@@ -3009,11 +3208,8 @@ func doTestMaxDepth(t *testing.T, h Handle) {
 			var v2 interface{}
 			err = testUnmarshal(&v2, b1, h)
 		}
-		if err1, ok := err.(decodeError); ok {
-			err = err1.codecError
-		}
 		var err0 interface{} = err
-		if err1, ok := err.(codecError); ok {
+		if err1, ok := err.(*codecError); ok {
 			err0 = err1.err
 		}
 		if err0 != v.E {
@@ -3027,7 +3223,7 @@ func doTestMaxDepth(t *testing.T, h Handle) {
 }
 
 func doTestMultipleEncDec(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	// encode a string multiple times.
 	// decode it multiple times.
@@ -3047,7 +3243,7 @@ func doTestMultipleEncDec(t *testing.T, h Handle) {
 }
 
 func doTestSelfExt(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	var ts TestSelfExtImpl
 	ts.S = "ugorji"
@@ -3062,7 +3258,7 @@ func doTestSelfExt(t *testing.T, h Handle) {
 }
 
 func doTestBytesEncodedAsArray(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 	// Need to test edge case where bytes are encoded as an array
 	// (not using optimized []byte native format)
@@ -3084,8 +3280,9 @@ func doTestBytesEncodedAsArray(t *testing.T, h Handle) {
 }
 
 func doTestStrucEncDec(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
+
 	{
 		var ts1 = newTestStruc(2, testNumRepeatString, false, !testSkipIntf, testMapStringKeyOnly)
 		var ts2 TestStruc
@@ -3094,6 +3291,7 @@ func doTestStrucEncDec(t *testing.T, h Handle) {
 		testDeepEqualErr(ts1, &ts2, t, name)
 		testReleaseBytes(bs)
 	}
+
 	// Note: We cannot use TestStrucFlex because it has omitempty values,
 	// Meaning that sometimes, encoded and decoded will not be same.
 	// {
@@ -3107,14 +3305,14 @@ func doTestStrucEncDec(t *testing.T, h Handle) {
 }
 
 func doTestStructKeyType(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	name := h.Name()
 
 	mh, mok := h.(*MsgpackHandle)
 
 	bch, bcok := h.(*BincHandle)
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	s2a := bh.StructToArray
 	bh.StructToArray = false
 	ir := bh.InterfaceReset
@@ -3175,28 +3373,28 @@ func doTestStructKeyType(t *testing.T, h Handle) {
 	}
 
 	m["F"] = 90
-	fn(testStrucKeyTypeT0{F: 90})
+	fn(&testStrucKeyTypeT0{F: 90})
 	fnclr()
 
 	m["FFFF"] = 100
-	fn(testStrucKeyTypeT1{F: 100})
+	fn(&testStrucKeyTypeT1{F: 100})
 	fnclr()
 
 	m[int64(-1)] = 200
-	fn(testStrucKeyTypeT2{F: 200})
+	fn(&testStrucKeyTypeT2{F: 200})
 	fnclr()
 
 	m[int64(1)] = 300
-	fn(testStrucKeyTypeT3{F: 300})
+	fn(&testStrucKeyTypeT3{F: 300})
 	fnclr()
 
 	m[float64(2.5)] = 400
-	fn(testStrucKeyTypeT4{F: 400})
+	fn(&testStrucKeyTypeT4{F: 400})
 	fnclr()
 }
 
 func doTestRawToStringToRawEtc(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// name := h.Name()
 
 	// Tests:
@@ -3205,7 +3403,7 @@ func doTestRawToStringToRawEtc(t *testing.T, h Handle) {
 	// - MapValueReset
 	// - DeleteOnMapValue (skipped - no longer supported)
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 
 	r2s := bh.RawToString
 	s2r := bh.StringToRaw
@@ -3332,13 +3530,13 @@ MAP_VALUE_RESET:
 
 // -----------------
 
-func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
-	defer testSetup(t)()
+func doTestJsonDecodeNonStringScalarInStringContext(t *testing.T, h Handle) {
+	defer testSetup(t, &h)()
 	var b = `{"s.true": "true", "b.true": true, "s.false": "false", "b.false": false, "s.10": "10", "i.10": 10, "i.-10": -10}`
 	var golden = map[string]string{"s.true": "true", "b.true": "true", "s.false": "false", "b.false": "false", "s.10": "10", "i.10": "10", "i.-10": "-10"}
 
 	var m map[string]string
-	d := NewDecoderBytes([]byte(b), testJsonH)
+	d := NewDecoderBytes([]byte(b), h)
 	d.MustDecode(&m)
 	if err := deepEqual(golden, m); err == nil {
 		if testVerbose {
@@ -3349,28 +3547,30 @@ func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
 		t.FailNow()
 	}
 
-	oldMapKeyAsString := testJsonH.MapKeyAsString
-	oldPreferFloat := testJsonH.PreferFloat
-	oldSignedInteger := testJsonH.SignedInteger
-	oldHTMLCharAsIs := testJsonH.HTMLCharsAsIs
-	oldMapType := testJsonH.MapType
+	jh := h.(*JsonHandle)
+
+	oldMapKeyAsString := jh.MapKeyAsString
+	oldPreferFloat := jh.PreferFloat
+	oldSignedInteger := jh.SignedInteger
+	oldHTMLCharAsIs := jh.HTMLCharsAsIs
+	oldMapType := jh.MapType
 
 	defer func() {
-		testJsonH.MapKeyAsString = oldMapKeyAsString
-		testJsonH.PreferFloat = oldPreferFloat
-		testJsonH.SignedInteger = oldSignedInteger
-		testJsonH.HTMLCharsAsIs = oldHTMLCharAsIs
-		testJsonH.MapType = oldMapType
+		jh.MapKeyAsString = oldMapKeyAsString
+		jh.PreferFloat = oldPreferFloat
+		jh.SignedInteger = oldSignedInteger
+		jh.HTMLCharsAsIs = oldHTMLCharAsIs
+		jh.MapType = oldMapType
 	}()
 
-	testJsonH.MapType = mapIntfIntfTyp
-	testJsonH.HTMLCharsAsIs = false
-	testJsonH.MapKeyAsString = true
-	testJsonH.PreferFloat = false
-	testJsonH.SignedInteger = false
+	jh.MapType = mapIntfIntfTyp
+	jh.HTMLCharsAsIs = false
+	jh.MapKeyAsString = true
+	jh.PreferFloat = false
+	jh.SignedInteger = false
 
 	// Also test out decoding string values into naked interface
-	b = `{"true": true, "false": false, "null": null, "7700000000000000000": 7700000000000000000}`
+	b = `{"true": true, "false": false, null: null, "7700000000000000000": 7700000000000000000}`
 	const num = 7700000000000000000 // 77
 	var golden2 = map[interface{}]interface{}{
 		true:  true,
@@ -3393,21 +3593,21 @@ func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
 	fn()
 	delete(golden2, uint64(num))
 
-	testJsonH.SignedInteger = true
+	jh.SignedInteger = true
 	golden2[int64(num)] = int64(num)
 	fn()
 	delete(golden2, int64(num))
-	testJsonH.SignedInteger = false
+	jh.SignedInteger = false
 
-	testJsonH.PreferFloat = true
+	jh.PreferFloat = true
 	golden2[float64(num)] = float64(num)
 	fn()
 	delete(golden2, float64(num))
-	testJsonH.PreferFloat = false
+	jh.PreferFloat = false
 }
 
-func TestJsonEncodeIndent(t *testing.T) {
-	defer testSetup(t)()
+func doTestJsonEncodeIndent(t *testing.T, h Handle) {
+	defer testSetup(t, &h)()
 	v := TestSimplish{
 		Ii: -794,
 		Ss: `A Man is
@@ -3428,23 +3628,25 @@ after the new line
 		v.Sl = append(v.Sl, &v3)
 		v.Mm[strconv.FormatInt(int64(i), 10)] = &v3
 	}
-	oldcan := testJsonH.Canonical
-	oldIndent := testJsonH.Indent
-	oldS2A := testJsonH.StructToArray
+	jh := h.(*JsonHandle)
+
+	oldcan := jh.Canonical
+	oldIndent := jh.Indent
+	oldS2A := jh.StructToArray
 	defer func() {
-		testJsonH.Canonical = oldcan
-		testJsonH.Indent = oldIndent
-		testJsonH.StructToArray = oldS2A
+		jh.Canonical = oldcan
+		jh.Indent = oldIndent
+		jh.StructToArray = oldS2A
 	}()
-	testJsonH.Canonical = true
-	testJsonH.Indent = -1
-	testJsonH.StructToArray = false
+	jh.Canonical = true
+	jh.Indent = -1
+	jh.StructToArray = false
 	var bs []byte
-	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
+	NewEncoderBytes(&bs, jh).MustEncode(&v)
 	txt1Tab := string(bs)
 	bs = nil
-	testJsonH.Indent = 120
-	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
+	jh.Indent = 120
+	NewEncoderBytes(&bs, jh).MustEncode(&v)
 	txtSpaces := string(bs)
 	// fmt.Printf("\n-----------\n%s\n------------\n%s\n-------------\n", txt1Tab, txtSpaces)
 
@@ -3520,80 +3722,14 @@ after the new line
 	}
 }
 
-func doTestBufioDecReader(t *testing.T, bufsize int) {
-	defer testSetup(t)()
-	bufsizehalf := (bufsize + 1) / 2
-
-	// try to read 85 bytes in chunks of 7 at a time.
-	var s = strings.Repeat("01234'56789      ", 5)
-	// fmt.Printf("s: %s\n", s)
-	var r = strings.NewReader(s)
-	var br bufioDecReader
-	var blist bytesFreelist
-	br.reset(r, bufsize, &blist)
-	b, err := ioutil.ReadAll(br.r)
-	if err != nil {
-		panic(err)
-	}
-	var s2 = string(b)
-	// fmt.Printf("s==s2: %v, len(s): %v, len(b): %v, len(s2): %v\n", s == s2, len(s), len(b), len(s2))
-	if s != s2 {
-		t.Logf("not equal: \ns:  %s\ns2: %s", s, s2)
-		t.FailNow()
-	}
-	// Now, test search functions for skip, readTo and readUntil
-	// readUntil ', readTo ', skip whitespace. 3 times in a loop, each time compare the token and/or outs
-	// readUntil: see: 56789
-	var out []byte
-	var token byte
-	br.reset(strings.NewReader(s), bufsizehalf, &blist)
-	// println()
-	for _, v2 := range [...]string{
-		`01234'`,
-		`56789      01234'`,
-		`56789      01234'`,
-		`56789      01234'`,
-	} {
-		out = br.readUntil('\'')
-		testDeepEqualErr(string(out), v2[:len(v2)-1], t, "-")
-		// fmt.Printf("readUntil: out: `%s`\n", out)
-	}
-	br.reset(strings.NewReader(s), bufsizehalf, &blist)
-	// println()
-
-	// 20200915: readTo is not longer available
-	// for range [4]struct{}{} {
-	// 	out = br.readTo(&numCharBitset)
-	// 	testDeepEqualErr(string(out), `01234`, t, "-")
-	// 	// fmt.Printf("readTo: out: `%s`\n", out)
-	// 	out = br.readUntil('\'', true)
-	// 	testDeepEqualErr(string(out), "'", t, "-")
-	// 	// fmt.Printf("readUntil: out: `%s`\n", out)
-	// 	out = br.readTo(&numCharBitset)
-	// 	testDeepEqualErr(string(out), `56789`, t, "-")
-	// 	// fmt.Printf("readTo: out: `%s`\n", out)
-	// 	out = br.readUntil('0', true)
-	// 	testDeepEqualErr(string(out), `      0`, t, "-")
-	// 	// fmt.Printf("readUntil: out: `%s`\n", out)
-	// 	br.unreadn1()
-	// }
-	br.reset(strings.NewReader(s), bufsizehalf, &blist)
-	for range [4]struct{}{} {
-		token = br.skipWhitespace() // br.skip(&whitespaceCharBitset)
-		testDeepEqualErr(token, byte('0'), t, "-")
-		out = br.readUntil(' ')
-		testDeepEqualErr(string(out), `1234'56789`, t, "-")
-	}
-}
-
 func doTestPreferArrayOverSlice(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// encode a slice, decode it with PreferArrayOverSlice
 	// if codecgen, skip the test (as codecgen doesn't work with PreferArrayOverSlice)
 	if codecgen {
 		t.Skip("skipping ... prefer array over slice is not supported in codecgen mode")
 	}
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	paos := bh.PreferArrayOverSlice
 	styp := bh.SliceType
 	defer func() {
@@ -3613,16 +3749,16 @@ func doTestPreferArrayOverSlice(t *testing.T, h Handle) {
 }
 
 func doTestZeroCopyBytes(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	// jsonhandle and cborhandle with indefiniteLength do not support inline bytes, so skip them.
-	if _, ok := h.(*JsonHandle); ok { // if h == testJsonH {
+	if _, ok := h.(*JsonHandle); ok {
 		t.Skipf("skipping ... zero copy bytes not supported by json handle")
 	}
 	if ch, ok := h.(*CborHandle); ok && ch.IndefiniteLength {
 		t.Skipf("skipping ... zero copy bytes not supported by cbor handle with IndefiniteLength=true")
 	}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 	zc := bh.ZeroCopy
 	defer func() {
 		bh.ZeroCopy = zc
@@ -3655,9 +3791,9 @@ func doTestZeroCopyBytes(t *testing.T, h Handle) {
 }
 
 func doTestNextValueBytes(t *testing.T, h Handle) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 
 	// - encode uint, int, float, bool, struct, map, slice, string - all separated by nil
 	// - use nextvaluebytes to grab he's got each one, and decode it, and compare
@@ -3689,10 +3825,10 @@ func doTestNextValueBytes(t *testing.T, h Handle) {
 
 	var valueBytes = make([][]byte, len(inputs)*2)
 
-	d, oldReadBufferSize := testSharedCodecDecoder(out, h, basicHandle(h))
+	d, oldReadBufferSize := testSharedCodecDecoder(out, h, testBasicHandle(h))
 	for i := 0; i < len(inputs)*2; i++ {
-		valueBytes[i] = d.d.nextValueBytes(nil)
-		// bs := d.d.nextValueBytes(nil)
+		valueBytes[i] = d.d.nextValueBytes([]byte{})
+		// bs := d.d.nextValueBytes([]byte{})
 		// valueBytes[i] = make([]byte, len(bs))
 		// copy(valueBytes[i], bs)
 	}
@@ -3716,18 +3852,17 @@ func doTestNextValueBytes(t *testing.T, h Handle) {
 }
 
 func doTestNumbers(t *testing.T, h Handle) {
-	defer testSetup(t)()
-	doTestIntegers(t, h)
-	doTestFloats(t, h)
+	defer testSetup(t, &h)()
+	__doTestIntegers(t, h)
+	__doTestFloats(t, h)
+	__doTestIntegerFloatConversions(t, h)
 }
 
-func doTestIntegers(t *testing.T, h Handle) {
-	defer testSetup(t)()
-
+func __doTestIntegers(t *testing.T, h Handle) {
 	// handle SignedInteger=true|false
 	// decode into an interface{}
 
-	bh := basicHandle(h)
+	bh := testBasicHandle(h)
 
 	oldSignedInteger := bh.SignedInteger
 	var oldPreferFloat bool
@@ -3816,9 +3951,7 @@ var testFloatsToParse = []float64{
 	1.00000000000000033306690738754696212708950042724609375,
 }
 
-func doTestFloats(t *testing.T, h Handle) {
-	defer testSetup(t)()
-
+func __doTestFloats(t *testing.T, h Handle) {
 	_, jok := h.(*JsonHandle)
 
 	f64s := testFloatsToParse
@@ -3863,9 +3996,53 @@ func doTestFloats(t *testing.T, h Handle) {
 	}
 }
 
+func __doTestIntegerFloatConversions(t *testing.T, h Handle) {
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	type tI struct{ N int }
+	type tU struct{ N uint }
+	type tF struct{ N float64 }
+
+	type elem struct {
+		in  interface{}
+		out interface{}
+		err string
+	}
+	tests := []elem{
+		// good
+		{tI{5}, tF{5.0}, ""},
+		{tU{5}, tF{5.0}, ""},
+		{tF{5.0}, tU{5}, ""},
+		{tF{5.0}, tI{5}, ""},
+		{tF{-5.0}, tI{-5}, ""},
+		// test negative number into unsigned integer
+		{tI{-5}, tU{5}, "error"},
+		{tF{-5.0}, tU{5}, "error"},
+		// test fractional float into integer
+		{tF{-5.7}, tU{5}, "error"},
+		{tF{-5.7}, tI{5}, "error"},
+	}
+	for _, v := range tests {
+		r := reflect.New(reflect.TypeOf(v.out))
+		b := testMarshalErr(v.in, h, t, "")
+		err := testUnmarshal(r.Interface(), b, h)
+		if v.err == "" {
+			testDeepEqualErr(err, nil, t, "")
+			testDeepEqualErr(r.Elem().Interface(), v.out, t, "")
+		} else if err == nil {
+			t.Logf("expecting an error but didn't receive any, with in: %v, out: %v, expecting err matching: %v", v.in, v.out, v.err)
+			t.FailNow()
+		}
+	}
+}
+
 func doTestStructFieldInfoToArray(t *testing.T, h Handle) {
-	defer testSetup(t)()
-	bh := basicHandle(h)
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
+	bh := testBasicHandle(h)
 
 	defer func(b bool) { bh.CheckCircularRef = b }(bh.CheckCircularRef)
 	bh.CheckCircularRef = true
@@ -3915,7 +4092,7 @@ func doTestStructFieldInfoToArray(t *testing.T, h Handle) {
 }
 
 func doTestDesc(t *testing.T, h Handle, m map[byte]string) {
-	defer testSetup(t)()
+	defer testSetup(t, &h)()
 	for k, v := range m {
 		if s := h.desc(k); s != v {
 			t.Logf("error describing descriptor: '%q' i.e. 0x%x, expected '%s', got '%s'", k, k, v, s)
@@ -3924,16 +4101,8 @@ func doTestDesc(t *testing.T, h Handle, m map[byte]string) {
 	}
 }
 
-func TestBufioDecReader(t *testing.T) {
-	defer testSetup(t)()
-	doTestBufioDecReader(t, 13)
-	doTestBufioDecReader(t, 3)
-	doTestBufioDecReader(t, 5)
-	doTestBufioDecReader(t, 127)
-}
-
 func TestAtomic(t *testing.T) {
-	defer testSetup(t)()
+	defer testSetup(t, nil)()
 	// load, store, load, confirm
 	if true {
 		var a atomicTypeInfoSlice
@@ -3984,8 +4153,12 @@ func TestAtomic(t *testing.T) {
 
 // -----------
 
-func TestJsonLargeInteger(t *testing.T) {
-	defer testSetup(t)()
+func doTestJsonLargeInteger(t *testing.T, h Handle) {
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
+	jh := h.(*JsonHandle)
 	for _, i := range []uint8{'L', 'A', 0} {
 		for _, j := range []interface{}{
 			int64(1 << 60),
@@ -3999,13 +4172,13 @@ func TestJsonLargeInteger(t *testing.T) {
 			int64(1840000e-2),
 			uint64(1840000e+2),
 		} {
-			doTestJsonLargeInteger(t, j, i)
+			__doTestJsonLargeInteger(t, j, i, jh)
 		}
 	}
 
-	oldIAS := testJsonH.IntegerAsString
-	defer func() { testJsonH.IntegerAsString = oldIAS }()
-	testJsonH.IntegerAsString = 0
+	oldIAS := jh.IntegerAsString
+	defer func() { jh.IntegerAsString = oldIAS }()
+	jh.IntegerAsString = 0
 
 	type tt struct {
 		s           string
@@ -4017,7 +4190,7 @@ func TestJsonLargeInteger(t *testing.T) {
 	var i int64
 	var ui uint64
 	var err error
-	var d *Decoder = NewDecoderBytes(nil, testJsonH)
+	var d *Decoder = NewDecoderBytes(nil, jh)
 	for _, v := range []tt{
 		{"0", true, true, 0, 0},
 		{"0000", true, true, 0, 0},
@@ -4078,9 +4251,13 @@ func TestJsonLargeInteger(t *testing.T) {
 	}
 }
 
-func TestJsonInvalidUnicode(t *testing.T) {
-	defer testSetup(t)()
+func doTestJsonInvalidUnicode(t *testing.T, h Handle) {
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
 	// t.Skipf("new json implementation does not handle bad unicode robustly")
+	jh := h.(*JsonHandle)
 
 	var err error
 
@@ -4098,9 +4275,9 @@ func TestJsonInvalidUnicode(t *testing.T) {
 
 	for k, v := range m {
 		// call testUnmarshal directly, so we can check for EOF
-		// testUnmarshalErr(&s, []byte(k), testJsonH, t, "-")
+		// testUnmarshalErr(&s, []byte(k), jh, t, "-")
 		var s string
-		err = testUnmarshal(&s, []byte(k), testJsonH)
+		err = testUnmarshal(&s, []byte(k), jh)
 		if err != nil {
 			if err == io.EOF {
 				continue
@@ -4124,7 +4301,7 @@ func TestJsonInvalidUnicode(t *testing.T) {
 	}
 	for k, v := range m {
 		var s string
-		err = testUnmarshal(&s, []byte(k), testJsonH)
+		err = testUnmarshal(&s, []byte(k), jh)
 		if err != nil {
 			t.Logf("%s: unmarshal failed: %v", "-", err)
 			t.FailNow()
@@ -4154,7 +4331,7 @@ func TestJsonInvalidUnicode(t *testing.T) {
 	}
 
 	for k, v := range m2 {
-		b, err = testMarshal(k, testJsonH)
+		b, err = testMarshal(k, jh)
 		if err != nil {
 			t.Logf("%s: marshal failed: %v", "-", err)
 			t.FailNow()
@@ -4168,7 +4345,20 @@ func TestJsonInvalidUnicode(t *testing.T) {
 	}
 }
 
-func TestJsonNumberParsing(t *testing.T) {
+func doTestJsonNumberParsing(t *testing.T, h Handle) {
+	defer testSetup(t, &h)()
+	parseInt64_reader := func(r readFloatResult) (v int64, fail bool) {
+		u, fail := parseUint64_reader(r)
+		if fail {
+			return
+		}
+		if r.neg {
+			v = -int64(u)
+		} else {
+			v = int64(u)
+		}
+		return
+	}
 	for _, f64 := range testFloatsToParse {
 		// using large prec might make a non-exact float ..., while small prec might make lose some precision.
 		// However, we can do a check, and only check if the (u)int64 and float64 can be converted to one another
@@ -4211,7 +4401,7 @@ func TestJsonNumberParsing(t *testing.T) {
 			if fint != float64(int64(fint)) {
 				goto F32
 			}
-			r = readFloat(bv, fi64i)
+			r = readFloat(bv, fi64u)
 			fail = !r.ok
 			if r.ok {
 				fsi, fail = parseInt64_reader(r)
@@ -4249,7 +4439,7 @@ func TestJsonNumberParsing(t *testing.T) {
 		}
 		vi := int64(v)
 		bv = strconv.AppendFloat(nil, float64(vi), 'E', prec, 64)
-		r = readFloat(bv, fi64i)
+		r = readFloat(bv, fi64u)
 		if r.ok {
 			if fs, fail := parseInt64_reader(r); fail || fs != vi {
 				t.Logf("int64 -> int64 error (prec: %v, fail: %v) parsing '%s', got %v, expected %v", prec, fail, bv, fs, vi)
@@ -4261,8 +4451,6 @@ func TestJsonNumberParsing(t *testing.T) {
 		}
 	}
 	{
-		h := testJsonH
-
 		var f64 float64 = 64.0
 		var f32 float32 = 32.0
 		var i int = 11
@@ -4284,9 +4472,13 @@ func TestJsonNumberParsing(t *testing.T) {
 	}
 }
 
-func TestMsgpackDecodeMapAndExtSizeMismatch(t *testing.T) {
+func doTestMsgpackDecodeMapAndExtSizeMismatch(t *testing.T, h Handle) {
+	if !testRecoverPanicToErr {
+		t.Skip(testSkipIfNotRecoverPanicToErrMsg)
+	}
+	defer testSetup(t, &h)()
 	fn := func(t *testing.T, b []byte, v interface{}) {
-		if err := NewDecoderBytes(b, testMsgpackH).Decode(v); err != io.EOF && err != io.ErrUnexpectedEOF {
+		if err := NewDecoderBytes(b, h).Decode(v); err != io.EOF && err != io.ErrUnexpectedEOF {
 			t.Fatalf("expected EOF or ErrUnexpectedEOF, got %v", err)
 		}
 	}
@@ -4309,6 +4501,7 @@ func TestMsgpackDecodeMapAndExtSizeMismatch(t *testing.T) {
 }
 
 func TestMapRangeIndex(t *testing.T) {
+	defer testSetup(t, nil)()
 	// t.Skip()
 	type T struct {
 		I int
@@ -4344,7 +4537,7 @@ func TestMapRangeIndex(t *testing.T) {
 	rvk := mapAddrLoopvarRV(mt.Key(), mt.Key().Kind())
 	rvv := mapAddrLoopvarRV(mt.Elem(), mt.Elem().Kind())
 	var it mapIter
-	mapRange(&it, rv4i(m1), rvk, rvv, true)
+	mapRange(&it, reflect.ValueOf(m1), rvk, rvv, true)
 	for it.Next() {
 		k := fnrv(it.Key(), rvk).Interface().(string)
 		v := fnrv(it.Value(), rvv).Interface().(*T)
@@ -4373,7 +4566,8 @@ func TestMapRangeIndex(t *testing.T) {
 	mt = reflect.TypeOf(m2)
 	rvk = mapAddrLoopvarRV(mt.Key(), mt.Key().Kind())
 	rvv = mapAddrLoopvarRV(mt.Elem(), mt.Elem().Kind())
-	mapRange(&it, rv4i(m2), rvk, rvv, true)
+	it = mapIter{} // zero it out first, before calling mapRange
+	mapRange(&it, reflect.ValueOf(m2), rvk, rvv, true)
 	for it.Next() {
 		k := fnrv(it.Key(), rvk).Interface().(*T)
 		v := fnrv(it.Value(), rvv).Interface().(T)
@@ -4392,10 +4586,17 @@ func TestMapRangeIndex(t *testing.T) {
 
 	fnTestMapIndex := func(mi ...interface{}) {
 		for _, m0 := range mi {
-			m := rv4i(m0)
-			rvv := mapAddrLoopvarRV(m.Type().Elem(), m.Type().Elem().Kind())
+			m := reflect.ValueOf(m0)
+			mkt := m.Type().Key()
+			mvt := m.Type().Elem()
+			kfast := mapKeyFastKindFor(mkt.Kind())
+			rvv := mapAddrLoopvarRV(mvt, mvt.Kind())
+			visindirect := mapStoresElemIndirect(mvt.Size())
+			visref := refBitset.isset(byte(mvt.Kind()))
+
 			for _, k := range m.MapKeys() {
-				testDeepEqualErr(m.MapIndex(k).Interface(), mapGet(m, k, rvv).Interface(), t, "map-index-eq")
+				mg := mapGet(m, k, rvv, kfast, visindirect, visref).Interface()
+				testDeepEqualErr(m.MapIndex(k).Interface(), mg, t, "map-index-eq")
 			}
 		}
 	}
@@ -4406,8 +4607,8 @@ func TestMapRangeIndex(t *testing.T) {
 	// var tt = &T{I: 3}
 	// ttTyp := reflect.TypeOf(tt)
 	// _, _ = tt, ttTyp
-	// mv := rv4i(m)
-	// it := mapRange(mv, rv4i(&s).Elem(), rv4i(&tt).Elem(), true) //ok
+	// mv := reflect.ValueOf(m)
+	// it := mapRange(mv, reflect.ValueOf(&s).Elem(), reflect.ValueOf(&tt).Elem(), true) //ok
 	// it := mapRange(mv, reflect.New(reflect.TypeOf(s)).Elem(), reflect.New(reflect.TypeOf(T{})).Elem(), true) // ok
 	// it := mapRange(mv, reflect.New(reflect.TypeOf(s)).Elem(), reflect.New(ttTyp.Elem()), true) // !ok
 	// it := mapRange(mv, reflect.New(reflect.TypeOf(s)).Elem(), reflect.New(reflect.TypeOf(T{})), true) !ok
@@ -4437,10 +4638,6 @@ func TestBincStdEncIntf(t *testing.T) {
 	doTestStdEncIntf(t, testBincH)
 }
 
-func TestBincMammoth(t *testing.T) {
-	doTestMammoth(t, testBincH)
-}
-
 func TestSimpleCodecsTable(t *testing.T) {
 	doTestCodecTableOne(t, testSimpleH)
 }
@@ -4457,10 +4654,6 @@ func TestSimpleStdEncIntf(t *testing.T) {
 	doTestStdEncIntf(t, testSimpleH)
 }
 
-func TestSimpleMammoth(t *testing.T) {
-	doTestMammoth(t, testSimpleH)
-}
-
 func TestMsgpackCodecsTable(t *testing.T) {
 	doTestCodecTableOne(t, testMsgpackH)
 }
@@ -4477,12 +4670,6 @@ func TestMsgpackStdEncIntf(t *testing.T) {
 	doTestStdEncIntf(t, testMsgpackH)
 }
 
-func TestMsgpackMammoth(t *testing.T) {
-	defer func(b bool) { testMsgpackH.RawToString = b }(testMsgpackH.RawToString)
-	testMsgpackH.RawToString = true
-	doTestMammoth(t, testMsgpackH)
-}
-
 func TestCborCodecsTable(t *testing.T) {
 	doTestCodecTableOne(t, testCborH)
 }
@@ -4503,10 +4690,6 @@ func TestCborStdEncIntf(t *testing.T) {
 	doTestStdEncIntf(t, testCborH)
 }
 
-func TestCborMammoth(t *testing.T) {
-	doTestMammoth(t, testCborH)
-}
-
 func TestJsonCodecsTable(t *testing.T) {
 	doTestCodecTableOne(t, testJsonH)
 }
@@ -4527,10 +4710,6 @@ func TestJsonStdEncIntf(t *testing.T) {
 	doTestStdEncIntf(t, testJsonH)
 }
 
-func TestJsonMammoth(t *testing.T) {
-	doTestMammoth(t, testJsonH)
-}
-
 // ----- Raw ---------
 func TestJsonRaw(t *testing.T) {
 	doTestRawValue(t, testJsonH)
@@ -4559,16 +4738,12 @@ func TestAllAnonCycle(t *testing.T) {
 }
 
 func TestAllErrWriter(t *testing.T) {
-	doTestErrWriter(t, testCborH)
-	doTestErrWriter(t, testJsonH)
+	doTestAllErrWriter(t, testCborH, testJsonH)
 }
 
 // ----- RPC custom -----
 
 func TestMsgpackRpcSpec(t *testing.T) {
-	if testMsgpackH.SliceElementReset {
-		t.Skipf("skipping ... MsgpackRpcSpec does not handle SliceElementReset - needs investigation")
-	}
 	doTestCodecRpcOne(t, MsgpackSpecRpc, testMsgpackH, true, 0)
 }
 
@@ -4741,28 +4916,6 @@ func TestSimpleLargeContainerLen(t *testing.T) {
 	doTestLargeContainerLen(t, testSimpleH)
 }
 
-func TestJsonMammothMapsAndSlices(t *testing.T) {
-	doTestMammothMapsAndSlices(t, testJsonH)
-}
-
-func TestCborMammothMapsAndSlices(t *testing.T) {
-	doTestMammothMapsAndSlices(t, testCborH)
-}
-
-func TestMsgpackMammothMapsAndSlices(t *testing.T) {
-	defer func(b bool) { testMsgpackH.RawToString = b }(testMsgpackH.RawToString)
-	testMsgpackH.RawToString = true
-	doTestMammothMapsAndSlices(t, testMsgpackH)
-}
-
-func TestBincMammothMapsAndSlices(t *testing.T) {
-	doTestMammothMapsAndSlices(t, testBincH)
-}
-
-func TestSimpleMammothMapsAndSlices(t *testing.T) {
-	doTestMammothMapsAndSlices(t, testSimpleH)
-}
-
 func TestJsonTime(t *testing.T) {
 	doTestTime(t, testJsonH)
 }
@@ -4812,8 +4965,6 @@ func TestCborDifferentMapOrSliceType(t *testing.T) {
 }
 
 func TestMsgpackDifferentMapOrSliceType(t *testing.T) {
-	defer func(b bool) { testMsgpackH.RawToString = b }(testMsgpackH.RawToString)
-	testMsgpackH.RawToString = true
 	doTestDifferentMapOrSliceType(t, testMsgpackH)
 }
 
@@ -4834,8 +4985,6 @@ func TestCborScalars(t *testing.T) {
 }
 
 func TestMsgpackScalars(t *testing.T) {
-	defer func(b bool) { testMsgpackH.RawToString = b }(testMsgpackH.RawToString)
-	testMsgpackH.RawToString = true
 	doTestScalars(t, testMsgpackH)
 }
 
@@ -5187,3 +5336,27 @@ func TestSimpleStructFieldInfoToArray(t *testing.T) {
 func TestMultipleEncDec(t *testing.T) {
 	doTestMultipleEncDec(t, testJsonH)
 }
+
+func TestJsonEncodeIndent(t *testing.T) {
+	doTestJsonEncodeIndent(t, testJsonH)
+}
+
+func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
+	doTestJsonDecodeNonStringScalarInStringContext(t, testJsonH)
+}
+
+func TestJsonLargeInteger(t *testing.T) {
+	doTestJsonLargeInteger(t, testJsonH)
+}
+
+func TestJsonInvalidUnicode(t *testing.T) {
+	doTestJsonInvalidUnicode(t, testJsonH)
+}
+
+func TestJsonNumberParsing(t *testing.T) {
+	doTestJsonNumberParsing(t, testJsonH)
+}
+
+func TestMsgpackDecodeMapAndExtSizeMismatch(t *testing.T) {
+	doTestMsgpackDecodeMapAndExtSizeMismatch(t, testMsgpackH)
+}
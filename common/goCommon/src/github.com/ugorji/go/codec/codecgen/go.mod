@@ -1,8 +0,0 @@
-module github.com/ugorji/go/codec/codecgen
-
-go 1.11
-
-require (
-	github.com/ugorji/go/codec v1.2.2
-	golang.org/x/tools v0.0.0-20201028224754-2c115999a7f0
-)
@@ -1,6 +0,0 @@
-// Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
-// Use of this source code is governed by a MIT license found in the LICENSE file.
-
-package main
-
-const genCodecPath = "github.com/ugorji/go/codec"
@@ -6,22 +6,21 @@ package codec
 import (
 	"encoding"
 	"errors"
-	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"strconv"
 	"time"
 )
 
-const (
-	msgBadDesc = "unrecognized descriptor byte"
-)
+const msgBadDesc = "unrecognized descriptor byte"
 
 const (
-	decDefMaxDepth         = 1024 // maximum depth
-	decDefSliceCap         = 8
-	decDefChanCap          = 64      // should be large, as cap cannot be expanded
-	decScratchByteArrayLen = (7 * 8) // ??? cacheLineSize +
+	decDefMaxDepth         = 1024                // maximum depth
+	decDefChanCap          = 64                  // should be large, as cap cannot be expanded
+	decScratchByteArrayLen = (8 + 2 + 2 + 1) * 8 // around cacheLineSize ie ~64, depending on Decoder size
+
+	// MARKER: massage decScratchByteArrayLen to ensure xxxDecDriver structs fit within cacheLine*N
 
 	// decFailNonEmptyIntf configures whether we error
 	// when decoding naked into a non-empty interface.
@@ -35,6 +34,14 @@ const (
 	//
 	// Consequently, we should relax this. Put it behind a const flag for now.
 	decFailNonEmptyIntf = false
+
+	// decUseTransient says that we should not use the transient optimization.
+	//
+	// There's potential for GC corruption or memory overwrites if transient isn't
+	// used carefully, so this flag helps turn it off quickly if needed.
+	//
+	// Use it everywhere needed so we can completely remove unused code blocks.
+	decUseTransient = true
 )
 
 var (
@@ -51,6 +58,26 @@ var (
 	errMaxDepthExceeded             = errors.New("maximum decoding depth exceeded")
 )
 
+// decByteState tracks where the []byte returned by the last call
+// to DecodeBytes or DecodeStringAsByte came from
+type decByteState uint8
+
+const (
+	decByteStateNone     decByteState = iota
+	decByteStateZerocopy              // view into []byte that we are decoding from
+	decByteStateReuseBuf              // view into transient buffer used internally by decDriver
+	// decByteStateNewAlloc
+)
+
+type decNotDecodeableReason uint8
+
+const (
+	decNotDecodeableReasonUnknown decNotDecodeableReason = iota
+	decNotDecodeableReasonBadKind
+	decNotDecodeableReasonNonAddrValue
+	decNotDecodeableReasonNilReference
+)
+
 type decDriver interface {
 	// this will check if the next token is a break.
 	CheckBreak() bool
@@ -88,24 +115,33 @@ type decDriver interface {
 	DecodeBool() (b bool)
 
 	// DecodeStringAsBytes returns the bytes representing a string.
-	// By definition, it will return a view into a scratch buffer.
+	// It will return a view into scratch buffer or input []byte (if applicable).
 	//
 	// Note: This can also decode symbols, if supported.
 	//
 	// Users should consume it right away and not store it for later use.
 	DecodeStringAsBytes() (v []byte)
 
-	// DecodeBytes may be called directly, without going through reflection.
-	// Consequently, it must be designed to handle possible nil.
+	// DecodeBytes returns the bytes representing a binary value.
+	// It will return a view into scratch buffer or input []byte (if applicable).
+	//
+	// All implementations must honor the contract below:
+	//    if ZeroCopy and applicable, return a view into input []byte we are decoding from
+	//    else if in == nil,          return a view into scratch buffer
+	//    else                        append decoded value to in[:0] and return that
+	//                                (this can be simulated by passing []byte{} as in parameter)
+	//
+	// Implementations must also update Decoder.decByteState on each call to
+	// DecodeBytes or DecodeStringAsBytes. Some callers may check that and work appropriately.
 	//
 	// Note: DecodeBytes may decode past the length of the passed byte slice, up to the cap.
 	// Consequently, it is ok to pass a zero-len slice to DecodeBytes, as the returned
 	// byte slice will have the appropriate length.
-	DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte)
+	DecodeBytes(in []byte) (out []byte)
 	// DecodeBytes(bs []byte, isstring, zerocopy bool) (bsOut []byte)
 
 	// DecodeExt will decode into a *RawExt or into an extension.
-	DecodeExt(v interface{}, xtag uint64, ext Ext)
+	DecodeExt(v interface{}, basetype reflect.Type, xtag uint64, ext Ext)
 	// decodeExt(verifyTag bool, tag byte) (xtag byte, xbs []byte)
 
 	DecodeTime() (t time.Time)
@@ -114,45 +150,63 @@ type decDriver interface {
 	// If the format doesn't prefix the length, it returns containerLenUnknown.
 	// If the expected array was a nil in the stream, it returns containerLenNil.
 	ReadArrayStart() int
-	ReadArrayEnd()
 
 	// ReadMapStart will return the length of the array.
 	// If the format doesn't prefix the length, it returns containerLenUnknown.
 	// If the expected array was a nil in the stream, it returns containerLenNil.
 	ReadMapStart() int
-	ReadMapEnd()
 
 	reset()
-	atEndOfDecode()
 
+	// atEndOfDecode()
+
+	// nextValueBytes will return the bytes representing the next value in the stream.
+	//
+	// if start is nil, then treat it as a request to discard the next set of bytes,
+	// and the return response does not matter.
+	// Typically, this means that the returned []byte is nil/empty/undefined.
+	//
+	// Optimize for decoding from a []byte, where the nextValueBytes will just be a sub-slice
+	// of the input slice. Callers that need to use this to not be a view into the input bytes
+	// should handle it appropriately.
 	nextValueBytes(start []byte) []byte
 
+	// descBd will describe the token descriptor that signifies what type was decoded
+	descBd() string
+
 	decoder() *Decoder
+
+	driverStateManager
+	decNegintPosintFloatNumber
 }
 
 type decDriverContainerTracker interface {
 	ReadArrayElem()
 	ReadMapElemKey()
 	ReadMapElemValue()
+	ReadArrayEnd()
+	ReadMapEnd()
 }
 
-type decodeError struct {
-	codecError
-	pos int
+type decNegintPosintFloatNumber interface {
+	decInteger() (ui uint64, neg, ok bool)
+	decFloat() (f float64, ok bool)
 }
 
-func (d decodeError) Error() string {
-	return fmt.Sprintf("%s decode error [pos %d]: %v", d.name, d.pos, d.err)
+type decDriverNoopNumberHelper struct{}
+
+func (x decDriverNoopNumberHelper) decInteger() (ui uint64, neg, ok bool) {
+	panic("decInteger unsupported")
 }
+func (x decDriverNoopNumberHelper) decFloat() (f float64, ok bool) { panic("decFloat unsupported") }
 
 type decDriverNoopContainerReader struct{}
 
-func (x decDriverNoopContainerReader) ReadArrayStart() (v int) { return }
-func (x decDriverNoopContainerReader) ReadArrayEnd()           {}
-func (x decDriverNoopContainerReader) ReadMapStart() (v int)   { return }
-func (x decDriverNoopContainerReader) ReadMapEnd()             {}
-func (x decDriverNoopContainerReader) CheckBreak() (v bool)    { return }
-func (x decDriverNoopContainerReader) atEndOfDecode()          {}
+// func (x decDriverNoopContainerReader) ReadArrayStart() (v int) { panic("ReadArrayStart unsupported") }
+// func (x decDriverNoopContainerReader) ReadMapStart() (v int)   { panic("ReadMapStart unsupported") }
+func (x decDriverNoopContainerReader) ReadArrayEnd()        {}
+func (x decDriverNoopContainerReader) ReadMapEnd()          {}
+func (x decDriverNoopContainerReader) CheckBreak() (v bool) { return }
 
 // DecodeOptions captures configuration options during decode.
 type DecodeOptions struct {
@@ -263,17 +317,17 @@ type DecodeOptions struct {
 	// By default, they are decoded as []byte, but can be decoded as string (if configured).
 	RawToString bool
 
-	// ZeroCopy controls whether decoded values point into the
-	// input bytes passed into a NewDecoderBytes/ResetBytes(...) call.
+	// ZeroCopy controls whether decoded values of []byte or string type
+	// point into the input []byte parameter passed to a NewDecoderBytes/ResetBytes(...) call.
 	//
 	// To illustrate, if ZeroCopy and decoding from a []byte (not io.Writer),
-	// then a []byte in the output result may just be a slice of (point into)
+	// then a []byte or string in the output result may just be a slice of (point into)
 	// the input bytes.
 	//
 	// This optimization prevents unnecessary copying.
 	//
-	// However, it is made optional, as the caller MUST ensure that the input parameter
-	// is not modified after the Decode() happens.
+	// However, it is made optional, as the caller MUST ensure that the input parameter []byte is
+	// not modified after the Decode() happens, as any changes are mirrored in the decoded result.
 	ZeroCopy bool
 
 	// PreferPointerForStructOrArray controls whether a struct or array
@@ -281,16 +335,22 @@ type DecodeOptions struct {
 	//
 	// This mostly impacts when we decode registered extensions.
 	PreferPointerForStructOrArray bool
+
+	// ValidateUnicode controls will cause decoding to fail if an expected unicode
+	// string is well-formed but include invalid codepoints.
+	//
+	// This could have a performance impact.
+	ValidateUnicode bool
 }
 
 // ----------------------------------------
 
 func (d *Decoder) rawExt(f *codecFnInfo, rv reflect.Value) {
-	d.d.DecodeExt(rv2i(rv), 0, nil)
+	d.d.DecodeExt(rv2i(rv), f.ti.rt, 0, nil)
 }
 
 func (d *Decoder) ext(f *codecFnInfo, rv reflect.Value) {
-	d.d.DecodeExt(rv2i(rv), f.xfTag, f.xfFn)
+	d.d.DecodeExt(rv2i(rv), f.ti.rt, f.xfTag, f.xfFn)
 }
 
 func (d *Decoder) selferUnmarshal(f *codecFnInfo, rv reflect.Value) {
@@ -299,7 +359,7 @@ func (d *Decoder) selferUnmarshal(f *codecFnInfo, rv reflect.Value) {
 
 func (d *Decoder) binaryUnmarshal(f *codecFnInfo, rv reflect.Value) {
 	bm := rv2i(rv).(encoding.BinaryUnmarshaler)
-	xbs := d.d.DecodeBytes(nil, true)
+	xbs := d.d.DecodeBytes(nil)
 	fnerr := bm.UnmarshalBinary(xbs)
 	d.onerror(fnerr)
 }
@@ -311,12 +371,23 @@ func (d *Decoder) textUnmarshal(f *codecFnInfo, rv reflect.Value) {
 }
 
 func (d *Decoder) jsonUnmarshal(f *codecFnInfo, rv reflect.Value) {
-	tm := rv2i(rv).(jsonUnmarshaler)
+	d.jsonUnmarshalV(rv2i(rv).(jsonUnmarshaler))
+}
+
+func (d *Decoder) jsonUnmarshalV(tm jsonUnmarshaler) {
 	// grab the bytes to be read, as UnmarshalJSON needs the full JSON so as to unmarshal it itself.
-	bs := d.blist.get(256)
-	bs = d.d.nextValueBytes(bs)
+	var bs0 = []byte{}
+	if !d.bytes {
+		bs0 = d.blist.get(256)
+	}
+	bs := d.d.nextValueBytes(bs0)
 	fnerr := tm.UnmarshalJSON(bs)
-	d.blist.put(bs)
+	if !d.bytes {
+		d.blist.put(bs)
+		if !byteSliceSameData(bs0, bs) {
+			d.blist.put(bs0)
+		}
+	}
 	d.onerror(fnerr)
 }
 
@@ -329,7 +400,7 @@ func (d *Decoder) raw(f *codecFnInfo, rv reflect.Value) {
 }
 
 func (d *Decoder) kString(f *codecFnInfo, rv reflect.Value) {
-	rvSetString(rv, string(d.d.DecodeStringAsBytes()))
+	rvSetString(rv, d.stringZC(d.d.DecodeStringAsBytes()))
 }
 
 func (d *Decoder) kBool(f *codecFnInfo, rv reflect.Value) {
@@ -348,6 +419,14 @@ func (d *Decoder) kFloat64(f *codecFnInfo, rv reflect.Value) {
 	rvSetFloat64(rv, d.d.DecodeFloat64())
 }
 
+func (d *Decoder) kComplex64(f *codecFnInfo, rv reflect.Value) {
+	rvSetComplex64(rv, complex(d.decodeFloat32(), 0))
+}
+
+func (d *Decoder) kComplex128(f *codecFnInfo, rv reflect.Value) {
+	rvSetComplex128(rv, complex(d.d.DecodeFloat64(), 0))
+}
+
 func (d *Decoder) kInt(f *codecFnInfo, rv reflect.Value) {
 	rvSetInt(rv, int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize)))
 }
@@ -422,11 +501,11 @@ func (d *Decoder) kInterfaceNaked(f *codecFnInfo) (rvn reflect.Value) {
 		if mtid == mapStrIntfTypId {
 			var v2 map[string]interface{}
 			d.decode(&v2)
-			rvn = rv4i(&v2).Elem()
+			rvn = rv4iptr(&v2).Elem()
 		} else if mtid == mapIntfIntfTypId {
 			var v2 map[interface{}]interface{}
 			d.decode(&v2)
-			rvn = rv4i(&v2).Elem()
+			rvn = rv4iptr(&v2).Elem()
 		} else if d.mtr {
 			rvn = reflect.New(d.h.MapType)
 			d.decode(rv2i(rvn))
@@ -439,7 +518,7 @@ func (d *Decoder) kInterfaceNaked(f *codecFnInfo) (rvn reflect.Value) {
 		if d.stid == 0 || d.stid == intfSliceTypId {
 			var v2 []interface{}
 			d.decode(&v2)
-			rvn = rv4i(&v2).Elem()
+			rvn = rv4iptr(&v2).Elem()
 		} else if d.str {
 			rvn = reflect.New(d.h.SliceType)
 			d.decode(rv2i(rvn))
@@ -460,10 +539,10 @@ func (d *Decoder) kInterfaceNaked(f *codecFnInfo) (rvn reflect.Value) {
 			// most likely cbor, as json decoding never reveals valueTypeExt (no tagging support)
 			if bfn == nil {
 				d.decode(&re.Value)
-				rvn = rv4i(&re).Elem()
+				rvn = rv4iptr(&re).Elem()
 			} else {
 				if bfn.ext == SelfExt {
-					rvn = rvZeroAddr(bfn.rt)
+					rvn = rvZeroAddrK(bfn.rt, bfn.rt.Kind())
 					d.decodeValue(rvn, d.h.fnNoExt(bfn.rt))
 				} else {
 					rvn = reflect.New(bfn.rt)
@@ -474,12 +553,12 @@ func (d *Decoder) kInterfaceNaked(f *codecFnInfo) (rvn reflect.Value) {
 		} else {
 			// one of the BytesExt ones: binc, msgpack, simple
 			if bfn == nil {
-				re.Data = detachZeroCopyBytes(d.bytes, nil, bytes)
-				rvn = rv4i(&re).Elem()
+				re.setData(bytes, false)
+				rvn = rv4iptr(&re).Elem()
 			} else {
 				rvn = reflect.New(bfn.rt)
 				if bfn.ext == SelfExt {
-					d.sideDecode(rv2i(rvn), bytes)
+					d.sideDecode(rv2i(rvn), bfn.rt, bytes)
 				} else {
 					bfn.ext.ReadExt(rv2i(rvn), bytes)
 				}
@@ -520,20 +599,34 @@ func (d *Decoder) kInterface(f *codecFnInfo, rv reflect.Value) {
 	// if an interface already contains something, we try
 	// to decode into what was there before.
 	// We do not replace with a generic value (as got from decodeNaked).
-
+	//
 	// every interface passed here MUST be settable.
+	//
+	// ensure you call rvSetIntf(...) before returning.
+
+	isnilrv := rvIsNil(rv)
+
 	var rvn reflect.Value
-	if d.h.InterfaceReset || rvIsNil(rv) {
+
+	if d.h.InterfaceReset {
 		// check if mapping to a type: if so, initialize it and move on
 		rvn = d.h.intf2impl(f.ti.rtid)
-		if rvn.IsValid() {
-			rv.Set(rvn)
-		} else {
+		if !rvn.IsValid() {
 			rvn = d.kInterfaceNaked(f)
 			if rvn.IsValid() {
-				rv.Set(rvn)
-			} else if d.h.InterfaceReset {
-				setZeroRV(rv)
+				rvSetIntf(rv, rvn)
+			} else if !isnilrv {
+				decSetNonNilRV2Zero4Intf(rv)
+			}
+			return
+		}
+	} else if isnilrv {
+		// check if mapping to a type: if so, initialize it and move on
+		rvn = d.h.intf2impl(f.ti.rtid)
+		if !rvn.IsValid() {
+			rvn = d.kInterfaceNaked(f)
+			if rvn.IsValid() {
+				rvSetIntf(rv, rvn)
 			}
 			return
 		}
@@ -542,30 +635,22 @@ func (d *Decoder) kInterface(f *codecFnInfo, rv reflect.Value) {
 		rvn = rv.Elem()
 	}
 
-	// Note: interface{} is settable, but underlying type may not be.
-	// Consequently, we MAY have to create a decodable value out of the underlying value,
-	// decode into it, and reset the interface itself.
-	// fmt.Printf(">>>> kInterface: rvn type: %v, rv type: %v\n", rvn.Type(), rv.Type())
+	// rvn is now a non-interface type
 
-	if isDecodeable(rvn) {
-		d.decodeValue(rvn, nil)
-		return
-	}
-
-	rvn2 := rvZeroAddrK(rvType(rvn), rvn.Kind())
-	rvSetDirect(rvn2, rvn)
-	d.decodeValue(rvn2, nil)
-	rv.Set(rvn2)
-}
+	canDecode, _ := isDecodeable(rvn)
 
-func decStructFieldKey(dd decDriver, keyType valueType, b *[decScratchByteArrayLen]byte) (rvkencname []byte) {
-	// use if-else-if, not switch (which compiles to binary-search)
-	// since keyType is typically valueTypeString, branch prediction is pretty good.
+	// Note: interface{} is settable, but underlying type may not be.
+	// Consequently, we MAY have to allocate a value (containing the underlying value),
+	// decode into it, and reset the interface to that new value.
 
-	if keyType == valueTypeString {
-		return dd.DecodeStringAsBytes()
+	if !canDecode {
+		rvn2 := d.oneShotAddrRV(rvn.Type(), rvn.Kind())
+		rvSetDirect(rvn2, rvn)
+		rvn = rvn2
 	}
-	return decStructFieldKeyNotString(dd, keyType, b)
+
+	d.decodeValue(rvn, nil)
+	rvSetIntf(rv, rvn)
 }
 
 func decStructFieldKeyNotString(dd decDriver, keyType valueType, b *[decScratchByteArrayLen]byte) (rvkencname []byte) {
@@ -581,16 +666,27 @@ func decStructFieldKeyNotString(dd decDriver, keyType valueType, b *[decScratchB
 	return
 }
 
+func (d *Decoder) kStructField(si *structFieldInfo, rv reflect.Value) {
+	if d.d.TryNil() {
+		if rv = si.path.field(rv); rv.IsValid() {
+			decSetNonNilRV2Zero(rv)
+		}
+		return
+	}
+	d.decodeValueNoCheckNil(si.path.fieldAlloc(rv), nil)
+}
+
 func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	ctyp := d.d.ContainerType()
+	ti := f.ti
 	var mf MissingFielder
-	if f.ti.flagMissingFielder {
+	if ti.flagMissingFielder {
 		mf = rv2i(rv).(MissingFielder)
-	} else if f.ti.flagMissingFielderPtr {
-		mf = rv2i(rv.Addr()).(MissingFielder)
+	} else if ti.flagMissingFielderPtr {
+		mf = rv2i(rvAddr(rv, ti.ptr)).(MissingFielder)
 	}
 	if ctyp == valueTypeMap {
-		containerLen := d.mapStart()
+		containerLen := d.mapStart(d.d.ReadMapStart())
 		if containerLen == 0 {
 			d.mapEnd()
 			return
@@ -602,16 +698,16 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 			name2 = namearr2[:0]
 		}
 		var rvkencname []byte
-		for j := 0; d.mapNext(j, containerLen, hasLen); j++ {
+		for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 			d.mapElemKey()
-			if f.ti.keyType == valueTypeString {
+			if ti.keyType == valueTypeString {
 				rvkencname = d.d.DecodeStringAsBytes()
 			} else {
-				rvkencname = decStructFieldKeyNotString(d.d, f.ti.keyType, &d.b)
+				rvkencname = decStructFieldKeyNotString(d.d, ti.keyType, &d.b)
 			}
 			d.mapElemValue()
-			if si := f.ti.siForEncName(stringView(rvkencname)); si != nil {
-				d.decodeValue(si.path.fieldAlloc(rv), nil)
+			if si := ti.siForEncName(rvkencname); si != nil {
+				d.kStructField(si, rv)
 			} else if mf != nil {
 				// store rvkencname in new []byte, as it previously shares Decoder.b, which is used in decode
 				name2 = append(name2[:0], rvkencname...)
@@ -626,44 +722,28 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 		}
 		d.mapEnd()
 	} else if ctyp == valueTypeArray {
-		containerLen := d.arrayStart()
+		containerLen := d.arrayStart(d.d.ReadArrayStart())
 		if containerLen == 0 {
 			d.arrayEnd()
 			return
 		}
 		// Not much gain from doing it two ways for array.
 		// Arrays are not used as much for structs.
+		tisfi := ti.sfi.source()
 		hasLen := containerLen >= 0
-		var checkbreak bool
-		for j, si := range f.ti.sfiSrc {
-			if hasLen {
-				if j == containerLen {
-					break
-				}
-			} else if d.checkBreak() {
-				checkbreak = true
-				break
-			}
+
+		// iterate all the items in the stream
+		// if mapped elem-wise to a field, handle it
+		// if more stream items than can be mapped, error it
+		for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 			d.arrayElem()
-			d.decodeValue(si.path.fieldAlloc(rv), nil)
-		}
-		var proceed bool
-		if hasLen {
-			proceed = containerLen > len(f.ti.sfiSrc)
-		} else {
-			proceed = !checkbreak
-		}
-		// if (hasLen && containerLen > len(f.ti.sfiSrc)) || (!hasLen && !checkbreak) {
-		if proceed {
-			// read remaining values and throw away
-			for j := len(f.ti.sfiSrc); ; j++ {
-				if !d.mapNext(j, containerLen, hasLen) {
-					break
-				}
-				d.arrayElem()
+			if j < len(tisfi) {
+				d.kStructField(tisfi[j], rv)
+			} else {
 				d.structFieldNotFound(j, "")
 			}
 		}
+
 		d.arrayEnd()
 	} else {
 		d.onerror(errNeedMapOrArrayDecodeToStruct)
@@ -676,20 +756,22 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 
 	// Note: rv is a slice type here - guaranteed
 
+	ti := f.ti
 	rvCanset := rv.CanSet()
 
 	ctyp := d.d.ContainerType()
 	if ctyp == valueTypeBytes || ctyp == valueTypeString {
 		// you can only decode bytes or string in the stream into a slice or array of bytes
-		if !(f.ti.rtid == uint8SliceTypId || f.ti.elemkind == uint8(reflect.Uint8)) {
-			d.errorf("bytes/string in stream must decode into slice/array of bytes, not %v", f.ti.rt)
+		if !(ti.rtid == uint8SliceTypId || ti.elemkind == uint8(reflect.Uint8)) {
+			d.errorf("bytes/string in stream must decode into slice/array of bytes, not %v", ti.rt)
 		}
 		rvbs := rvGetBytes(rv)
 		if !rvCanset {
 			// not addressable byte slice, so do not decode into it past the length
 			rvbs = rvbs[:len(rvbs):len(rvbs)]
 		}
-		bs2 := d.d.DecodeBytes(rvbs, false)
+		bs2 := d.decodeBytesInto(rvbs)
+		// if !(len(bs2) == len(rvbs) && byteSliceSameData(rvbs, bs2)) {
 		if !(len(bs2) > 0 && len(bs2) == len(rvbs) && &bs2[0] == &rvbs[0]) {
 			if rvCanset {
 				rvSetBytes(rv, bs2)
@@ -706,7 +788,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	if containerLenS == 0 {
 		if rvCanset {
 			if rvIsNil(rv) {
-				rvSetDirect(rv, rvSliceZeroCap(f.ti.rt))
+				rvSetDirect(rv, rvSliceZeroCap(ti.rt))
 			} else {
 				rvSetSliceLen(rv, 0)
 			}
@@ -715,12 +797,11 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 		return
 	}
 
-	rtelem0Mut := !isImmutableKind(reflect.Kind(f.ti.elemkind))
-	rtelem := f.ti.elem
-	rtelemkind := rtelem.Kind()
-	for rtelemkind == reflect.Ptr {
+	rtelem0Mut := !scalarBitset.isset(ti.elemkind)
+	rtelem := ti.elem
+
+	for k := reflect.Kind(ti.elemkind); k == reflect.Ptr; k = rtelem.Kind() {
 		rtelem = rtelem.Elem()
-		rtelemkind = rtelem.Kind()
 	}
 
 	var fn *codecFn
@@ -736,7 +817,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	if hasLen {
 		if containerLenS > rvcap {
 			oldRvlenGtZero := rvlen > 0
-			rvlen1 := decInferLen(containerLenS, d.h.MaxInitLen, int(f.ti.elemsize))
+			rvlen1 := decInferLen(containerLenS, d.h.MaxInitLen, int(ti.elemsize))
 			if rvlen1 == rvlen {
 			} else if rvlen1 <= rvcap {
 				if rvCanset {
@@ -745,15 +826,14 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 				}
 			} else if rvCanset { // rvlen1 > rvcap
 				rvlen = rvlen1
-				rv = reflect.MakeSlice(f.ti.rt, rvlen, rvlen)
-				rvCanset = false
+				rv, rvCanset = rvMakeSlice(rv, f.ti, rvlen, rvlen)
 				rvcap = rvlen
-				rvChanged = true
+				rvChanged = !rvCanset
 			} else { // rvlen1 > rvcap && !canSet
 				d.errorf("cannot decode into non-settable slice")
 			}
 			if rvChanged && oldRvlenGtZero && rtelem0Mut {
-				rvCopySlice(rv, rv0) // only copy up to length NOT cap i.e. rv0.Slice(0, rvcap)
+				rvCopySlice(rv, rv0, rtelem) // only copy up to length NOT cap i.e. rv0.Slice(0, rvcap)
 			}
 		} else if containerLenS != rvlen {
 			if rvCanset {
@@ -768,24 +848,24 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 
 	var j int
 
-	for ; d.mapNext(j, containerLenS, hasLen); j++ {
-		if j == 0 && f.seq == seqTypeSlice && rvIsNil(rv) { // means hasLen = false
-			if rvCanset {
-				rvlen = decDefSliceCap
-				rvcap = rvlen + rvlen
-				rv = reflect.MakeSlice(f.ti.rt, rvlen, rvcap)
-				rvCanset = false
-				rvChanged = true
-			} else {
-				d.errorf("cannot decode into non-settable slice")
+	for ; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j == 0 {
+			if rvIsNil(rv) { // means hasLen = false
+				if rvCanset {
+					rvlen = decInferLen(containerLenS, d.h.MaxInitLen, int(ti.elemsize))
+					rv, rvCanset = rvMakeSlice(rv, f.ti, rvlen, rvlen)
+					rvcap = rvlen
+					rvChanged = !rvCanset
+				} else {
+					d.errorf("cannot decode into non-settable slice")
+				}
+			}
+			if fn == nil {
+				fn = d.h.fn(rtelem)
 			}
 		}
 		// if indefinite, etc, then expand the slice if necessary
 		if j >= rvlen {
-			if f.seq == seqTypeArray {
-				decArrayCannotExpand(slh, hasLen, rvlen, j, containerLenS)
-				return
-			}
 			slh.ElemContainerState(j)
 
 			// expand the slice up to the cap.
@@ -804,25 +884,16 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 				if !(rvCanset || rvChanged) {
 					d.onerror(errExpandSliceCannotChange)
 				}
-				rvcap = int(growCap(uint(rvcap), uint(f.ti.elemsize), 1))
+				rv, rvcap, rvCanset = rvGrowSlice(rv, f.ti, rvcap, 1)
 				rvlen = rvcap
-				rv9 = reflect.MakeSlice(f.ti.rt, rvlen, rvcap)
-				rvCopySlice(rv9, rv)
-				rv = rv9
-				rvCanset = false
-				rvChanged = true
+				rvChanged = !rvCanset
 			}
 		} else {
 			slh.ElemContainerState(j)
 		}
 		rv9 = rvSliceIndex(rv, j, f.ti)
 		if elemReset {
-			// rv9.Set(rtelem0Zero) // elem0Zero initialized to rvZeroK(f.ti.elem, f.ti.elemkind)
-			rvSetDirectZero(rv9)
-		}
-
-		if fn == nil {
-			fn = d.h.fn(rtelem)
+			rvSetZero(rv9)
 		}
 		d.decodeValue(rv9, fn)
 	}
@@ -835,7 +906,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 		// rvlen = j
 	} else if j == 0 && rvIsNil(rv) {
 		if rvCanset {
-			rv = rvSliceZeroCap(f.ti.rt)
+			rv = rvSliceZeroCap(ti.rt)
 			rvCanset = false
 			rvChanged = true
 		}
@@ -843,24 +914,89 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	slh.End()
 
 	if rvChanged { // infers rvCanset=true, so it can be reset
-		rv0.Set(rv)
+		rvSetDirect(rv0, rv)
+	}
+}
+
+func (d *Decoder) kArray(f *codecFnInfo, rv reflect.Value) {
+	// An array can be set from a map or array in stream.
+
+	ctyp := d.d.ContainerType()
+	if handleBytesWithinKArray && (ctyp == valueTypeBytes || ctyp == valueTypeString) {
+		// you can only decode bytes or string in the stream into a slice or array of bytes
+		if f.ti.elemkind != uint8(reflect.Uint8) {
+			d.errorf("bytes/string in stream can decode into array of bytes, but not %v", f.ti.rt)
+		}
+		rvbs := rvGetArrayBytes(rv, nil)
+		bs2 := d.decodeBytesInto(rvbs)
+		if !byteSliceSameData(rvbs, bs2) && len(rvbs) > 0 && len(bs2) > 0 {
+			copy(rvbs, bs2)
+		}
+		return
+	}
+
+	slh, containerLenS := d.decSliceHelperStart() // only expects valueType(Array|Map) - never Nil
+
+	// an array can never return a nil slice. so no need to check f.array here.
+	if containerLenS == 0 {
+		slh.End()
+		return
+	}
+
+	rtelem := f.ti.elem
+	for k := reflect.Kind(f.ti.elemkind); k == reflect.Ptr; k = rtelem.Kind() {
+		rtelem = rtelem.Elem()
+	}
+
+	var fn *codecFn
+
+	var rv9 reflect.Value
+
+	rvlen := rv.Len() // same as cap
+	hasLen := containerLenS > 0
+	if hasLen && containerLenS > rvlen {
+		d.errorf("cannot decode into array with length: %v, less than container length: %v", rvlen, containerLenS)
+	}
+
+	// consider creating new element once, and just decoding into it.
+	var elemReset = d.h.SliceElementReset
+
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		// note that you cannot expand the array if indefinite and we go past array length
+		if j >= rvlen {
+			slh.arrayCannotExpand(hasLen, rvlen, j, containerLenS)
+			return
+		}
+
+		slh.ElemContainerState(j)
+		rv9 = rvArrayIndex(rv, j, f.ti)
+		if elemReset {
+			rvSetZero(rv9)
+		}
+
+		if fn == nil {
+			fn = d.h.fn(rtelem)
+		}
+		d.decodeValue(rv9, fn)
 	}
+	slh.End()
 }
 
-func (d *Decoder) kSliceForChan(f *codecFnInfo, rv reflect.Value) {
+func (d *Decoder) kChan(f *codecFnInfo, rv reflect.Value) {
 	// A slice can be set from a map or array in stream.
 	// This way, the order can be kept (as order is lost with map).
 
-	if f.ti.chandir&uint8(reflect.SendDir) == 0 {
+	ti := f.ti
+	if ti.chandir&uint8(reflect.SendDir) == 0 {
 		d.errorf("receive-only channel cannot be decoded")
 	}
 	ctyp := d.d.ContainerType()
 	if ctyp == valueTypeBytes || ctyp == valueTypeString {
 		// you can only decode bytes or string in the stream into a slice or array of bytes
-		if !(f.ti.rtid == uint8SliceTypId || f.ti.elemkind == uint8(reflect.Uint8)) {
-			d.errorf("bytes/string in stream must decode into slice/array of bytes, not %v", f.ti.rt)
+		if !(ti.rtid == uint8SliceTypId || ti.elemkind == uint8(reflect.Uint8)) {
+			d.errorf("bytes/string in stream must decode into slice/array of bytes, not %v", ti.rt)
 		}
-		bs2 := d.d.DecodeBytes(nil, true)
+		bs2 := d.d.DecodeBytes(nil)
 		irv := rv2i(rv)
 		ch, ok := irv.(chan<- byte)
 		if !ok {
@@ -880,18 +1016,17 @@ func (d *Decoder) kSliceForChan(f *codecFnInfo, rv reflect.Value) {
 	// an array can never return a nil slice. so no need to check f.array here.
 	if containerLenS == 0 {
 		if rvCanset && rvIsNil(rv) {
-			rvSetDirect(rv, reflect.MakeChan(f.ti.rt, 0))
+			rvSetDirect(rv, reflect.MakeChan(ti.rt, 0))
 		}
 		slh.End()
 		return
 	}
 
-	rtelem0Mut := !isImmutableKind(reflect.Kind(f.ti.elemkind))
-	rtelem := f.ti.elem
-	rtelemkind := rtelem.Kind()
-	for rtelemkind == reflect.Ptr {
+	rtelem := ti.elem
+	useTransient := decUseTransient && ti.elemkind != byte(reflect.Ptr) && ti.tielem.flagCanTransient
+
+	for k := reflect.Kind(ti.elemkind); k == reflect.Ptr; k = rtelem.Kind() {
 		rtelem = rtelem.Elem()
-		rtelemkind = rtelem.Kind()
 	}
 
 	var fn *codecFn
@@ -900,45 +1035,51 @@ func (d *Decoder) kSliceForChan(f *codecFnInfo, rv reflect.Value) {
 	var rv0 = rv
 	var rv9 reflect.Value
 
-	var rvlen int // := rv.Len()
+	var rvlen int // = rv.Len()
 	hasLen := containerLenS > 0
 
-	var j int
-
-	for ; d.mapNext(j, containerLenS, hasLen); j++ {
-		if j == 0 && rvIsNil(rv) {
-			if hasLen {
-				rvlen = decInferLen(containerLenS, d.h.MaxInitLen, int(f.ti.elemsize))
-			} else {
-				rvlen = decDefChanCap
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j == 0 {
+			if rvIsNil(rv) {
+				if hasLen {
+					rvlen = decInferLen(containerLenS, d.h.MaxInitLen, int(ti.elemsize))
+				} else {
+					rvlen = decDefChanCap
+				}
+				if rvCanset {
+					rv = reflect.MakeChan(ti.rt, rvlen)
+					rvChanged = true
+				} else {
+					d.errorf("cannot decode into non-settable chan")
+				}
 			}
-			if rvCanset {
-				rv = reflect.MakeChan(f.ti.rt, rvlen)
-				rvChanged = true
-			} else {
-				d.errorf("cannot decode into non-settable chan")
+			if fn == nil {
+				fn = d.h.fn(rtelem)
 			}
 		}
 		slh.ElemContainerState(j)
-		if rtelem0Mut || !rv9.IsValid() { // || (f.ti.elemkind == reflect.Ptr && rvIsNil(rv9)) {
-			rv9 = rvZeroAddrK(f.ti.elem, reflect.Kind(f.ti.elemkind))
+		if rv9.IsValid() {
+			rvSetZero(rv9)
+		} else if decUseTransient && useTransient {
+			rv9 = d.perType.TransientAddrK(ti.elem, reflect.Kind(ti.elemkind))
+		} else {
+			rv9 = rvZeroAddrK(ti.elem, reflect.Kind(ti.elemkind))
 		}
-		if fn == nil {
-			fn = d.h.fn(rtelem)
+		if !d.d.TryNil() {
+			d.decodeValueNoCheckNil(rv9, fn)
 		}
-		d.decodeValue(rv9, fn)
 		rv.Send(rv9)
 	}
 	slh.End()
 
 	if rvChanged { // infers rvCanset=true, so it can be reset
-		rv0.Set(rv)
+		rvSetDirect(rv0, rv)
 	}
 
 }
 
 func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	ti := f.ti
 	if rvIsNil(rv) {
 		rvlen := decInferLen(containerLen, d.h.MaxInitLen, int(ti.keysize+ti.elemsize))
@@ -952,28 +1093,45 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 
 	ktype, vtype := ti.key, ti.elem
 	ktypeId := rt2id(ktype)
-	vtypeKind := vtype.Kind()
-	ktypeKind := ktype.Kind()
+	vtypeKind := reflect.Kind(ti.elemkind)
+	ktypeKind := reflect.Kind(ti.keykind)
+	kfast := mapKeyFastKindFor(ktypeKind)
+	visindirect := mapStoresElemIndirect(uintptr(ti.elemsize))
+	visref := refBitset.isset(ti.elemkind)
+
+	vtypePtr := vtypeKind == reflect.Ptr
+	ktypePtr := ktypeKind == reflect.Ptr
+
+	vTransient := decUseTransient && !vtypePtr && ti.tielem.flagCanTransient
+	kTransient := decUseTransient && !ktypePtr && ti.tikey.flagCanTransient
 
 	var vtypeElem reflect.Type
 
 	var keyFn, valFn *codecFn
-	var ktypeLo, vtypeLo reflect.Type
+	var ktypeLo, vtypeLo = ktype, vtype
 
-	for ktypeLo = ktype; ktypeLo.Kind() == reflect.Ptr; ktypeLo = ktypeLo.Elem() {
+	if ktypeKind == reflect.Ptr {
+		for ktypeLo = ktype.Elem(); ktypeLo.Kind() == reflect.Ptr; ktypeLo = ktypeLo.Elem() {
+		}
 	}
 
-	for vtypeLo = vtype; vtypeLo.Kind() == reflect.Ptr; vtypeLo = vtypeLo.Elem() {
+	if vtypePtr {
+		vtypeElem = vtype.Elem()
+		for vtypeLo = vtypeElem; vtypeLo.Kind() == reflect.Ptr; vtypeLo = vtypeLo.Elem() {
+		}
 	}
 
-	rvvMut := !isImmutableKind(vtypeKind)
-	rvvCanNil := isnilBitset.isset(byte(vtypeKind))
-	var vtypeIsPtr, vtypeIsMap bool
-	if rvvMut {
-		vtypeIsPtr = vtypeKind == reflect.Ptr
-		vtypeIsMap = vtypeKind == reflect.Ptr
-	}
+	rvkMut := !scalarBitset.isset(ti.keykind) // if ktype is immutable, then re-use the same rvk.
+	rvvMut := !scalarBitset.isset(ti.elemkind)
+	rvvCanNil := isnilBitset.isset(ti.elemkind)
 
+	// rvk: key
+	// rvkn: if non-mutable, on each iteration of loop, set rvk to this
+	// rvv: value
+	// rvvn: if non-mutable, on each iteration of loop, set rvv to this
+	//       if mutable, may be used as a temporary value for local-scoped operations
+	// rvva: if mutable, used as transient value for use for key lookup
+	// rvvz: zero value of map value type, used to do a map set when nil is found in stream
 	var rvk, rvkn, rvv, rvvn, rvva, rvvz reflect.Value
 
 	// we do a doMapGet if kind is mutable, and InterfaceReset=true if interface
@@ -983,26 +1141,57 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 		if rvvMut && (vtypeKind != reflect.Interface || !d.h.InterfaceReset) {
 			doMapGet = true
 			rvva = mapAddrLoopvarRV(vtype, vtypeKind)
-			if vtypeKind == reflect.Ptr {
-				vtypeElem = vtype.Elem()
-			}
 		}
 	}
 
-	rvkMut := !isImmutableKind(ktype.Kind()) // if ktype is immutable, then re-use the same rvk.
 	ktypeIsString := ktypeId == stringTypId
 	ktypeIsIntf := ktypeId == intfTypId
 
 	hasLen := containerLen > 0
+
+	// kstrbs is used locally for the key bytes, so we can reduce allocation.
+	// When we read keys, we copy to this local bytes array, and use a stringView for lookup.
+	// We only convert it into a true string if we have to do a set on the map.
+
+	// Since kstr2bs will usually escape to the heap, declaring a [64]byte array may be wasteful.
+	// It is only valuable if we are sure that it is declared on the stack.
+	// var kstrarr [64]byte // most keys are less than 32 bytes, and even more less than 64
+	// var kstrbs = kstrarr[:0]
 	var kstrbs []byte
+	var kstr2bs []byte
+	var s string
+
+	var callFnRvk bool
+
+	fnRvk2 := func() (s string) {
+		callFnRvk = false
+		if len(kstr2bs) < 2 {
+			return string(kstr2bs)
+		}
+		return d.mapKeyString(&callFnRvk, &kstrbs, &kstr2bs)
+	}
 
-	for j := 0; d.mapNext(j, containerLen, hasLen); j++ {
+	// Use a possibly transient (map) value (and key), to reduce allocation
+
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
+		callFnRvk = false
 		if j == 0 {
+			// if vtypekind is a scalar and thus value will be decoded using TransientAddrK,
+			// then it is ok to use TransientAddr2K for the map key.
+			if decUseTransient && vTransient && kTransient {
+				rvk = d.perType.TransientAddr2K(ktype, ktypeKind)
+			} else {
+				rvk = rvZeroAddrK(ktype, ktypeKind)
+			}
 			if !rvkMut {
-				rvkn = rvZeroAddrK(ktype, ktypeKind)
+				rvkn = rvk
 			}
 			if !rvvMut {
-				rvvn = rvZeroAddrK(vtype, vtypeKind)
+				if decUseTransient && vTransient {
+					rvvn = d.perType.TransientAddrK(vtype, vtypeKind)
+				} else {
+					rvvn = rvZeroAddrK(vtype, vtypeKind)
+				}
 			}
 			if !ktypeIsString && keyFn == nil {
 				keyFn = d.h.fn(ktypeLo)
@@ -1010,26 +1199,24 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 			if valFn == nil {
 				valFn = d.h.fn(vtypeLo)
 			}
-		}
-
-		if rvkMut {
-			rvk = rvZeroAddrK(ktype, ktypeKind)
+		} else if rvkMut {
+			rvSetZero(rvk)
 		} else {
 			rvk = rvkn
 		}
 
 		d.mapElemKey()
-
 		if ktypeIsString {
-			kstrbs = d.d.DecodeStringAsBytes()
-			rvk.SetString(d.string(kstrbs))
+			kstr2bs = d.d.DecodeStringAsBytes()
+			rvSetString(rvk, fnRvk2())
 		} else {
+			d.decByteState = decByteStateNone
 			d.decodeValue(rvk, keyFn)
-
-			// special case if interface wrapping a byte array.
+			// special case if interface wrapping a byte slice
 			if ktypeIsIntf {
-				if rvk2 := rvk.Elem(); rvk2.IsValid() && rvType(rvk2) == uint8SliceTyp {
-					rvk.Set(rv4i(d.string(rvGetBytes(rvk2))))
+				if rvk2 := rvk.Elem(); rvk2.IsValid() && rvk2.Type() == uint8SliceTyp {
+					kstr2bs = rvGetBytes(rvk2)
+					rvSetIntf(rvk, rv4istr(fnRvk2()))
 				}
 				// NOTE: consider failing early if map/slice/func
 			}
@@ -1042,45 +1229,82 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 			if !rvvz.IsValid() {
 				rvvz = rvZeroK(vtype, vtypeKind)
 			}
-			mapSet(rv, rvk, rvvz)
+			if callFnRvk {
+				s = d.string(kstr2bs)
+				if ktypeIsString {
+					rvSetString(rvk, s)
+				} else { // ktypeIsIntf
+					rvSetIntf(rvk, rv4istr(s))
+				}
+			}
+			mapSet(rv, rvk, rvvz, kfast, visindirect, visref)
 			continue
 		}
 
 		// there is non-nil content in the stream to decode ...
+		// consequently, it's ok to just directly create new value to the pointer (if vtypePtr)
 
-		// set doMapSet to false if u do a get, and its a non-nil pointer, else keep as true
+		// set doMapSet to false iff u do a get, and the return value is a non-nil pointer
 		doMapSet = true
 
-		if rvvMut {
-			if doMapGet {
-				rvv = mapGet(rv, rvk, rvva)
-				if rvv.IsValid() && (!rvvCanNil || (rvvCanNil && !rvIsNil(rvv))) {
-					if vtypeIsPtr {
-						doMapSet = false
-					} else if vtypeKind != reflect.Map { // ok to decode directly into map
-						// make addressable (so you can set the slice/array elements or interface, etc)
-						rvvn = rvZeroAddrK(vtype, vtypeKind)
-						rvvn.Set(rvv)
-						rvv = rvvn
-					}
+		if !rvvMut {
+			rvv = rvvn
+		} else if !doMapGet {
+			goto NEW_RVV
+		} else {
+			rvv = mapGet(rv, rvk, rvva, kfast, visindirect, visref)
+			if !rvv.IsValid() || (rvvCanNil && rvIsNil(rvv)) {
+				goto NEW_RVV
+			}
+			switch vtypeKind {
+			case reflect.Ptr, reflect.Map: // ok to decode directly into map
+				doMapSet = false
+			case reflect.Interface:
+				// if an interface{}, just decode into it iff a non-nil ptr/map, else allocate afresh
+				rvvn = rvv.Elem()
+				if k := rvvn.Kind(); (k == reflect.Ptr || k == reflect.Map) && !rvIsNil(rvvn) {
+					d.decodeValueNoCheckNil(rvvn, nil) // valFn is incorrect here
+					continue
+				}
+				// make addressable (so we can set the interface)
+				rvvn = rvZeroAddrK(vtype, vtypeKind)
+				rvSetIntf(rvvn, rvv)
+				rvv = rvvn
+			default:
+				// make addressable (so you can set the slice/array elements, etc)
+				if decUseTransient && vTransient {
+					rvvn = d.perType.TransientAddrK(vtype, vtypeKind)
 				} else {
-					if vtypeIsMap {
-						rvv = reflect.New(vtypeElem)
-					} else {
-						rvv = rvZeroAddrK(vtype, vtypeKind)
-					}
+					rvvn = rvZeroAddrK(vtype, vtypeKind)
 				}
-			} else {
-				rvv = rvZeroAddrK(vtype, vtypeKind)
+				rvSetDirect(rvvn, rvv)
+				rvv = rvvn
 			}
+		}
+		goto DECODE_VALUE_NO_CHECK_NIL
+
+	NEW_RVV:
+		if vtypePtr {
+			rvv = reflect.New(vtypeElem) // non-nil in stream, so allocate value
+		} else if decUseTransient && vTransient {
+			rvv = d.perType.TransientAddrK(vtype, vtypeKind)
 		} else {
-			rvv = rvvn
+			rvv = rvZeroAddrK(vtype, vtypeKind)
 		}
 
+	DECODE_VALUE_NO_CHECK_NIL:
 		d.decodeValueNoCheckNil(rvv, valFn)
 
 		if doMapSet {
-			mapSet(rv, rvk, rvv)
+			if callFnRvk {
+				s = d.string(kstr2bs)
+				if ktypeIsString {
+					rvSetString(rvk, s)
+				} else { // ktypeIsIntf
+					rvSetIntf(rvk, rv4istr(s))
+				}
+			}
+			mapSet(rv, rvk, rvv, kfast, visindirect, visref)
 		}
 	}
 
@@ -1117,7 +1341,10 @@ type Decoder struct {
 	hh  Handle
 	err error
 
-	is map[string]string // used for interning strings
+	perType decPerType
+
+	// used for interning strings
+	is internerMap
 
 	// ---- cpu cache line boundary?
 	// ---- writable fields during execution --- *try* to keep in sep cache line
@@ -1130,7 +1357,8 @@ type Decoder struct {
 	calls uint16 // what depth in mustDecode are we in now.
 
 	c containerState
-	_ [1]byte // padding
+
+	decByteState
 
 	// b is an always-available scratch buffer used by Decoder and decDrivers.
 	// By being always-available, it can be used for one-off things without
@@ -1144,7 +1372,9 @@ type Decoder struct {
 // OR pass in a memory buffered reader (eg bufio.Reader, bytes.Buffer).
 func NewDecoder(r io.Reader, h Handle) *Decoder {
 	d := h.newDecDriver().decoder()
-	d.Reset(r)
+	if r != nil {
+		d.Reset(r)
+	}
 	return d
 }
 
@@ -1152,38 +1382,57 @@ func NewDecoder(r io.Reader, h Handle) *Decoder {
 // from a byte slice with zero copying.
 func NewDecoderBytes(in []byte, h Handle) *Decoder {
 	d := h.newDecDriver().decoder()
-	d.ResetBytes(in)
+	if in != nil {
+		d.ResetBytes(in)
+	}
 	return d
 }
 
+// NewDecoderString returns a Decoder which efficiently decodes directly
+// from a string with zero copying.
+//
+// It is a convenience function that calls NewDecoderBytes with a
+// []byte view into the string.
+//
+// This can be an efficient zero-copy if using default mode i.e. without codec.safe tag.
+func NewDecoderString(s string, h Handle) *Decoder {
+	return NewDecoderBytes(bytesView(s), h)
+}
+
 func (d *Decoder) r() *decRd {
 	return &d.decRd
 }
 
 func (d *Decoder) init(h Handle) {
+	initHandle(h)
+	d.cbreak = d.js || d.cbor
 	d.bytes = true
 	d.err = errDecoderNotInitialized
-	d.h = basicHandle(h)
+	d.h = h.getBasicHandle()
 	d.hh = h
 	d.be = h.isBinary()
-	// NOTE: do not initialize d.n here. It is lazily initialized in d.naked()
-	if d.h.InternString {
-		d.is = make(map[string]string, 32)
+	if d.h.InternString && d.is == nil {
+		d.is.init()
 	}
+	// NOTE: do not initialize d.n here. It is lazily initialized in d.naked()
 }
 
 func (d *Decoder) resetCommon() {
 	d.d.reset()
 	d.err = nil
+	d.c = 0
+	d.decByteState = decByteStateNone
 	d.depth = 0
 	d.calls = 0
-	d.maxdepth = d.h.MaxDepth
-	if d.maxdepth <= 0 {
-		d.maxdepth = decDefMaxDepth
-	}
 	// reset all things which were cached from the Handle, but could change
-	d.mtid, d.stid = 0, 0
-	d.mtr, d.str = false, false
+	d.maxdepth = decDefMaxDepth
+	if d.h.MaxDepth > 0 {
+		d.maxdepth = d.h.MaxDepth
+	}
+	d.mtid = 0
+	d.stid = 0
+	d.mtr = false
+	d.str = false
 	if d.h.MapType != nil {
 		d.mtid = rt2id(d.h.MapType)
 		d.mtr = fastpathAvIndex(d.mtid) != -1
@@ -1198,24 +1447,14 @@ func (d *Decoder) resetCommon() {
 // clearing all state from last run(s).
 func (d *Decoder) Reset(r io.Reader) {
 	if r == nil {
-		return
+		r = &eofReader
 	}
 	d.bytes = false
-	if d.h.ReaderBufferSize > 0 {
-		if d.bi == nil {
-			d.bi = new(bufioDecReader)
-		}
-		d.bi.reset(r, d.h.ReaderBufferSize, &d.blist)
-		d.bufio = true
-		d.decReader = d.bi
-	} else {
-		if d.ri == nil {
-			d.ri = new(ioDecReader)
-		}
-		d.ri.reset(r, &d.blist)
-		d.bufio = false
-		d.decReader = d.ri
+	if d.ri == nil {
+		d.ri = new(ioDecReader)
 	}
+	d.ri.reset(r, d.h.ReaderBufferSize, &d.blist)
+	d.decReader = d.ri
 	d.resetCommon()
 }
 
@@ -1223,15 +1462,25 @@ func (d *Decoder) Reset(r io.Reader) {
 // clearing all state from last run(s).
 func (d *Decoder) ResetBytes(in []byte) {
 	if in == nil {
-		return
+		in = []byte{}
 	}
-	d.bufio = false
 	d.bytes = true
 	d.decReader = &d.rb
 	d.rb.reset(in)
 	d.resetCommon()
 }
 
+// ResetString resets the Decoder with a new string to decode from,
+// clearing all state from last run(s).
+//
+// It is a convenience function that calls ResetBytes with a
+// []byte view into the string.
+//
+// This can be an efficient zero-copy if using default mode i.e. without codec.safe tag.
+func (d *Decoder) ResetString(s string) {
+	d.ResetBytes(bytesView(s))
+}
+
 func (d *Decoder) naked() *fauxUnion {
 	return &d.n
 }
@@ -1245,14 +1494,15 @@ func (d *Decoder) naked() *fauxUnion {
 // We will decode and store a value in that nil interface.
 //
 // Sample usages:
-//   // Decoding into a non-nil typed value
-//   var f float32
-//   err = codec.NewDecoder(r, handle).Decode(&f)
 //
-//   // Decoding into nil interface
-//   var v interface{}
-//   dec := codec.NewDecoder(r, handle)
-//   err = dec.Decode(&v)
+//	// Decoding into a non-nil typed value
+//	var f float32
+//	err = codec.NewDecoder(r, handle).Decode(&f)
+//
+//	// Decoding into nil interface
+//	var v interface{}
+//	dec := codec.NewDecoder(r, handle)
+//	err = dec.Decode(&v)
 //
 // When decoding into a nil interface{}, we will decode into an appropriate value based
 // on the contents of the stream:
@@ -1260,6 +1510,7 @@ func (d *Decoder) naked() *fauxUnion {
 //   - Other values are decoded appropriately depending on the type:
 //     bool, string, []byte, time.Time, etc
 //   - Extensions are decoded as RawExt (if no ext function registered for the tag)
+//
 // Configurations exist on the Handle to override defaults
 // (e.g. for MapType, SliceType and how to decode raw bytes).
 //
@@ -1301,38 +1552,32 @@ func (d *Decoder) Decode(v interface{}) (err error) {
 	// tried to use closure, as runtime optimizes defer with no params.
 	// This seemed to be causing weird issues (like circular reference found, unexpected panic, etc).
 	// Also, see https://github.com/golang/go/issues/14939#issuecomment-417836139
-	if d.err != nil {
-		return d.err
+	if !debugging {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				err = d.err
+			}
+		}()
 	}
-	defer func() {
-		if x := recover(); x != nil {
-			panicValToErr(d, x, &err)
-			d.err = err
-		}
-	}()
 
-	d.mustDecode(v)
+	d.MustDecode(v)
 	return
 }
 
 // MustDecode is like Decode, but panics if unable to Decode.
-// This provides insight to the code location that triggered the error.
+//
+// Note: This provides insight to the code location that triggered the error.
 func (d *Decoder) MustDecode(v interface{}) {
 	halt.onerror(d.err)
-	d.mustDecode(v)
-}
+	if d.hh == nil {
+		halt.onerror(errNoFormatHandle)
+	}
 
-// MustDecode is like Decode, but panics if unable to Decode.
-// This provides insight to the code location that triggered the error.
-func (d *Decoder) mustDecode(v interface{}) {
 	// Top-level: v is a pointer and not nil.
-
 	d.calls++
 	d.decode(v)
 	d.calls--
-	if d.calls == 0 {
-		d.d.atEndOfDecode()
-	}
 }
 
 // Release releases shared (pooled) resources.
@@ -1348,18 +1593,18 @@ func (d *Decoder) Release() {
 }
 
 func (d *Decoder) swallow() {
-	bs := d.blist.get(256)
-	bs = d.d.nextValueBytes(bs) // discard it
-	d.blist.put(bs)
+	d.d.nextValueBytes(nil)
 }
 
 func (d *Decoder) swallowErr() (err error) {
-	bs := d.blist.get(256)
-	defer func() {
-		panicToErr(d, &err)
-		d.blist.put(bs)
-	}()
-	bs = d.d.nextValueBytes(bs) // discard it
+	if !debugging {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &err)
+			}
+		}()
+	}
+	d.swallow()
 	return
 }
 
@@ -1401,6 +1646,10 @@ func setZero(iv interface{}) {
 		*v = 0
 	case *float64:
 		*v = 0
+	case *complex64:
+		*v = 0
+	case *complex128:
+		*v = 0
 	case *[]byte:
 		*v = nil
 	case *Raw:
@@ -1408,15 +1657,16 @@ func setZero(iv interface{}) {
 	case *time.Time:
 		*v = time.Time{}
 	case reflect.Value:
-		setZeroRV(v)
+		decSetNonNilRV2Zero(v)
 	default:
 		if !fastpathDecodeSetZeroTypeSwitch(iv) {
-			setZeroRV(rv)
+			decSetNonNilRV2Zero(rv)
 		}
 	}
 }
 
-func setZeroRV(v reflect.Value) {
+// decSetNonNilRV2Zero will set the non-nil value to its zero value.
+func decSetNonNilRV2Zero(v reflect.Value) {
 	// If not decodeable (settable), we do not touch it.
 	// We considered empty'ing it if not decodeable e.g.
 	//    - if chan, drain it
@@ -1427,14 +1677,33 @@ func setZeroRV(v reflect.Value) {
 	// whole value to the zero value, or leave AS IS.
 
 	k := v.Kind()
-	if k == reflect.Ptr || k == reflect.Interface {
-		v = v.Elem()
-	}
-	if v.CanSet() {
+	if k == reflect.Interface {
+		decSetNonNilRV2Zero4Intf(v)
+	} else if k == reflect.Ptr {
+		decSetNonNilRV2Zero4Ptr(v)
+	} else if v.CanSet() {
 		rvSetDirectZero(v)
 	}
 }
 
+func decSetNonNilRV2Zero4Ptr(v reflect.Value) {
+	ve := v.Elem()
+	if ve.CanSet() {
+		rvSetZero(ve) // we can have a pointer to an interface
+	} else if v.CanSet() {
+		rvSetZero(v)
+	}
+}
+
+func decSetNonNilRV2Zero4Intf(v reflect.Value) {
+	ve := v.Elem()
+	if ve.CanSet() {
+		rvSetDirectZero(ve) // interfaces always have element as a non-interface
+	} else if v.CanSet() {
+		rvSetZero(v)
+	}
+}
+
 func (d *Decoder) decode(iv interface{}) {
 	// a switch with only concrete types can be optimized.
 	// consequently, we deal with nil and interfaces outside the switch.
@@ -1447,12 +1716,12 @@ func (d *Decoder) decode(iv interface{}) {
 	// case nil:
 	// case Selfer:
 	case reflect.Value:
-		if !isDecodeable(v) {
+		if x, _ := isDecodeable(v); !x {
 			d.haltAsNotDecodeable(v)
 		}
 		d.decodeValue(v, nil)
 	case *string:
-		*v = string(d.d.DecodeStringAsBytes())
+		*v = d.stringZC(d.d.DecodeStringAsBytes())
 	case *bool:
 		*v = d.d.DecodeBool()
 	case *int:
@@ -1476,14 +1745,18 @@ func (d *Decoder) decode(iv interface{}) {
 	case *uint64:
 		*v = d.d.DecodeUint64()
 	case *float32:
-		*v = float32(d.decodeFloat32())
+		*v = d.decodeFloat32()
 	case *float64:
 		*v = d.d.DecodeFloat64()
+	case *complex64:
+		*v = complex(d.decodeFloat32(), 0)
+	case *complex128:
+		*v = complex(d.d.DecodeFloat64(), 0)
 	case *[]byte:
-		*v = d.d.DecodeBytes(*v, false)
+		*v = d.decodeBytesInto(*v)
 	case []byte:
 		// not addressable byte slice, so do not decode into it past the length
-		b := d.d.DecodeBytes(v[:len(v):len(v)], false)
+		b := d.decodeBytesInto(v[:len(v):len(v)])
 		if !(len(b) > 0 && len(b) == len(v) && &b[0] == &v[0]) { // not same slice
 			copy(v, b)
 		}
@@ -1493,13 +1766,13 @@ func (d *Decoder) decode(iv interface{}) {
 		*v = d.rawBytes()
 
 	case *interface{}:
-		d.decodeValue(rv4i(iv), nil)
+		d.decodeValue(rv4iptr(v), nil)
 
 	default:
 		// we can't check non-predefined types, as they might be a Selfer or extension.
 		if skipFastpathTypeSwitchInDirectCall || !fastpathDecodeTypeSwitch(iv, d) {
-			v := rv4i(iv)
-			if !isDecodeable(v) {
+			v := reflect.ValueOf(iv)
+			if x, _ := isDecodeable(v); !x {
 				d.haltAsNotDecodeable(v)
 			}
 			d.decodeValue(v, nil)
@@ -1517,7 +1790,7 @@ func (d *Decoder) decode(iv interface{}) {
 // subsequent calls i.e. kXXX methods, etc do not have to handle it themselves.
 func (d *Decoder) decodeValue(rv reflect.Value, fn *codecFn) {
 	if d.d.TryNil() {
-		setZeroRV(rv)
+		decSetNonNilRV2Zero(rv)
 		return
 	}
 	d.decodeValueNoCheckNil(rv, fn)
@@ -1532,7 +1805,7 @@ PTR:
 	if rv.Kind() == reflect.Ptr {
 		rvpValid = true
 		if rvIsNil(rv) {
-			rvSetDirect(rv, reflect.New(rvType(rv).Elem()))
+			rvSetDirect(rv, reflect.New(rv.Type().Elem()))
 		}
 		rvp = rv
 		rv = rv.Elem()
@@ -1540,25 +1813,24 @@ PTR:
 	}
 
 	if fn == nil {
-		fn = d.h.fn(rvType(rv))
+		fn = d.h.fn(rv.Type())
 	}
 	if fn.i.addrD {
 		if rvpValid {
-			fn.fd(d, &fn.i, rvp)
+			rv = rvp
 		} else if rv.CanAddr() {
-			fn.fd(d, &fn.i, rv.Addr())
+			rv = rvAddr(rv, fn.i.ti.ptr)
 		} else if fn.i.addrDf {
 			d.errorf("cannot decode into a non-pointer value")
-		} else {
-			fn.fd(d, &fn.i, rv)
 		}
-	} else {
-		fn.fd(d, &fn.i, rv)
 	}
+	fn.fd(d, &fn.i, rv)
 }
 
 func (d *Decoder) structFieldNotFound(index int, rvkencname string) {
-	// NOTE: rvkencname may be a stringView, so don't pass it to another function.
+	// Note: rvkencname is used only if there is an error, to pass into d.errorf.
+	// Consequently, it is ok to pass in a stringView
+	// Since rvkencname may be a stringView, do NOT pass it to another function.
 	if d.h.ErrorIfNoField {
 		if index >= 0 {
 			d.errorf("no matching struct field found when decoding stream array at index %v", index)
@@ -1575,36 +1847,11 @@ func (d *Decoder) arrayCannotExpand(sliceLen, streamLen int) {
 	}
 }
 
-// isDecodeable checks if value can be decoded into
-//
-// decode can take any reflect.Value that is a inherently addressable i.e.
-//   - array
-//   - non-nil chan    (we will SEND to it)
-//   - non-nil slice   (we will set its elements)
-//   - non-nil map     (we will put into it)
-//   - non-nil pointer (we can "update" it)
-func isDecodeable(rv reflect.Value) (canDecode bool) {
-	switch rv.Kind() {
-	case reflect.Array:
-		canDecode = rv.CanAddr()
-	case reflect.Ptr, reflect.Slice, reflect.Chan, reflect.Map:
-		if !rvIsNil(rv) {
-			canDecode = true
-		}
-	}
-	return
-}
-
-// func (d *Decoder) ensureDecodeable(rv reflect.Value) {
-// 	if !isDecodeable(rv) {
-// 		d.haltAsNotDecodeable(rv)
-// 	}
-// }
-
 func (d *Decoder) haltAsNotDecodeable(rv reflect.Value) {
 	if !rv.IsValid() {
 		d.onerror(errCannotDecodeIntoNil)
 	}
+	// check if an interface can be retrieved, before grabbing an interface
 	if !rv.CanInterface() {
 		d.errorf("cannot decode into a value without an interface: %v", rv)
 	}
@@ -1622,33 +1869,45 @@ func (d *Decoder) depthDecr() {
 	d.depth--
 }
 
-// Possibly get an interned version of a string
+// Possibly get an interned version of a string, iff InternString=true and decoding a map key.
 //
 // This should mostly be used for map keys, where the key type is string.
 // This is because keys of a map/struct are typically reused across many objects.
 func (d *Decoder) string(v []byte) (s string) {
-	if v == nil {
-		return
-	}
-	if d.is == nil {
+	if d.is == nil || d.c != containerMapKey || len(v) < 2 || len(v) > internMaxStrLen {
 		return string(v)
 	}
-	s, ok := d.is[string(v)] // no allocation here, per go implementation
-	if !ok {
-		s = string(v) // new allocation here
-		d.is[s] = s
+	return d.is.string(v)
+}
+
+func (d *Decoder) zerocopy() bool {
+	return d.bytes && d.h.ZeroCopy
+}
+
+// decodeBytesInto is a convenience delegate function to decDriver.DecodeBytes.
+// It ensures that `in` is not a nil byte, before calling decDriver.DecodeBytes,
+// as decDriver.DecodeBytes treats a nil as a hint to use its internal scratch buffer.
+func (d *Decoder) decodeBytesInto(in []byte) (v []byte) {
+	if in == nil {
+		in = []byte{}
 	}
-	return
+	return d.d.DecodeBytes(in)
 }
 
 func (d *Decoder) rawBytes() (v []byte) {
 	// ensure that this is not a view into the bytes
 	// i.e. if necessary, make new copy always.
-	return d.d.nextValueBytes(nil)
+	v = d.d.nextValueBytes([]byte{})
+	if d.bytes && !d.h.ZeroCopy {
+		vv := make([]byte, len(v))
+		copy(vv, v) // using copy here triggers make+copy optimization eliding memclr
+		v = vv
+	}
+	return
 }
 
 func (d *Decoder) wrapErr(v error, err *error) {
-	*err = decodeError{codecError: codecError{name: d.hh.Name(), err: v}, pos: d.NumBytesRead()}
+	*err = wrapCodecErr(v, d.hh.Name(), d.NumBytesRead(), false)
 }
 
 // NumBytesRead returns the number of bytes read
@@ -1673,22 +1932,47 @@ func (d *Decoder) decodeFloat32() float32 {
 
 // MARKER: do not call mapEnd if mapStart returns containerLenNil.
 
-func (d *Decoder) mapNext(j, containerLen int, hasLen bool) bool {
+// MARKER: optimize decoding since all formats do not truly support all decDriver'ish operations.
+// - Read(Map|Array)Start is only supported by all formats.
+// - CheckBreak is only supported by json and cbor.
+// - Read(Map|Array)End is only supported by json.
+// - Read(Map|Array)Elem(Kay|Value) is only supported by json.
+// Honor these in the code, to reduce the number of interface calls (even if empty).
+
+func (d *Decoder) checkBreak() (v bool) {
+	// MARKER: jsonDecDriver.CheckBreak() cannot be inlined (over budget inlining cost).
+	// Consequently, there's no benefit in incurring the cost of this wrapping function.
+	// It is faster to just call the interface method directly.
+
+	// if d.js {
+	// 	return d.jsondriver().CheckBreak()
+	// }
+	// if d.cbor {
+	// 	return d.cbordriver().CheckBreak()
+	// }
+
+	if d.cbreak {
+		v = d.d.CheckBreak()
+	}
+	return
+}
+
+func (d *Decoder) containerNext(j, containerLen int, hasLen bool) bool {
+	// MARKER: keep in sync with gen-helper.go.tmpl
+
 	// return (hasLen && j < containerLen) || !(hasLen || slh.d.checkBreak())
 	if hasLen {
 		return j < containerLen
-	} else {
-		return !d.checkBreak()
 	}
+	return !d.checkBreak()
 }
 
-func (d *Decoder) mapStart() (v int) {
-	v = d.d.ReadMapStart()
+func (d *Decoder) mapStart(v int) int {
 	if v != containerLenNil {
 		d.depthIncr()
 		d.c = containerMapStart
 	}
-	return
+	return v
 }
 
 func (d *Decoder) mapElemKey() {
@@ -1706,18 +1990,20 @@ func (d *Decoder) mapElemValue() {
 }
 
 func (d *Decoder) mapEnd() {
-	d.d.ReadMapEnd()
+	if d.js {
+		d.jsondriver().ReadMapEnd()
+	}
+	// d.d.ReadMapEnd()
 	d.depthDecr()
 	d.c = 0
 }
 
-func (d *Decoder) arrayStart() (v int) {
-	v = d.d.ReadArrayStart()
+func (d *Decoder) arrayStart(v int) int {
 	if v != containerLenNil {
 		d.depthIncr()
 		d.c = containerArrayStart
 	}
-	return
+	return v
 }
 
 func (d *Decoder) arrayElem() {
@@ -1728,12 +2014,15 @@ func (d *Decoder) arrayElem() {
 }
 
 func (d *Decoder) arrayEnd() {
-	d.d.ReadArrayEnd()
+	if d.js {
+		d.jsondriver().ReadArrayEnd()
+	}
+	// d.d.ReadArrayEnd()
 	d.depthDecr()
 	d.c = 0
 }
 
-func (d *Decoder) interfaceExtConvertAndDecode(v interface{}, ext Ext) {
+func (d *Decoder) interfaceExtConvertAndDecode(v interface{}, ext InterfaceExt) {
 	// var v interface{} = ext.ConvertExt(rv)
 	// d.d.decode(&v)
 	// ext.UpdateExt(rv, v)
@@ -1747,7 +2036,7 @@ func (d *Decoder) interfaceExtConvertAndDecode(v interface{}, ext Ext) {
 	// - interface should be a pointer if struct|array, else a value
 
 	var s interface{}
-	rv := rv4i(v)
+	rv := reflect.ValueOf(v)
 	rv2 := rv.Elem()
 	rvk := rv2.Kind()
 	if rvk == reflect.Struct || rvk == reflect.Array {
@@ -1755,23 +2044,73 @@ func (d *Decoder) interfaceExtConvertAndDecode(v interface{}, ext Ext) {
 	} else {
 		s = ext.ConvertExt(rv2i(rv2))
 	}
-	rv = rv4i(s)
+	rv = reflect.ValueOf(s)
+
+	// We cannot use isDecodeable here, as the value converted may be nil,
+	// or it may not be nil but is not addressable and thus we cannot extend it, etc.
+	// Instead, we just ensure that the value is addressable.
+
 	if !rv.CanAddr() {
-		if rv.Kind() == reflect.Ptr {
-			rv2 = reflect.New(rvType(rv).Elem())
+		rvk = rv.Kind()
+		rv2 = d.oneShotAddrRV(rv.Type(), rvk)
+		if rvk == reflect.Interface {
+			rvSetIntf(rv2, rv)
 		} else {
-			rv2 = rvZeroAddrK(rvType(rv), rv.Kind())
+			rvSetDirect(rv2, rv)
 		}
-		rvSetDirect(rv2, rv)
 		rv = rv2
 	}
+
 	d.decodeValue(rv, nil)
 	ext.UpdateExt(v, rv2i(rv))
 }
 
-func (d *Decoder) sideDecode(v interface{}, bs []byte) {
-	rv := baseRV(v)
-	NewDecoderBytes(bs, d.hh).decodeValue(rv, d.h.fnNoExt(rvType(rv)))
+func (d *Decoder) sideDecode(v interface{}, basetype reflect.Type, bs []byte) {
+	// NewDecoderBytes(bs, d.hh).decodeValue(baseRV(v), d.h.fnNoExt(basetype))
+
+	defer func(rb bytesDecReader, bytes bool,
+		c containerState, dbs decByteState, depth int16, r decReader, state interface{}) {
+		d.rb = rb
+		d.bytes = bytes
+		d.c = c
+		d.decByteState = dbs
+		d.depth = depth
+		d.decReader = r
+		d.d.restoreState(state)
+	}(d.rb, d.bytes, d.c, d.decByteState, d.depth, d.decReader, d.d.captureState())
+
+	// d.rb.reset(in)
+	d.rb = bytesDecReader{bs[:len(bs):len(bs)], 0}
+	d.bytes = true
+	d.decReader = &d.rb
+	d.d.resetState()
+	d.c = 0
+	d.decByteState = decByteStateNone
+	d.depth = 0
+
+	// must call using fnNoExt
+	d.decodeValue(baseRV(v), d.h.fnNoExt(basetype))
+}
+
+func (d *Decoder) fauxUnionReadRawBytes(asString bool) {
+	if asString || d.h.RawToString {
+		d.n.v = valueTypeString
+		// fauxUnion is only used within DecodeNaked calls; consequently, we should try to intern.
+		d.n.s = d.stringZC(d.d.DecodeBytes(nil))
+	} else {
+		d.n.v = valueTypeBytes
+		d.n.l = d.d.DecodeBytes([]byte{})
+	}
+}
+
+func (d *Decoder) oneShotAddrRV(rvt reflect.Type, rvk reflect.Kind) reflect.Value {
+	if decUseTransient &&
+		(numBoolStrSliceBitset.isset(byte(rvk)) ||
+			((rvk == reflect.Struct || rvk == reflect.Array) &&
+				d.h.getTypeInfo(rt2id(rvt), rvt).flagCanTransient)) {
+		return d.perType.TransientAddrK(rvt, rvk)
+	}
+	return rvZeroAddrK(rvt, rvk)
 }
 
 // --------------------------------------------------
@@ -1795,9 +2134,9 @@ func (d *Decoder) decSliceHelperStart() (x decSliceHelper, clen int) {
 		x.IsNil = true
 	case valueTypeArray:
 		x.Array = true
-		clen = d.arrayStart()
+		clen = d.arrayStart(d.d.ReadArrayStart())
 	case valueTypeMap:
-		clen = d.mapStart()
+		clen = d.mapStart(d.d.ReadMapStart())
 		clen += clen
 	default:
 		d.errorf("only encoded map or array can be decoded into a slice (%d)", x.ct)
@@ -1826,13 +2165,154 @@ func (x decSliceHelper) ElemContainerState(index int) {
 	}
 }
 
-func decByteSlice(r *decRd, clen, maxInitLen int, bs []byte) (bsOut []byte) {
-	if clen == 0 {
-		return zeroByteSlice
+func (x decSliceHelper) arrayCannotExpand(hasLen bool, lenv, j, containerLenS int) {
+	x.d.arrayCannotExpand(lenv, j+1)
+	// drain completely and return
+	x.ElemContainerState(j)
+	x.d.swallow()
+	j++
+	for ; x.d.containerNext(j, containerLenS, hasLen); j++ {
+		x.ElemContainerState(j)
+		x.d.swallow()
 	}
-	if len(bs) == clen {
-		bsOut = bs
-		r.readb(bsOut)
+	x.End()
+}
+
+// decNextValueBytesHelper helps with NextValueBytes calls.
+//
+// Typical usage:
+//   - each Handle's decDriver will implement a high level nextValueBytes,
+//     which will track the current cursor, delegate to a nextValueBytesR
+//     method, and then potentially call bytesRdV at the end.
+//
+// See simple.go for typical usage model.
+type decNextValueBytesHelper struct {
+	d *Decoder
+}
+
+func (x decNextValueBytesHelper) append1(v *[]byte, b byte) {
+	if *v != nil && !x.d.bytes {
+		*v = append(*v, b)
+	}
+}
+
+func (x decNextValueBytesHelper) appendN(v *[]byte, b ...byte) {
+	if *v != nil && !x.d.bytes {
+		*v = append(*v, b...)
+	}
+}
+
+func (x decNextValueBytesHelper) appendS(v *[]byte, b string) {
+	if *v != nil && !x.d.bytes {
+		*v = append(*v, b...)
+	}
+}
+
+func (x decNextValueBytesHelper) bytesRdV(v *[]byte, startpos uint) {
+	if x.d.bytes {
+		*v = x.d.rb.b[startpos:x.d.rb.c]
+	}
+}
+
+// decNegintPosintFloatNumberHelper is used for formats that are binary
+// and have distinct ways of storing positive integers vs negative integers
+// vs floats, which are uniquely identified by the byte descriptor.
+//
+// Currently, these formats are binc, cbor and simple.
+type decNegintPosintFloatNumberHelper struct {
+	d *Decoder
+}
+
+func (x decNegintPosintFloatNumberHelper) uint64(ui uint64, neg, ok bool) uint64 {
+	if ok && !neg {
+		return ui
+	}
+	return x.uint64TryFloat(ok)
+}
+
+func (x decNegintPosintFloatNumberHelper) uint64TryFloat(ok bool) (ui uint64) {
+	if ok { // neg = true
+		x.d.errorf("assigning negative signed value to unsigned type")
+	}
+	f, ok := x.d.d.decFloat()
+	if ok && f >= 0 && noFrac64(math.Float64bits(f)) {
+		ui = uint64(f)
+	} else {
+		x.d.errorf("invalid number loading uint64, with descriptor: %v", x.d.d.descBd())
+	}
+	return ui
+}
+
+func decNegintPosintFloatNumberHelperInt64v(ui uint64, neg, incrIfNeg bool) (i int64) {
+	if neg && incrIfNeg {
+		ui++
+	}
+	i = chkOvf.SignedIntV(ui)
+	if neg {
+		i = -i
+	}
+	return
+}
+
+func (x decNegintPosintFloatNumberHelper) int64(ui uint64, neg, ok bool) (i int64) {
+	if ok {
+		return decNegintPosintFloatNumberHelperInt64v(ui, neg, x.d.cbor)
+	}
+	// 	return x.int64TryFloat()
+	// }
+	// func (x decNegintPosintFloatNumberHelper) int64TryFloat() (i int64) {
+	f, ok := x.d.d.decFloat()
+	if ok && noFrac64(math.Float64bits(f)) {
+		i = int64(f)
+	} else {
+		x.d.errorf("invalid number loading uint64, with descriptor: %v", x.d.d.descBd())
+	}
+	return
+}
+
+func (x decNegintPosintFloatNumberHelper) float64(f float64, ok bool) float64 {
+	if ok {
+		return f
+	}
+	return x.float64TryInteger()
+}
+
+func (x decNegintPosintFloatNumberHelper) float64TryInteger() float64 {
+	ui, neg, ok := x.d.d.decInteger()
+	if !ok {
+		x.d.errorf("invalid descriptor for float: %v", x.d.d.descBd())
+	}
+	return float64(decNegintPosintFloatNumberHelperInt64v(ui, neg, x.d.cbor))
+}
+
+// isDecodeable checks if value can be decoded into
+//
+// decode can take any reflect.Value that is a inherently addressable i.e.
+//   - non-nil chan    (we will SEND to it)
+//   - non-nil slice   (we will set its elements)
+//   - non-nil map     (we will put into it)
+//   - non-nil pointer (we can "update" it)
+//   - func: no
+//   - interface: no
+//   - array:                   if canAddr=true
+//   - any other value pointer: if canAddr=true
+func isDecodeable(rv reflect.Value) (canDecode bool, reason decNotDecodeableReason) {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Chan, reflect.Map:
+		canDecode = !rvIsNil(rv)
+		reason = decNotDecodeableReasonNilReference
+	case reflect.Func, reflect.Interface, reflect.Invalid, reflect.UnsafePointer:
+		reason = decNotDecodeableReasonBadKind
+	default:
+		canDecode = rv.CanAddr()
+		reason = decNotDecodeableReasonNonAddrValue
+	}
+	return
+}
+
+func decByteSlice(r *decRd, clen, maxInitLen int, bs []byte) (bsOut []byte) {
+	if clen <= 0 {
+		bsOut = zeroByteSlice
 	} else if cap(bs) >= clen {
 		bsOut = bs[:clen]
 		r.readb(bsOut)
@@ -1850,40 +2330,20 @@ func decByteSlice(r *decRd, clen, maxInitLen int, bs []byte) (bsOut []byte) {
 	return
 }
 
-// detachZeroCopyBytes will copy the in bytes into dest,
-// or create a new one if not large enough.
-//
-// It is used to ensure that the []byte returned is not
-// part of the input stream or input stream buffers.
-func detachZeroCopyBytes(isBytesReader bool, dest []byte, in []byte) (out []byte) {
-	if len(in) == 0 {
-		return in
-	}
-	// if isBytesReader || len(in) <= scratchByteArrayLen {
-	// 	if cap(dest) >= len(in) {
-	// 		out = dest[:len(in)]
-	// 	} else {
-	// 		out = make([]byte, len(in))
-	// 	}
-	// 	copy(out, in)
-	// 	return
-	// }
-	if cap(dest) >= len(in) {
-		out = dest[:len(in)]
-	} else {
-		out = make([]byte, len(in))
-	}
-	copy(out, in)
-	return
-}
-
 // decInferLen will infer a sensible length, given the following:
-//    - clen: length wanted.
-//    - maxlen: max length to be returned.
-//      if <= 0, it is unset, and we infer it based on the unit size
-//    - unit: number of bytes for each element of the collection
-func decInferLen(clen, maxlen, unit int) (rvlen int) {
-	const maxLenIfUnset = 8 // 64
+//   - clen: length wanted.
+//   - maxlen: max length to be returned.
+//     if <= 0, it is unset, and we infer it based on the unit size
+//   - unit: number of bytes for each element of the collection
+func decInferLen(clen, maxlen, unit int) int {
+	// anecdotal testing showed increase in allocation with map length of 16.
+	// We saw same typical alloc from 0-8, then a 20% increase at 16.
+	// Thus, we set it to 8.
+	const (
+		minLenIfUnset = 8
+		maxMem        = 256 * 1024 // 256Kb Memory
+	)
+
 	// handle when maxlen is not set i.e. <= 0
 
 	// clen==0:           use 0
@@ -1893,56 +2353,24 @@ func decInferLen(clen, maxlen, unit int) (rvlen int) {
 	// maxlen> 0, clen>0: cap at maxlen
 
 	if clen == 0 || clen == containerLenNil {
-		return
+		return 0
 	}
 	if clen < 0 {
-		return maxLenIfUnset
+		// if unspecified, return 64 for bytes, ... 8 for uint64, ... and everything else
+		clen = 64 / unit
+		if clen > minLenIfUnset {
+			return clen
+		}
+		return minLenIfUnset
 	}
-	if unit == 0 {
+	if unit <= 0 {
 		return clen
 	}
 	if maxlen <= 0 {
-		// no maxlen defined. Use maximum of 256K memory, with a floor of 4K items.
-		// maxlen = 256 * 1024 / unit
-		// if maxlen < (4 * 1024) {
-		// 	maxlen = 4 * 1024
-		// }
-		if unit < (256 / 4) {
-			maxlen = 256 * 1024 / unit
-		} else {
-			maxlen = 4 * 1024
-		}
-		// if maxlen > maxLenIfUnset {
-		// 	maxlen = maxLenIfUnset
-		// }
-	}
-	if clen > maxlen {
-		rvlen = maxlen
-	} else {
-		rvlen = clen
+		maxlen = maxMem / unit
 	}
-	return
-}
-
-func fauxUnionReadRawBytes(dr decDriver, d *Decoder, n *fauxUnion, rawToString bool) {
-	if rawToString {
-		n.v = valueTypeString
-		n.s = string(dr.DecodeBytes(d.b[:], true))
-	} else {
-		n.v = valueTypeBytes
-		n.l = dr.DecodeBytes(nil, false)
-	}
-}
-
-func decArrayCannotExpand(slh decSliceHelper, hasLen bool, lenv, j, containerLenS int) {
-	slh.d.arrayCannotExpand(lenv, j+1)
-	// drain completely and return
-	slh.ElemContainerState(j)
-	slh.d.swallow()
-	j++
-	for ; slh.d.mapNext(j, containerLenS, hasLen); j++ {
-		slh.ElemContainerState(j)
-		slh.d.swallow()
+	if clen < maxlen {
+		return clen
 	}
-	slh.End()
+	return maxlen
 }
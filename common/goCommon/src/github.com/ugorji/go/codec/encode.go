@@ -6,7 +6,6 @@ package codec
 import (
 	"encoding"
 	"errors"
-	"fmt"
 	"io"
 	"reflect"
 	"sort"
@@ -29,7 +28,7 @@ type encDriver interface {
 	EncodeFloat32(f float32)
 	EncodeFloat64(f float64)
 	EncodeRawExt(re *RawExt)
-	EncodeExt(v interface{}, xtag uint64, ext Ext)
+	EncodeExt(v interface{}, basetype reflect.Type, xtag uint64, ext Ext)
 	// EncodeString using cUTF8, honor'ing StringToRaw flag
 	EncodeString(v string)
 	EncodeStringBytesRaw(v []byte)
@@ -39,9 +38,12 @@ type encDriver interface {
 	WriteMapStart(length int)
 	WriteMapEnd()
 
+	// reset will reset current encoding runtime state, and cached information from the handle
 	reset()
-	atEndOfEncode()
+
 	encoder() *Encoder
+
+	driverStateManager
 }
 
 type encDriverContainerTracker interface {
@@ -50,13 +52,12 @@ type encDriverContainerTracker interface {
 	WriteMapElemValue()
 }
 
-type encodeError struct {
-	codecError
-}
+type encDriverNoState struct{}
 
-func (e encodeError) Error() string {
-	return fmt.Sprintf("%s encode error: %v", e.name, e.err)
-}
+func (encDriverNoState) captureState() interface{}  { return nil }
+func (encDriverNoState) reset()                     {}
+func (encDriverNoState) resetState()                {}
+func (encDriverNoState) restoreState(v interface{}) {}
 
 type encDriverNoopContainerWriter struct{}
 
@@ -64,7 +65,23 @@ func (encDriverNoopContainerWriter) WriteArrayStart(length int) {}
 func (encDriverNoopContainerWriter) WriteArrayEnd()             {}
 func (encDriverNoopContainerWriter) WriteMapStart(length int)   {}
 func (encDriverNoopContainerWriter) WriteMapEnd()               {}
-func (encDriverNoopContainerWriter) atEndOfEncode()             {}
+
+// encStructFieldObj[Slice] is used for sorting when there are missing fields and canonical flag is set
+type encStructFieldObj struct {
+	key   string
+	rv    reflect.Value
+	intf  interface{}
+	ascii bool
+	isRv  bool
+}
+
+type encStructFieldObjSlice []encStructFieldObj
+
+func (p encStructFieldObjSlice) Len() int      { return len(p) }
+func (p encStructFieldObjSlice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p encStructFieldObjSlice) Less(i, j int) bool {
+	return p[uint(i)].key < p[uint(j)].key
+}
 
 // EncodeOptions captures configuration options during encode.
 type EncodeOptions struct {
@@ -111,9 +128,12 @@ type EncodeOptions struct {
 
 	// RecursiveEmptyCheck controls how we determine whether a value is empty.
 	//
-	// If true, we descend into interfaces and pointers and check struct fields one by one to
-	// see if empty. In this mode, we honor IsZero, Comparable, IsCodecEmpty(), etc.
-	// Note: This will make OmitEmpty more expensive due to the large number of reflect calls.
+	// If true, we descend into interfaces and pointers to reursively check if value is empty.
+	//
+	// We *might* check struct fields one by one to see if empty
+	// (if we cannot directly check if a struct value is equal to its zero value).
+	// If so, we honor IsZero, Comparable, IsCodecEmpty(), etc.
+	// Note: This *may* make OmitEmpty more expensive due to the large number of reflect calls.
 	//
 	// If false, we check if the value is equal to its zero value (newly allocated state).
 	RecursiveEmptyCheck bool
@@ -147,6 +167,14 @@ type EncodeOptions struct {
 	// to store a float64 as a half float. Doing this check has a small performance cost,
 	// but the benefit is that the encoded message will be smaller.
 	OptimumSize bool
+
+	// NoAddressableReadonly controls whether we try to force a non-addressable value
+	// to be addressable so we can call a pointer method on it e.g. for types
+	// that support Selfer, json.Marshaler, etc.
+	//
+	// Use it in the very rare occurrence that your types modify a pointer value when calling
+	// an encode callback function e.g. JsonMarshal, TextMarshal, BinaryMarshal or CodecEncodeSelf.
+	NoAddressableReadonly bool
 }
 
 // ---------------------------------------------
@@ -156,7 +184,7 @@ func (e *Encoder) rawExt(f *codecFnInfo, rv reflect.Value) {
 }
 
 func (e *Encoder) ext(f *codecFnInfo, rv reflect.Value) {
-	e.e.EncodeExt(rv2i(rv), f.xfTag, f.xfFn)
+	e.e.EncodeExt(rv2i(rv), f.ti.rt, f.xfTag, f.xfFn)
 }
 
 func (e *Encoder) selferMarshal(f *codecFnInfo, rv reflect.Value) {
@@ -182,6 +210,20 @@ func (e *Encoder) raw(f *codecFnInfo, rv reflect.Value) {
 	e.rawBytes(rv2i(rv).(Raw))
 }
 
+func (e *Encoder) encodeComplex64(v complex64) {
+	if imag(v) != 0 {
+		e.errorf("cannot encode complex number: %v, with imaginary values: %v", v, imag(v))
+	}
+	e.e.EncodeFloat32(real(v))
+}
+
+func (e *Encoder) encodeComplex128(v complex128) {
+	if imag(v) != 0 {
+		e.errorf("cannot encode complex number: %v, with imaginary values: %v", v, imag(v))
+	}
+	e.e.EncodeFloat64(real(v))
+}
+
 func (e *Encoder) kBool(f *codecFnInfo, rv reflect.Value) {
 	e.e.EncodeBool(rvGetBool(rv))
 }
@@ -194,12 +236,20 @@ func (e *Encoder) kString(f *codecFnInfo, rv reflect.Value) {
 	e.e.EncodeString(rvGetString(rv))
 }
 
+func (e *Encoder) kFloat32(f *codecFnInfo, rv reflect.Value) {
+	e.e.EncodeFloat32(rvGetFloat32(rv))
+}
+
 func (e *Encoder) kFloat64(f *codecFnInfo, rv reflect.Value) {
 	e.e.EncodeFloat64(rvGetFloat64(rv))
 }
 
-func (e *Encoder) kFloat32(f *codecFnInfo, rv reflect.Value) {
-	e.e.EncodeFloat32(rvGetFloat32(rv))
+func (e *Encoder) kComplex64(f *codecFnInfo, rv reflect.Value) {
+	e.encodeComplex64(rvGetComplex64(rv))
+}
+
+func (e *Encoder) kComplex128(f *codecFnInfo, rv reflect.Value) {
+	e.encodeComplex128(rvGetComplex128(rv))
 }
 
 func (e *Encoder) kInt(f *codecFnInfo, rv reflect.Value) {
@@ -251,7 +301,7 @@ func (e *Encoder) kErr(f *codecFnInfo, rv reflect.Value) {
 }
 
 func chanToSlice(rv reflect.Value, rtslice reflect.Type, timeout time.Duration) (rvcs reflect.Value) {
-	rvcs = rvZero(rtslice)
+	rvcs = rvZeroK(rtslice, reflect.Slice)
 	if timeout < 0 { // consume until close
 		for {
 			recv, recvOk := rv.Recv()
@@ -267,7 +317,7 @@ func chanToSlice(rv reflect.Value, rtslice reflect.Type, timeout time.Duration)
 			cases[1] = reflect.SelectCase{Dir: reflect.SelectDefault}
 		} else {
 			tt := time.NewTimer(timeout)
-			cases[1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: rv4i(tt.C)}
+			cases[1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(tt.C)}
 		}
 		for {
 			chosen, recv, recvOk := reflect.Select(cases)
@@ -284,9 +334,8 @@ func (e *Encoder) kSeqFn(rtelem reflect.Type) (fn *codecFn) {
 	for rtelem.Kind() == reflect.Ptr {
 		rtelem = rtelem.Elem()
 	}
-	// if kind is reflect.Interface, do not pre-determine the
-	// encoding type, because preEncodeValue may break it down to
-	// a concrete type and kInterface will bomb.
+	// if kind is reflect.Interface, do not pre-determine the encoding type,
+	// because preEncodeValue may break it down to a concrete type and kInterface will bomb.
 	if rtelem.Kind() != reflect.Interface {
 		fn = e.h.fn(rtelem)
 	}
@@ -327,7 +376,7 @@ func (e *Encoder) kSliceW(rv reflect.Value, ti *typeInfo) {
 }
 
 func (e *Encoder) kArrayWMbs(rv reflect.Value, ti *typeInfo) {
-	var l = rvLenArray(rv)
+	var l = rv.Len()
 	if l == 0 {
 		e.mapStart(0)
 	} else {
@@ -347,7 +396,7 @@ func (e *Encoder) kArrayWMbs(rv reflect.Value, ti *typeInfo) {
 }
 
 func (e *Encoder) kArrayW(rv reflect.Value, ti *typeInfo) {
-	var l = rvLenArray(rv)
+	var l = rv.Len()
 	e.arrayStart(l)
 	if l > 0 {
 		fn := e.kSeqFn(ti.elem)
@@ -390,8 +439,8 @@ func (e *Encoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 func (e *Encoder) kArray(f *codecFnInfo, rv reflect.Value) {
 	if f.ti.mbs {
 		e.kArrayWMbs(rv, f.ti)
-	} else if uint8TypId == rt2id(f.ti.elem) {
-		e.e.EncodeStringBytesRaw(rvGetArrayBytesRO(rv, e.b[:]))
+	} else if handleBytesWithinKArray && uint8TypId == rt2id(f.ti.elem) {
+		e.e.EncodeStringBytesRaw(rvGetArrayBytes(rv, []byte{}))
 	} else {
 		e.kArrayW(rv, f.ti)
 	}
@@ -401,10 +450,9 @@ func (e *Encoder) kSliceBytesChan(rv reflect.Value) {
 	// do not use range, so that the number of elements encoded
 	// does not change, and encoding does not hang waiting on someone to close chan.
 
-	// for b := range rv2i(rv).(<-chan byte) { bs = append(bs, b) }
-	// ch := rv2i(rv).(<-chan byte) // fix error - that this is a chan byte, not a <-chan byte.
+	bs0 := e.blist.peek(32, true)
+	bs := bs0
 
-	bs := e.b[:0]
 	irv := rv2i(rv)
 	ch, ok := irv.(<-chan byte)
 	if !ok {
@@ -440,29 +488,36 @@ L1:
 	}
 
 	e.e.EncodeStringBytesRaw(bs)
+	e.blist.put(bs)
+	if !byteSliceSameData(bs0, bs) {
+		e.blist.put(bs0)
+	}
 }
 
 func (e *Encoder) kStructSfi(f *codecFnInfo) []*structFieldInfo {
 	if e.h.Canonical {
-		return f.ti.sfiSort
+		return f.ti.sfi.sorted()
 	}
-	return f.ti.sfiSrc
+	return f.ti.sfi.source()
 }
 
 func (e *Encoder) kStructNoOmitempty(f *codecFnInfo, rv reflect.Value) {
+	var tisfi []*structFieldInfo
 	if f.ti.toArray || e.h.StructToArray { // toArray
-		e.arrayStart(len(f.ti.sfiSrc))
-		for _, si := range f.ti.sfiSrc {
+		tisfi = f.ti.sfi.source()
+		e.arrayStart(len(tisfi))
+		for _, si := range tisfi {
 			e.arrayElem()
 			e.encodeValue(si.path.field(rv), nil)
 		}
 		e.arrayEnd()
 	} else {
-		tisfi := e.kStructSfi(f)
+		tisfi = e.kStructSfi(f)
 		e.mapStart(len(tisfi))
+		keytyp := f.ti.keyType
 		for _, si := range tisfi {
 			e.mapElemKey()
-			e.kStructFieldKey(f.ti.keyType, si.path.encNameAsciiAlphaNum, si.encName)
+			e.kStructFieldKey(keytyp, si.path.encNameAsciiAlphaNum, si.encName)
 			e.mapElemValue()
 			e.encodeValue(si.path.field(rv), nil)
 		}
@@ -476,25 +531,21 @@ func (e *Encoder) kStructFieldKey(keyType valueType, encNameAsciiAlphaNum bool,
 
 func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	var newlen int
-	toMap := !(f.ti.toArray || e.h.StructToArray)
+	ti := f.ti
+	toMap := !(ti.toArray || e.h.StructToArray)
 	var mf map[string]interface{}
-	if f.ti.flagMissingFielder {
+	if ti.flagMissingFielder {
 		mf = rv2i(rv).(MissingFielder).CodecMissingFields()
 		toMap = true
 		newlen += len(mf)
-	} else if f.ti.flagMissingFielderPtr {
-		if rv.CanAddr() {
-			mf = rv2i(rv.Addr()).(MissingFielder).CodecMissingFields()
-		} else {
-			// make a new addressable value of same one, and use it
-			rv2 := reflect.New(rvType(rv))
-			rvSetDirect(rv2.Elem(), rv)
-			mf = rv2i(rv2).(MissingFielder).CodecMissingFields()
-		}
+	} else if ti.flagMissingFielderPtr {
+		rv2 := e.addrRV(rv, ti.rt, ti.ptr)
+		mf = rv2i(rv2).(MissingFielder).CodecMissingFields()
 		toMap = true
 		newlen += len(mf)
 	}
-	newlen += len(f.ti.sfiSrc)
+	tisfi := ti.sfi.source()
+	newlen += len(tisfi)
 
 	var fkvs = e.slist.get(newlen)[:newlen]
 
@@ -513,38 +564,69 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 			fkvs[newlen] = kv
 			newlen++
 		}
-		var mflen int
-		for k, v := range mf {
-			if k == "" {
-				delete(mf, k)
-				continue
-			}
-			if f.ti.infoFieldOmitempty && isEmptyValue(rv4i(v), e.h.TypeInfos, recur) {
-				delete(mf, k)
-				continue
+
+		var mf2s []stringIntf
+		if len(mf) > 0 {
+			mf2s = make([]stringIntf, 0, len(mf))
+			for k, v := range mf {
+				if k == "" {
+					continue
+				}
+				if ti.infoFieldOmitempty && isEmptyValue(reflect.ValueOf(v), e.h.TypeInfos, recur) {
+					continue
+				}
+				mf2s = append(mf2s, stringIntf{k, v})
 			}
-			mflen++
-		}
-		// encode it all
-		e.mapStart(newlen + mflen)
-		for j = 0; j < newlen; j++ {
-			kv = fkvs[j]
-			e.mapElemKey()
-			e.kStructFieldKey(f.ti.keyType, kv.v.path.encNameAsciiAlphaNum, kv.v.encName)
-			e.mapElemValue()
-			e.encodeValue(kv.r, nil)
 		}
-		// now, add the others
-		for k, v := range mf {
-			e.mapElemKey()
-			e.kStructFieldKey(f.ti.keyType, false, k)
-			e.mapElemValue()
-			e.encode(v)
+
+		e.mapStart(newlen + len(mf2s))
+
+		// When there are missing fields, and Canonical flag is set,
+		// we cannot have the missing fields and struct fields sorted independently.
+		// We have to capture them together and sort as a unit.
+
+		if len(mf2s) > 0 && e.h.Canonical {
+			mf2w := make([]encStructFieldObj, newlen+len(mf2s))
+			for j = 0; j < newlen; j++ {
+				kv = fkvs[j]
+				mf2w[j] = encStructFieldObj{kv.v.encName, kv.r, nil, kv.v.path.encNameAsciiAlphaNum, true}
+			}
+			for _, v := range mf2s {
+				mf2w[j] = encStructFieldObj{v.v, reflect.Value{}, v.i, false, false}
+				j++
+			}
+			sort.Sort((encStructFieldObjSlice)(mf2w))
+			for _, v := range mf2w {
+				e.mapElemKey()
+				e.kStructFieldKey(ti.keyType, v.ascii, v.key)
+				e.mapElemValue()
+				if v.isRv {
+					e.encodeValue(v.rv, nil)
+				} else {
+					e.encode(v.intf)
+				}
+			}
+		} else {
+			keytyp := ti.keyType
+			for j = 0; j < newlen; j++ {
+				kv = fkvs[j]
+				e.mapElemKey()
+				e.kStructFieldKey(keytyp, kv.v.path.encNameAsciiAlphaNum, kv.v.encName)
+				e.mapElemValue()
+				e.encodeValue(kv.r, nil)
+			}
+			for _, v := range mf2s {
+				e.mapElemKey()
+				e.kStructFieldKey(keytyp, false, v.v)
+				e.mapElemValue()
+				e.encode(v.i)
+			}
 		}
+
 		e.mapEnd()
 	} else {
-		newlen = len(f.ti.sfiSrc)
-		for i, si := range f.ti.sfiSrc { // use unsorted array (to match sequence in struct)
+		newlen = len(tisfi)
+		for i, si := range tisfi { // use unsorted array (to match sequence in struct)
 			kv.r = si.path.field(rv)
 			// use the zero value.
 			// if a reference or struct, set to nil (so you do not output too much)
@@ -589,8 +671,8 @@ func (e *Encoder) kMap(f *codecFnInfo, rv reflect.Value) {
 
 	var keyFn, valFn *codecFn
 
-	ktypeKind := f.ti.key.Kind()
-	vtypeKind := f.ti.elem.Kind()
+	ktypeKind := reflect.Kind(f.ti.keykind)
+	vtypeKind := reflect.Kind(f.ti.elemkind)
 
 	rtval := f.ti.elem
 	rtvalkind := vtypeKind
@@ -604,15 +686,11 @@ func (e *Encoder) kMap(f *codecFnInfo, rv reflect.Value) {
 
 	var rvv = mapAddrLoopvarRV(f.ti.elem, vtypeKind)
 
-	if e.h.Canonical {
-		e.kMapCanonical(f.ti.key, f.ti.elem, rv, rvv, valFn)
-		e.mapEnd()
-		return
-	}
-
 	rtkey := f.ti.key
 	var keyTypeIsString = stringTypId == rt2id(rtkey) // rtkeyid
-	if !keyTypeIsString {
+	if keyTypeIsString {
+		keyFn = e.h.fn(rtkey)
+	} else {
 		for rtkey.Kind() == reflect.Ptr {
 			rtkey = rtkey.Elem()
 		}
@@ -621,6 +699,12 @@ func (e *Encoder) kMap(f *codecFnInfo, rv reflect.Value) {
 		}
 	}
 
+	if e.h.Canonical {
+		e.kMapCanonical(f.ti, rv, rvv, keyFn, valFn)
+		e.mapEnd()
+		return
+	}
+
 	var rvk = mapAddrLoopvarRV(f.ti.key, ktypeKind)
 
 	var it mapIter
@@ -641,25 +725,40 @@ func (e *Encoder) kMap(f *codecFnInfo, rv reflect.Value) {
 	e.mapEnd()
 }
 
-func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value, valFn *codecFn) {
-	// we previously did out-of-band if an extension was registered.
-	// This is not necessary, as the natural kind is sufficient for ordering.
+func (e *Encoder) kMapCanonical(ti *typeInfo, rv, rvv reflect.Value, keyFn, valFn *codecFn) {
+	// The base kind of the type of the map key is sufficient for ordering.
+	// We only do out of band if that kind is not ordered (number or string), bool or time.Time.
+	// If the key is a predeclared type, directly call methods on encDriver e.g. EncodeString
+	// but if not, call encodeValue, in case it has an extension registered or otherwise.
+	rtkey := ti.key
+	rtkeydecl := rtkey.PkgPath() == "" && rtkey.Name() != "" // key type is predeclared
 
 	mks := rv.MapKeys()
-	switch rtkey.Kind() {
+	rtkeyKind := rtkey.Kind()
+	kfast := mapKeyFastKindFor(rtkeyKind)
+	visindirect := mapStoresElemIndirect(uintptr(ti.elemsize))
+	visref := refBitset.isset(ti.elemkind)
+
+	switch rtkeyKind {
 	case reflect.Bool:
-		mksv := make([]boolRv, len(mks))
-		for i, k := range mks {
-			v := &mksv[i]
-			v.r = k
-			v.v = k.Bool()
+		// though bool keys make no sense in a map, it *could* happen.
+		// in that case, we MUST support it in reflection mode,
+		// as that is the fallback for even codecgen and others.
+
+		// sort the keys so that false comes before true
+		// ie if 2 keys in order (true, false), then swap them
+		if len(mks) == 2 && mks[0].Bool() {
+			mks[0], mks[1] = mks[1], mks[0]
 		}
-		sort.Sort(boolRvSlice(mksv))
-		for i := range mksv {
+		for i := range mks {
 			e.mapElemKey()
-			e.e.EncodeBool(mksv[i].v)
+			if rtkeydecl {
+				e.e.EncodeBool(mks[i].Bool())
+			} else {
+				e.encodeValueNonNil(mks[i], keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mks[i], rvv, kfast, visindirect, visref), valFn)
 		}
 	case reflect.String:
 		mksv := make([]stringRv, len(mks))
@@ -671,9 +770,13 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 		sort.Sort(stringRvSlice(mksv))
 		for i := range mksv {
 			e.mapElemKey()
-			e.e.EncodeString(mksv[i].v)
+			if rtkeydecl {
+				e.e.EncodeString(mksv[i].v)
+			} else {
+				e.encodeValueNonNil(mksv[i].r, keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 		}
 	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint, reflect.Uintptr:
 		mksv := make([]uint64Rv, len(mks))
@@ -685,9 +788,13 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 		sort.Sort(uint64RvSlice(mksv))
 		for i := range mksv {
 			e.mapElemKey()
-			e.e.EncodeUint(mksv[i].v)
+			if rtkeydecl {
+				e.e.EncodeUint(mksv[i].v)
+			} else {
+				e.encodeValueNonNil(mksv[i].r, keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 		}
 	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
 		mksv := make([]int64Rv, len(mks))
@@ -699,9 +806,13 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 		sort.Sort(int64RvSlice(mksv))
 		for i := range mksv {
 			e.mapElemKey()
-			e.e.EncodeInt(mksv[i].v)
+			if rtkeydecl {
+				e.e.EncodeInt(mksv[i].v)
+			} else {
+				e.encodeValueNonNil(mksv[i].r, keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 		}
 	case reflect.Float32:
 		mksv := make([]float64Rv, len(mks))
@@ -713,9 +824,13 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 		sort.Sort(float64RvSlice(mksv))
 		for i := range mksv {
 			e.mapElemKey()
-			e.e.EncodeFloat32(float32(mksv[i].v))
+			if rtkeydecl {
+				e.e.EncodeFloat32(float32(mksv[i].v))
+			} else {
+				e.encodeValueNonNil(mksv[i].r, keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 		}
 	case reflect.Float64:
 		mksv := make([]float64Rv, len(mks))
@@ -727,11 +842,15 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 		sort.Sort(float64RvSlice(mksv))
 		for i := range mksv {
 			e.mapElemKey()
-			e.e.EncodeFloat64(mksv[i].v)
+			if rtkeydecl {
+				e.e.EncodeFloat64(mksv[i].v)
+			} else {
+				e.encodeValueNonNil(mksv[i].r, keyFn)
+			}
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 		}
-	case reflect.Struct:
+	default:
 		if rtkey == timeTyp {
 			mksv := make([]timeRv, len(mks))
 			for i, k := range mks {
@@ -744,32 +863,57 @@ func (e *Encoder) kMapCanonical(rtkey, rtval reflect.Type, rv, rvv reflect.Value
 				e.mapElemKey()
 				e.e.EncodeTime(mksv[i].v)
 				e.mapElemValue()
-				e.encodeValue(mapGet(rv, mksv[i].r, rvv), valFn)
+				e.encodeValue(mapGet(rv, mksv[i].r, rvv, kfast, visindirect, visref), valFn)
 			}
 			break
 		}
-		fallthrough
-	default:
+
 		// out-of-band
 		// first encode each key to a []byte first, then sort them, then record
-		var mksv = e.blist.get(len(mks) * 16)
-		e2 := NewEncoderBytes(&mksv, e.hh)
+		bs0 := e.blist.get(len(mks) * 16)
+		mksv := bs0
 		mksbv := make([]bytesRv, len(mks))
-		for i, k := range mks {
-			v := &mksbv[i]
-			l := len(mksv)
-			e2.MustEncode(k)
-			v.r = k
-			v.v = mksv[l:]
-		}
+
+		func() {
+			// replicate sideEncode logic
+			defer func(wb bytesEncAppender, bytes bool, c containerState, state interface{}) {
+				e.wb = wb
+				e.bytes = bytes
+				e.c = c
+				e.e.restoreState(state)
+			}(e.wb, e.bytes, e.c, e.e.captureState())
+
+			// e2 := NewEncoderBytes(&mksv, e.hh)
+			e.wb = bytesEncAppender{mksv[:0], &mksv}
+			e.bytes = true
+			e.c = 0
+			e.e.resetState()
+
+			for i, k := range mks {
+				v := &mksbv[i]
+				l := len(mksv)
+
+				e.c = containerMapKey
+				e.encodeValue(k, nil)
+				e.atEndOfEncode()
+				e.w().end()
+
+				v.r = k
+				v.v = mksv[l:]
+			}
+		}()
+
 		sort.Sort(bytesRvSlice(mksbv))
 		for j := range mksbv {
 			e.mapElemKey()
 			e.encWr.writeb(mksbv[j].v)
 			e.mapElemValue()
-			e.encodeValue(mapGet(rv, mksbv[j].r, rvv), valFn)
+			e.encodeValue(mapGet(rv, mksbv[j].r, rvv, kfast, visindirect, visref), valFn)
 		}
 		e.blist.put(mksv)
+		if !byteSliceSameData(bs0, mksv) {
+			e.blist.put(bs0)
+		}
 	}
 }
 
@@ -810,11 +954,9 @@ type Encoder struct {
 	// Consequently, we need a tuple of type and pointer, which interface{} natively provides.
 	ci []interface{} // []uintptr
 
-	slist sfiRvFreelist
+	perType encPerType
 
-	b [2 * 8]byte // for encoding chan byte, (non-addressable) [N]byte, etc
-
-	// ---- cpu cache line boundary?
+	slist sfiRvFreelist
 }
 
 // NewEncoder returns an Encoder for encoding into an io.Writer.
@@ -823,7 +965,9 @@ type Encoder struct {
 // OR pass in a memory buffered writer (eg bufio.Writer, bytes.Buffer).
 func NewEncoder(w io.Writer, h Handle) *Encoder {
 	e := h.newEncDriver().encoder()
-	e.Reset(w)
+	if w != nil {
+		e.Reset(w)
+	}
 	return e
 }
 
@@ -834,15 +978,18 @@ func NewEncoder(w io.Writer, h Handle) *Encoder {
 // After encoding, the out parameter contains the encoded contents.
 func NewEncoderBytes(out *[]byte, h Handle) *Encoder {
 	e := h.newEncDriver().encoder()
-	e.ResetBytes(out)
+	if out != nil {
+		e.ResetBytes(out)
+	}
 	return e
 }
 
 func (e *Encoder) init(h Handle) {
+	initHandle(h)
 	e.err = errEncoderNotInitialized
 	e.bytes = true
 	e.hh = h
-	e.h = basicHandle(h)
+	e.h = h.getBasicHandle()
 	e.be = e.hh.isBinary()
 }
 
@@ -857,6 +1004,7 @@ func (e *Encoder) resetCommon() {
 	}
 	e.c = 0
 	e.calls = 0
+	e.seq = 0
 	e.err = nil
 }
 
@@ -865,9 +1013,6 @@ func (e *Encoder) resetCommon() {
 // This accommodates using the state of the Encoder,
 // where it has "cached" information about sub-engines.
 func (e *Encoder) Reset(w io.Writer) {
-	if w == nil {
-		return
-	}
 	e.bytes = false
 	if e.wf == nil {
 		e.wf = new(bufioEncWriter)
@@ -878,15 +1023,8 @@ func (e *Encoder) Reset(w io.Writer) {
 
 // ResetBytes resets the Encoder with a new destination output []byte.
 func (e *Encoder) ResetBytes(out *[]byte) {
-	if out == nil {
-		return
-	}
-	var in []byte = *out
-	if in == nil {
-		in = make([]byte, defEncByteBufSize)
-	}
 	e.bytes = true
-	e.wb.reset(in, out)
+	e.wb.reset(encInBytes(out), out)
 	e.resetCommon()
 }
 
@@ -903,16 +1041,17 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 // To set an option on all fields (e.g. omitempty on all fields), you
 // can create a field called _struct, and set flags on it. The options
 // which can be set on _struct are:
-//    - omitempty: so all fields are omitted if empty
-//    - toarray: so struct is encoded as an array
-//    - int: so struct key names are encoded as signed integers (instead of strings)
-//    - uint: so struct key names are encoded as unsigned integers (instead of strings)
-//    - float: so struct key names are encoded as floats (instead of strings)
+//   - omitempty: so all fields are omitted if empty
+//   - toarray: so struct is encoded as an array
+//   - int: so struct key names are encoded as signed integers (instead of strings)
+//   - uint: so struct key names are encoded as unsigned integers (instead of strings)
+//   - float: so struct key names are encoded as floats (instead of strings)
+//
 // More details on these below.
 //
 // Struct values "usually" encode as maps. Each exported struct field is encoded unless:
-//    - the field's tag is "-", OR
-//    - the field is empty (empty or the zero value) and its tag specifies the "omitempty" option.
+//   - the field's tag is "-", OR
+//   - the field is empty (empty or the zero value) and its tag specifies the "omitempty" option.
 //
 // When encoding as a map, the first string in the tag (before the comma)
 // is the map key string to use when encoding.
@@ -925,8 +1064,9 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 // This is done with the int,uint or float option on the _struct field (see above).
 //
 // However, struct values may encode as arrays. This happens when:
-//    - StructToArray Encode option is set, OR
-//    - the tag on the _struct field sets the "toarray" option
+//   - StructToArray Encode option is set, OR
+//   - the tag on the _struct field sets the "toarray" option
+//
 // Note that omitempty is ignored when encoding struct values as arrays,
 // as an entry must be encoded for each field, to maintain its position.
 //
@@ -936,33 +1076,33 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 // or interface value, and any array, slice, map, or string of length zero.
 //
 // Anonymous fields are encoded inline except:
-//    - the struct tag specifies a replacement name (first value)
-//    - the field is of an interface type
+//   - the struct tag specifies a replacement name (first value)
+//   - the field is of an interface type
 //
 // Examples:
 //
-//      // NOTE: 'json:' can be used as struct tag key, in place 'codec:' below.
-//      type MyStruct struct {
-//          _struct bool    `codec:",omitempty"`   //set omitempty for every field
-//          Field1 string   `codec:"-"`            //skip this field
-//          Field2 int      `codec:"myName"`       //Use key "myName" in encode stream
-//          Field3 int32    `codec:",omitempty"`   //use key "Field3". Omit if empty.
-//          Field4 bool     `codec:"f4,omitempty"` //use key "f4". Omit if empty.
-//          io.Reader                              //use key "Reader".
-//          MyStruct        `codec:"my1"           //use key "my1".
-//          MyStruct                               //inline it
-//          ...
-//      }
+//	// NOTE: 'json:' can be used as struct tag key, in place 'codec:' below.
+//	type MyStruct struct {
+//	    _struct bool    `codec:",omitempty"`   //set omitempty for every field
+//	    Field1 string   `codec:"-"`            //skip this field
+//	    Field2 int      `codec:"myName"`       //Use key "myName" in encode stream
+//	    Field3 int32    `codec:",omitempty"`   //use key "Field3". Omit if empty.
+//	    Field4 bool     `codec:"f4,omitempty"` //use key "f4". Omit if empty.
+//	    io.Reader                              //use key "Reader".
+//	    MyStruct        `codec:"my1"           //use key "my1".
+//	    MyStruct                               //inline it
+//	    ...
+//	}
 //
-//      type MyStruct struct {
-//          _struct bool    `codec:",toarray"`     //encode struct as an array
-//      }
+//	type MyStruct struct {
+//	    _struct bool    `codec:",toarray"`     //encode struct as an array
+//	}
 //
-//      type MyStruct struct {
-//          _struct bool    `codec:",uint"`        //encode struct with "unsigned integer" keys
-//          Field1 string   `codec:"1"`            //encode Field1 key using: EncodeInt(1)
-//          Field2 string   `codec:"2"`            //encode Field2 key using: EncodeInt(2)
-//      }
+//	type MyStruct struct {
+//	    _struct bool    `codec:",uint"`        //encode struct with "unsigned integer" keys
+//	    Field1 string   `codec:"1"`            //encode Field1 key using: EncodeInt(1)
+//	    Field2 string   `codec:"2"`            //encode Field2 key using: EncodeInt(2)
+//	}
 //
 // The mode of encoding is based on the type of the value. When a value is seen:
 //   - If a Selfer, call its CodecEncodeSelf method
@@ -977,36 +1117,35 @@ func (e *Encoder) Encode(v interface{}) (err error) {
 	// tried to use closure, as runtime optimizes defer with no params.
 	// This seemed to be causing weird issues (like circular reference found, unexpected panic, etc).
 	// Also, see https://github.com/golang/go/issues/14939#issuecomment-417836139
-
-	if e.err != nil {
-		return e.err
+	if !debugging {
+		defer func() {
+			// if error occurred during encoding, return that error;
+			// else if error occurred on end'ing (i.e. during flush), return that error.
+			if x := recover(); x != nil {
+				panicValToErr(e, x, &e.err)
+				err = e.err
+			}
+		}()
 	}
-	defer func() {
-		// if error occurred during encoding, return that error;
-		// else if error occurred on end'ing (i.e. during flush), return that error.
-		if x := recover(); x != nil {
-			panicValToErr(e, x, &err)
-			e.err = err
-		}
-	}()
 
-	e.mustEncode(v)
+	e.MustEncode(v)
 	return
 }
 
 // MustEncode is like Encode, but panics if unable to Encode.
-// This provides insight to the code location that triggered the error.
+//
+// Note: This provides insight to the code location that triggered the error.
 func (e *Encoder) MustEncode(v interface{}) {
 	halt.onerror(e.err)
-	e.mustEncode(v)
-}
+	if e.hh == nil {
+		halt.onerror(errNoFormatHandle)
+	}
 
-func (e *Encoder) mustEncode(v interface{}) {
 	e.calls++
 	e.encode(v)
 	e.calls--
 	if e.calls == 0 {
-		e.e.atEndOfEncode()
+		e.atEndOfEncode()
 		e.w().end()
 	}
 }
@@ -1074,6 +1213,10 @@ func (e *Encoder) encode(iv interface{}) {
 		e.e.EncodeFloat32(v)
 	case float64:
 		e.e.EncodeFloat64(v)
+	case complex64:
+		e.encodeComplex64(v)
+	case complex128:
+		e.encodeComplex128(v)
 	case time.Time:
 		e.e.EncodeTime(v)
 	case []byte:
@@ -1110,6 +1253,10 @@ func (e *Encoder) encode(iv interface{}) {
 		e.e.EncodeFloat32(*v)
 	case *float64:
 		e.e.EncodeFloat64(*v)
+	case *complex64:
+		e.encodeComplex64(*v)
+	case *complex128:
+		e.encodeComplex128(*v)
 	case *time.Time:
 		e.e.EncodeTime(*v)
 	case *[]byte:
@@ -1148,25 +1295,26 @@ TOP:
 		rvpValid = true
 		rvp = rv
 		rv = rv.Elem()
-		if e.h.CheckCircularRef && rv.Kind() == reflect.Struct {
-			// sptr = rvAddr(rv) // use rv, not rvp, as rvAddr gives ptr to the data rv
-			sptr = rv2i(rvp)
-			for _, vv := range e.ci {
-				if eq4i(sptr, vv) { // error if sptr already seen
-					e.errorf("circular reference found: %p, %T", sptr, sptr)
-				}
-			}
-			e.ci = append(e.ci, sptr)
-			break TOP
-		}
 		goto TOP
 	case reflect.Interface:
 		if rvIsNil(rv) {
 			e.e.EncodeNil()
 			return
 		}
+		rvpValid = false
+		rvp = reflect.Value{}
 		rv = rv.Elem()
 		goto TOP
+	case reflect.Struct:
+		if rvpValid && e.h.CheckCircularRef {
+			sptr = rv2i(rvp)
+			for _, vv := range e.ci {
+				if eq4i(sptr, vv) { // error if sptr already seen
+					e.errorf("circular reference found: %p, %T", sptr, sptr)
+				}
+			}
+			e.ci = append(e.ci, sptr)
+		}
 	case reflect.Slice, reflect.Map, reflect.Chan:
 		if rvIsNil(rv) {
 			e.e.EncodeNil()
@@ -1177,34 +1325,50 @@ TOP:
 		return
 	}
 
-	var rt reflect.Type
 	if fn == nil {
-		rt = rvType(rv)
-		fn = e.h.fn(rt)
+		fn = e.h.fn(rv.Type())
 	}
 
 	if !fn.i.addrE { // typically, addrE = false, so check it first
-		fn.fe(e, &fn.i, rv)
+		// keep rv same
 	} else if rvpValid {
-		fn.fe(e, &fn.i, rvp)
-	} else if rv.CanAddr() {
-		fn.fe(e, &fn.i, rv.Addr())
-	} else if fn.i.addrEf {
-		if rt == nil {
-			rt = rvType(rv)
-		}
-		rv2 := rvZeroAddrK(rt, rv.Kind())
-		rvSetDirect(rv2, rv)
-		fn.fe(e, &fn.i, rv2.Addr())
+		rv = rvp
 	} else {
-		fn.fe(e, &fn.i, rv)
+		rv = e.addrRV(rv, fn.i.ti.rt, fn.i.ti.ptr)
 	}
+	fn.fe(e, &fn.i, rv)
 
 	if sptr != nil { // remove sptr
 		e.ci = e.ci[:len(e.ci)-1]
 	}
 }
 
+// encodeValueNonNil can encode a number, bool, or string
+// OR non-nil values of kind map, slice and chan.
+func (e *Encoder) encodeValueNonNil(rv reflect.Value, fn *codecFn) {
+	if fn == nil {
+		fn = e.h.fn(rv.Type())
+	}
+
+	if fn.i.addrE { // typically, addrE = false, so check it first
+		rv = e.addrRV(rv, fn.i.ti.rt, fn.i.ti.ptr)
+	}
+	fn.fe(e, &fn.i, rv)
+}
+
+// addrRV returns a addressable value which may be readonly
+func (e *Encoder) addrRV(rv reflect.Value, typ, ptrType reflect.Type) (rva reflect.Value) {
+	if rv.CanAddr() {
+		return rvAddr(rv, ptrType)
+	}
+	if e.h.NoAddressableReadonly {
+		rva = reflect.New(typ)
+		rvSetDirect(rva.Elem(), rv)
+		return
+	}
+	return rvAddr(e.perType.AddressableRO(rv), ptrType)
+}
+
 func (e *Encoder) marshalUtf8(bs []byte, fnerr error) {
 	e.onerror(fnerr)
 	if bs == nil {
@@ -1241,7 +1405,7 @@ func (e *Encoder) rawBytes(vv Raw) {
 }
 
 func (e *Encoder) wrapErr(v error, err *error) {
-	*err = encodeError{codecError{name: e.hh.Name(), err: v}}
+	*err = wrapCodecErr(v, e.hh.Name(), 0, true)
 }
 
 // ---- container tracker methods
@@ -1297,12 +1461,45 @@ func (e *Encoder) haltOnMbsOddLen(length int) {
 	}
 }
 
-func (e *Encoder) sideEncode(v interface{}, bs *[]byte) {
+func (e *Encoder) atEndOfEncode() {
+	// e.e.atEndOfEncode()
+	if e.js {
+		e.jsondriver().atEndOfEncode()
+	}
+}
+
+func (e *Encoder) sideEncode(v interface{}, basetype reflect.Type, bs *[]byte) {
+	// rv := baseRV(v)
+	// e2 := NewEncoderBytes(bs, e.hh)
+	// e2.encodeValue(rv, e2.h.fnNoExt(basetype))
+	// e2.atEndOfEncode()
+	// e2.w().end()
+
+	defer func(wb bytesEncAppender, bytes bool, c containerState, state interface{}) {
+		e.wb = wb
+		e.bytes = bytes
+		e.c = c
+		e.e.restoreState(state)
+	}(e.wb, e.bytes, e.c, e.e.captureState())
+
+	e.wb = bytesEncAppender{encInBytes(bs)[:0], bs}
+	e.bytes = true
+	e.c = 0
+	e.e.resetState()
+
+	// must call using fnNoExt
 	rv := baseRV(v)
-	e2 := NewEncoderBytes(bs, e.hh)
-	e2.encodeValue(rv, e.h.fnNoExt(rvType(rv)))
-	e2.e.atEndOfEncode()
-	e2.w().end()
+	e.encodeValue(rv, e.h.fnNoExt(basetype))
+	e.atEndOfEncode()
+	e.w().end()
+}
+
+func encInBytes(out *[]byte) (in []byte) {
+	in = *out
+	if in == nil {
+		in = make([]byte, defEncByteBufSize)
+	}
+	return
 }
 
 func encStructFieldKey(encName string, ee encDriver, w *encWr,
@@ -1,4 +1,5 @@
-// +build !notfastpath
+//go:build !notfastpath && !codec.notfastpath
+// +build !notfastpath,!codec.notfastpath
 
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
@@ -48,15 +49,15 @@ type fastpathE struct {
 	decfn func(*Decoder, *codecFnInfo, reflect.Value)
 }
 
-type fastpathA [54]fastpathE
-type fastpathARtid [54]uintptr
+type fastpathA [56]fastpathE
+type fastpathARtid [56]uintptr
 
 var fastpathAv fastpathA
 var fastpathAvRtid fastpathARtid
 
 type fastpathAslice struct{}
 
-func (fastpathAslice) Len() int { return 54 }
+func (fastpathAslice) Len() int { return 56 }
 func (fastpathAslice) Less(i, j int) bool {
 	return fastpathAvRtid[uint(i)] < fastpathAvRtid[uint(j)]
 }
@@ -68,9 +69,9 @@ func (fastpathAslice) Swap(i, j int) {
 func fastpathAvIndex(rtid uintptr) int {
 	// use binary search to grab the index (adapted from sort/search.go)
 	// Note: we use goto (instead of for loop) so this can be inlined.
-	// h, i, j := 0, 0, 54
+	// h, i, j := 0, 0, 56
 	var h, i uint
-	var j uint = 54
+	var j uint = 56
 LOOP:
 	if i < j {
 		h = (i + j) >> 1 // avoid overflow when computing h // h = i + (j-i)/2
@@ -81,7 +82,7 @@ LOOP:
 		}
 		goto LOOP
 	}
-	if i < 54 && fastpathAvRtid[i] == rtid {
+	if i < 56 && fastpathAvRtid[i] == rtid {
 		return int(i)
 	}
 	return -1
@@ -103,7 +104,9 @@ func init() {
 	fn([]interface{}(nil), (*Encoder).fastpathEncSliceIntfR, (*Decoder).fastpathDecSliceIntfR)
 	fn([]string(nil), (*Encoder).fastpathEncSliceStringR, (*Decoder).fastpathDecSliceStringR)
 	fn([][]byte(nil), (*Encoder).fastpathEncSliceBytesR, (*Decoder).fastpathDecSliceBytesR)
+	fn([]float32(nil), (*Encoder).fastpathEncSliceFloat32R, (*Decoder).fastpathDecSliceFloat32R)
 	fn([]float64(nil), (*Encoder).fastpathEncSliceFloat64R, (*Decoder).fastpathDecSliceFloat64R)
+	fn([]uint8(nil), (*Encoder).fastpathEncSliceUint8R, (*Decoder).fastpathDecSliceUint8R)
 	fn([]uint64(nil), (*Encoder).fastpathEncSliceUint64R, (*Decoder).fastpathDecSliceUint64R)
 	fn([]int(nil), (*Encoder).fastpathEncSliceIntR, (*Decoder).fastpathDecSliceIntR)
 	fn([]int32(nil), (*Encoder).fastpathEncSliceInt32R, (*Decoder).fastpathDecSliceInt32R)
@@ -116,7 +119,7 @@ func init() {
 	fn(map[string]uint8(nil), (*Encoder).fastpathEncMapStringUint8R, (*Decoder).fastpathDecMapStringUint8R)
 	fn(map[string]uint64(nil), (*Encoder).fastpathEncMapStringUint64R, (*Decoder).fastpathDecMapStringUint64R)
 	fn(map[string]int(nil), (*Encoder).fastpathEncMapStringIntR, (*Decoder).fastpathDecMapStringIntR)
-	fn(map[string]int64(nil), (*Encoder).fastpathEncMapStringInt64R, (*Decoder).fastpathDecMapStringInt64R)
+	fn(map[string]int32(nil), (*Encoder).fastpathEncMapStringInt32R, (*Decoder).fastpathDecMapStringInt32R)
 	fn(map[string]float64(nil), (*Encoder).fastpathEncMapStringFloat64R, (*Decoder).fastpathDecMapStringFloat64R)
 	fn(map[string]bool(nil), (*Encoder).fastpathEncMapStringBoolR, (*Decoder).fastpathDecMapStringBoolR)
 	fn(map[uint8]interface{}(nil), (*Encoder).fastpathEncMapUint8IntfR, (*Decoder).fastpathDecMapUint8IntfR)
@@ -125,7 +128,7 @@ func init() {
 	fn(map[uint8]uint8(nil), (*Encoder).fastpathEncMapUint8Uint8R, (*Decoder).fastpathDecMapUint8Uint8R)
 	fn(map[uint8]uint64(nil), (*Encoder).fastpathEncMapUint8Uint64R, (*Decoder).fastpathDecMapUint8Uint64R)
 	fn(map[uint8]int(nil), (*Encoder).fastpathEncMapUint8IntR, (*Decoder).fastpathDecMapUint8IntR)
-	fn(map[uint8]int64(nil), (*Encoder).fastpathEncMapUint8Int64R, (*Decoder).fastpathDecMapUint8Int64R)
+	fn(map[uint8]int32(nil), (*Encoder).fastpathEncMapUint8Int32R, (*Decoder).fastpathDecMapUint8Int32R)
 	fn(map[uint8]float64(nil), (*Encoder).fastpathEncMapUint8Float64R, (*Decoder).fastpathDecMapUint8Float64R)
 	fn(map[uint8]bool(nil), (*Encoder).fastpathEncMapUint8BoolR, (*Decoder).fastpathDecMapUint8BoolR)
 	fn(map[uint64]interface{}(nil), (*Encoder).fastpathEncMapUint64IntfR, (*Decoder).fastpathDecMapUint64IntfR)
@@ -134,7 +137,7 @@ func init() {
 	fn(map[uint64]uint8(nil), (*Encoder).fastpathEncMapUint64Uint8R, (*Decoder).fastpathDecMapUint64Uint8R)
 	fn(map[uint64]uint64(nil), (*Encoder).fastpathEncMapUint64Uint64R, (*Decoder).fastpathDecMapUint64Uint64R)
 	fn(map[uint64]int(nil), (*Encoder).fastpathEncMapUint64IntR, (*Decoder).fastpathDecMapUint64IntR)
-	fn(map[uint64]int64(nil), (*Encoder).fastpathEncMapUint64Int64R, (*Decoder).fastpathDecMapUint64Int64R)
+	fn(map[uint64]int32(nil), (*Encoder).fastpathEncMapUint64Int32R, (*Decoder).fastpathDecMapUint64Int32R)
 	fn(map[uint64]float64(nil), (*Encoder).fastpathEncMapUint64Float64R, (*Decoder).fastpathDecMapUint64Float64R)
 	fn(map[uint64]bool(nil), (*Encoder).fastpathEncMapUint64BoolR, (*Decoder).fastpathDecMapUint64BoolR)
 	fn(map[int]interface{}(nil), (*Encoder).fastpathEncMapIntIntfR, (*Decoder).fastpathDecMapIntIntfR)
@@ -143,18 +146,18 @@ func init() {
 	fn(map[int]uint8(nil), (*Encoder).fastpathEncMapIntUint8R, (*Decoder).fastpathDecMapIntUint8R)
 	fn(map[int]uint64(nil), (*Encoder).fastpathEncMapIntUint64R, (*Decoder).fastpathDecMapIntUint64R)
 	fn(map[int]int(nil), (*Encoder).fastpathEncMapIntIntR, (*Decoder).fastpathDecMapIntIntR)
-	fn(map[int]int64(nil), (*Encoder).fastpathEncMapIntInt64R, (*Decoder).fastpathDecMapIntInt64R)
+	fn(map[int]int32(nil), (*Encoder).fastpathEncMapIntInt32R, (*Decoder).fastpathDecMapIntInt32R)
 	fn(map[int]float64(nil), (*Encoder).fastpathEncMapIntFloat64R, (*Decoder).fastpathDecMapIntFloat64R)
 	fn(map[int]bool(nil), (*Encoder).fastpathEncMapIntBoolR, (*Decoder).fastpathDecMapIntBoolR)
-	fn(map[int64]interface{}(nil), (*Encoder).fastpathEncMapInt64IntfR, (*Decoder).fastpathDecMapInt64IntfR)
-	fn(map[int64]string(nil), (*Encoder).fastpathEncMapInt64StringR, (*Decoder).fastpathDecMapInt64StringR)
-	fn(map[int64][]byte(nil), (*Encoder).fastpathEncMapInt64BytesR, (*Decoder).fastpathDecMapInt64BytesR)
-	fn(map[int64]uint8(nil), (*Encoder).fastpathEncMapInt64Uint8R, (*Decoder).fastpathDecMapInt64Uint8R)
-	fn(map[int64]uint64(nil), (*Encoder).fastpathEncMapInt64Uint64R, (*Decoder).fastpathDecMapInt64Uint64R)
-	fn(map[int64]int(nil), (*Encoder).fastpathEncMapInt64IntR, (*Decoder).fastpathDecMapInt64IntR)
-	fn(map[int64]int64(nil), (*Encoder).fastpathEncMapInt64Int64R, (*Decoder).fastpathDecMapInt64Int64R)
-	fn(map[int64]float64(nil), (*Encoder).fastpathEncMapInt64Float64R, (*Decoder).fastpathDecMapInt64Float64R)
-	fn(map[int64]bool(nil), (*Encoder).fastpathEncMapInt64BoolR, (*Decoder).fastpathDecMapInt64BoolR)
+	fn(map[int32]interface{}(nil), (*Encoder).fastpathEncMapInt32IntfR, (*Decoder).fastpathDecMapInt32IntfR)
+	fn(map[int32]string(nil), (*Encoder).fastpathEncMapInt32StringR, (*Decoder).fastpathDecMapInt32StringR)
+	fn(map[int32][]byte(nil), (*Encoder).fastpathEncMapInt32BytesR, (*Decoder).fastpathDecMapInt32BytesR)
+	fn(map[int32]uint8(nil), (*Encoder).fastpathEncMapInt32Uint8R, (*Decoder).fastpathDecMapInt32Uint8R)
+	fn(map[int32]uint64(nil), (*Encoder).fastpathEncMapInt32Uint64R, (*Decoder).fastpathDecMapInt32Uint64R)
+	fn(map[int32]int(nil), (*Encoder).fastpathEncMapInt32IntR, (*Decoder).fastpathDecMapInt32IntR)
+	fn(map[int32]int32(nil), (*Encoder).fastpathEncMapInt32Int32R, (*Decoder).fastpathDecMapInt32Int32R)
+	fn(map[int32]float64(nil), (*Encoder).fastpathEncMapInt32Float64R, (*Decoder).fastpathDecMapInt32Float64R)
+	fn(map[int32]bool(nil), (*Encoder).fastpathEncMapInt32BoolR, (*Decoder).fastpathDecMapInt32BoolR)
 
 	sort.Sort(fastpathAslice{})
 }
@@ -188,6 +191,14 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncSliceBytesV(*v, e)
 		}
+	case []float32:
+		fastpathTV.EncSliceFloat32V(v, e)
+	case *[]float32:
+		if *v == nil {
+			e.e.EncodeNil()
+		} else {
+			fastpathTV.EncSliceFloat32V(*v, e)
+		}
 	case []float64:
 		fastpathTV.EncSliceFloat64V(v, e)
 	case *[]float64:
@@ -196,6 +207,14 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncSliceFloat64V(*v, e)
 		}
+	case []uint8:
+		fastpathTV.EncSliceUint8V(v, e)
+	case *[]uint8:
+		if *v == nil {
+			e.e.EncodeNil()
+		} else {
+			fastpathTV.EncSliceUint8V(*v, e)
+		}
 	case []uint64:
 		fastpathTV.EncSliceUint64V(v, e)
 	case *[]uint64:
@@ -284,13 +303,13 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncMapStringIntV(*v, e)
 		}
-	case map[string]int64:
-		fastpathTV.EncMapStringInt64V(v, e)
-	case *map[string]int64:
+	case map[string]int32:
+		fastpathTV.EncMapStringInt32V(v, e)
+	case *map[string]int32:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapStringInt64V(*v, e)
+			fastpathTV.EncMapStringInt32V(*v, e)
 		}
 	case map[string]float64:
 		fastpathTV.EncMapStringFloat64V(v, e)
@@ -356,13 +375,13 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncMapUint8IntV(*v, e)
 		}
-	case map[uint8]int64:
-		fastpathTV.EncMapUint8Int64V(v, e)
-	case *map[uint8]int64:
+	case map[uint8]int32:
+		fastpathTV.EncMapUint8Int32V(v, e)
+	case *map[uint8]int32:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapUint8Int64V(*v, e)
+			fastpathTV.EncMapUint8Int32V(*v, e)
 		}
 	case map[uint8]float64:
 		fastpathTV.EncMapUint8Float64V(v, e)
@@ -428,13 +447,13 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncMapUint64IntV(*v, e)
 		}
-	case map[uint64]int64:
-		fastpathTV.EncMapUint64Int64V(v, e)
-	case *map[uint64]int64:
+	case map[uint64]int32:
+		fastpathTV.EncMapUint64Int32V(v, e)
+	case *map[uint64]int32:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapUint64Int64V(*v, e)
+			fastpathTV.EncMapUint64Int32V(*v, e)
 		}
 	case map[uint64]float64:
 		fastpathTV.EncMapUint64Float64V(v, e)
@@ -500,13 +519,13 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncMapIntIntV(*v, e)
 		}
-	case map[int]int64:
-		fastpathTV.EncMapIntInt64V(v, e)
-	case *map[int]int64:
+	case map[int]int32:
+		fastpathTV.EncMapIntInt32V(v, e)
+	case *map[int]int32:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapIntInt64V(*v, e)
+			fastpathTV.EncMapIntInt32V(*v, e)
 		}
 	case map[int]float64:
 		fastpathTV.EncMapIntFloat64V(v, e)
@@ -524,77 +543,77 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 		} else {
 			fastpathTV.EncMapIntBoolV(*v, e)
 		}
-	case map[int64]interface{}:
-		fastpathTV.EncMapInt64IntfV(v, e)
-	case *map[int64]interface{}:
+	case map[int32]interface{}:
+		fastpathTV.EncMapInt32IntfV(v, e)
+	case *map[int32]interface{}:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64IntfV(*v, e)
+			fastpathTV.EncMapInt32IntfV(*v, e)
 		}
-	case map[int64]string:
-		fastpathTV.EncMapInt64StringV(v, e)
-	case *map[int64]string:
+	case map[int32]string:
+		fastpathTV.EncMapInt32StringV(v, e)
+	case *map[int32]string:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64StringV(*v, e)
+			fastpathTV.EncMapInt32StringV(*v, e)
 		}
-	case map[int64][]byte:
-		fastpathTV.EncMapInt64BytesV(v, e)
-	case *map[int64][]byte:
+	case map[int32][]byte:
+		fastpathTV.EncMapInt32BytesV(v, e)
+	case *map[int32][]byte:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64BytesV(*v, e)
+			fastpathTV.EncMapInt32BytesV(*v, e)
 		}
-	case map[int64]uint8:
-		fastpathTV.EncMapInt64Uint8V(v, e)
-	case *map[int64]uint8:
+	case map[int32]uint8:
+		fastpathTV.EncMapInt32Uint8V(v, e)
+	case *map[int32]uint8:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64Uint8V(*v, e)
+			fastpathTV.EncMapInt32Uint8V(*v, e)
 		}
-	case map[int64]uint64:
-		fastpathTV.EncMapInt64Uint64V(v, e)
-	case *map[int64]uint64:
+	case map[int32]uint64:
+		fastpathTV.EncMapInt32Uint64V(v, e)
+	case *map[int32]uint64:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64Uint64V(*v, e)
+			fastpathTV.EncMapInt32Uint64V(*v, e)
 		}
-	case map[int64]int:
-		fastpathTV.EncMapInt64IntV(v, e)
-	case *map[int64]int:
+	case map[int32]int:
+		fastpathTV.EncMapInt32IntV(v, e)
+	case *map[int32]int:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64IntV(*v, e)
+			fastpathTV.EncMapInt32IntV(*v, e)
 		}
-	case map[int64]int64:
-		fastpathTV.EncMapInt64Int64V(v, e)
-	case *map[int64]int64:
+	case map[int32]int32:
+		fastpathTV.EncMapInt32Int32V(v, e)
+	case *map[int32]int32:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64Int64V(*v, e)
+			fastpathTV.EncMapInt32Int32V(*v, e)
 		}
-	case map[int64]float64:
-		fastpathTV.EncMapInt64Float64V(v, e)
-	case *map[int64]float64:
+	case map[int32]float64:
+		fastpathTV.EncMapInt32Float64V(v, e)
+	case *map[int32]float64:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64Float64V(*v, e)
+			fastpathTV.EncMapInt32Float64V(*v, e)
 		}
-	case map[int64]bool:
-		fastpathTV.EncMapInt64BoolV(v, e)
-	case *map[int64]bool:
+	case map[int32]bool:
+		fastpathTV.EncMapInt32BoolV(v, e)
+	case *map[int32]bool:
 		if *v == nil {
 			e.e.EncodeNil()
 		} else {
-			fastpathTV.EncMapInt64BoolV(*v, e)
+			fastpathTV.EncMapInt32BoolV(*v, e)
 		}
 	default:
 		_ = v // workaround https://github.com/golang/go/issues/12927 seen in go1.4
@@ -605,10 +624,16 @@ func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
 
 // -- -- fast path functions
 func (e *Encoder) fastpathEncSliceIntfR(f *codecFnInfo, rv reflect.Value) {
+	var v []interface{}
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]interface{})
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceIntfV(rv2i(rv).([]interface{}), e)
+		fastpathTV.EncAsMapSliceIntfV(v, e)
 	} else {
-		fastpathTV.EncSliceIntfV(rv2i(rv).([]interface{}), e)
+		fastpathTV.EncSliceIntfV(v, e)
 	}
 }
 func (fastpathT) EncSliceIntfV(v []interface{}, e *Encoder) {
@@ -633,10 +658,16 @@ func (fastpathT) EncAsMapSliceIntfV(v []interface{}, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceStringR(f *codecFnInfo, rv reflect.Value) {
+	var v []string
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]string)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceStringV(rv2i(rv).([]string), e)
+		fastpathTV.EncAsMapSliceStringV(v, e)
 	} else {
-		fastpathTV.EncSliceStringV(rv2i(rv).([]string), e)
+		fastpathTV.EncSliceStringV(v, e)
 	}
 }
 func (fastpathT) EncSliceStringV(v []string, e *Encoder) {
@@ -661,10 +692,16 @@ func (fastpathT) EncAsMapSliceStringV(v []string, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceBytesR(f *codecFnInfo, rv reflect.Value) {
+	var v [][]byte
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([][]byte)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceBytesV(rv2i(rv).([][]byte), e)
+		fastpathTV.EncAsMapSliceBytesV(v, e)
 	} else {
-		fastpathTV.EncSliceBytesV(rv2i(rv).([][]byte), e)
+		fastpathTV.EncSliceBytesV(v, e)
 	}
 }
 func (fastpathT) EncSliceBytesV(v [][]byte, e *Encoder) {
@@ -688,11 +725,51 @@ func (fastpathT) EncAsMapSliceBytesV(v [][]byte, e *Encoder) {
 	}
 	e.mapEnd()
 }
+func (e *Encoder) fastpathEncSliceFloat32R(f *codecFnInfo, rv reflect.Value) {
+	var v []float32
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]float32)
+	}
+	if f.ti.mbs {
+		fastpathTV.EncAsMapSliceFloat32V(v, e)
+	} else {
+		fastpathTV.EncSliceFloat32V(v, e)
+	}
+}
+func (fastpathT) EncSliceFloat32V(v []float32, e *Encoder) {
+	e.arrayStart(len(v))
+	for j := range v {
+		e.arrayElem()
+		e.e.EncodeFloat32(v[j])
+	}
+	e.arrayEnd()
+}
+func (fastpathT) EncAsMapSliceFloat32V(v []float32, e *Encoder) {
+	e.haltOnMbsOddLen(len(v))
+	e.mapStart(len(v) >> 1) // e.mapStart(len(v) / 2)
+	for j := range v {
+		if j&1 == 0 { // if j%2 == 0 {
+			e.mapElemKey()
+		} else {
+			e.mapElemValue()
+		}
+		e.e.EncodeFloat32(v[j])
+	}
+	e.mapEnd()
+}
 func (e *Encoder) fastpathEncSliceFloat64R(f *codecFnInfo, rv reflect.Value) {
+	var v []float64
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]float64)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceFloat64V(rv2i(rv).([]float64), e)
+		fastpathTV.EncAsMapSliceFloat64V(v, e)
 	} else {
-		fastpathTV.EncSliceFloat64V(rv2i(rv).([]float64), e)
+		fastpathTV.EncSliceFloat64V(v, e)
 	}
 }
 func (fastpathT) EncSliceFloat64V(v []float64, e *Encoder) {
@@ -716,11 +793,46 @@ func (fastpathT) EncAsMapSliceFloat64V(v []float64, e *Encoder) {
 	}
 	e.mapEnd()
 }
+func (e *Encoder) fastpathEncSliceUint8R(f *codecFnInfo, rv reflect.Value) {
+	var v []uint8
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]uint8)
+	}
+	if f.ti.mbs {
+		fastpathTV.EncAsMapSliceUint8V(v, e)
+	} else {
+		fastpathTV.EncSliceUint8V(v, e)
+	}
+}
+func (fastpathT) EncSliceUint8V(v []uint8, e *Encoder) {
+	e.e.EncodeStringBytesRaw(v)
+}
+func (fastpathT) EncAsMapSliceUint8V(v []uint8, e *Encoder) {
+	e.haltOnMbsOddLen(len(v))
+	e.mapStart(len(v) >> 1) // e.mapStart(len(v) / 2)
+	for j := range v {
+		if j&1 == 0 { // if j%2 == 0 {
+			e.mapElemKey()
+		} else {
+			e.mapElemValue()
+		}
+		e.e.EncodeUint(uint64(v[j]))
+	}
+	e.mapEnd()
+}
 func (e *Encoder) fastpathEncSliceUint64R(f *codecFnInfo, rv reflect.Value) {
+	var v []uint64
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]uint64)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceUint64V(rv2i(rv).([]uint64), e)
+		fastpathTV.EncAsMapSliceUint64V(v, e)
 	} else {
-		fastpathTV.EncSliceUint64V(rv2i(rv).([]uint64), e)
+		fastpathTV.EncSliceUint64V(v, e)
 	}
 }
 func (fastpathT) EncSliceUint64V(v []uint64, e *Encoder) {
@@ -745,10 +857,16 @@ func (fastpathT) EncAsMapSliceUint64V(v []uint64, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceIntR(f *codecFnInfo, rv reflect.Value) {
+	var v []int
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]int)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceIntV(rv2i(rv).([]int), e)
+		fastpathTV.EncAsMapSliceIntV(v, e)
 	} else {
-		fastpathTV.EncSliceIntV(rv2i(rv).([]int), e)
+		fastpathTV.EncSliceIntV(v, e)
 	}
 }
 func (fastpathT) EncSliceIntV(v []int, e *Encoder) {
@@ -773,10 +891,16 @@ func (fastpathT) EncAsMapSliceIntV(v []int, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceInt32R(f *codecFnInfo, rv reflect.Value) {
+	var v []int32
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]int32)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceInt32V(rv2i(rv).([]int32), e)
+		fastpathTV.EncAsMapSliceInt32V(v, e)
 	} else {
-		fastpathTV.EncSliceInt32V(rv2i(rv).([]int32), e)
+		fastpathTV.EncSliceInt32V(v, e)
 	}
 }
 func (fastpathT) EncSliceInt32V(v []int32, e *Encoder) {
@@ -801,10 +925,16 @@ func (fastpathT) EncAsMapSliceInt32V(v []int32, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceInt64R(f *codecFnInfo, rv reflect.Value) {
+	var v []int64
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]int64)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceInt64V(rv2i(rv).([]int64), e)
+		fastpathTV.EncAsMapSliceInt64V(v, e)
 	} else {
-		fastpathTV.EncSliceInt64V(rv2i(rv).([]int64), e)
+		fastpathTV.EncSliceInt64V(v, e)
 	}
 }
 func (fastpathT) EncSliceInt64V(v []int64, e *Encoder) {
@@ -829,10 +959,16 @@ func (fastpathT) EncAsMapSliceInt64V(v []int64, e *Encoder) {
 	e.mapEnd()
 }
 func (e *Encoder) fastpathEncSliceBoolR(f *codecFnInfo, rv reflect.Value) {
+	var v []bool
+	if rv.Kind() == reflect.Array {
+		rvGetSlice4Array(rv, &v)
+	} else {
+		v = rv2i(rv).([]bool)
+	}
 	if f.ti.mbs {
-		fastpathTV.EncAsMapSliceBoolV(rv2i(rv).([]bool), e)
+		fastpathTV.EncAsMapSliceBoolV(v, e)
 	} else {
-		fastpathTV.EncSliceBoolV(rv2i(rv).([]bool), e)
+		fastpathTV.EncSliceBoolV(v, e)
 	}
 }
 func (fastpathT) EncSliceBoolV(v []bool, e *Encoder) {
@@ -1030,10 +1166,10 @@ func (fastpathT) EncMapStringIntV(v map[string]int, e *Encoder) {
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapStringInt64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapStringInt64V(rv2i(rv).(map[string]int64), e)
+func (e *Encoder) fastpathEncMapStringInt32R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapStringInt32V(rv2i(rv).(map[string]int32), e)
 }
-func (fastpathT) EncMapStringInt64V(v map[string]int64, e *Encoder) {
+func (fastpathT) EncMapStringInt32V(v map[string]int32, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
 		v2 := make([]string, len(v))
@@ -1047,14 +1183,14 @@ func (fastpathT) EncMapStringInt64V(v map[string]int64, e *Encoder) {
 			e.mapElemKey()
 			e.e.EncodeString(k2)
 			e.mapElemValue()
-			e.e.EncodeInt(v[k2])
+			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
 			e.e.EncodeString(k2)
 			e.mapElemValue()
-			e.e.EncodeInt(v2)
+			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
@@ -1291,10 +1427,10 @@ func (fastpathT) EncMapUint8IntV(v map[uint8]int, e *Encoder) {
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapUint8Int64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapUint8Int64V(rv2i(rv).(map[uint8]int64), e)
+func (e *Encoder) fastpathEncMapUint8Int32R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapUint8Int32V(rv2i(rv).(map[uint8]int32), e)
 }
-func (fastpathT) EncMapUint8Int64V(v map[uint8]int64, e *Encoder) {
+func (fastpathT) EncMapUint8Int32V(v map[uint8]int32, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
 		v2 := make([]uint8, len(v))
@@ -1308,14 +1444,14 @@ func (fastpathT) EncMapUint8Int64V(v map[uint8]int64, e *Encoder) {
 			e.mapElemKey()
 			e.e.EncodeUint(uint64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v[k2])
+			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
 			e.e.EncodeUint(uint64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v2)
+			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
@@ -1552,10 +1688,10 @@ func (fastpathT) EncMapUint64IntV(v map[uint64]int, e *Encoder) {
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapUint64Int64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapUint64Int64V(rv2i(rv).(map[uint64]int64), e)
+func (e *Encoder) fastpathEncMapUint64Int32R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapUint64Int32V(rv2i(rv).(map[uint64]int32), e)
 }
-func (fastpathT) EncMapUint64Int64V(v map[uint64]int64, e *Encoder) {
+func (fastpathT) EncMapUint64Int32V(v map[uint64]int32, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
 		v2 := make([]uint64, len(v))
@@ -1569,14 +1705,14 @@ func (fastpathT) EncMapUint64Int64V(v map[uint64]int64, e *Encoder) {
 			e.mapElemKey()
 			e.e.EncodeUint(k2)
 			e.mapElemValue()
-			e.e.EncodeInt(v[k2])
+			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
 			e.e.EncodeUint(k2)
 			e.mapElemValue()
-			e.e.EncodeInt(v2)
+			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
@@ -1813,10 +1949,10 @@ func (fastpathT) EncMapIntIntV(v map[int]int, e *Encoder) {
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapIntInt64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapIntInt64V(rv2i(rv).(map[int]int64), e)
+func (e *Encoder) fastpathEncMapIntInt32R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapIntInt32V(rv2i(rv).(map[int]int32), e)
 }
-func (fastpathT) EncMapIntInt64V(v map[int]int64, e *Encoder) {
+func (fastpathT) EncMapIntInt32V(v map[int]int32, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
 		v2 := make([]int, len(v))
@@ -1830,14 +1966,14 @@ func (fastpathT) EncMapIntInt64V(v map[int]int64, e *Encoder) {
 			e.mapElemKey()
 			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v[k2])
+			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
 			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v2)
+			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
@@ -1900,261 +2036,261 @@ func (fastpathT) EncMapIntBoolV(v map[int]bool, e *Encoder) {
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64IntfR(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64IntfV(rv2i(rv).(map[int64]interface{}), e)
+func (e *Encoder) fastpathEncMapInt32IntfR(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32IntfV(rv2i(rv).(map[int32]interface{}), e)
 }
-func (fastpathT) EncMapInt64IntfV(v map[int64]interface{}, e *Encoder) {
+func (fastpathT) EncMapInt32IntfV(v map[int32]interface{}, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.encode(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.encode(v2)
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64StringR(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64StringV(rv2i(rv).(map[int64]string), e)
+func (e *Encoder) fastpathEncMapInt32StringR(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32StringV(rv2i(rv).(map[int32]string), e)
 }
-func (fastpathT) EncMapInt64StringV(v map[int64]string, e *Encoder) {
+func (fastpathT) EncMapInt32StringV(v map[int32]string, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeString(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeString(v2)
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64BytesR(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64BytesV(rv2i(rv).(map[int64][]byte), e)
+func (e *Encoder) fastpathEncMapInt32BytesR(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32BytesV(rv2i(rv).(map[int32][]byte), e)
 }
-func (fastpathT) EncMapInt64BytesV(v map[int64][]byte, e *Encoder) {
+func (fastpathT) EncMapInt32BytesV(v map[int32][]byte, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeStringBytesRaw(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeStringBytesRaw(v2)
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64Uint8R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64Uint8V(rv2i(rv).(map[int64]uint8), e)
+func (e *Encoder) fastpathEncMapInt32Uint8R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32Uint8V(rv2i(rv).(map[int32]uint8), e)
 }
-func (fastpathT) EncMapInt64Uint8V(v map[int64]uint8, e *Encoder) {
+func (fastpathT) EncMapInt32Uint8V(v map[int32]uint8, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeUint(uint64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeUint(uint64(v2))
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64Uint64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64Uint64V(rv2i(rv).(map[int64]uint64), e)
+func (e *Encoder) fastpathEncMapInt32Uint64R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32Uint64V(rv2i(rv).(map[int32]uint64), e)
 }
-func (fastpathT) EncMapInt64Uint64V(v map[int64]uint64, e *Encoder) {
+func (fastpathT) EncMapInt32Uint64V(v map[int32]uint64, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeUint(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeUint(v2)
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64IntR(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64IntV(rv2i(rv).(map[int64]int), e)
+func (e *Encoder) fastpathEncMapInt32IntR(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32IntV(rv2i(rv).(map[int32]int), e)
 }
-func (fastpathT) EncMapInt64IntV(v map[int64]int, e *Encoder) {
+func (fastpathT) EncMapInt32IntV(v map[int32]int, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64Int64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64Int64V(rv2i(rv).(map[int64]int64), e)
+func (e *Encoder) fastpathEncMapInt32Int32R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32Int32V(rv2i(rv).(map[int32]int32), e)
 }
-func (fastpathT) EncMapInt64Int64V(v map[int64]int64, e *Encoder) {
+func (fastpathT) EncMapInt32Int32V(v map[int32]int32, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v[k2])
+			e.e.EncodeInt(int64(v[k2]))
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
-			e.e.EncodeInt(v2)
+			e.e.EncodeInt(int64(v2))
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64Float64R(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64Float64V(rv2i(rv).(map[int64]float64), e)
+func (e *Encoder) fastpathEncMapInt32Float64R(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32Float64V(rv2i(rv).(map[int32]float64), e)
 }
-func (fastpathT) EncMapInt64Float64V(v map[int64]float64, e *Encoder) {
+func (fastpathT) EncMapInt32Float64V(v map[int32]float64, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeFloat64(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeFloat64(v2)
 		}
 	}
 	e.mapEnd()
 }
-func (e *Encoder) fastpathEncMapInt64BoolR(f *codecFnInfo, rv reflect.Value) {
-	fastpathTV.EncMapInt64BoolV(rv2i(rv).(map[int64]bool), e)
+func (e *Encoder) fastpathEncMapInt32BoolR(f *codecFnInfo, rv reflect.Value) {
+	fastpathTV.EncMapInt32BoolV(rv2i(rv).(map[int32]bool), e)
 }
-func (fastpathT) EncMapInt64BoolV(v map[int64]bool, e *Encoder) {
+func (fastpathT) EncMapInt32BoolV(v map[int32]bool, e *Encoder) {
 	e.mapStart(len(v))
 	if e.h.Canonical {
-		v2 := make([]int64, len(v))
+		v2 := make([]int32, len(v))
 		var i uint
 		for k := range v {
 			v2[i] = k
 			i++
 		}
-		sort.Sort(int64Slice(v2))
+		sort.Sort(int32Slice(v2))
 		for _, k2 := range v2 {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeBool(v[k2])
 		}
 	} else {
 		for k2, v2 := range v {
 			e.mapElemKey()
-			e.e.EncodeInt(k2)
+			e.e.EncodeInt(int64(k2))
 			e.mapElemValue()
 			e.e.EncodeBool(v2)
 		}
@@ -2190,6 +2326,13 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		if v2, changed = fastpathTV.DecSliceBytesY(*v, d); changed {
 			*v = v2
 		}
+	case []float32:
+		fastpathTV.DecSliceFloat32N(v, d)
+	case *[]float32:
+		var v2 []float32
+		if v2, changed = fastpathTV.DecSliceFloat32Y(*v, d); changed {
+			*v = v2
+		}
 	case []float64:
 		fastpathTV.DecSliceFloat64N(v, d)
 	case *[]float64:
@@ -2197,6 +2340,13 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		if v2, changed = fastpathTV.DecSliceFloat64Y(*v, d); changed {
 			*v = v2
 		}
+	case []uint8:
+		fastpathTV.DecSliceUint8N(v, d)
+	case *[]uint8:
+		var v2 []uint8
+		if v2, changed = fastpathTV.DecSliceUint8Y(*v, d); changed {
+			*v = v2
+		}
 	case []uint64:
 		fastpathTV.DecSliceUint64N(v, d)
 	case *[]uint64:
@@ -2233,7 +2383,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 			*v = v2
 		}
 	case map[string]interface{}:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringIntfL(v, containerLen, d)
@@ -2243,7 +2393,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]interface{}:
 		fastpathTV.DecMapStringIntfX(v, d)
 	case map[string]string:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringStringL(v, containerLen, d)
@@ -2253,7 +2403,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]string:
 		fastpathTV.DecMapStringStringX(v, d)
 	case map[string][]byte:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringBytesL(v, containerLen, d)
@@ -2263,7 +2413,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string][]byte:
 		fastpathTV.DecMapStringBytesX(v, d)
 	case map[string]uint8:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringUint8L(v, containerLen, d)
@@ -2273,7 +2423,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]uint8:
 		fastpathTV.DecMapStringUint8X(v, d)
 	case map[string]uint64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringUint64L(v, containerLen, d)
@@ -2283,7 +2433,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]uint64:
 		fastpathTV.DecMapStringUint64X(v, d)
 	case map[string]int:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringIntL(v, containerLen, d)
@@ -2292,18 +2442,18 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		}
 	case *map[string]int:
 		fastpathTV.DecMapStringIntX(v, d)
-	case map[string]int64:
-		containerLen = d.mapStart()
+	case map[string]int32:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapStringInt64L(v, containerLen, d)
+				fastpathTV.DecMapStringInt32L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[string]int64:
-		fastpathTV.DecMapStringInt64X(v, d)
+	case *map[string]int32:
+		fastpathTV.DecMapStringInt32X(v, d)
 	case map[string]float64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringFloat64L(v, containerLen, d)
@@ -2313,7 +2463,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]float64:
 		fastpathTV.DecMapStringFloat64X(v, d)
 	case map[string]bool:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapStringBoolL(v, containerLen, d)
@@ -2323,7 +2473,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[string]bool:
 		fastpathTV.DecMapStringBoolX(v, d)
 	case map[uint8]interface{}:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8IntfL(v, containerLen, d)
@@ -2333,7 +2483,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]interface{}:
 		fastpathTV.DecMapUint8IntfX(v, d)
 	case map[uint8]string:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8StringL(v, containerLen, d)
@@ -2343,7 +2493,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]string:
 		fastpathTV.DecMapUint8StringX(v, d)
 	case map[uint8][]byte:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8BytesL(v, containerLen, d)
@@ -2353,7 +2503,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8][]byte:
 		fastpathTV.DecMapUint8BytesX(v, d)
 	case map[uint8]uint8:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8Uint8L(v, containerLen, d)
@@ -2363,7 +2513,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]uint8:
 		fastpathTV.DecMapUint8Uint8X(v, d)
 	case map[uint8]uint64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8Uint64L(v, containerLen, d)
@@ -2373,7 +2523,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]uint64:
 		fastpathTV.DecMapUint8Uint64X(v, d)
 	case map[uint8]int:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8IntL(v, containerLen, d)
@@ -2382,18 +2532,18 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		}
 	case *map[uint8]int:
 		fastpathTV.DecMapUint8IntX(v, d)
-	case map[uint8]int64:
-		containerLen = d.mapStart()
+	case map[uint8]int32:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapUint8Int64L(v, containerLen, d)
+				fastpathTV.DecMapUint8Int32L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[uint8]int64:
-		fastpathTV.DecMapUint8Int64X(v, d)
+	case *map[uint8]int32:
+		fastpathTV.DecMapUint8Int32X(v, d)
 	case map[uint8]float64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8Float64L(v, containerLen, d)
@@ -2403,7 +2553,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]float64:
 		fastpathTV.DecMapUint8Float64X(v, d)
 	case map[uint8]bool:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint8BoolL(v, containerLen, d)
@@ -2413,7 +2563,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint8]bool:
 		fastpathTV.DecMapUint8BoolX(v, d)
 	case map[uint64]interface{}:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64IntfL(v, containerLen, d)
@@ -2423,7 +2573,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]interface{}:
 		fastpathTV.DecMapUint64IntfX(v, d)
 	case map[uint64]string:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64StringL(v, containerLen, d)
@@ -2433,7 +2583,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]string:
 		fastpathTV.DecMapUint64StringX(v, d)
 	case map[uint64][]byte:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64BytesL(v, containerLen, d)
@@ -2443,7 +2593,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64][]byte:
 		fastpathTV.DecMapUint64BytesX(v, d)
 	case map[uint64]uint8:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64Uint8L(v, containerLen, d)
@@ -2453,7 +2603,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]uint8:
 		fastpathTV.DecMapUint64Uint8X(v, d)
 	case map[uint64]uint64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64Uint64L(v, containerLen, d)
@@ -2463,7 +2613,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]uint64:
 		fastpathTV.DecMapUint64Uint64X(v, d)
 	case map[uint64]int:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64IntL(v, containerLen, d)
@@ -2472,18 +2622,18 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		}
 	case *map[uint64]int:
 		fastpathTV.DecMapUint64IntX(v, d)
-	case map[uint64]int64:
-		containerLen = d.mapStart()
+	case map[uint64]int32:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapUint64Int64L(v, containerLen, d)
+				fastpathTV.DecMapUint64Int32L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[uint64]int64:
-		fastpathTV.DecMapUint64Int64X(v, d)
+	case *map[uint64]int32:
+		fastpathTV.DecMapUint64Int32X(v, d)
 	case map[uint64]float64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64Float64L(v, containerLen, d)
@@ -2493,7 +2643,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]float64:
 		fastpathTV.DecMapUint64Float64X(v, d)
 	case map[uint64]bool:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapUint64BoolL(v, containerLen, d)
@@ -2503,7 +2653,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[uint64]bool:
 		fastpathTV.DecMapUint64BoolX(v, d)
 	case map[int]interface{}:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntIntfL(v, containerLen, d)
@@ -2513,7 +2663,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int]interface{}:
 		fastpathTV.DecMapIntIntfX(v, d)
 	case map[int]string:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntStringL(v, containerLen, d)
@@ -2523,7 +2673,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int]string:
 		fastpathTV.DecMapIntStringX(v, d)
 	case map[int][]byte:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntBytesL(v, containerLen, d)
@@ -2533,7 +2683,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int][]byte:
 		fastpathTV.DecMapIntBytesX(v, d)
 	case map[int]uint8:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntUint8L(v, containerLen, d)
@@ -2543,7 +2693,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int]uint8:
 		fastpathTV.DecMapIntUint8X(v, d)
 	case map[int]uint64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntUint64L(v, containerLen, d)
@@ -2553,7 +2703,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int]uint64:
 		fastpathTV.DecMapIntUint64X(v, d)
 	case map[int]int:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntIntL(v, containerLen, d)
@@ -2562,18 +2712,18 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		}
 	case *map[int]int:
 		fastpathTV.DecMapIntIntX(v, d)
-	case map[int]int64:
-		containerLen = d.mapStart()
+	case map[int]int32:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapIntInt64L(v, containerLen, d)
+				fastpathTV.DecMapIntInt32L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int]int64:
-		fastpathTV.DecMapIntInt64X(v, d)
+	case *map[int]int32:
+		fastpathTV.DecMapIntInt32X(v, d)
 	case map[int]float64:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntFloat64L(v, containerLen, d)
@@ -2583,7 +2733,7 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 	case *map[int]float64:
 		fastpathTV.DecMapIntFloat64X(v, d)
 	case map[int]bool:
-		containerLen = d.mapStart()
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
 				fastpathTV.DecMapIntBoolL(v, containerLen, d)
@@ -2592,96 +2742,96 @@ func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
 		}
 	case *map[int]bool:
 		fastpathTV.DecMapIntBoolX(v, d)
-	case map[int64]interface{}:
-		containerLen = d.mapStart()
+	case map[int32]interface{}:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64IntfL(v, containerLen, d)
+				fastpathTV.DecMapInt32IntfL(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]interface{}:
-		fastpathTV.DecMapInt64IntfX(v, d)
-	case map[int64]string:
-		containerLen = d.mapStart()
+	case *map[int32]interface{}:
+		fastpathTV.DecMapInt32IntfX(v, d)
+	case map[int32]string:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64StringL(v, containerLen, d)
+				fastpathTV.DecMapInt32StringL(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]string:
-		fastpathTV.DecMapInt64StringX(v, d)
-	case map[int64][]byte:
-		containerLen = d.mapStart()
+	case *map[int32]string:
+		fastpathTV.DecMapInt32StringX(v, d)
+	case map[int32][]byte:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64BytesL(v, containerLen, d)
+				fastpathTV.DecMapInt32BytesL(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64][]byte:
-		fastpathTV.DecMapInt64BytesX(v, d)
-	case map[int64]uint8:
-		containerLen = d.mapStart()
+	case *map[int32][]byte:
+		fastpathTV.DecMapInt32BytesX(v, d)
+	case map[int32]uint8:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64Uint8L(v, containerLen, d)
+				fastpathTV.DecMapInt32Uint8L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]uint8:
-		fastpathTV.DecMapInt64Uint8X(v, d)
-	case map[int64]uint64:
-		containerLen = d.mapStart()
+	case *map[int32]uint8:
+		fastpathTV.DecMapInt32Uint8X(v, d)
+	case map[int32]uint64:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64Uint64L(v, containerLen, d)
+				fastpathTV.DecMapInt32Uint64L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]uint64:
-		fastpathTV.DecMapInt64Uint64X(v, d)
-	case map[int64]int:
-		containerLen = d.mapStart()
+	case *map[int32]uint64:
+		fastpathTV.DecMapInt32Uint64X(v, d)
+	case map[int32]int:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64IntL(v, containerLen, d)
+				fastpathTV.DecMapInt32IntL(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]int:
-		fastpathTV.DecMapInt64IntX(v, d)
-	case map[int64]int64:
-		containerLen = d.mapStart()
+	case *map[int32]int:
+		fastpathTV.DecMapInt32IntX(v, d)
+	case map[int32]int32:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64Int64L(v, containerLen, d)
+				fastpathTV.DecMapInt32Int32L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]int64:
-		fastpathTV.DecMapInt64Int64X(v, d)
-	case map[int64]float64:
-		containerLen = d.mapStart()
+	case *map[int32]int32:
+		fastpathTV.DecMapInt32Int32X(v, d)
+	case map[int32]float64:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64Float64L(v, containerLen, d)
+				fastpathTV.DecMapInt32Float64L(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]float64:
-		fastpathTV.DecMapInt64Float64X(v, d)
-	case map[int64]bool:
-		containerLen = d.mapStart()
+	case *map[int32]float64:
+		fastpathTV.DecMapInt32Float64X(v, d)
+	case map[int32]bool:
+		containerLen = d.mapStart(d.d.ReadMapStart())
 		if containerLen != containerLenNil {
 			if containerLen != 0 {
-				fastpathTV.DecMapInt64BoolL(v, containerLen, d)
+				fastpathTV.DecMapInt32BoolL(v, containerLen, d)
 			}
 			d.mapEnd()
 		}
-	case *map[int64]bool:
-		fastpathTV.DecMapInt64BoolX(v, d)
+	case *map[int32]bool:
+		fastpathTV.DecMapInt32BoolX(v, d)
 	default:
 		_ = v // workaround https://github.com/golang/go/issues/12927 seen in go1.4
 		return false
@@ -2697,8 +2847,12 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 		*v = nil
 	case *[][]byte:
 		*v = nil
+	case *[]float32:
+		*v = nil
 	case *[]float64:
 		*v = nil
+	case *[]uint8:
+		*v = nil
 	case *[]uint64:
 		*v = nil
 	case *[]int:
@@ -2722,7 +2876,7 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 		*v = nil
 	case *map[string]int:
 		*v = nil
-	case *map[string]int64:
+	case *map[string]int32:
 		*v = nil
 	case *map[string]float64:
 		*v = nil
@@ -2740,7 +2894,7 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 		*v = nil
 	case *map[uint8]int:
 		*v = nil
-	case *map[uint8]int64:
+	case *map[uint8]int32:
 		*v = nil
 	case *map[uint8]float64:
 		*v = nil
@@ -2758,7 +2912,7 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 		*v = nil
 	case *map[uint64]int:
 		*v = nil
-	case *map[uint64]int64:
+	case *map[uint64]int32:
 		*v = nil
 	case *map[uint64]float64:
 		*v = nil
@@ -2776,29 +2930,29 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 		*v = nil
 	case *map[int]int:
 		*v = nil
-	case *map[int]int64:
+	case *map[int]int32:
 		*v = nil
 	case *map[int]float64:
 		*v = nil
 	case *map[int]bool:
 		*v = nil
-	case *map[int64]interface{}:
+	case *map[int32]interface{}:
 		*v = nil
-	case *map[int64]string:
+	case *map[int32]string:
 		*v = nil
-	case *map[int64][]byte:
+	case *map[int32][]byte:
 		*v = nil
-	case *map[int64]uint8:
+	case *map[int32]uint8:
 		*v = nil
-	case *map[int64]uint64:
+	case *map[int32]uint64:
 		*v = nil
-	case *map[int64]int:
+	case *map[int32]int:
 		*v = nil
-	case *map[int64]int64:
+	case *map[int32]int32:
 		*v = nil
-	case *map[int64]float64:
+	case *map[int32]float64:
 		*v = nil
-	case *map[int64]bool:
+	case *map[int32]bool:
 		*v = nil
 
 	default:
@@ -2811,12 +2965,18 @@ func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool {
 // -- -- fast path functions
 
 func (d *Decoder) fastpathDecSliceIntfR(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []interface{}
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]interface{})
-		if v, changed := fastpathTV.DecSliceIntfY(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceIntfY(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceIntfN(v, d)
+	default:
 		fastpathTV.DecSliceIntfN(rv2i(rv).([]interface{}), d)
 	}
 }
@@ -2825,7 +2985,7 @@ func (f fastpathT) DecSliceIntfX(vp *[]interface{}, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceIntfY(v []interface{}, d *Decoder) (_ []interface{}, changed bool) {
+func (fastpathT) DecSliceIntfY(v []interface{}, d *Decoder) (v2 []interface{}, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -2859,9 +3019,9 @@ func (fastpathT) DecSliceIntfY(v []interface{}, d *Decoder) (_ []interface{}, ch
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 16)
 			v = make([]interface{}, uint(xlen))
 			changed = true
 		}
@@ -2892,9 +3052,9 @@ func (fastpathT) DecSliceIntfN(v []interface{}, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -2904,12 +3064,18 @@ func (fastpathT) DecSliceIntfN(v []interface{}, d *Decoder) {
 }
 
 func (d *Decoder) fastpathDecSliceStringR(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []string
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]string)
-		if v, changed := fastpathTV.DecSliceStringY(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceStringY(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceStringN(v, d)
+	default:
 		fastpathTV.DecSliceStringN(rv2i(rv).([]string), d)
 	}
 }
@@ -2918,7 +3084,7 @@ func (f fastpathT) DecSliceStringX(vp *[]string, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceStringY(v []string, d *Decoder) (_ []string, changed bool) {
+func (fastpathT) DecSliceStringY(v []string, d *Decoder) (v2 []string, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -2952,9 +3118,9 @@ func (fastpathT) DecSliceStringY(v []string, d *Decoder) (_ []string, changed bo
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 16)
 			v = make([]string, uint(xlen))
 			changed = true
 		}
@@ -2963,7 +3129,7 @@ func (fastpathT) DecSliceStringY(v []string, d *Decoder) (_ []string, changed bo
 			changed = true
 		}
 		slh.ElemContainerState(j)
-		v[uint(j)] = string(d.d.DecodeStringAsBytes())
+		v[uint(j)] = d.stringZC(d.d.DecodeStringAsBytes())
 	}
 	if j < len(v) {
 		v = v[:uint(j)]
@@ -2985,24 +3151,30 @@ func (fastpathT) DecSliceStringN(v []string, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
-		v[uint(j)] = string(d.d.DecodeStringAsBytes())
+		v[uint(j)] = d.stringZC(d.d.DecodeStringAsBytes())
 	}
 	slh.End()
 }
 
 func (d *Decoder) fastpathDecSliceBytesR(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v [][]byte
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[][]byte)
-		if v, changed := fastpathTV.DecSliceBytesY(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceBytesY(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceBytesN(v, d)
+	default:
 		fastpathTV.DecSliceBytesN(rv2i(rv).([][]byte), d)
 	}
 }
@@ -3011,7 +3183,7 @@ func (f fastpathT) DecSliceBytesX(vp *[][]byte, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceBytesY(v [][]byte, d *Decoder) (_ [][]byte, changed bool) {
+func (fastpathT) DecSliceBytesY(v [][]byte, d *Decoder) (v2 [][]byte, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3045,9 +3217,9 @@ func (fastpathT) DecSliceBytesY(v [][]byte, d *Decoder) (_ [][]byte, changed boo
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 24)
 			v = make([][]byte, uint(xlen))
 			changed = true
 		}
@@ -3056,7 +3228,7 @@ func (fastpathT) DecSliceBytesY(v [][]byte, d *Decoder) (_ [][]byte, changed boo
 			changed = true
 		}
 		slh.ElemContainerState(j)
-		v[uint(j)] = d.d.DecodeBytes(nil, false)
+		v[uint(j)] = d.d.DecodeBytes([]byte{})
 	}
 	if j < len(v) {
 		v = v[:uint(j)]
@@ -3078,24 +3250,129 @@ func (fastpathT) DecSliceBytesN(v [][]byte, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
-		v[uint(j)] = d.d.DecodeBytes(nil, false)
+		v[uint(j)] = d.d.DecodeBytes([]byte{})
+	}
+	slh.End()
+}
+
+func (d *Decoder) fastpathDecSliceFloat32R(f *codecFnInfo, rv reflect.Value) {
+	var v []float32
+	switch rv.Kind() {
+	case reflect.Ptr:
+		vp := rv2i(rv).(*[]float32)
+		var changed bool
+		if v, changed = fastpathTV.DecSliceFloat32Y(*vp, d); changed {
+			*vp = v
+		}
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceFloat32N(v, d)
+	default:
+		fastpathTV.DecSliceFloat32N(rv2i(rv).([]float32), d)
+	}
+}
+func (f fastpathT) DecSliceFloat32X(vp *[]float32, d *Decoder) {
+	if v, changed := f.DecSliceFloat32Y(*vp, d); changed {
+		*vp = v
+	}
+}
+func (fastpathT) DecSliceFloat32Y(v []float32, d *Decoder) (v2 []float32, changed bool) {
+	slh, containerLenS := d.decSliceHelperStart()
+	if slh.IsNil {
+		if v == nil {
+			return
+		}
+		return nil, true
+	}
+	if containerLenS == 0 {
+		if v == nil {
+			v = []float32{}
+		} else if len(v) != 0 {
+			v = v[:0]
+		}
+		slh.End()
+		return v, true
+	}
+	hasLen := containerLenS > 0
+	var xlen int
+	if hasLen {
+		if containerLenS > cap(v) {
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 4)
+			if xlen <= cap(v) {
+				v = v[:uint(xlen)]
+			} else {
+				v = make([]float32, uint(xlen))
+			}
+			changed = true
+		} else if containerLenS != len(v) {
+			v = v[:containerLenS]
+			changed = true
+		}
+	}
+	var j int
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j == 0 && len(v) == 0 { // means hasLen == false
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 4)
+			v = make([]float32, uint(xlen))
+			changed = true
+		}
+		if j >= len(v) {
+			v = append(v, 0)
+			changed = true
+		}
+		slh.ElemContainerState(j)
+		v[uint(j)] = float32(d.decodeFloat32())
+	}
+	if j < len(v) {
+		v = v[:uint(j)]
+		changed = true
+	} else if j == 0 && v == nil {
+		v = []float32{}
+		changed = true
+	}
+	slh.End()
+	return v, changed
+}
+func (fastpathT) DecSliceFloat32N(v []float32, d *Decoder) {
+	slh, containerLenS := d.decSliceHelperStart()
+	if slh.IsNil {
+		return
+	}
+	if containerLenS == 0 {
+		slh.End()
+		return
+	}
+	hasLen := containerLenS > 0
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j >= len(v) {
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
+			return
+		}
+		slh.ElemContainerState(j)
+		v[uint(j)] = float32(d.decodeFloat32())
 	}
 	slh.End()
 }
 
 func (d *Decoder) fastpathDecSliceFloat64R(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []float64
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]float64)
-		if v, changed := fastpathTV.DecSliceFloat64Y(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceFloat64Y(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceFloat64N(v, d)
+	default:
 		fastpathTV.DecSliceFloat64N(rv2i(rv).([]float64), d)
 	}
 }
@@ -3104,7 +3381,7 @@ func (f fastpathT) DecSliceFloat64X(vp *[]float64, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceFloat64Y(v []float64, d *Decoder) (_ []float64, changed bool) {
+func (fastpathT) DecSliceFloat64Y(v []float64, d *Decoder) (v2 []float64, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3138,9 +3415,9 @@ func (fastpathT) DecSliceFloat64Y(v []float64, d *Decoder) (_ []float64, changed
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 8)
 			v = make([]float64, uint(xlen))
 			changed = true
 		}
@@ -3171,9 +3448,9 @@ func (fastpathT) DecSliceFloat64N(v []float64, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3182,13 +3459,136 @@ func (fastpathT) DecSliceFloat64N(v []float64, d *Decoder) {
 	slh.End()
 }
 
+func (d *Decoder) fastpathDecSliceUint8R(f *codecFnInfo, rv reflect.Value) {
+	var v []uint8
+	switch rv.Kind() {
+	case reflect.Ptr:
+		vp := rv2i(rv).(*[]uint8)
+		var changed bool
+		if v, changed = fastpathTV.DecSliceUint8Y(*vp, d); changed {
+			*vp = v
+		}
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceUint8N(v, d)
+	default:
+		fastpathTV.DecSliceUint8N(rv2i(rv).([]uint8), d)
+	}
+}
+func (f fastpathT) DecSliceUint8X(vp *[]uint8, d *Decoder) {
+	if v, changed := f.DecSliceUint8Y(*vp, d); changed {
+		*vp = v
+	}
+}
+func (fastpathT) DecSliceUint8Y(v []uint8, d *Decoder) (v2 []uint8, changed bool) {
+	switch d.d.ContainerType() {
+	case valueTypeNil, valueTypeMap:
+		break
+	default:
+		v2 = d.decodeBytesInto(v[:len(v):len(v)])
+		changed = !(len(v2) > 0 && len(v2) == len(v) && &v2[0] == &v[0]) // not same slice
+		return
+	}
+	slh, containerLenS := d.decSliceHelperStart()
+	if slh.IsNil {
+		if v == nil {
+			return
+		}
+		return nil, true
+	}
+	if containerLenS == 0 {
+		if v == nil {
+			v = []uint8{}
+		} else if len(v) != 0 {
+			v = v[:0]
+		}
+		slh.End()
+		return v, true
+	}
+	hasLen := containerLenS > 0
+	var xlen int
+	if hasLen {
+		if containerLenS > cap(v) {
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 1)
+			if xlen <= cap(v) {
+				v = v[:uint(xlen)]
+			} else {
+				v = make([]uint8, uint(xlen))
+			}
+			changed = true
+		} else if containerLenS != len(v) {
+			v = v[:containerLenS]
+			changed = true
+		}
+	}
+	var j int
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j == 0 && len(v) == 0 { // means hasLen == false
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 1)
+			v = make([]uint8, uint(xlen))
+			changed = true
+		}
+		if j >= len(v) {
+			v = append(v, 0)
+			changed = true
+		}
+		slh.ElemContainerState(j)
+		v[uint(j)] = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
+	}
+	if j < len(v) {
+		v = v[:uint(j)]
+		changed = true
+	} else if j == 0 && v == nil {
+		v = []uint8{}
+		changed = true
+	}
+	slh.End()
+	return v, changed
+}
+func (fastpathT) DecSliceUint8N(v []uint8, d *Decoder) {
+	switch d.d.ContainerType() {
+	case valueTypeNil, valueTypeMap:
+		break
+	default:
+		v2 := d.decodeBytesInto(v[:len(v):len(v)])
+		if !(len(v2) > 0 && len(v2) == len(v) && &v2[0] == &v[0]) { // not same slice
+			copy(v, v2)
+		}
+		return
+	}
+	slh, containerLenS := d.decSliceHelperStart()
+	if slh.IsNil {
+		return
+	}
+	if containerLenS == 0 {
+		slh.End()
+		return
+	}
+	hasLen := containerLenS > 0
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
+		if j >= len(v) {
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
+			return
+		}
+		slh.ElemContainerState(j)
+		v[uint(j)] = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
+	}
+	slh.End()
+}
+
 func (d *Decoder) fastpathDecSliceUint64R(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []uint64
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]uint64)
-		if v, changed := fastpathTV.DecSliceUint64Y(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceUint64Y(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceUint64N(v, d)
+	default:
 		fastpathTV.DecSliceUint64N(rv2i(rv).([]uint64), d)
 	}
 }
@@ -3197,7 +3597,7 @@ func (f fastpathT) DecSliceUint64X(vp *[]uint64, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceUint64Y(v []uint64, d *Decoder) (_ []uint64, changed bool) {
+func (fastpathT) DecSliceUint64Y(v []uint64, d *Decoder) (v2 []uint64, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3231,9 +3631,9 @@ func (fastpathT) DecSliceUint64Y(v []uint64, d *Decoder) (_ []uint64, changed bo
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 8)
 			v = make([]uint64, uint(xlen))
 			changed = true
 		}
@@ -3264,9 +3664,9 @@ func (fastpathT) DecSliceUint64N(v []uint64, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3276,12 +3676,18 @@ func (fastpathT) DecSliceUint64N(v []uint64, d *Decoder) {
 }
 
 func (d *Decoder) fastpathDecSliceIntR(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []int
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]int)
-		if v, changed := fastpathTV.DecSliceIntY(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceIntY(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceIntN(v, d)
+	default:
 		fastpathTV.DecSliceIntN(rv2i(rv).([]int), d)
 	}
 }
@@ -3290,7 +3696,7 @@ func (f fastpathT) DecSliceIntX(vp *[]int, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceIntY(v []int, d *Decoder) (_ []int, changed bool) {
+func (fastpathT) DecSliceIntY(v []int, d *Decoder) (v2 []int, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3324,9 +3730,9 @@ func (fastpathT) DecSliceIntY(v []int, d *Decoder) (_ []int, changed bool) {
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 8)
 			v = make([]int, uint(xlen))
 			changed = true
 		}
@@ -3357,9 +3763,9 @@ func (fastpathT) DecSliceIntN(v []int, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3369,12 +3775,18 @@ func (fastpathT) DecSliceIntN(v []int, d *Decoder) {
 }
 
 func (d *Decoder) fastpathDecSliceInt32R(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []int32
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]int32)
-		if v, changed := fastpathTV.DecSliceInt32Y(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceInt32Y(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceInt32N(v, d)
+	default:
 		fastpathTV.DecSliceInt32N(rv2i(rv).([]int32), d)
 	}
 }
@@ -3383,7 +3795,7 @@ func (f fastpathT) DecSliceInt32X(vp *[]int32, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceInt32Y(v []int32, d *Decoder) (_ []int32, changed bool) {
+func (fastpathT) DecSliceInt32Y(v []int32, d *Decoder) (v2 []int32, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3417,9 +3829,9 @@ func (fastpathT) DecSliceInt32Y(v []int32, d *Decoder) (_ []int32, changed bool)
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 4)
 			v = make([]int32, uint(xlen))
 			changed = true
 		}
@@ -3450,9 +3862,9 @@ func (fastpathT) DecSliceInt32N(v []int32, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3462,12 +3874,18 @@ func (fastpathT) DecSliceInt32N(v []int32, d *Decoder) {
 }
 
 func (d *Decoder) fastpathDecSliceInt64R(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []int64
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]int64)
-		if v, changed := fastpathTV.DecSliceInt64Y(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceInt64Y(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceInt64N(v, d)
+	default:
 		fastpathTV.DecSliceInt64N(rv2i(rv).([]int64), d)
 	}
 }
@@ -3476,7 +3894,7 @@ func (f fastpathT) DecSliceInt64X(vp *[]int64, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceInt64Y(v []int64, d *Decoder) (_ []int64, changed bool) {
+func (fastpathT) DecSliceInt64Y(v []int64, d *Decoder) (v2 []int64, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3510,9 +3928,9 @@ func (fastpathT) DecSliceInt64Y(v []int64, d *Decoder) (_ []int64, changed bool)
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 8)
 			v = make([]int64, uint(xlen))
 			changed = true
 		}
@@ -3543,9 +3961,9 @@ func (fastpathT) DecSliceInt64N(v []int64, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3555,12 +3973,18 @@ func (fastpathT) DecSliceInt64N(v []int64, d *Decoder) {
 }
 
 func (d *Decoder) fastpathDecSliceBoolR(f *codecFnInfo, rv reflect.Value) {
-	if f.seq != seqTypeArray && rv.Kind() == reflect.Ptr {
+	var v []bool
+	switch rv.Kind() {
+	case reflect.Ptr:
 		vp := rv2i(rv).(*[]bool)
-		if v, changed := fastpathTV.DecSliceBoolY(*vp, d); changed {
+		var changed bool
+		if v, changed = fastpathTV.DecSliceBoolY(*vp, d); changed {
 			*vp = v
 		}
-	} else {
+	case reflect.Array:
+		rvGetSlice4Array(rv, &v)
+		fastpathTV.DecSliceBoolN(v, d)
+	default:
 		fastpathTV.DecSliceBoolN(rv2i(rv).([]bool), d)
 	}
 }
@@ -3569,7 +3993,7 @@ func (f fastpathT) DecSliceBoolX(vp *[]bool, d *Decoder) {
 		*vp = v
 	}
 }
-func (fastpathT) DecSliceBoolY(v []bool, d *Decoder) (_ []bool, changed bool) {
+func (fastpathT) DecSliceBoolY(v []bool, d *Decoder) (v2 []bool, changed bool) {
 	slh, containerLenS := d.decSliceHelperStart()
 	if slh.IsNil {
 		if v == nil {
@@ -3603,9 +4027,9 @@ func (fastpathT) DecSliceBoolY(v []bool, d *Decoder) (_ []bool, changed bool) {
 		}
 	}
 	var j int
-	for j = 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j = 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j == 0 && len(v) == 0 { // means hasLen == false
-			xlen = decDefSliceCap
+			xlen = decInferLen(containerLenS, d.h.MaxInitLen, 1)
 			v = make([]bool, uint(xlen))
 			changed = true
 		}
@@ -3636,9 +4060,9 @@ func (fastpathT) DecSliceBoolN(v []bool, d *Decoder) {
 		return
 	}
 	hasLen := containerLenS > 0
-	for j := 0; (hasLen && j < containerLenS) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLenS, hasLen); j++ {
 		if j >= len(v) {
-			decArrayCannotExpand(slh, hasLen, len(v), j, containerLenS)
+			slh.arrayCannotExpand(hasLen, len(v), j, containerLenS)
 			return
 		}
 		slh.ElemContainerState(j)
@@ -3647,7 +4071,7 @@ func (fastpathT) DecSliceBoolN(v []bool, d *Decoder) {
 	slh.End()
 }
 func (d *Decoder) fastpathDecMapStringIntfR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]interface{})
 		if *vp == nil {
@@ -3662,7 +4086,7 @@ func (d *Decoder) fastpathDecMapStringIntfR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringIntfX(vp *map[string]interface{}, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3676,13 +4100,17 @@ func (f fastpathT) DecMapStringIntfX(vp *map[string]interface{}, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringIntfL(v map[string]interface{}, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]interface{} given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset && !d.h.InterfaceReset
 	var mk string
 	var mv interface{}
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		if mapGet {
 			mv = v[mk]
@@ -3690,13 +4118,11 @@ func (fastpathT) DecMapStringIntfL(v map[string]interface{}, containerLen int, d
 			mv = nil
 		}
 		d.decode(&mv)
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringStringR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]string)
 		if *vp == nil {
@@ -3711,7 +4137,7 @@ func (d *Decoder) fastpathDecMapStringStringR(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringStringX(vp *map[string]string, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3725,21 +4151,23 @@ func (f fastpathT) DecMapStringStringX(vp *map[string]string, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringStringL(v map[string]string, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]string given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv string
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
-		mv = string(d.d.DecodeStringAsBytes())
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.stringZC(d.d.DecodeStringAsBytes())
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringBytesR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string][]byte)
 		if *vp == nil {
@@ -3754,7 +4182,7 @@ func (d *Decoder) fastpathDecMapStringBytesR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringBytesX(vp *map[string][]byte, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3768,27 +4196,29 @@ func (f fastpathT) DecMapStringBytesX(vp *map[string][]byte, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringBytesL(v map[string][]byte, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string][]byte given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset
 	var mk string
 	var mv []byte
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		if mapGet {
 			mv = v[mk]
 		} else {
 			mv = nil
 		}
-		mv = d.d.DecodeBytes(mv, false)
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.decodeBytesInto(mv)
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringUint8R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]uint8)
 		if *vp == nil {
@@ -3803,7 +4233,7 @@ func (d *Decoder) fastpathDecMapStringUint8R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringUint8X(vp *map[string]uint8, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3817,21 +4247,23 @@ func (f fastpathT) DecMapStringUint8X(vp *map[string]uint8, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringUint8L(v map[string]uint8, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]uint8 given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv uint8
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		mv = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringUint64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]uint64)
 		if *vp == nil {
@@ -3846,7 +4278,7 @@ func (d *Decoder) fastpathDecMapStringUint64R(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringUint64X(vp *map[string]uint64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3860,21 +4292,23 @@ func (f fastpathT) DecMapStringUint64X(vp *map[string]uint64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringUint64L(v map[string]uint64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]uint64 given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv uint64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		mv = d.d.DecodeUint64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringIntR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]int)
 		if *vp == nil {
@@ -3889,7 +4323,7 @@ func (d *Decoder) fastpathDecMapStringIntR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringIntX(vp *map[string]int, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3903,64 +4337,68 @@ func (f fastpathT) DecMapStringIntX(vp *map[string]int, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringIntL(v map[string]int, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]int given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv int
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		mv = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapStringInt64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapStringInt32R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[string]int64)
+		vp, _ := rv2i(rv).(*map[string]int32)
 		if *vp == nil {
-			*vp = make(map[string]int64, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[string]int32, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapStringInt64L(*vp, containerLen, d)
+			fastpathTV.DecMapStringInt32L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapStringInt64L(rv2i(rv).(map[string]int64), containerLen, d)
+		fastpathTV.DecMapStringInt32L(rv2i(rv).(map[string]int32), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapStringInt64X(vp *map[string]int64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapStringInt32X(vp *map[string]int32, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[string]int64, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[string]int32, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			f.DecMapStringInt64L(*vp, containerLen, d)
+			f.DecMapStringInt32L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapStringInt64L(v map[string]int64, containerLen int, d *Decoder) {
+func (fastpathT) DecMapStringInt32L(v map[string]int32, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]int32 given stream length: %v", containerLen)
+		return
+	}
 	var mk string
-	var mv int64
+	var mv int32
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
-		mv = d.d.DecodeInt64()
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringFloat64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]float64)
 		if *vp == nil {
@@ -3975,7 +4413,7 @@ func (d *Decoder) fastpathDecMapStringFloat64R(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringFloat64X(vp *map[string]float64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -3989,21 +4427,23 @@ func (f fastpathT) DecMapStringFloat64X(vp *map[string]float64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringFloat64L(v map[string]float64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]float64 given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv float64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		mv = d.d.DecodeFloat64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapStringBoolR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[string]bool)
 		if *vp == nil {
@@ -4018,7 +4458,7 @@ func (d *Decoder) fastpathDecMapStringBoolR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapStringBoolX(vp *map[string]bool, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4032,21 +4472,23 @@ func (f fastpathT) DecMapStringBoolX(vp *map[string]bool, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapStringBoolL(v map[string]bool, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[string]bool given stream length: %v", containerLen)
+		return
+	}
 	var mk string
 	var mv bool
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = string(d.d.DecodeStringAsBytes())
+		mk = d.stringZC(d.d.DecodeStringAsBytes())
 		d.mapElemValue()
 		mv = d.d.DecodeBool()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8IntfR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]interface{})
 		if *vp == nil {
@@ -4061,7 +4503,7 @@ func (d *Decoder) fastpathDecMapUint8IntfR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8IntfX(vp *map[uint8]interface{}, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4075,11 +4517,15 @@ func (f fastpathT) DecMapUint8IntfX(vp *map[uint8]interface{}, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8IntfL(v map[uint8]interface{}, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]interface{} given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset && !d.h.InterfaceReset
 	var mk uint8
 	var mv interface{}
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
@@ -4089,13 +4535,11 @@ func (fastpathT) DecMapUint8IntfL(v map[uint8]interface{}, containerLen int, d *
 			mv = nil
 		}
 		d.decode(&mv)
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8StringR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]string)
 		if *vp == nil {
@@ -4110,7 +4554,7 @@ func (d *Decoder) fastpathDecMapUint8StringR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8StringX(vp *map[uint8]string, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4124,21 +4568,23 @@ func (f fastpathT) DecMapUint8StringX(vp *map[uint8]string, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8StringL(v map[uint8]string, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]string given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv string
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
-		mv = string(d.d.DecodeStringAsBytes())
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.stringZC(d.d.DecodeStringAsBytes())
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8BytesR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8][]byte)
 		if *vp == nil {
@@ -4153,7 +4599,7 @@ func (d *Decoder) fastpathDecMapUint8BytesR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8BytesX(vp *map[uint8][]byte, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4167,11 +4613,15 @@ func (f fastpathT) DecMapUint8BytesX(vp *map[uint8][]byte, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8BytesL(v map[uint8][]byte, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8][]byte given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset
 	var mk uint8
 	var mv []byte
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
@@ -4180,14 +4630,12 @@ func (fastpathT) DecMapUint8BytesL(v map[uint8][]byte, containerLen int, d *Deco
 		} else {
 			mv = nil
 		}
-		mv = d.d.DecodeBytes(mv, false)
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.decodeBytesInto(mv)
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8Uint8R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]uint8)
 		if *vp == nil {
@@ -4202,7 +4650,7 @@ func (d *Decoder) fastpathDecMapUint8Uint8R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8Uint8X(vp *map[uint8]uint8, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4216,21 +4664,23 @@ func (f fastpathT) DecMapUint8Uint8X(vp *map[uint8]uint8, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8Uint8L(v map[uint8]uint8, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]uint8 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv uint8
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
 		mv = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8Uint64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]uint64)
 		if *vp == nil {
@@ -4245,7 +4695,7 @@ func (d *Decoder) fastpathDecMapUint8Uint64R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8Uint64X(vp *map[uint8]uint64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4259,21 +4709,23 @@ func (f fastpathT) DecMapUint8Uint64X(vp *map[uint8]uint64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8Uint64L(v map[uint8]uint64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]uint64 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv uint64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
 		mv = d.d.DecodeUint64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8IntR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]int)
 		if *vp == nil {
@@ -4288,7 +4740,7 @@ func (d *Decoder) fastpathDecMapUint8IntR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8IntX(vp *map[uint8]int, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4302,64 +4754,68 @@ func (f fastpathT) DecMapUint8IntX(vp *map[uint8]int, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8IntL(v map[uint8]int, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]int given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv int
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
 		mv = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapUint8Int64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapUint8Int32R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[uint8]int64)
+		vp, _ := rv2i(rv).(*map[uint8]int32)
 		if *vp == nil {
-			*vp = make(map[uint8]int64, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[uint8]int32, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapUint8Int64L(*vp, containerLen, d)
+			fastpathTV.DecMapUint8Int32L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapUint8Int64L(rv2i(rv).(map[uint8]int64), containerLen, d)
+		fastpathTV.DecMapUint8Int32L(rv2i(rv).(map[uint8]int32), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapUint8Int64X(vp *map[uint8]int64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapUint8Int32X(vp *map[uint8]int32, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[uint8]int64, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[uint8]int32, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			f.DecMapUint8Int64L(*vp, containerLen, d)
+			f.DecMapUint8Int32L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapUint8Int64L(v map[uint8]int64, containerLen int, d *Decoder) {
+func (fastpathT) DecMapUint8Int32L(v map[uint8]int32, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]int32 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
-	var mv int64
+	var mv int32
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
-		mv = d.d.DecodeInt64()
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8Float64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]float64)
 		if *vp == nil {
@@ -4374,7 +4830,7 @@ func (d *Decoder) fastpathDecMapUint8Float64R(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8Float64X(vp *map[uint8]float64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4388,21 +4844,23 @@ func (f fastpathT) DecMapUint8Float64X(vp *map[uint8]float64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8Float64L(v map[uint8]float64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]float64 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv float64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
 		mv = d.d.DecodeFloat64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint8BoolR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint8]bool)
 		if *vp == nil {
@@ -4417,7 +4875,7 @@ func (d *Decoder) fastpathDecMapUint8BoolR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint8BoolX(vp *map[uint8]bool, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4431,21 +4889,23 @@ func (f fastpathT) DecMapUint8BoolX(vp *map[uint8]bool, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint8BoolL(v map[uint8]bool, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint8]bool given stream length: %v", containerLen)
+		return
+	}
 	var mk uint8
 	var mv bool
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
 		d.mapElemValue()
 		mv = d.d.DecodeBool()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64IntfR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]interface{})
 		if *vp == nil {
@@ -4460,7 +4920,7 @@ func (d *Decoder) fastpathDecMapUint64IntfR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64IntfX(vp *map[uint64]interface{}, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4474,11 +4934,15 @@ func (f fastpathT) DecMapUint64IntfX(vp *map[uint64]interface{}, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64IntfL(v map[uint64]interface{}, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]interface{} given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset && !d.h.InterfaceReset
 	var mk uint64
 	var mv interface{}
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
@@ -4488,13 +4952,11 @@ func (fastpathT) DecMapUint64IntfL(v map[uint64]interface{}, containerLen int, d
 			mv = nil
 		}
 		d.decode(&mv)
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64StringR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]string)
 		if *vp == nil {
@@ -4509,7 +4971,7 @@ func (d *Decoder) fastpathDecMapUint64StringR(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64StringX(vp *map[uint64]string, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4523,21 +4985,23 @@ func (f fastpathT) DecMapUint64StringX(vp *map[uint64]string, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64StringL(v map[uint64]string, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]string given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv string
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
-		mv = string(d.d.DecodeStringAsBytes())
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.stringZC(d.d.DecodeStringAsBytes())
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64BytesR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64][]byte)
 		if *vp == nil {
@@ -4552,7 +5016,7 @@ func (d *Decoder) fastpathDecMapUint64BytesR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64BytesX(vp *map[uint64][]byte, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4566,11 +5030,15 @@ func (f fastpathT) DecMapUint64BytesX(vp *map[uint64][]byte, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64BytesL(v map[uint64][]byte, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64][]byte given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset
 	var mk uint64
 	var mv []byte
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
@@ -4579,14 +5047,12 @@ func (fastpathT) DecMapUint64BytesL(v map[uint64][]byte, containerLen int, d *De
 		} else {
 			mv = nil
 		}
-		mv = d.d.DecodeBytes(mv, false)
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.decodeBytesInto(mv)
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64Uint8R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]uint8)
 		if *vp == nil {
@@ -4601,7 +5067,7 @@ func (d *Decoder) fastpathDecMapUint64Uint8R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64Uint8X(vp *map[uint64]uint8, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4615,21 +5081,23 @@ func (f fastpathT) DecMapUint64Uint8X(vp *map[uint64]uint8, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64Uint8L(v map[uint64]uint8, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]uint8 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv uint8
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
 		mv = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64Uint64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]uint64)
 		if *vp == nil {
@@ -4644,7 +5112,7 @@ func (d *Decoder) fastpathDecMapUint64Uint64R(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64Uint64X(vp *map[uint64]uint64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4658,21 +5126,23 @@ func (f fastpathT) DecMapUint64Uint64X(vp *map[uint64]uint64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64Uint64L(v map[uint64]uint64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]uint64 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv uint64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
 		mv = d.d.DecodeUint64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64IntR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]int)
 		if *vp == nil {
@@ -4687,7 +5157,7 @@ func (d *Decoder) fastpathDecMapUint64IntR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64IntX(vp *map[uint64]int, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4701,64 +5171,68 @@ func (f fastpathT) DecMapUint64IntX(vp *map[uint64]int, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64IntL(v map[uint64]int, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]int given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv int
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
 		mv = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapUint64Int64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapUint64Int32R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[uint64]int64)
+		vp, _ := rv2i(rv).(*map[uint64]int32)
 		if *vp == nil {
-			*vp = make(map[uint64]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[uint64]int32, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapUint64Int64L(*vp, containerLen, d)
+			fastpathTV.DecMapUint64Int32L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapUint64Int64L(rv2i(rv).(map[uint64]int64), containerLen, d)
+		fastpathTV.DecMapUint64Int32L(rv2i(rv).(map[uint64]int32), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapUint64Int64X(vp *map[uint64]int64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapUint64Int32X(vp *map[uint64]int32, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[uint64]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[uint64]int32, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			f.DecMapUint64Int64L(*vp, containerLen, d)
+			f.DecMapUint64Int32L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapUint64Int64L(v map[uint64]int64, containerLen int, d *Decoder) {
+func (fastpathT) DecMapUint64Int32L(v map[uint64]int32, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]int32 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
-	var mv int64
+	var mv int32
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
-		mv = d.d.DecodeInt64()
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64Float64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]float64)
 		if *vp == nil {
@@ -4773,7 +5247,7 @@ func (d *Decoder) fastpathDecMapUint64Float64R(f *codecFnInfo, rv reflect.Value)
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64Float64X(vp *map[uint64]float64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4787,21 +5261,23 @@ func (f fastpathT) DecMapUint64Float64X(vp *map[uint64]float64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64Float64L(v map[uint64]float64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]float64 given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv float64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
 		mv = d.d.DecodeFloat64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapUint64BoolR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[uint64]bool)
 		if *vp == nil {
@@ -4816,7 +5292,7 @@ func (d *Decoder) fastpathDecMapUint64BoolR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapUint64BoolX(vp *map[uint64]bool, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4830,21 +5306,23 @@ func (f fastpathT) DecMapUint64BoolX(vp *map[uint64]bool, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapUint64BoolL(v map[uint64]bool, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[uint64]bool given stream length: %v", containerLen)
+		return
+	}
 	var mk uint64
 	var mv bool
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = d.d.DecodeUint64()
 		d.mapElemValue()
 		mv = d.d.DecodeBool()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntIntfR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]interface{})
 		if *vp == nil {
@@ -4859,7 +5337,7 @@ func (d *Decoder) fastpathDecMapIntIntfR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntIntfX(vp *map[int]interface{}, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4873,11 +5351,15 @@ func (f fastpathT) DecMapIntIntfX(vp *map[int]interface{}, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntIntfL(v map[int]interface{}, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]interface{} given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset && !d.h.InterfaceReset
 	var mk int
 	var mv interface{}
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
@@ -4887,13 +5369,11 @@ func (fastpathT) DecMapIntIntfL(v map[int]interface{}, containerLen int, d *Deco
 			mv = nil
 		}
 		d.decode(&mv)
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntStringR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]string)
 		if *vp == nil {
@@ -4908,7 +5388,7 @@ func (d *Decoder) fastpathDecMapIntStringR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntStringX(vp *map[int]string, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4922,21 +5402,23 @@ func (f fastpathT) DecMapIntStringX(vp *map[int]string, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntStringL(v map[int]string, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]string given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv string
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
-		mv = string(d.d.DecodeStringAsBytes())
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.stringZC(d.d.DecodeStringAsBytes())
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntBytesR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int][]byte)
 		if *vp == nil {
@@ -4951,7 +5433,7 @@ func (d *Decoder) fastpathDecMapIntBytesR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntBytesX(vp *map[int][]byte, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -4965,11 +5447,15 @@ func (f fastpathT) DecMapIntBytesX(vp *map[int][]byte, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntBytesL(v map[int][]byte, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int][]byte given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset
 	var mk int
 	var mv []byte
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
@@ -4978,14 +5464,12 @@ func (fastpathT) DecMapIntBytesL(v map[int][]byte, containerLen int, d *Decoder)
 		} else {
 			mv = nil
 		}
-		mv = d.d.DecodeBytes(mv, false)
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.decodeBytesInto(mv)
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntUint8R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]uint8)
 		if *vp == nil {
@@ -5000,7 +5484,7 @@ func (d *Decoder) fastpathDecMapIntUint8R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntUint8X(vp *map[int]uint8, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -5014,21 +5498,23 @@ func (f fastpathT) DecMapIntUint8X(vp *map[int]uint8, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntUint8L(v map[int]uint8, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]uint8 given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv uint8
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
 		mv = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntUint64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]uint64)
 		if *vp == nil {
@@ -5043,7 +5529,7 @@ func (d *Decoder) fastpathDecMapIntUint64R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntUint64X(vp *map[int]uint64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -5057,21 +5543,23 @@ func (f fastpathT) DecMapIntUint64X(vp *map[int]uint64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntUint64L(v map[int]uint64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]uint64 given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv uint64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
 		mv = d.d.DecodeUint64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntIntR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]int)
 		if *vp == nil {
@@ -5086,7 +5574,7 @@ func (d *Decoder) fastpathDecMapIntIntR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntIntX(vp *map[int]int, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -5100,64 +5588,68 @@ func (f fastpathT) DecMapIntIntX(vp *map[int]int, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntIntL(v map[int]int, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]int given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv int
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
 		mv = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapIntInt64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapIntInt32R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int]int64)
+		vp, _ := rv2i(rv).(*map[int]int32)
 		if *vp == nil {
-			*vp = make(map[int]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int]int32, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapIntInt64L(*vp, containerLen, d)
+			fastpathTV.DecMapIntInt32L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapIntInt64L(rv2i(rv).(map[int]int64), containerLen, d)
+		fastpathTV.DecMapIntInt32L(rv2i(rv).(map[int]int32), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapIntInt64X(vp *map[int]int64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapIntInt32X(vp *map[int]int32, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int]int32, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			f.DecMapIntInt64L(*vp, containerLen, d)
+			f.DecMapIntInt32L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapIntInt64L(v map[int]int64, containerLen int, d *Decoder) {
+func (fastpathT) DecMapIntInt32L(v map[int]int32, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]int32 given stream length: %v", containerLen)
+		return
+	}
 	var mk int
-	var mv int64
+	var mv int32
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
-		mv = d.d.DecodeInt64()
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntFloat64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]float64)
 		if *vp == nil {
@@ -5172,7 +5664,7 @@ func (d *Decoder) fastpathDecMapIntFloat64R(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntFloat64X(vp *map[int]float64, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -5186,21 +5678,23 @@ func (f fastpathT) DecMapIntFloat64X(vp *map[int]float64, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntFloat64L(v map[int]float64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]float64 given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv float64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
 		mv = d.d.DecodeFloat64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
 func (d *Decoder) fastpathDecMapIntBoolR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
 		vp, _ := rv2i(rv).(*map[int]bool)
 		if *vp == nil {
@@ -5215,7 +5709,7 @@ func (d *Decoder) fastpathDecMapIntBoolR(f *codecFnInfo, rv reflect.Value) {
 	d.mapEnd()
 }
 func (f fastpathT) DecMapIntBoolX(vp *map[int]bool, d *Decoder) {
-	containerLen := d.mapStart()
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
@@ -5229,56 +5723,62 @@ func (f fastpathT) DecMapIntBoolX(vp *map[int]bool, d *Decoder) {
 	}
 }
 func (fastpathT) DecMapIntBoolL(v map[int]bool, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int]bool given stream length: %v", containerLen)
+		return
+	}
 	var mk int
 	var mv bool
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
 		mk = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
 		d.mapElemValue()
 		mv = d.d.DecodeBool()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64IntfR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32IntfR(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]interface{})
+		vp, _ := rv2i(rv).(*map[int32]interface{})
 		if *vp == nil {
-			*vp = make(map[int64]interface{}, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[int32]interface{}, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64IntfL(*vp, containerLen, d)
+			fastpathTV.DecMapInt32IntfL(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64IntfL(rv2i(rv).(map[int64]interface{}), containerLen, d)
+		fastpathTV.DecMapInt32IntfL(rv2i(rv).(map[int32]interface{}), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64IntfX(vp *map[int64]interface{}, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32IntfX(vp *map[int32]interface{}, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]interface{}, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[int32]interface{}, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64IntfL(*vp, containerLen, d)
+			f.DecMapInt32IntfL(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64IntfL(v map[int64]interface{}, containerLen int, d *Decoder) {
+func (fastpathT) DecMapInt32IntfL(v map[int32]interface{}, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]interface{} given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset && !d.h.InterfaceReset
-	var mk int64
+	var mk int32
 	var mv interface{}
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		if mapGet {
 			mv = v[mk]
@@ -5286,358 +5786,372 @@ func (fastpathT) DecMapInt64IntfL(v map[int64]interface{}, containerLen int, d *
 			mv = nil
 		}
 		d.decode(&mv)
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64StringR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32StringR(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]string)
+		vp, _ := rv2i(rv).(*map[int32]string)
 		if *vp == nil {
-			*vp = make(map[int64]string, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[int32]string, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64StringL(*vp, containerLen, d)
+			fastpathTV.DecMapInt32StringL(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64StringL(rv2i(rv).(map[int64]string), containerLen, d)
+		fastpathTV.DecMapInt32StringL(rv2i(rv).(map[int32]string), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64StringX(vp *map[int64]string, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32StringX(vp *map[int32]string, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]string, decInferLen(containerLen, d.h.MaxInitLen, 24))
+			*vp = make(map[int32]string, decInferLen(containerLen, d.h.MaxInitLen, 20))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64StringL(*vp, containerLen, d)
+			f.DecMapInt32StringL(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64StringL(v map[int64]string, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32StringL(v map[int32]string, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]string given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv string
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
-		mv = string(d.d.DecodeStringAsBytes())
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.stringZC(d.d.DecodeStringAsBytes())
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64BytesR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32BytesR(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64][]byte)
+		vp, _ := rv2i(rv).(*map[int32][]byte)
 		if *vp == nil {
-			*vp = make(map[int64][]byte, decInferLen(containerLen, d.h.MaxInitLen, 32))
+			*vp = make(map[int32][]byte, decInferLen(containerLen, d.h.MaxInitLen, 28))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64BytesL(*vp, containerLen, d)
+			fastpathTV.DecMapInt32BytesL(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64BytesL(rv2i(rv).(map[int64][]byte), containerLen, d)
+		fastpathTV.DecMapInt32BytesL(rv2i(rv).(map[int32][]byte), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64BytesX(vp *map[int64][]byte, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32BytesX(vp *map[int32][]byte, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64][]byte, decInferLen(containerLen, d.h.MaxInitLen, 32))
+			*vp = make(map[int32][]byte, decInferLen(containerLen, d.h.MaxInitLen, 28))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64BytesL(*vp, containerLen, d)
+			f.DecMapInt32BytesL(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64BytesL(v map[int64][]byte, containerLen int, d *Decoder) {
+func (fastpathT) DecMapInt32BytesL(v map[int32][]byte, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32][]byte given stream length: %v", containerLen)
+		return
+	}
 	mapGet := v != nil && !d.h.MapValueReset
-	var mk int64
+	var mk int32
 	var mv []byte
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		if mapGet {
 			mv = v[mk]
 		} else {
 			mv = nil
 		}
-		mv = d.d.DecodeBytes(mv, false)
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = d.decodeBytesInto(mv)
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64Uint8R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32Uint8R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]uint8)
+		vp, _ := rv2i(rv).(*map[int32]uint8)
 		if *vp == nil {
-			*vp = make(map[int64]uint8, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[int32]uint8, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64Uint8L(*vp, containerLen, d)
+			fastpathTV.DecMapInt32Uint8L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64Uint8L(rv2i(rv).(map[int64]uint8), containerLen, d)
+		fastpathTV.DecMapInt32Uint8L(rv2i(rv).(map[int32]uint8), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64Uint8X(vp *map[int64]uint8, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32Uint8X(vp *map[int32]uint8, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]uint8, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[int32]uint8, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64Uint8L(*vp, containerLen, d)
+			f.DecMapInt32Uint8L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64Uint8L(v map[int64]uint8, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32Uint8L(v map[int32]uint8, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]uint8 given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv uint8
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		mv = uint8(chkOvf.UintV(d.d.DecodeUint64(), 8))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64Uint64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32Uint64R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]uint64)
+		vp, _ := rv2i(rv).(*map[int32]uint64)
 		if *vp == nil {
-			*vp = make(map[int64]uint64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]uint64, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64Uint64L(*vp, containerLen, d)
+			fastpathTV.DecMapInt32Uint64L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64Uint64L(rv2i(rv).(map[int64]uint64), containerLen, d)
+		fastpathTV.DecMapInt32Uint64L(rv2i(rv).(map[int32]uint64), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64Uint64X(vp *map[int64]uint64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32Uint64X(vp *map[int32]uint64, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]uint64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]uint64, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64Uint64L(*vp, containerLen, d)
+			f.DecMapInt32Uint64L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64Uint64L(v map[int64]uint64, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32Uint64L(v map[int32]uint64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]uint64 given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv uint64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		mv = d.d.DecodeUint64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64IntR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32IntR(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]int)
+		vp, _ := rv2i(rv).(*map[int32]int)
 		if *vp == nil {
-			*vp = make(map[int64]int, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]int, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64IntL(*vp, containerLen, d)
+			fastpathTV.DecMapInt32IntL(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64IntL(rv2i(rv).(map[int64]int), containerLen, d)
+		fastpathTV.DecMapInt32IntL(rv2i(rv).(map[int32]int), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64IntX(vp *map[int64]int, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32IntX(vp *map[int32]int, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]int, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]int, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64IntL(*vp, containerLen, d)
+			f.DecMapInt32IntL(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64IntL(v map[int64]int, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32IntL(v map[int32]int, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]int given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv int
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		mv = int(chkOvf.IntV(d.d.DecodeInt64(), intBitsize))
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64Int64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32Int32R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]int64)
+		vp, _ := rv2i(rv).(*map[int32]int32)
 		if *vp == nil {
-			*vp = make(map[int64]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]int32, decInferLen(containerLen, d.h.MaxInitLen, 8))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64Int64L(*vp, containerLen, d)
+			fastpathTV.DecMapInt32Int32L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64Int64L(rv2i(rv).(map[int64]int64), containerLen, d)
+		fastpathTV.DecMapInt32Int32L(rv2i(rv).(map[int32]int32), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64Int64X(vp *map[int64]int64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32Int32X(vp *map[int32]int32, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]int64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]int32, decInferLen(containerLen, d.h.MaxInitLen, 8))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64Int64L(*vp, containerLen, d)
+			f.DecMapInt32Int32L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64Int64L(v map[int64]int64, containerLen int, d *Decoder) {
-	var mk int64
-	var mv int64
+func (fastpathT) DecMapInt32Int32L(v map[int32]int32, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]int32 given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
+	var mv int32
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
-		mv = d.d.DecodeInt64()
-		if v != nil {
-			v[mk] = mv
-		}
+		mv = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64Float64R(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32Float64R(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]float64)
+		vp, _ := rv2i(rv).(*map[int32]float64)
 		if *vp == nil {
-			*vp = make(map[int64]float64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]float64, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64Float64L(*vp, containerLen, d)
+			fastpathTV.DecMapInt32Float64L(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64Float64L(rv2i(rv).(map[int64]float64), containerLen, d)
+		fastpathTV.DecMapInt32Float64L(rv2i(rv).(map[int32]float64), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64Float64X(vp *map[int64]float64, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32Float64X(vp *map[int32]float64, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]float64, decInferLen(containerLen, d.h.MaxInitLen, 16))
+			*vp = make(map[int32]float64, decInferLen(containerLen, d.h.MaxInitLen, 12))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64Float64L(*vp, containerLen, d)
+			f.DecMapInt32Float64L(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64Float64L(v map[int64]float64, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32Float64L(v map[int32]float64, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]float64 given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv float64
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		mv = d.d.DecodeFloat64()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
-func (d *Decoder) fastpathDecMapInt64BoolR(f *codecFnInfo, rv reflect.Value) {
-	containerLen := d.mapStart()
+func (d *Decoder) fastpathDecMapInt32BoolR(f *codecFnInfo, rv reflect.Value) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if rv.Kind() == reflect.Ptr {
-		vp, _ := rv2i(rv).(*map[int64]bool)
+		vp, _ := rv2i(rv).(*map[int32]bool)
 		if *vp == nil {
-			*vp = make(map[int64]bool, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[int32]bool, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			fastpathTV.DecMapInt64BoolL(*vp, containerLen, d)
+			fastpathTV.DecMapInt32BoolL(*vp, containerLen, d)
 		}
 	} else if containerLen != 0 {
-		fastpathTV.DecMapInt64BoolL(rv2i(rv).(map[int64]bool), containerLen, d)
+		fastpathTV.DecMapInt32BoolL(rv2i(rv).(map[int32]bool), containerLen, d)
 	}
 	d.mapEnd()
 }
-func (f fastpathT) DecMapInt64BoolX(vp *map[int64]bool, d *Decoder) {
-	containerLen := d.mapStart()
+func (f fastpathT) DecMapInt32BoolX(vp *map[int32]bool, d *Decoder) {
+	containerLen := d.mapStart(d.d.ReadMapStart())
 	if containerLen == containerLenNil {
 		*vp = nil
 	} else {
 		if *vp == nil {
-			*vp = make(map[int64]bool, decInferLen(containerLen, d.h.MaxInitLen, 9))
+			*vp = make(map[int32]bool, decInferLen(containerLen, d.h.MaxInitLen, 5))
 		}
 		if containerLen != 0 {
-			f.DecMapInt64BoolL(*vp, containerLen, d)
+			f.DecMapInt32BoolL(*vp, containerLen, d)
 		}
 		d.mapEnd()
 	}
 }
-func (fastpathT) DecMapInt64BoolL(v map[int64]bool, containerLen int, d *Decoder) {
-	var mk int64
+func (fastpathT) DecMapInt32BoolL(v map[int32]bool, containerLen int, d *Decoder) {
+	if v == nil {
+		d.errorf("cannot decode into nil map[int32]bool given stream length: %v", containerLen)
+		return
+	}
+	var mk int32
 	var mv bool
 	hasLen := containerLen > 0
-	for j := 0; (hasLen && j < containerLen) || !(hasLen || d.checkBreak()); j++ {
+	for j := 0; d.containerNext(j, containerLen, hasLen); j++ {
 		d.mapElemKey()
-		mk = d.d.DecodeInt64()
+		mk = int32(chkOvf.IntV(d.d.DecodeInt64(), 32))
 		d.mapElemValue()
 		mv = d.d.DecodeBool()
-		if v != nil {
-			v[mk] = mv
-		}
+		v[mk] = mv
 	}
 }
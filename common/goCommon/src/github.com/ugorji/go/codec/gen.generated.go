@@ -18,7 +18,8 @@ if {{var "v"}} == nil {
 	{{var "v"}} = make(map[{{ .KTyp }}]{{ .Typ }}, {{var "rl"}})
 	*{{ .Varname }} = {{var "v"}}
 }
-var {{var "mk"}} {{ .KTyp }}
+{{ $mk := var "mk" -}}
+var {{ $mk }} {{ .KTyp }}
 var {{var "mv"}} {{ .Typ }}
 var {{var "mg"}}, {{var "mdn"}} {{if decElemKindPtr}}, {{var "ms"}}, {{var "mok"}}{{end}} bool
 if z.DecBasicHandle().MapValueReset {
@@ -28,12 +29,16 @@ if z.DecBasicHandle().MapValueReset {
 	{{end}} }
 if {{var "l"}} != 0 {
 	{{var "hl"}} := {{var "l"}} > 0 
-	for {{var "j"}} := 0; ({{var "hl"}} && {{var "j"}} < {{var "l"}}) || !({{var "hl"}} || z.DecCheckBreak()); {{var "j"}}++ {
+	for {{var "j"}} := 0; z.DecContainerNext({{var "j"}}, {{var "l"}}, {{var "hl"}}); {{var "j"}}++ {
 	z.DecReadMapElemKey()
-	{{ $x := printf "%vmk%v" .TempVar .Rand }}{{ decLineVarK $x -}}
+	{{ if eq .KTyp "string" -}}
+		{{ decLineVarK $mk -}}{{- /* decLineVarKStrZC $mk */ -}}
+	{{ else -}}
+		{{ decLineVarK $mk -}}
+	{{ end -}}
 	{{ if eq .KTyp "interface{}" }}{{/* // special case if a byte array. */ -}}
     if {{var "bv"}}, {{var "bok"}} := {{var "mk"}}.([]byte); {{var "bok"}} {
-		{{var "mk"}} = string({{var "bv"}})
+		{{var "mk"}} = z.DecStringZC({{var "bv"}})
 	}
     {{ end -}}
     {{if decElemKindPtr -}}
@@ -41,21 +46,21 @@ if {{var "l"}} != 0 {
     {{end -}}
 	if {{var "mg"}} {
 		{{if decElemKindPtr -}}
-        {{var "mv"}}, {{var "mok"}} = {{var "v"}}[{{var "mk"}}] 
+        {{var "mv"}}, {{var "mok"}} = {{var "v"}}[{{ $mk }}]
 		if {{var "mok"}} {
 			{{var "ms"}} = false
 		}
         {{else -}}
-        {{var "mv"}} = {{var "v"}}[{{var "mk"}}]
+        {{var "mv"}} = {{var "v"}}[{{ $mk }}]
         {{end -}}
 	} {{if not decElemKindImmutable}}else { {{var "mv"}} = {{decElemZero}} }{{end}}
 	z.DecReadMapElemValue()
 	{{var "mdn"}} = false
 	{{ $x := printf "%vmv%v" .TempVar .Rand }}{{ $y := printf "%vmdn%v" .TempVar .Rand }}{{ decLineVar $x $y -}}
 	if {{var "mdn"}} {
-		{{var "v"}}[{{var "mk"}}] = {{decElemZero}}
-	} else if {{if decElemKindPtr}} {{var "ms"}} && {{end}} {{var "v"}} != nil {
-		{{var "v"}}[{{var "mk"}}] = {{var "mv"}}
+		{{var "v"}}[{{ $mk }}] = {{decElemZero}}
+	} else {{if decElemKindPtr}} if {{var "ms"}} {{end}} {
+		{{var "v"}}[{{ $mk }}] = {{var "mv"}}
 	}
 }
 } // else len==0: leave as-is (do not clear map entries)
@@ -108,8 +113,8 @@ if {{var "l"}} == 0 {
 	}
     {{end -}}
 	var {{var "j"}} int 
-    {{/* // var {{var "dn"}} bool */ -}}
-	for {{var "j"}} = 0; ({{var "hl"}} && {{var "j"}} < {{var "l"}}) || !({{var "hl"}} || z.DecCheckBreak()); {{var "j"}}++ { // bounds-check-elimination
+	{{/* // var {{var "dn"}} bool */ -}}
+	for {{var "j"}} = 0; z.DecContainerNext({{var "j"}}, {{var "l"}}, {{var "hl"}}); {{var "j"}}++ {
 		{{if not isArray}} if {{var "j"}} == 0 && {{var "v"}} == nil {
 			if {{var "hl"}} {
 				{{var "rl"}} = z.DecInferLen({{var "l"}}, z.DecBasicHandle().MaxInitLen, {{ .Size }})
@@ -144,7 +149,7 @@ if {{var "l"}} == 0 {
 		{{var "v"}} = {{var "v"}}[:{{var "j"}}]
 		{{var "c"}} = true
 	} else if {{var "j"}} == 0 && {{var "v"}} == nil {
-		{{var "v"}} = make([]{{ .Typ }}, 0)
+		{{var "v"}} = []{{ .Typ }}{}
 		{{var "c"}} = true
 	}
     {{end -}}
@@ -1,8 +1,9 @@
-// +build codecgen.exec
-
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
 
+//go:build codecgen.exec
+// +build codecgen.exec
+
 package codec
 
 import (
@@ -23,6 +24,7 @@ import (
 	"sync"
 	"text/template"
 	"time"
+	// "ugorji.net/zz"
 	"unicode"
 	"unicode/utf8"
 )
@@ -39,13 +41,19 @@ import (
 // In those areas, we try to only do reflection or interface-conversion when NECESSARY:
 //    - Extensions, only if Extensions are configured.
 //
-// However, codecgen doesn't support the following:
-//   - Canonical option. (codecgen IGNORES it currently)
-//     This is just because it has not been implemented.
+// However, note following codecgen caveats:
+//   - Canonical option.
+//     If Canonical=true, codecgen'ed code may delegate encoding maps to reflection-based code.
+//     This is due to the runtime work needed to marshal a map in canonical mode.
+//     However, if map key is a pre-defined/builtin numeric or string type, codecgen
+//     will try to write it out itself
+//   - CheckCircularRef option.
+//     When encoding a struct, a circular reference can lead to a stack overflow.
+//     If CheckCircularRef=true, codecgen'ed code will delegate encoding structs to reflection-based code.
 //   - MissingFielder implementation.
-//     If a type implements MissingFielder, it is completely ignored by codecgen.
-//   - CheckCircularReference.
-//     When encoding, if a circular reference is encountered, it will cause a stack overflow.
+//     If a type implements MissingFielder, a Selfer is not generated (with a warning message).
+//     Statically reproducing the runtime work needed to extract the missing fields and marshal them
+//     along with the struct fields, while handling the Canonical=true special case, was onerous to implement.
 //
 // During encode/decode, Selfer takes precedence.
 // A type implementing Selfer will know how to encode/decode itself statically.
@@ -89,25 +97,43 @@ import (
 //
 // Note:
 //   codecgen-generated code depends on the variables defined by fast-path.generated.go.
-//   consequently, you cannot run with tags "codecgen notfastpath".
+//   consequently, you cannot run with tags "codecgen codec.notfastpath".
+//
+// Note:
+//   genInternalXXX functions are used for generating fast-path and other internally generated
+//   files, and not for use in codecgen.
+
+// Size of a struct or value is not portable across machines, especially across 32-bit vs 64-bit
+// operating systems. This is due to types like int, uintptr, pointers, (and derived types like slice), etc
+// which use the natural word size on those machines, which may be 4 bytes (on 32-bit) or 8 bytes (on 64-bit).
+//
+// Within decInferLen calls, we may generate an explicit size of the entry.
+// We do this because decInferLen values are expected to be approximate,
+// and serve as a good hint on the size of the elements or key+value entry.
+//
+// Since development is done on 64-bit machines, the sizes will be roughly correctly
+// on 64-bit OS, and slightly larger than expected on 32-bit OS.
+// This is ok.
+//
+// For reference, look for 'Size' in fast-path.go.tmpl, gen-dec-(array|map).go.tmpl and gen.go (this file).
 
 // GenVersion is the current version of codecgen.
 //
 // MARKER: Increment this value each time codecgen changes fundamentally.
+// Also update codecgen/gen.go (minimumCodecVersion, genVersion, etc).
 // Fundamental changes are:
 //   - helper methods change (signature change, new ones added, some removed, etc)
 //   - codecgen command line changes
 //
 // v1: Initial Version
 // v2: -
-// v3: Changes for Kubernetes:
-//     changes in signature of some unpublished helper methods and codecgen cmdline arguments.
+// v3: For Kubernetes: changes in signature of some unpublished helper methods and codecgen cmdline arguments.
 // v4: Removed separator support from (en|de)cDriver, and refactored codec(gen)
 // v5: changes to support faster json decoding. Let encoder/decoder maintain state of collections.
 // v6: removed unsafe from gen, and now uses codecgen.exec tag
 // v7: -
 // v8: current - we now maintain compatibility with old generated code.
-// v9: skipped
+// v9: - skipped
 // v10: modified encDriver and decDriver interfaces.
 // v11: remove deprecated methods of encDriver and decDriver.
 // v12: removed deprecated methods from genHelper and changed container tracking logic
@@ -119,7 +145,15 @@ import (
 // v18: 20201004 changed definition of genHelper...Extension (to take interface{}) and eliminated I2Rtid method
 // v19: 20201115 updated codecgen cmdline flags and optimized output
 // v20: 20201120 refactored GenHelper to one exported function
-const genVersion = 20
+// v21: 20210104 refactored generated code to honor ZeroCopy=true for more efficiency
+// v22: 20210118 fixed issue in generated code when encoding a type which is also a codec.Selfer
+// v23: 20210203 changed slice/map types for which we generate fast-path functions
+// v24: 20210226 robust handling for Canonical|CheckCircularRef flags and MissingFielder implementations
+// v25: 20210406 pass base reflect.Type to side(En|De)code and (En|De)codeExt calls
+// v26: 20230201 genHelper changes for more inlining and consequent performance
+// v27: 20230219 fix error decoding struct from array - due to misplaced counter increment
+// v28: 20230224  fix decoding missing fields of struct from array, due to double counter increment
+const genVersion = 28
 
 const (
 	genCodecPkg        = "codec1978" // MARKER: keep in sync with codecgen/gen.go
@@ -130,12 +164,9 @@ const (
 	// This is because nil can appear anywhere, so we should always check.
 	genAnythingCanBeNil = true
 
-	// if genUseOneFunctionForDecStructMap, make a single codecDecodeSelferFromMap function;
-	// else make codecDecodeSelferFromMap{LenPrefix,CheckBreak} so that conditionals
-	// are not executed a lot.
-	//
-	// From testing, it didn't make much difference in runtime, so keep as true (one function only)
-	genUseOneFunctionForDecStructMap = true
+	// genStructCanonical configures whether we generate 2 paths based on Canonical flag
+	// when encoding struct fields.
+	genStructCanonical = true
 
 	// genFastpathCanonical configures whether we support Canonical in fast path.
 	// The savings is not much.
@@ -145,21 +176,14 @@ const (
 
 	// genFastpathTrimTypes configures whether we trim uncommon fastpath types.
 	genFastpathTrimTypes = true
-
-	// genDecStructArrayInlineLoopCheck configures whether we create a next function
-	// for each iteration in the loop and call it, or just inline it.
-	//
-	// with inlining, we get better performance but about 10% larger files.
-	genDecStructArrayInlineLoopCheck = true
 )
 
-type genStructMapStyle uint8
+type genStringDecAsBytes string
+type genStringDecZC string
 
-const (
-	genStructMapStyleConsolidated genStructMapStyle = iota
-	genStructMapStyleLenPrefix
-	genStructMapStyleCheckBreak
-)
+var genStringDecAsBytesTyp = reflect.TypeOf(genStringDecAsBytes(""))
+var genStringDecZCTyp = reflect.TypeOf(genStringDecZC(""))
+var genFormats = []string{"Json", "Cbor", "Msgpack", "Binc", "Simple"}
 
 var (
 	errGenAllTypesSamePkg        = errors.New("All types must be in the same package")
@@ -260,17 +284,17 @@ func (g *genIfClause) c(last bool) (v string) {
 // Library users: DO NOT USE IT DIRECTLY. IT WILL CHANGE CONTINUOUSLY WITHOUT NOTICE.
 func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	jsonOnlyWhen, toArrayWhen, omitEmptyWhen *bool,
-	ti *TypeInfos, typ ...reflect.Type) {
+	ti *TypeInfos, types ...reflect.Type) (warnings []string) {
 	// All types passed to this method do not have a codec.Selfer method implemented directly.
 	// codecgen already checks the AST and skips any types that define the codec.Selfer methods.
 	// Consequently, there's no need to check and trim them if they implement codec.Selfer
 
-	if len(typ) == 0 {
+	if len(types) == 0 {
 		return
 	}
 	x := genRunner{
 		w:             w,
-		t:             typ,
+		t:             types,
 		te:            make(map[uintptr]bool),
 		td:            make(map[uintptr]bool),
 		tz:            make(map[uintptr]bool),
@@ -280,7 +304,7 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 		tm:            make(map[reflect.Type]struct{}),
 		ty:            make(map[reflect.Type]struct{}),
 		ts:            []reflect.Type{},
-		bp:            genImportPath(typ[0]),
+		bp:            genImportPath(types[0]),
 		xs:            uid,
 		ti:            ti,
 		jsonOnlyWhen:  jsonOnlyWhen,
@@ -300,13 +324,26 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	// gather imports first:
 	x.cp = genImportPath(reflect.TypeOf(x))
 	x.imn[x.cp] = genCodecPkg
-	for _, t := range typ {
+
+	// iterate, check if all in same package, and remove any missingfielders
+	for i := 0; i < len(x.t); {
+		t := x.t[i]
 		// xdebugf("###########: PkgPath: '%v', Name: '%s'\n", genImportPath(t), t.Name())
 		if genImportPath(t) != x.bp {
 			halt.onerror(errGenAllTypesSamePkg)
 		}
+		ti1 := x.ti.get(rt2id(t), t)
+		if ti1.flagMissingFielder || ti1.flagMissingFielderPtr {
+			// output diagnostic message  - that nothing generated for this type
+			warnings = append(warnings, fmt.Sprintf("type: '%v' not generated; implements codec.MissingFielder", t))
+			copy(x.t[i:], x.t[i+1:])
+			x.t = x.t[:len(x.t)-1]
+			continue
+		}
 		x.genRefPkgs(t)
+		i++
 	}
+
 	x.line("// +build go1.6")
 	if buildTags != "" {
 		x.line("// +build " + buildTags)
@@ -337,7 +374,7 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 		}
 	}
 	// add required packages
-	for _, k := range [...]string{"runtime", "errors", "strconv"} { // "reflect", "fmt"
+	for _, k := range [...]string{"runtime", "errors", "strconv", "sort"} { // "reflect", "fmt"
 		if _, ok := x.im[k]; !ok {
 			x.line("\"" + k + "\"")
 		}
@@ -363,6 +400,7 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	x.line(")")
 	x.line("var (")
 	x.line("errCodecSelferOnlyMapOrArrayEncodeToStruct" + x.xs + " = " + "errors.New(`only encoded map or array can be decoded into a struct`)")
+	x.line("_ sort.Interface = nil")
 	x.line(")")
 	x.line("")
 
@@ -371,6 +409,16 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	x.line("")
 	x.linef("func %sFalse() bool { return false }", x.hn)
 	x.linef("func %sTrue() bool { return true }", x.hn)
+	x.line("")
+
+	// add types for sorting canonical
+	for _, s := range []string{"string", "uint64", "int64", "float64"} {
+		x.linef("type %s%sSlice []%s", x.hn, s, s)
+		x.linef("func (p %s%sSlice) Len() int      { return len(p) }", x.hn, s)
+		x.linef("func (p %s%sSlice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }", x.hn, s)
+		x.linef("func (p %s%sSlice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }", x.hn, s)
+	}
+
 	x.line("")
 	x.varsfxreset()
 	x.line("func init() {")
@@ -391,8 +439,9 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	x.line("")
 
 	// generate rest of type info
-	for _, t := range typ {
+	for _, t := range x.t {
 		x.tc = t
+		x.linef("func (%s) codecSelferViaCodecgen() {}", x.genTypeName(t))
 		x.selfer(true)
 		x.selfer(false)
 		x.tryGenIsZero(t)
@@ -437,6 +486,7 @@ func Gen(w io.Writer, buildTags, pkgName, uid string, noExtensions bool,
 	}
 
 	x.line("")
+	return
 }
 
 func (x *genRunner) checkForSelfer(t reflect.Type, varname string) bool {
@@ -631,7 +681,7 @@ func (x *genRunner) tryGenIsZero(t reflect.Type) (done bool) {
 	delete(x.ty, t)
 
 	ti := x.ti.get(rtid, t)
-	tisfi := ti.sfiSrc // always use sequence from file. decStruct expects same thing.
+	tisfi := ti.sfi.source() // always use sequence from file. decStruct expects same thing.
 	varname := genTopLevelVarName
 
 	x.linef("func (%s *%s) IsCodecEmpty() bool {", varname, x.genTypeName(t))
@@ -663,6 +713,7 @@ func (x *genRunner) tryGenIsZero(t reflect.Type) (done bool) {
 
 func (x *genRunner) selfer(encode bool) {
 	t := x.tc
+	// ti := x.ti.get(rt2id(t), t)
 	t0 := t
 	// always make decode use a pointer receiver,
 	// and structs/arrays always use a ptr receiver (encode|decode)
@@ -682,6 +733,9 @@ func (x *genRunner) selfer(encode bool) {
 	if encode {
 		x.line(") CodecEncodeSelf(e *" + x.cpfx + "Encoder) {")
 		x.genRequiredMethodVars(true)
+		if t0.Kind() == reflect.Struct {
+			x.linef("if z.EncBasicHandle().CheckCircularRef { z.EncEncode(%s); return }", genTopLevelVarName)
+		}
 		x.encVar(genTopLevelVarName, t)
 	} else {
 		x.line(") CodecDecodeSelf(d *" + x.cpfx + "Decoder) {")
@@ -700,28 +754,12 @@ func (x *genRunner) selfer(encode bool) {
 	}
 
 	// write is containerMap
-	if genUseOneFunctionForDecStructMap {
-		x.out(fnSigPfx)
-		x.line(") codecDecodeSelfFromMap(l int, d *" + x.cpfx + "Decoder) {")
-		x.genRequiredMethodVars(false)
-		x.decStructMap(genTopLevelVarName, "l", rt2id(t0), t0, genStructMapStyleConsolidated)
-		x.line("}")
-		x.line("")
-	} else {
-		x.out(fnSigPfx)
-		x.line(") codecDecodeSelfFromMapLenPrefix(l int, d *" + x.cpfx + "Decoder) {")
-		x.genRequiredMethodVars(false)
-		x.decStructMap(genTopLevelVarName, "l", rt2id(t0), t0, genStructMapStyleLenPrefix)
-		x.line("}")
-		x.line("")
-
-		x.out(fnSigPfx)
-		x.line(") codecDecodeSelfFromMapCheckBreak(l int, d *" + x.cpfx + "Decoder) {")
-		x.genRequiredMethodVars(false)
-		x.decStructMap(genTopLevelVarName, "l", rt2id(t0), t0, genStructMapStyleCheckBreak)
-		x.line("}")
-		x.line("")
-	}
+	x.out(fnSigPfx)
+	x.line(") codecDecodeSelfFromMap(l int, d *" + x.cpfx + "Decoder) {")
+	x.genRequiredMethodVars(false)
+	x.decStructMap(genTopLevelVarName, "l", rt2id(t0), t0)
+	x.line("}")
+	x.line("")
 
 	// write containerArray
 	x.out(fnSigPfx)
@@ -734,7 +772,7 @@ func (x *genRunner) selfer(encode bool) {
 }
 
 // used for chan, array, slice, map
-func (x *genRunner) xtraSM(varname string, t reflect.Type, encode, isptr bool) {
+func (x *genRunner) xtraSM(varname string, t reflect.Type, ti *typeInfo, encode, isptr bool) {
 	var ptrPfx, addrPfx string
 	if isptr {
 		ptrPfx = "*"
@@ -746,28 +784,38 @@ func (x *genRunner) xtraSM(varname string, t reflect.Type, encode, isptr bool) {
 	} else {
 		x.linef("h.dec%s((*%s)(%s%s), d)", x.genMethodNameT(t), x.genTypeName(t), addrPfx, varname)
 	}
-	x.registerXtraT(t)
+	x.registerXtraT(t, ti)
 }
 
-func (x *genRunner) registerXtraT(t reflect.Type) {
+func (x *genRunner) registerXtraT(t reflect.Type, ti *typeInfo) {
 	// recursively register the types
+	tk := t.Kind()
+	if tk == reflect.Ptr {
+		x.registerXtraT(t.Elem(), nil)
+		return
+	}
 	if _, ok := x.tm[t]; ok {
 		return
 	}
-	var tkey reflect.Type
-	switch t.Kind() {
-	case reflect.Chan, reflect.Slice, reflect.Array:
-	case reflect.Map:
-		tkey = t.Key()
+
+	switch tk {
+	case reflect.Chan, reflect.Slice, reflect.Array, reflect.Map:
 	default:
 		return
 	}
+	// only register the type if it will not default to a fast-path
+	if ti == nil {
+		ti = x.ti.get(rt2id(t), t)
+	}
+	if _, rtidu := genFastpathUnderlying(t, ti.rtid, ti); fastpathAvIndex(rtidu) != -1 {
+		return
+	}
 	x.tm[t] = struct{}{}
 	x.ts = append(x.ts, t)
 	// check if this refers to any xtra types eg. a slice of array: add the array
-	x.registerXtraT(t.Elem())
-	if tkey != nil {
-		x.registerXtraT(tkey)
+	x.registerXtraT(t.Elem(), nil)
+	if tk == reflect.Map {
+		x.registerXtraT(t.Key(), nil)
 	}
 }
 
@@ -848,7 +896,7 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 	}
 
 	if t == timeTyp {
-		x.linef("%s !z.EncBasicHandle().TimeNotBuiltin { r.EncodeTime(%s%s)", hasIf.c(false), ptrPfx, varname)
+		x.linef("%s z.EncBasicHandle().TimeBuiltin() { r.EncodeTime(%s%s)", hasIf.c(false), ptrPfx, varname)
 		// return
 	}
 	if t == rawTyp {
@@ -862,7 +910,8 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 	// only check for extensions if extensions are configured,
 	// and the type is named, and has a packagePath,
 	// and this is not the CodecEncodeSelf or CodecDecodeSelf method (i.e. it is not a Selfer)
-	if !x.nx && varname != genTopLevelVarName && genImportPath(t) != "" && t.Name() != "" {
+	if !x.nx && varname != genTopLevelVarName && t != genStringDecAsBytesTyp &&
+		t != genStringDecZCTyp && genImportPath(t) != "" && t.Name() != "" {
 		yy := fmt.Sprintf("%sxt%s", genTempVarPfx, mi)
 		x.linef("%s %s := z.Extension(%s); %s != nil { z.EncExtension(%s, %s) ",
 			hasIf.c(false), yy, varname, yy, varname, yy)
@@ -872,9 +921,14 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 		if ti2.flagSelfer {
 			x.linef("%s %s.CodecEncodeSelf(e)", hasIf.c(true), varname)
 			return
-		} else if ti2.flagSelferPtr {
-			x.linef("%s %ssf%s := &%s", hasIf.c(true), genTempVarPfx, mi, varname)
-			x.linef("%ssf%s.CodecEncodeSelf(e)", genTempVarPfx, mi)
+		}
+		if ti2.flagSelferPtr {
+			if isptr {
+				x.linef("%s %s.CodecEncodeSelf(e)", hasIf.c(true), varname)
+			} else {
+				x.linef("%s %ssf%s := &%s", hasIf.c(true), genTempVarPfx, mi, varname)
+				x.linef("%ssf%s.CodecEncodeSelf(e)", genTempVarPfx, mi)
+			}
 			return
 		}
 
@@ -889,7 +943,7 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 		if t == t0 {
 			inlist = true
 			if x.checkForSelfer(t, varname) {
-				x.line(varname + ".CodecEncodeSelf(e)")
+				x.linef("%s %s.CodecEncodeSelf(e)", hasIf.c(true), varname)
 				return
 			}
 			break
@@ -929,15 +983,25 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 		x.line("r.EncodeFloat32(float32(" + varname + "))")
 	case reflect.Float64:
 		x.line("r.EncodeFloat64(float64(" + varname + "))")
+	case reflect.Complex64:
+		x.linef("z.EncEncodeComplex64(complex64(%s))", varname)
+	case reflect.Complex128:
+		x.linef("z.EncEncodeComplex128(complex128(%s))", varname)
 	case reflect.Bool:
 		x.line("r.EncodeBool(bool(" + varname + "))")
 	case reflect.String:
 		x.linef("r.EncodeString(string(%s))", varname)
 	case reflect.Chan:
-		x.xtraSM(varname, t, true, false)
+		x.xtraSM(varname, t, ti2, true, false)
 		// x.encListFallback(varname, rtid, t)
 	case reflect.Array:
-		x.xtraSM(varname, t, true, true)
+		_, rtidu := genFastpathUnderlying(t, rtid, ti2)
+		if fastpathAvIndex(rtidu) != -1 {
+			g := x.newFastpathGenV(ti2.key)
+			x.linef("z.F.%sV((%s)(%s[:]), e)", g.MethodNamePfx("Enc", false), x.genTypeName(ti2.key), varname)
+		} else {
+			x.xtraSM(varname, t, ti2, true, true)
+		}
 	case reflect.Slice:
 		// if nil, call dedicated function
 		// if a []byte, call dedicated function
@@ -945,14 +1009,22 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
+
 		x.linef("if %s == nil { r.EncodeNil() } else {", varname)
 		if rtid == uint8SliceTypId {
 			x.line("r.EncodeStringBytesRaw([]byte(" + varname + "))")
-		} else if fastpathAvIndex(rtid) != -1 {
-			g := x.newFastpathGenV(t)
-			x.line("z.F." + g.MethodNamePfx("Enc", false) + "V(" + varname + ", e)")
 		} else {
-			x.xtraSM(varname, t, true, false)
+			tu, rtidu := genFastpathUnderlying(t, rtid, ti2)
+			if fastpathAvIndex(rtidu) != -1 {
+				g := x.newFastpathGenV(tu)
+				if rtid == rtidu {
+					x.linef("z.F.%sV(%s, e)", g.MethodNamePfx("Enc", false), varname)
+				} else {
+					x.linef("z.F.%sV((%s)(%s), e)", g.MethodNamePfx("Enc", false), x.genTypeName(tu), varname)
+				}
+			} else {
+				x.xtraSM(varname, t, ti2, true, false)
+			}
 		}
 		x.linef("} // end block: if %s slice == nil", varname)
 	case reflect.Map:
@@ -962,11 +1034,16 @@ func (x *genRunner) enc(varname string, t reflect.Type, isptr bool) {
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
 		x.linef("if %s == nil { r.EncodeNil() } else {", varname)
-		if fastpathAvIndex(rtid) != -1 {
-			g := x.newFastpathGenV(t)
-			x.line("z.F." + g.MethodNamePfx("Enc", false) + "V(" + varname + ", e)")
+		tu, rtidu := genFastpathUnderlying(t, rtid, ti2)
+		if fastpathAvIndex(rtidu) != -1 {
+			g := x.newFastpathGenV(tu)
+			if rtid == rtidu {
+				x.linef("z.F.%sV(%s, e)", g.MethodNamePfx("Enc", false), varname)
+			} else {
+				x.linef("z.F.%sV((%s)(%s), e)", g.MethodNamePfx("Enc", false), x.genTypeName(tu), varname)
+			}
 		} else {
-			x.xtraSM(varname, t, true, false)
+			x.xtraSM(varname, t, ti2, true, false)
 		}
 		x.linef("} // end block: if %s map == nil", varname)
 	case reflect.Struct:
@@ -994,6 +1071,10 @@ func (x *genRunner) encZero(t reflect.Type) {
 		x.line("r.EncodeFloat32(0)")
 	case reflect.Float64:
 		x.line("r.EncodeFloat64(0)")
+	case reflect.Complex64:
+		x.line("z.EncEncodeComplex64(0)")
+	case reflect.Complex128:
+		x.line("z.EncEncodeComplex128(0)")
 	case reflect.Bool:
 		x.line("r.EncodeBool(false)")
 	case reflect.String:
@@ -1037,7 +1118,7 @@ func (x *genRunner) doEncOmitEmptyLine(t2 reflect.StructField, varname string, b
 func (x *genRunner) encOmitEmptyLine(t2 reflect.StructField, varname string, buf *genBuf) {
 	// xdebugf("calling encOmitEmptyLine on: %v", t2.Type)
 	// smartly check omitEmpty on a struct type, as it may contain uncomparable map/slice/etc.
-	// also, for maps/slices/arrays, check if len ! 0 (not if == zero value)
+	// also, for maps/slices, check if len ! 0 (not if == zero value)
 	varname2 := varname + "." + t2.Name
 	switch t2.Type.Kind() {
 	case reflect.Struct:
@@ -1072,9 +1153,9 @@ func (x *genRunner) encOmitEmptyLine(t2 reflect.StructField, varname string, buf
 			buf.s(varname2).s(" != ").s(x.genZeroValueR(t2.Type))
 			break
 		}
-		// fmt.Printf("???? !!!! We shouldn't get to this point !!!! ???? - for type: %v\n", t2.Type)
 		// buf.s("(")
 		buf.s(x.sayFalse()) // buf.s("false")
+		var wrote bool
 		for i, n := 0, t2.Type.NumField(); i < n; i++ {
 			f := t2.Type.Field(i)
 			if f.PkgPath != "" { // unexported
@@ -1082,12 +1163,27 @@ func (x *genRunner) encOmitEmptyLine(t2 reflect.StructField, varname string, buf
 			}
 			buf.s(" || ")
 			x.encOmitEmptyLine(f, varname2, buf)
+			wrote = true
+		}
+		if !wrote {
+			buf.s(" || ").s(x.sayTrue())
 		}
 		//buf.s(")")
 	case reflect.Bool:
 		buf.s("bool(").s(varname2).s(")")
-	case reflect.Map, reflect.Slice, reflect.Array, reflect.Chan:
+	case reflect.Map, reflect.Slice, reflect.Chan:
 		buf.s("len(").s(varname2).s(") != 0")
+	case reflect.Array:
+		tlen := t2.Type.Len()
+		if tlen == 0 {
+			buf.s(x.sayFalse())
+		} else if t2.Type.Comparable() {
+			buf.s(varname2).s(" != ").s(x.genZeroValueR(t2.Type))
+		} else { // then we cannot even compare the individual values
+			// TODO use playground to check if you can compare to a
+			// zero value of an array, even if array not comparable.
+			buf.s(x.sayTrue())
+		}
 	default:
 		buf.s(varname2).s(" != ").s(x.genZeroValueR(t2.Type))
 	}
@@ -1105,7 +1201,7 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 	ti2arrayvar := genTempVarPfx + "r" + i
 	struct2arrvar := genTempVarPfx + "2arr" + i
 
-	tisfi := ti.sfiSrc // always use sequence from file. decStruct expects same thing.
+	tisfi := ti.sfi.source() // always use sequence from file. decStruct expects same thing.
 
 	type genFQN struct {
 		i       string
@@ -1117,7 +1213,10 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 	}
 
 	genFQNs := make([]genFQN, len(tisfi))
+	si2Pos := make(map[*structFieldInfo]int) // stores position in sorted structFieldInfos
+
 	for j, si := range tisfi {
+		si2Pos[si] = j
 		q := &genFQNs[j]
 		q.i = x.varsfx()
 		q.nilVar = genTempVarPfx + "n" + q.i
@@ -1231,53 +1330,68 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 			x.linef("z.EncWriteMapStart(%d)", len(tisfi))
 		}
 
-		for j, si := range tisfi {
-			q := &genFQNs[j]
-			doOmitEmptyCheck := (omitEmptySometimes && si.path.omitEmpty) || omitEmptyAlways
-			if doOmitEmptyCheck {
-				x.linef("if %s[%v] {", numfieldsvar, j)
-			}
-			x.linef("z.EncWriteMapElemKey()")
-
-			// emulate EncStructFieldKey
-			switch ti.keyType {
-			case valueTypeInt:
-				x.linef("r.EncodeInt(z.M.Int(strconv.ParseInt(`%s`, 10, 64)))", si.encName)
-			case valueTypeUint:
-				x.linef("r.EncodeUint(z.M.Uint(strconv.ParseUint(`%s`, 10, 64)))", si.encName)
-			case valueTypeFloat:
-				x.linef("r.EncodeFloat64(z.M.Float(strconv.ParseFloat(`%s`, 64)))", si.encName)
-			default: // string
-				if x.jsonOnlyWhen == nil {
-					if si.path.encNameAsciiAlphaNum {
-						x.linef(`if z.IsJSONHandle() { z.WriteStr("\"%s\"") } else { `, si.encName)
-					}
-					x.linef("r.EncodeString(`%s`)", si.encName)
-					if si.path.encNameAsciiAlphaNum {
-						x.linef("}")
-					}
-				} else if *(x.jsonOnlyWhen) {
-					if si.path.encNameAsciiAlphaNum {
-						x.linef(`z.WriteStr("\"%s\"")`, si.encName)
+		fn := func(tisfi []*structFieldInfo) {
+			// tisfi here may be source or sorted, so use the src position stored elsewhere
+			for _, si := range tisfi {
+				pos := si2Pos[si]
+				q := &genFQNs[pos]
+				doOmitEmptyCheck := (omitEmptySometimes && si.path.omitEmpty) || omitEmptyAlways
+				if doOmitEmptyCheck {
+					x.linef("if %s[%v] {", numfieldsvar, pos)
+				}
+				x.linef("z.EncWriteMapElemKey()")
+
+				// emulate EncStructFieldKey
+				switch ti.keyType {
+				case valueTypeInt:
+					x.linef("r.EncodeInt(z.M.Int(strconv.ParseInt(`%s`, 10, 64)))", si.encName)
+				case valueTypeUint:
+					x.linef("r.EncodeUint(z.M.Uint(strconv.ParseUint(`%s`, 10, 64)))", si.encName)
+				case valueTypeFloat:
+					x.linef("r.EncodeFloat64(z.M.Float(strconv.ParseFloat(`%s`, 64)))", si.encName)
+				default: // string
+					if x.jsonOnlyWhen == nil {
+						if si.path.encNameAsciiAlphaNum {
+							x.linef(`if z.IsJSONHandle() { z.EncWr().WriteStr("\"%s\"") } else { `, si.encName)
+						}
+						x.linef("r.EncodeString(`%s`)", si.encName)
+						if si.path.encNameAsciiAlphaNum {
+							x.linef("}")
+						}
+					} else if *(x.jsonOnlyWhen) {
+						if si.path.encNameAsciiAlphaNum {
+							x.linef(`z.EncWr().WriteStr("\"%s\"")`, si.encName)
+						} else {
+							x.linef("r.EncodeString(`%s`)", si.encName)
+						}
 					} else {
 						x.linef("r.EncodeString(`%s`)", si.encName)
 					}
+				}
+				x.line("z.EncWriteMapElemValue()")
+				if q.canNil {
+					x.line("if " + q.nilVar + " { r.EncodeNil() } else { ")
+					x.encVarChkNil(q.fqname, q.sf.Type, false)
+					x.line("}")
 				} else {
-					x.linef("r.EncodeString(`%s`)", si.encName)
+					x.encVarChkNil(q.fqname, q.sf.Type, false)
+				}
+				if doOmitEmptyCheck {
+					x.line("}")
 				}
 			}
-			x.line("z.EncWriteMapElemValue()")
-			if q.canNil {
-				x.line("if " + q.nilVar + " { r.EncodeNil() } else { ")
-				x.encVarChkNil(q.fqname, q.sf.Type, false)
-				x.line("}")
-			} else {
-				x.encVarChkNil(q.fqname, q.sf.Type, false)
-			}
-			if doOmitEmptyCheck {
-				x.line("}")
-			}
 		}
+
+		if genStructCanonical {
+			x.linef("if z.EncBasicHandle().Canonical {") // if Canonical block
+			fn(ti.sfi.sorted())
+			x.linef("} else {") // else !Canonical block
+			fn(ti.sfi.source())
+			x.linef("}") // end if Canonical block
+		} else {
+			fn(tisfi)
+		}
+
 		x.line("z.EncWriteMapEnd()")
 	}
 	if toArraySometimes {
@@ -1316,11 +1430,17 @@ func (x *genRunner) encListFallback(varname string, t reflect.Type) {
 	}
 
 	x.line("z.EncWriteArrayStart(len(" + varname + "))")
-	x.linef("for _, %sv%s := range %s {", genTempVarPfx, i, varname)
-	x.linef("z.EncWriteArrayElem()")
 
-	x.encVar(genTempVarPfx+"v"+i, t.Elem())
+	// x.linef("for _, %sv%s := range %s {", genTempVarPfx, i, varname)
+	// x.linef("z.EncWriteArrayElem()")
+	// x.encVar(genTempVarPfx+"v"+i, t.Elem())
+	// x.line("}")
+
+	x.linef("for %sv%s := range %s {", genTempVarPfx, i, varname)
+	x.linef("z.EncWriteArrayElem()")
+	x.encVar(fmt.Sprintf("%s[%sv%s]", varname, genTempVarPfx, i), t.Elem())
 	x.line("}")
+
 	x.line("z.EncWriteArrayEnd()")
 	if t.Kind() == reflect.Chan {
 		x.line("}")
@@ -1328,10 +1448,111 @@ func (x *genRunner) encListFallback(varname string, t reflect.Type) {
 }
 
 func (x *genRunner) encMapFallback(varname string, t reflect.Type) {
-	x.linef("if %s == nil { r.EncodeNil(); return }", varname)
-	// MARKER: Canonical Option is not honored
-	i := x.varsfx()
-	x.line("z.EncWriteMapStart(len(" + varname + "))")
+	x.linef("if %s == nil { r.EncodeNil()", varname)
+	x.line("} else if z.EncBasicHandle().Canonical {")
+
+	// Solve for easy case accomodated by sort package without reflection i.e.
+	// map keys of type: float, int, string (pre-defined/builtin types).
+	//
+	// To do this, we will get the keys into an array of uint64|float64|string,
+	// sort them, then write them out, and grab the value and encode it appropriately
+	tkey := t.Key()
+	tkind := tkey.Kind()
+	// tkeybase := tkey
+	// for tkeybase.Kind() == reflect.Ptr {
+	// 	tkeybase = tkeybase.Elem()
+	// }
+	// tikey := x.ti.get(rt2id(tkeybase), tkeybase)
+
+	// pre-defined types have a name and no pkgpath and appropriate kind
+	predeclared := tkey.PkgPath() == "" && tkey.Name() != ""
+
+	canonSortKind := reflect.Invalid
+	switch tkind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		canonSortKind = reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		canonSortKind = reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		canonSortKind = reflect.Float64
+	case reflect.String:
+		canonSortKind = reflect.String
+	}
+
+	var i string = x.varsfx()
+
+	fnCanonNumBoolStrKind := func() {
+		if !predeclared {
+			x.linef("var %svv%s %s", genTempVarPfx, i, x.genTypeName(tkey))
+			x.linef("%sencfn%s := z.EncFnGivenAddr(&%svv%s)", genTempVarPfx, i, genTempVarPfx, i)
+		}
+		// get the type, get the slice type its mapped to, and complete the code
+		x.linef("%ss%s := make([]%s, 0, len(%s))", genTempVarPfx, i, canonSortKind, varname)
+		x.linef("for k, _ := range %s {", varname)
+		x.linef("  %ss%s = append(%ss%s, %s(k))", genTempVarPfx, i, genTempVarPfx, i, canonSortKind)
+		x.linef("}")
+		x.linef("sort.Sort(%s%sSlice(%ss%s))", x.hn, canonSortKind, genTempVarPfx, i)
+		x.linef("z.EncWriteMapStart(len(%s))", varname)
+		x.linef("for _, %sv%s := range %ss%s {", genTempVarPfx, i, genTempVarPfx, i)
+		x.linef("  z.EncWriteMapElemKey()")
+		if predeclared {
+			switch tkind {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+				x.linef("r.EncodeInt(int64(%sv%s))", genTempVarPfx, i)
+			case reflect.Int64:
+				x.linef("r.EncodeInt(%sv%s)", genTempVarPfx, i)
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uintptr:
+				x.linef("r.EncodeUint(%sv%s)", genTempVarPfx, i)
+			case reflect.Uint64:
+				x.linef("r.EncodeUint(uint64(%sv%s))", genTempVarPfx, i)
+			case reflect.Float32:
+				x.linef("r.EncodeFloat32(float32(%sv%s))", genTempVarPfx, i)
+			case reflect.Float64:
+				x.linef("r.EncodeFloat64(%sv%s)", genTempVarPfx, i)
+			case reflect.String:
+				x.linef("r.EncodeString(%sv%s)", genTempVarPfx, i)
+			}
+		} else {
+			x.linef("%svv%s = %s(%sv%s)", genTempVarPfx, i, x.genTypeName(tkey), genTempVarPfx, i)
+			x.linef("z.EncEncodeNumBoolStrKindGivenAddr(&%svv%s, %sencfn%s)", genTempVarPfx, i, genTempVarPfx, i)
+		}
+		x.linef("  z.EncWriteMapElemValue()")
+		vname := genTempVarPfx + "e" + i
+		if predeclared {
+			x.linef("%s := %s[%s(%sv%s)]", vname, varname, x.genTypeName(tkey), genTempVarPfx, i)
+		} else {
+			x.linef("%s := %s[%svv%s]", vname, varname, genTempVarPfx, i)
+		}
+		x.encVar(vname, t.Elem())
+		x.linef("}")
+
+		x.line("z.EncWriteMapEnd()")
+
+	}
+
+	// if canonSortKind != reflect.Invalid && !tikey.flagMarshalInterface {
+	// 	if predeclared {
+	// 		fnCanonNumBoolStrKind()
+	// 	} else {
+	// 		// handle if an extension
+	// 		x.linef("if z.Extension(%s(%s)) != nil { z.EncEncodeMapNonNil(%s) } else {",
+	// 			x.genTypeName(tkey), x.genZeroValueR(tkey), varname)
+	// 		fnCanonNumBoolStrKind()
+	// 		x.line("}")
+	// 	}
+	// } else {
+	// 	x.linef("z.EncEncodeMapNonNil(%s)", varname)
+	// }
+
+	if canonSortKind != reflect.Invalid {
+		fnCanonNumBoolStrKind()
+	} else {
+		x.linef("z.EncEncodeMapNonNil(%s)", varname)
+	}
+
+	x.line("} else {")
+
+	x.linef("z.EncWriteMapStart(len(%s))", varname)
 	x.linef("for %sk%s, %sv%s := range %s {", genTempVarPfx, i, genTempVarPfx, i, varname)
 	x.linef("z.EncWriteMapElemKey()")
 	x.encVar(genTempVarPfx+"k"+i, t.Key())
@@ -1339,6 +1560,8 @@ func (x *genRunner) encMapFallback(varname string, t reflect.Type) {
 	x.encVar(genTempVarPfx+"v"+i, t.Elem())
 	x.line("}")
 	x.line("z.EncWriteMapEnd()")
+
+	x.line("}")
 }
 
 func (x *genRunner) decVarInitPtr(varname, nilvar string, t reflect.Type, si *structFieldInfo,
@@ -1485,7 +1708,7 @@ func (x *genRunner) dec(varname string, t reflect.Type, isptr bool) {
 		addrPfx = "&"
 	}
 	if t == timeTyp {
-		x.linef("%s !z.DecBasicHandle().TimeNotBuiltin { %s%v = r.DecodeTime()", hasIf.c(false), ptrPfx, varname)
+		x.linef("%s z.DecBasicHandle().TimeBuiltin() { %s%v = r.DecodeTime()", hasIf.c(false), ptrPfx, varname)
 		// return
 	}
 	if t == rawTyp {
@@ -1502,7 +1725,8 @@ func (x *genRunner) dec(varname string, t reflect.Type, isptr bool) {
 	// and the type is named, and has a packagePath,
 	// and this is not the CodecEncodeSelf or CodecDecodeSelf method (i.e. it is not a Selfer)
 	// xdebugf("genRunner.dec: varname: %v, t: %v, genImportPath: %v, t.Name: %v", varname, t, genImportPath(t), t.Name())
-	if !x.nx && varname != genTopLevelVarName && genImportPath(t) != "" && t.Name() != "" {
+	if !x.nx && varname != genTopLevelVarName && t != genStringDecAsBytesTyp &&
+		t != genStringDecZCTyp && genImportPath(t) != "" && t.Name() != "" {
 		// first check if extensions are configued, before doing the interface conversion
 		yy := fmt.Sprintf("%sxt%s", genTempVarPfx, mi)
 		x.linef("%s %s := z.Extension(%s); %s != nil { z.DecExtension(%s%s, %s) ", hasIf.c(false), yy, varname, yy, addrPfx, varname, yy)
@@ -1563,34 +1787,55 @@ func (x *genRunner) dec(varname string, t reflect.Type, isptr bool) {
 	}
 
 	switch t.Kind() {
-	case reflect.Array, reflect.Chan:
-		x.xtraSM(varname, t, false, isptr)
+	case reflect.Chan:
+		x.xtraSM(varname, t, ti2, false, isptr)
+	case reflect.Array:
+		_, rtidu := genFastpathUnderlying(t, rtid, ti2)
+		if fastpathAvIndex(rtidu) != -1 {
+			g := x.newFastpathGenV(ti2.key)
+			x.linef("z.F.%sN((%s)(%s[:]), d)", g.MethodNamePfx("Dec", false), x.genTypeName(ti2.key), varname)
+		} else {
+			x.xtraSM(varname, t, ti2, false, isptr)
+		}
 	case reflect.Slice:
 		// if a []byte, call dedicated function
 		// if a known fastpath slice, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
+
 		if rtid == uint8SliceTypId {
-			x.linef("%s%s = r.DecodeBytes(%s(%s[]byte)(%s), false)",
-				ptrPfx, varname, ptrPfx, ptrPfx, varname)
-		} else if fastpathAvIndex(rtid) != -1 {
-			g := x.newFastpathGenV(t)
-			x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
+			x.linef("%s%s = z.DecodeBytesInto(%s(%s[]byte)(%s))", ptrPfx, varname, ptrPfx, ptrPfx, varname)
 		} else {
-			x.xtraSM(varname, t, false, isptr)
-			// x.decListFallback(varname, rtid, false, t)
+			tu, rtidu := genFastpathUnderlying(t, rtid, ti2)
+			if fastpathAvIndex(rtidu) != -1 {
+				g := x.newFastpathGenV(tu)
+				if rtid == rtidu {
+					x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
+				} else {
+					x.linef("z.F.%sX((*%s)(%s%s), d)", g.MethodNamePfx("Dec", false), x.genTypeName(tu), addrPfx, varname)
+				}
+			} else {
+				x.xtraSM(varname, t, ti2, false, isptr)
+				// x.decListFallback(varname, rtid, false, t)
+			}
 		}
 	case reflect.Map:
 		// if a known fastpath map, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
-		if fastpathAvIndex(rtid) != -1 {
-			g := x.newFastpathGenV(t)
-			x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
+
+		tu, rtidu := genFastpathUnderlying(t, rtid, ti2)
+		if fastpathAvIndex(rtidu) != -1 {
+			g := x.newFastpathGenV(tu)
+			if rtid == rtidu {
+				x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
+			} else {
+				x.linef("z.F.%sX((*%s)(%s%s), d)", g.MethodNamePfx("Dec", false), x.genTypeName(tu), addrPfx, varname)
+			}
 		} else {
-			x.xtraSM(varname, t, false, isptr)
+			x.xtraSM(varname, t, ti2, false, isptr)
 		}
 	case reflect.Struct:
 		if inlist {
@@ -1653,10 +1898,21 @@ func (x *genRunner) decTryAssignPrimitive(varname string, t reflect.Type, isptr
 	case reflect.Float64:
 		x.linef("%s%s = (%s)(r.DecodeFloat64())", ptr, varname, x.genTypeName(t))
 
+	case reflect.Complex64:
+		x.linef("%s%s = (%s)(complex(z.DecDecodeFloat32(), 0))", ptr, varname, x.genTypeName(t))
+	case reflect.Complex128:
+		x.linef("%s%s = (%s)(complex(r.DecodeFloat64(), 0))", ptr, varname, x.genTypeName(t))
+
 	case reflect.Bool:
 		x.linef("%s%s = (%s)(r.DecodeBool())", ptr, varname, x.genTypeName(t))
 	case reflect.String:
-		x.linef("%s%s = (%s)(string(r.DecodeStringAsBytes()))", ptr, varname, x.genTypeName(t))
+		if t == genStringDecAsBytesTyp {
+			x.linef("%s%s = r.DecodeStringAsBytes()", ptr, varname)
+		} else if t == genStringDecZCTyp {
+			x.linef("%s%s = (string)(z.DecStringZC(r.DecodeStringAsBytes()))", ptr, varname)
+		} else {
+			x.linef("%s%s = (%s)(z.DecStringZC(r.DecodeStringAsBytes()))", ptr, varname, x.genTypeName(t))
+		}
 	default:
 		return false
 	}
@@ -1665,11 +1921,11 @@ func (x *genRunner) decTryAssignPrimitive(varname string, t reflect.Type, isptr
 
 func (x *genRunner) decListFallback(varname string, rtid uintptr, t reflect.Type) {
 	if t.AssignableTo(uint8SliceTyp) {
-		x.line("*" + varname + " = r.DecodeBytes(*((*[]byte)(" + varname + ")), false)")
+		x.line("*" + varname + " = z.DecodeBytesInto(*((*[]byte)(" + varname + ")))")
 		return
 	}
 	if t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8 {
-		x.linef("r.DecodeBytes( ((*[%d]byte)(%s))[:], true)", t.Len(), varname)
+		x.linef("r.DecodeBytes( ((*[%d]byte)(%s))[:])", t.Len(), varname)
 		return
 	}
 	type tstruc struct {
@@ -1744,6 +2000,14 @@ func (x *genRunner) decMapFallback(varname string, rtid uintptr, t reflect.Type)
 	funcs["decElemKindIntf"] = func() bool {
 		return telem.Kind() == reflect.Interface
 	}
+	funcs["decLineVarKStrBytes"] = func(varname string) string {
+		x.decVar(varname, "", genStringDecAsBytesTyp, false, true)
+		return ""
+	}
+	funcs["decLineVarKStrZC"] = func(varname string) string {
+		x.decVar(varname, "", genStringDecZCTyp, false, true)
+		return ""
+	}
 	funcs["decLineVarK"] = func(varname string) string {
 		x.decVar(varname, "", tkey, false, true)
 		return ""
@@ -1766,8 +2030,8 @@ func (x *genRunner) decMapFallback(varname string, rtid uintptr, t reflect.Type)
 
 func (x *genRunner) decStructMapSwitch(kName string, varname string, rtid uintptr, t reflect.Type) {
 	ti := x.ti.get(rtid, t)
-	tisfi := ti.sfiSrc // always use sequence from file. decStruct expects same thing.
-	x.line("switch (" + kName + ") {")
+	tisfi := ti.sfi.source() // always use sequence from file. decStruct expects same thing.
+	x.line("switch string(" + kName + ") {")
 	var newbuf, nilbuf genBuf
 	for _, si := range tisfi {
 		x.line("case \"" + si.encName + "\":")
@@ -1784,39 +2048,32 @@ func (x *genRunner) decStructMapSwitch(kName string, varname string, rtid uintpt
 	}
 	x.line("default:")
 	// pass the slice here, so that the string will not escape, and maybe save allocation
-	x.linef("z.DecStructFieldNotFound(-1, %s)", kName)
+	x.linef("z.DecStructFieldNotFound(-1, string(%s))", kName)
 	x.linef("} // end switch %s", kName)
 }
 
-func (x *genRunner) decStructMap(varname, lenvarname string, rtid uintptr, t reflect.Type, style genStructMapStyle) {
+func (x *genRunner) decStructMap(varname, lenvarname string, rtid uintptr, t reflect.Type) {
 	tpfx := genTempVarPfx
 	ti := x.ti.get(rtid, t)
 	i := x.varsfx()
 	kName := tpfx + "s" + i
 
-	switch style {
-	case genStructMapStyleLenPrefix:
-		x.linef("for %sj%s := 0; %sj%s < %s; %sj%s++ {", tpfx, i, tpfx, i, lenvarname, tpfx, i)
-	case genStructMapStyleCheckBreak:
-		x.linef("for %sj%s := 0; !z.DecCheckBreak(); %sj%s++ {", tpfx, i, tpfx, i)
-	default: // 0, otherwise.
-		x.linef("var %shl%s bool = %s >= 0", tpfx, i, lenvarname) // has length
-		x.linef("for %sj%s := 0; ; %sj%s++ {", tpfx, i, tpfx, i)
-		x.linef("if %shl%s { if %sj%s >= %s { break }", tpfx, i, tpfx, i, lenvarname)
-		x.line("} else { if z.DecCheckBreak() { break }; }")
-	}
+	x.linef("var %shl%s bool = %s >= 0", tpfx, i, lenvarname) // has length
+	x.linef("for %sj%s := 0; z.DecContainerNext(%sj%s, %s, %shl%s); %sj%s++ {",
+		tpfx, i, tpfx, i, lenvarname, tpfx, i, tpfx, i)
+
 	x.line("z.DecReadMapElemKey()")
 
 	// emulate decstructfieldkey
 	switch ti.keyType {
 	case valueTypeInt:
-		x.linef("%s := z.StringView(strconv.AppendInt(z.DecScratchArrayBuffer()[:0], r.DecodeInt64(), 10))", kName)
+		x.linef("%s := strconv.AppendInt(z.DecScratchArrayBuffer()[:0], r.DecodeInt64(), 10)", kName)
 	case valueTypeUint:
-		x.linef("%s := z.StringView(strconv.AppendUint(z.DecScratchArrayBuffer()[:0], r.DecodeUint64(), 10))", kName)
+		x.linef("%s := strconv.AppendUint(z.DecScratchArrayBuffer()[:0], r.DecodeUint64(), 10)", kName)
 	case valueTypeFloat:
-		x.linef("%s := z.StringView(strconv.AppendFloat(z.DecScratchArrayBuffer()[:0], r.DecodeFloat64(), 'f', -1, 64))", kName)
+		x.linef("%s := strconv.AppendFloat(z.DecScratchArrayBuffer()[:0], r.DecodeFloat64(), 'f', -1, 64)", kName)
 	default: // string
-		x.linef("%s := z.StringView(r.DecodeStringAsBytes())", kName)
+		x.linef("%s := r.DecodeStringAsBytes()", kName)
 	}
 
 	x.line("z.DecReadMapElemValue()")
@@ -1829,28 +2086,14 @@ func (x *genRunner) decStructArray(varname, lenvarname, breakString string, rtid
 	tpfx := genTempVarPfx
 	i := x.varsfx()
 	ti := x.ti.get(rtid, t)
-	tisfi := ti.sfiSrc // always use sequence from file. decStruct expects same thing.
+	tisfi := ti.sfi.source() // always use sequence from file. decStruct expects same thing.
 	x.linef("var %sj%s int", tpfx, i)
 	x.linef("var %sb%s bool", tpfx, i)                        // break
 	x.linef("var %shl%s bool = %s >= 0", tpfx, i, lenvarname) // has length
-	if !genDecStructArrayInlineLoopCheck {
-		x.linef("var %sfn%s = func() bool { ", tpfx, i)
-		x.linef("%sj%s++; if %shl%s { %sb%s = %sj%s > %s } else { %sb%s = z.DecCheckBreak() };",
-			tpfx, i, tpfx, i, tpfx, i,
-			tpfx, i, lenvarname, tpfx, i)
-		x.linef("if %sb%s { z.DecReadArrayEnd(); return true }; return false", tpfx, i)
-		x.linef("} // end func %sfn%s", tpfx, i)
-	}
 	var newbuf, nilbuf genBuf
 	for _, si := range tisfi {
-		if genDecStructArrayInlineLoopCheck {
-			x.linef("%sj%s++; if %shl%s { %sb%s = %sj%s > %s } else { %sb%s = z.DecCheckBreak() }",
-				tpfx, i, tpfx, i, tpfx, i,
-				tpfx, i, lenvarname, tpfx, i)
-			x.linef("if %sb%s { z.DecReadArrayEnd(); %s }", tpfx, i, breakString)
-		} else {
-			x.linef("if %sfn%s() { %s }", tpfx, i, breakString)
-		}
+		x.linef("%sb%s = !z.DecContainerNext(%sj%s, %s, %shl%s)", tpfx, i, tpfx, i, lenvarname, tpfx, i)
+		x.linef("if %sb%s { z.DecReadArrayEnd(); %s }", tpfx, i, breakString)
 		x.line("z.DecReadArrayElem()")
 		newbuf.reset()
 		nilbuf.reset()
@@ -1862,13 +2105,11 @@ func (x *genRunner) decStructArray(varname, lenvarname, breakString string, rtid
 		if len(newbuf.buf) > 0 {
 			x.line("}")
 		}
+		x.linef("%sj%s++", tpfx, i)
 	}
 	// read remaining values and throw away.
-	x.line("for {")
-	x.linef("%sj%s++; if %shl%s { %sb%s = %sj%s > %s } else { %sb%s = z.DecCheckBreak() }",
-		tpfx, i, tpfx, i, tpfx, i,
-		tpfx, i, lenvarname, tpfx, i)
-	x.linef("if %sb%s { break }", tpfx, i)
+	x.linef("for ; z.DecContainerNext(%sj%s, %s, %shl%s); %sj%s++ {",
+		tpfx, i, lenvarname, tpfx, i, tpfx, i)
 	x.line("z.DecReadArrayElem()")
 	x.linef(`z.DecStructFieldNotFound(%sj%s - 1, "")`, tpfx, i)
 	x.line("}")
@@ -1883,15 +2124,10 @@ func (x *genRunner) decStruct(varname string, rtid uintptr, t reflect.Type) {
 	x.linef("} else if %sct%s == codecSelferValueTypeMap%s {", genTempVarPfx, i, x.xs)
 	x.line(genTempVarPfx + "l" + i + " := z.DecReadMapStart()")
 	x.linef("if %sl%s == 0 {", genTempVarPfx, i)
-	if genUseOneFunctionForDecStructMap {
-		x.line("} else { ")
-		x.linef("%s.codecDecodeSelfFromMap(%sl%s, d)", varname, genTempVarPfx, i)
-	} else {
-		x.line("} else if " + genTempVarPfx + "l" + i + " > 0 { ")
-		x.line(varname + ".codecDecodeSelfFromMapLenPrefix(" + genTempVarPfx + "l" + i + ", d)")
-		x.line("} else {")
-		x.line(varname + ".codecDecodeSelfFromMapCheckBreak(" + genTempVarPfx + "l" + i + ", d)")
-	}
+
+	x.line("} else { ")
+	x.linef("%s.codecDecodeSelfFromMap(%sl%s, d)", varname, genTempVarPfx, i)
+
 	x.line("}")
 	x.line("z.DecReadMapEnd()")
 
@@ -1927,7 +2163,8 @@ func (x *genRunner) newFastpathGenV(t reflect.Type) (v fastpathGenV) {
 		v.Elem = x.genTypeName(te)
 		v.Size = int(te.Size())
 	case reflect.Map:
-		te, tk := t.Elem(), t.Key()
+		te := t.Elem()
+		tk := t.Key()
 		v.Elem = x.genTypeName(te)
 		v.MapKey = x.genTypeName(tk)
 		v.Size = int(te.Size() + tk.Size())
@@ -1997,6 +2234,16 @@ func genNonPtr(t reflect.Type) reflect.Type {
 	return t
 }
 
+func genFastpathUnderlying(t reflect.Type, rtid uintptr, ti *typeInfo) (tu reflect.Type, rtidu uintptr) {
+	tu = t
+	rtidu = rtid
+	if ti.flagHasPkgPath {
+		tu = ti.fastpathUnderlying
+		rtidu = rt2id(tu)
+	}
+	return
+}
+
 func genTitleCaseName(s string) string {
 	switch s {
 	case "interface{}", "interface {}":
@@ -2085,17 +2332,19 @@ func genCustomTypeName(tstr string) string {
 }
 
 func genIsImmutable(t reflect.Type) (v bool) {
-	return isImmutableKind(t.Kind())
+	return scalarBitset.isset(byte(t.Kind()))
 }
 
 type genInternal struct {
 	Version int
 	Values  []fastpathGenV
+	Formats []string
 }
 
 func (x genInternal) FastpathLen() (l int) {
 	for _, v := range x.Values {
-		if v.Primitive == "" && !(v.MapKey == "" && v.Elem == "uint8") {
+		// if v.Primitive == "" && !(v.MapKey == "" && v.Elem == "uint8") {
+		if v.Primitive == "" {
 			l++
 		}
 	}
@@ -2137,7 +2386,7 @@ func genInternalNonZeroValue(s string) string {
 		i = 2
 	case "bytes", "[]byte", "[]uint8":
 		i = 3
-	case "float32", "float64", "float", "double":
+	case "float32", "float64", "float", "double", "complex", "complex64", "complex128":
 		i = 4
 	default:
 		i = 5
@@ -2148,6 +2397,7 @@ func genInternalNonZeroValue(s string) string {
 	return genInternalNonZeroValueStrs[idx%slen][i] // return string, to remove ambiguity
 }
 
+// Note: used for fastpath only
 func genInternalEncCommandAsString(s string, vname string) string {
 	switch s {
 	case "uint64":
@@ -2175,7 +2425,8 @@ func genInternalEncCommandAsString(s string, vname string) string {
 	}
 }
 
-func genInternalDecCommandAsString(s string) string {
+// Note: used for fastpath only
+func genInternalDecCommandAsString(s string, mapkey bool) string {
 	switch s {
 	case "uint":
 		return "uint(chkOvf.UintV(d.d.DecodeUint64(), uintBitsize))"
@@ -2201,13 +2452,21 @@ func genInternalDecCommandAsString(s string) string {
 		return "d.d.DecodeInt64()"
 
 	case "string":
-		return "string(d.d.DecodeStringAsBytes())"
+		// if mapkey {
+		// 	return "d.stringZC(d.d.DecodeStringAsBytes())"
+		// }
+		// return "string(d.d.DecodeStringAsBytes())"
+		return "d.stringZC(d.d.DecodeStringAsBytes())"
 	case "[]byte", "[]uint8", "bytes":
-		return "d.d.DecodeBytes(nil, false)"
+		return "d.d.DecodeBytes([]byte{})"
 	case "float32":
 		return "float32(d.decodeFloat32())"
 	case "float64":
 		return "d.d.DecodeFloat64()"
+	case "complex64":
+		return "complex(d.decodeFloat32(), 0)"
+	case "complex128":
+		return "complex(d.d.DecodeFloat64(), 0)"
 	case "bool":
 		return "d.d.DecodeBool()"
 	default:
@@ -2287,6 +2546,8 @@ func genInternalInit() {
 		"int64":       8,
 		"float32":     4,
 		"float64":     8,
+		"complex64":   8,
+		"complex128":  16,
 		"bool":        1,
 	}
 
@@ -2340,7 +2601,7 @@ func genInternalInit() {
 	// }
 	// var mapkeytypestr = string(mb)
 
-	var gt = genInternal{Version: genVersion}
+	var gt = genInternal{Version: genVersion, Formats: genFormats}
 
 	// For each slice or map type, there must be a (symmetrical) Encode and Decode fast-path function
 
@@ -2417,10 +2678,11 @@ func genInternalFastpathSliceTypes() []string {
 		"interface{}",
 		"string",
 		"[]byte",
-		// "float32",
+		"float32",
 		"float64",
 		// "uint",
 		// "uint8", // no need for fastpath of []uint8, as it is handled specially
+		"uint8", // keep fast-path, so it doesn't have to go through reflection
 		// "uint16",
 		// "uint32",
 		"uint64",
@@ -2442,16 +2704,16 @@ func genInternalFastpathMapKeyTypes() []string {
 		// "float32",
 		// "float64",
 		// "uint",
-		"uint8",
+		"uint8", // byte
 		// "uint16",
 		// "uint32",
-		"uint64",
+		"uint64", // used for keys
 		// "uintptr",
-		"int",
+		"int", // default number key
 		// "int8",
 		// "int16",
-		// "int32",
-		"int64",
+		"int32", // rune
+		// "int64",
 		// "bool",
 	}
 }
@@ -2462,16 +2724,16 @@ func genInternalFastpathMapValueTypes() []string {
 		"string",
 		"[]byte",
 		// "uint",
-		"uint8",
+		"uint8", // byte
 		// "uint16",
 		// "uint32",
-		"uint64",
+		"uint64", // used for keys, etc
 		// "uintptr",
-		"int",
+		"int", // default number
 		//"int8",
 		// "int16",
-		// "int32", // rune (mostly used for unicode)
-		"int64",
+		"int32", // rune (mostly used for unicode)
+		// "int64",
 		// "float32",
 		"float64",
 		"bool",
@@ -2499,7 +2761,7 @@ func genInternalSortableTypes() []string {
 // that are used for reflection-based canonical's encoding of maps.
 //
 // For now, we only support the highest sizes for
-// int64, uint64, float64, bool, string, bytes.
+// int64, uint64, float64, string, bytes.
 func genInternalSortablePlusTypes() []string {
 	return []string{
 		"string",
@@ -2507,7 +2769,7 @@ func genInternalSortablePlusTypes() []string {
 		"uint64",
 		// "uintptr",
 		"int64",
-		"bool",
+		// "bool",
 		"time",
 		"bytes",
 	}
@@ -1,5 +1,3 @@
 module github.com/ugorji/go/codec
 
 go 1.11
-
-require github.com/ugorji/go v1.2.2
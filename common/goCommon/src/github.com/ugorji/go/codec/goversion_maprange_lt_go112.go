@@ -1,9 +1,10 @@
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
 
+//go:build go1.7 && !go1.12 && (safe || codec.safe || appengine)
 // +build go1.7
 // +build !go1.12
-// +build safe appengine
+// +build safe codec.safe appengine
 
 package codec
 
@@ -141,6 +141,54 @@ package codec
 // ------------------------------------------
 // Passing reflect.Kind to functions that take a reflect.Value
 //   - Note that reflect.Value.Kind() is very cheap, as its fundamentally a binary AND of 2 numbers
+//
+// ------------------------------------------
+// Transient values during decoding
+//
+// With reflection, the stack is not used. Consequently, values which may be stack-allocated in
+// normal use will cause a heap allocation when using reflection.
+//
+// There are cases where we know that a value is transient, and we just need to decode into it
+// temporarily so we can right away use its value for something else.
+//
+// In these situations, we can elide the heap allocation by being deliberate with use of a pre-cached
+// scratch memory or scratch value.
+//
+// We use this for situations:
+// - decode into a temp value x, and then set x into an interface
+// - decode into a temp value, for use as a map key, to lookup up a map value
+// - decode into a temp value, for use as a map value, to set into a map
+// - decode into a temp value, for sending into a channel
+//
+// By definition, Transient values are NEVER pointer-shaped values,
+// like pointer, func, map, chan. Using transient for pointer-shaped values
+// can lead to data corruption when GC tries to follow what it saw as a pointer at one point.
+//
+// In general, transient values are values which can be decoded as an atomic value
+// using a single call to the decDriver. This naturally includes bool or numeric types.
+//
+// Note that some values which "contain" pointers, specifically string and slice,
+// can also be transient. In the case of string, it is decoded as an atomic value.
+// In the case of a slice, decoding into its elements always uses an addressable
+// value in memory ie we grow the slice, and then decode directly into the memory
+// address corresponding to that index in the slice.
+//
+// To handle these string and slice values, we have to use a scratch value
+// which has the same shape of a string or slice.
+//
+// Consequently, the full range of types which can be transient is:
+// - numbers
+// - bool
+// - string
+// - slice
+//
+// and whbut we MUST use a scratch space with that element
+// being defined as an unsafe.Pointer to start with.
+//
+// We have to be careful with maps. Because we iterate map keys and values during a range,
+// we must have 2 variants of the scratch space/value for maps and keys separately.
+//
+// These are the TransientAddrK and TransientAddr2K methods of decPerType.
 
 import (
 	"encoding"
@@ -160,6 +208,17 @@ import (
 	"unicode/utf8"
 )
 
+// if debugging is true, then
+//   - within Encode/Decode, do not recover from panic's
+//   - etc
+//
+// Note: Negative tests that check for errors will fail, so only use this
+// when debugging, and run only one test at a time preferably.
+//
+// Note: RPC tests depend on getting the error from an Encode/Decode call.
+// Consequently, they will always fail if debugging = true.
+const debugging = false
+
 const (
 	// containerLenUnknown is length returned from Read(Map|Array)Len
 	// when a format doesn't know apiori.
@@ -170,6 +229,14 @@ const (
 	// when a 'nil' was encountered in the stream.
 	containerLenNil = math.MinInt32
 
+	// [N]byte is handled by converting to []byte first,
+	// and sending to the dedicated fast-path function for []byte.
+	//
+	// Code exists in case our understanding is wrong.
+	// keep the defensive code behind this flag, so we can remove/hide it if needed.
+	// For now, we enable the defensive code (ie set it to true).
+	handleBytesWithinKArray = true
+
 	// Support encoding.(Binary|Text)(Unm|M)arshaler.
 	// This constant flag will enable or disable it.
 	supportMarshalInterfaces = true
@@ -193,7 +260,30 @@ const (
 
 const cpu32Bit = ^uint(0)>>32 == 0
 
+type rkind byte
+
+const (
+	rkindPtr    = rkind(reflect.Ptr)
+	rkindString = rkind(reflect.String)
+	rkindChan   = rkind(reflect.Chan)
+)
+
+type mapKeyFastKind uint8
+
+const (
+	mapKeyFastKind32 = iota + 1
+	mapKeyFastKind32ptr
+	mapKeyFastKind64
+	mapKeyFastKind64ptr
+	mapKeyFastKindStr
+)
+
 var (
+	// use a global mutex to ensure each Handle is initialized.
+	// We do this, so we don't have to store the basicHandle mutex
+	// directly in BasicHandle, so it can be shallow-copied.
+	handleInitMu sync.Mutex
+
 	must mustHdl
 	halt panicHdl
 
@@ -205,6 +295,9 @@ var (
 	// numCharWithExpBitset64 bitset64
 	// numCharNoExpBitset64   bitset64
 	// whitespaceCharBitset64 bitset64
+	//
+	// // hasptrBitset sets bit for all kinds which always have internal pointers
+	// hasptrBitset bitset32
 
 	// refBitset sets bit for all kinds which are direct internal references
 	refBitset bitset32
@@ -212,14 +305,24 @@ var (
 	// isnilBitset sets bit for all kinds which can be compared to nil
 	isnilBitset bitset32
 
+	// numBoolBitset sets bit for all number and bool kinds
+	numBoolBitset bitset32
+
+	// numBoolStrSliceBitset sets bits for all kinds which are numbers, bool, strings and slices
+	numBoolStrSliceBitset bitset32
+
 	// scalarBitset sets bit for all kinds which are scalars/primitives and thus immutable
 	scalarBitset bitset32
 
+	mapKeyFastKindVals [32]mapKeyFastKind
+
 	// codecgen is set to true by codecgen, so that tests, etc can use this information as needed.
 	codecgen bool
 
 	oneByteArr    [1]byte
 	zeroByteSlice = oneByteArr[:0:0]
+
+	eofReader devNullReader
 )
 
 var (
@@ -231,7 +334,11 @@ var (
 	errExtFnConvertExtUnsupported = errors.New("InterfaceExt.ConvertExt is not supported")
 	errExtFnUpdateExtUnsupported  = errors.New("InterfaceExt.UpdateExt is not supported")
 
-	errPanicHdlUndefinedErr = errors.New("panic: undefined error")
+	errPanicUndefined = errors.New("panic: undefined error")
+
+	errHandleInited = errors.New("cannot modify initialized Handle")
+
+	errNoFormatHandle = errors.New("no handle (cannot identify format)")
 )
 
 var pool4tiload = sync.Pool{
@@ -245,7 +352,31 @@ var pool4tiload = sync.Pool{
 }
 
 func init() {
-	scalarBitset.
+	xx := func(f mapKeyFastKind, k ...reflect.Kind) {
+		for _, v := range k {
+			mapKeyFastKindVals[byte(v)&31] = f // 'v % 32' equal to 'v & 31'
+		}
+	}
+
+	var f mapKeyFastKind
+
+	f = mapKeyFastKind64
+	if wordSizeBits == 32 {
+		f = mapKeyFastKind32
+	}
+	xx(f, reflect.Int, reflect.Uint, reflect.Uintptr)
+
+	f = mapKeyFastKind64ptr
+	if wordSizeBits == 32 {
+		f = mapKeyFastKind32ptr
+	}
+	xx(f, reflect.Ptr)
+
+	xx(mapKeyFastKindStr, reflect.String)
+	xx(mapKeyFastKind32, reflect.Uint32, reflect.Int32, reflect.Float32)
+	xx(mapKeyFastKind64, reflect.Uint64, reflect.Int64, reflect.Float64)
+
+	numBoolBitset.
 		set(byte(reflect.Bool)).
 		set(byte(reflect.Int)).
 		set(byte(reflect.Int8)).
@@ -261,10 +392,20 @@ func init() {
 		set(byte(reflect.Float32)).
 		set(byte(reflect.Float64)).
 		set(byte(reflect.Complex64)).
-		set(byte(reflect.Complex128)).
+		set(byte(reflect.Complex128))
+
+	numBoolStrSliceBitset = numBoolBitset
+
+	numBoolStrSliceBitset.
+		set(byte(reflect.String)).
+		set(byte(reflect.Slice))
+
+	scalarBitset = numBoolBitset
+
+	scalarBitset.
 		set(byte(reflect.String))
 
-	// MARKER: reflect.Array is not a scalar, as its contents can be modified
+	// MARKER: reflect.Array is not a scalar, as its contents can be modified.
 
 	refBitset.
 		set(byte(reflect.Map)).
@@ -279,6 +420,11 @@ func init() {
 		set(byte(reflect.Interface)).
 		set(byte(reflect.Slice))
 
+	// hasptrBitset = isnilBitset
+	//
+	// hasptrBitset.
+	// 	set(byte(reflect.String))
+
 	for i := byte(0); i <= utf8.RuneSelf; i++ {
 		if (i >= '0' && i <= '9') || (i >= 'a' && i <= 'z') || (i >= 'A' && i <= 'Z') {
 			asciiAlphaNumBitset.set(i)
@@ -286,30 +432,36 @@ func init() {
 		switch i {
 		case ' ', '\t', '\r', '\n':
 			whitespaceCharBitset.set(i)
-			// whitespaceCharBitset64.set(i)
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			digitCharBitset.set(i)
 			numCharBitset.set(i)
-			// numCharWithExpBitset64.set(i - 42)
-			// numCharNoExpBitset64.set(i)
 		case '.', '+', '-':
 			numCharBitset.set(i)
-			// numCharWithExpBitset64.set(i - 42)
-			// numCharNoExpBitset64.set(i)
 		case 'e', 'E':
 			numCharBitset.set(i)
-			// numCharWithExpBitset64.set(i - 42)
 		}
 	}
 }
 
-type handleFlag uint8
+// driverStateManager supports the runtime state of an (enc|dec)Driver.
+//
+// During a side(En|De)code call, we can capture the state, reset it,
+// and then restore it later to continue the primary encoding/decoding.
+type driverStateManager interface {
+	resetState()
+	captureState() interface{}
+	restoreState(state interface{})
+}
 
-const (
-	initedHandleFlag handleFlag = 1 << iota
-	binaryHandleFlag
-	jsonHandleFlag
-)
+type bdAndBdread struct {
+	bdRead bool
+	bd     byte
+}
+
+func (x bdAndBdread) captureState() interface{}   { return x }
+func (x *bdAndBdread) resetState()                { x.bd, x.bdRead = 0, false }
+func (x *bdAndBdread) reset()                     { x.resetState() }
+func (x *bdAndBdread) restoreState(v interface{}) { *x = v.(bdAndBdread) }
 
 type clsErr struct {
 	err    error // error on closing
@@ -373,15 +525,6 @@ func (x valueType) String() string {
 	return strconv.FormatInt(int64(x), 10)
 }
 
-type seqType uint8
-
-const (
-	_ seqType = iota
-	seqTypeArray
-	seqTypeSlice
-	seqTypeChan
-)
-
 // note that containerMapStart and containerArraySend are not sent.
 // This is because the ReadXXXStart and EncodeXXXStart already does these.
 type containerState uint8
@@ -465,16 +608,33 @@ type isCodecEmptyer interface {
 }
 
 type codecError struct {
-	name string
-	err  error
+	err    error
+	name   string
+	pos    int
+	encode bool
 }
 
-func (e codecError) Cause() error {
+func (e *codecError) Cause() error {
 	return e.err
 }
 
-func (e codecError) Error() string {
-	return fmt.Sprintf("%s error: %v", e.name, e.err)
+func (e *codecError) Unwrap() error {
+	return e.err
+}
+
+func (e *codecError) Error() string {
+	if e.encode {
+		return fmt.Sprintf("%s encode error: %v", e.name, e.err)
+	}
+	return fmt.Sprintf("%s decode error [pos %d]: %v", e.name, e.pos, e.err)
+}
+
+func wrapCodecErr(in error, name string, numbytesread int, encode bool) (out error) {
+	x, ok := in.(*codecError)
+	if ok && x.pos == numbytesread && x.name == name && x.encode == encode {
+		return in
+	}
+	return &codecError{in, name, numbytesread, encode}
 }
 
 var (
@@ -512,10 +672,11 @@ var (
 	jsonMarshalerTyp   = reflect.TypeOf((*jsonMarshaler)(nil)).Elem()
 	jsonUnmarshalerTyp = reflect.TypeOf((*jsonUnmarshaler)(nil)).Elem()
 
-	selferTyp         = reflect.TypeOf((*Selfer)(nil)).Elem()
-	missingFielderTyp = reflect.TypeOf((*MissingFielder)(nil)).Elem()
-	iszeroTyp         = reflect.TypeOf((*isZeroer)(nil)).Elem()
-	isCodecEmptyerTyp = reflect.TypeOf((*isCodecEmptyer)(nil)).Elem()
+	selferTyp                = reflect.TypeOf((*Selfer)(nil)).Elem()
+	missingFielderTyp        = reflect.TypeOf((*MissingFielder)(nil)).Elem()
+	iszeroTyp                = reflect.TypeOf((*isZeroer)(nil)).Elem()
+	isCodecEmptyerTyp        = reflect.TypeOf((*isCodecEmptyer)(nil)).Elem()
+	isSelferViaCodecgenerTyp = reflect.TypeOf((*isSelferViaCodecgener)(nil)).Elem()
 
 	uint8TypId      = rt2id(uint8Typ)
 	uint8SliceTypId = rt2id(uint8SliceTyp)
@@ -559,9 +720,10 @@ var SelfExt = &extFailWrapper{}
 // By definition, it is not allowed for a Selfer to directly call Encode or Decode on itself.
 // If that is done, Encode/Decode will rightfully fail with a Stack Overflow style error.
 // For example, the snippet below will cause such an error.
-//     type testSelferRecur struct{}
-//     func (s *testSelferRecur) CodecEncodeSelf(e *Encoder) { e.MustEncode(s) }
-//     func (s *testSelferRecur) CodecDecodeSelf(d *Decoder) { d.MustDecode(s) }
+//
+//	type testSelferRecur struct{}
+//	func (s *testSelferRecur) CodecEncodeSelf(e *Encoder) { e.MustEncode(s) }
+//	func (s *testSelferRecur) CodecDecodeSelf(d *Decoder) { d.MustDecode(s) }
 //
 // Note: *the first set of bytes of any value MUST NOT represent nil in the format*.
 // This is because, during each decode, we first check the the next set of bytes
@@ -571,6 +733,10 @@ type Selfer interface {
 	CodecDecodeSelf(*Decoder)
 }
 
+type isSelferViaCodecgener interface {
+	codecSelferViaCodecgen()
+}
+
 // MissingFielder defines the interface allowing structs to internally decode or encode
 // values which do not map to struct fields.
 //
@@ -601,13 +767,14 @@ type MissingFielder interface {
 // This affords storing a map in a specific sequence in the stream.
 //
 // Example usage:
-//    type T1 []string         // or []int or []Point or any other "slice" type
-//    func (_ T1) MapBySlice{} // T1 now implements MapBySlice, and will be encoded as a map
-//    type T2 struct { KeyValues T1 }
 //
-//    var kvs = []string{"one", "1", "two", "2", "three", "3"}
-//    var v2 = T2{ KeyValues: T1(kvs) }
-//    // v2 will be encoded like the map: {"KeyValues": {"one": "1", "two": "2", "three": "3"} }
+//	type T1 []string         // or []int or []Point or any other "slice" type
+//	func (_ T1) MapBySlice{} // T1 now implements MapBySlice, and will be encoded as a map
+//	type T2 struct { KeyValues T1 }
+//
+//	var kvs = []string{"one", "1", "two", "2", "three", "3"}
+//	var v2 = T2{ KeyValues: T1(kvs) }
+//	// v2 will be encoded like the map: {"KeyValues": {"one": "1", "two": "2", "three": "3"} }
 //
 // The support of MapBySlice affords the following:
 //   - A slice or array type which implements MapBySlice will be encoded as a map
@@ -616,6 +783,39 @@ type MapBySlice interface {
 	MapBySlice()
 }
 
+// basicHandleRuntimeState holds onto all BasicHandle runtime and cached config information.
+//
+// Storing this outside BasicHandle allows us create shallow copies of a Handle,
+// which can be used e.g. when we need to modify config fields temporarily.
+// Shallow copies are used within tests, so we can modify some config fields for a test
+// temporarily when running tests in parallel, without running the risk that a test executing
+// in parallel with other tests does not see a transient modified values not meant for it.
+type basicHandleRuntimeState struct {
+	// these are used during runtime.
+	// At init time, they should have nothing in them.
+	rtidFns      atomicRtidFnSlice
+	rtidFnsNoExt atomicRtidFnSlice
+
+	// Note: basicHandleRuntimeState is not comparable, due to these slices here (extHandle, intf2impls).
+	// If *[]T is used instead, this becomes comparable, at the cost of extra indirection.
+	// Thses slices are used all the time, so keep as slices (not pointers).
+
+	extHandle
+
+	intf2impls
+
+	mu sync.Mutex
+
+	jsonHandle   bool
+	binaryHandle bool
+
+	// timeBuiltin is initialized from TimeNotBuiltin, and used internally.
+	// once initialized, it cannot be changed, as the function for encoding/decoding time.Time
+	// will have been cached and the TimeNotBuiltin value will not be consulted thereafter.
+	timeBuiltin bool
+	_           bool // padding
+}
+
 // BasicHandle encapsulates the common options and extension functions.
 //
 // Deprecated: DO NOT USE DIRECTLY. EXPORTED FOR GODOC BENEFIT. WILL BE REMOVED.
@@ -628,14 +828,7 @@ type BasicHandle struct {
 	// If not configured, the default TypeInfos is used, which uses struct tag keys: codec, json
 	TypeInfos *TypeInfos
 
-	// Note: BasicHandle is not comparable, due to these slices here (extHandle, intf2impls).
-	// If *[]T is used instead, this becomes comparable, at the cost of extra indirection.
-	// Thses slices are used all the time, so keep as slices (not pointers).
-
-	extHandle
-
-	rtidFns      atomicRtidFnSlice
-	rtidFnsNoExt atomicRtidFnSlice
+	*basicHandleRuntimeState
 
 	// ---- cache line
 
@@ -645,11 +838,6 @@ type BasicHandle struct {
 
 	EncodeOptions
 
-	intf2impls
-
-	mu     sync.Mutex
-	inited uint32 // holds if inited, and also handle flags (binary encoding, json handler, etc)
-
 	RPCOptions
 
 	// TimeNotBuiltin configures whether time.Time should be treated as a builtin type.
@@ -666,8 +854,7 @@ type BasicHandle struct {
 	//
 	// Note: DO NOT CHANGE AFTER FIRST USE.
 	//
-	// Once a Handle has been used, do not modify this option.
-	// It will lead to unexpected behaviour during encoding and decoding.
+	// Once a Handle has been initialized (used), do not modify this option. It will be ignored.
 	TimeNotBuiltin bool
 
 	// ExplicitRelease configures whether Release() is implicitly called after an encode or
@@ -693,16 +880,22 @@ type BasicHandle struct {
 	ExplicitRelease bool
 
 	// ---- cache line
+	inited uint32 // holds if inited, and also handle flags (binary encoding, json handler, etc)
+
 }
 
-// basicHandle returns an initialized BasicHandle from the Handle.
-func basicHandle(hh Handle) (x *BasicHandle) {
-	x = hh.getBasicHandle()
-	// ** We need to simulate once.Do, to ensure no data race within the block.
-	// ** Consequently, below would not work.
+// initHandle does a one-time initialization of the handle.
+// After this is run, do not modify the Handle, as some modifications are ignored
+// e.g. extensions, registered interfaces, TimeNotBuiltIn, etc
+func initHandle(hh Handle) {
+	x := hh.getBasicHandle()
+
+	// MARKER: We need to simulate once.Do, to ensure no data race within the block.
+	// Consequently, below would not work.
+	//
 	// if atomic.CompareAndSwapUint32(&x.inited, 0, 1) {
 	// 	x.be = hh.isBinary()
-	// 	_, x.js = hh.(*JsonHandle)
+	// 	x.js = hh.isJson
 	// 	x.n = hh.Name()[0]
 	// }
 
@@ -710,32 +903,90 @@ func basicHandle(hh Handle) (x *BasicHandle) {
 	// is not sufficient, since a race condition can occur within init(Handle) function.
 	// init is made noinline, so that this function can be inlined by its caller.
 	if atomic.LoadUint32(&x.inited) == 0 {
-		x.init(hh)
+		x.initHandle(hh)
 	}
-	return
 }
 
-func (x *BasicHandle) isJs() bool {
-	return handleFlag(x.inited)&jsonHandleFlag != 0
+func (x *BasicHandle) basicInit() {
+	x.rtidFns.store(nil)
+	x.rtidFnsNoExt.store(nil)
+	x.timeBuiltin = !x.TimeNotBuiltin
+}
+
+func (x *BasicHandle) init() {}
+
+func (x *BasicHandle) isInited() bool {
+	return atomic.LoadUint32(&x.inited) != 0
 }
 
-func (x *BasicHandle) isBe() bool {
-	return handleFlag(x.inited)&binaryHandleFlag != 0
+// clearInited: DANGEROUS - only use in testing, etc
+func (x *BasicHandle) clearInited() {
+	atomic.StoreUint32(&x.inited, 0)
+}
+
+// TimeBuiltin returns whether time.Time OOTB support is used,
+// based on the initial configuration of TimeNotBuiltin
+func (x *basicHandleRuntimeState) TimeBuiltin() bool {
+	return x.timeBuiltin
+}
+
+func (x *basicHandleRuntimeState) isJs() bool {
+	return x.jsonHandle
+}
+
+func (x *basicHandleRuntimeState) isBe() bool {
+	return x.binaryHandle
+}
+
+func (x *basicHandleRuntimeState) setExt(rt reflect.Type, tag uint64, ext Ext) (err error) {
+	rk := rt.Kind()
+	for rk == reflect.Ptr {
+		rt = rt.Elem()
+		rk = rt.Kind()
+	}
+
+	if rt.PkgPath() == "" || rk == reflect.Interface { // || rk == reflect.Ptr {
+		return fmt.Errorf("codec.Handle.SetExt: Takes named type, not a pointer or interface: %v", rt)
+	}
+
+	rtid := rt2id(rt)
+	// handle all natively supported type appropriately, so they cannot have an extension.
+	// However, we do not return an error for these, as we do not document that.
+	// Instead, we silently treat as a no-op, and return.
+	switch rtid {
+	case rawTypId, rawExtTypId:
+		return
+	case timeTypId:
+		if x.timeBuiltin {
+			return
+		}
+	}
+
+	for i := range x.extHandle {
+		v := &x.extHandle[i]
+		if v.rtid == rtid {
+			v.tag, v.ext = tag, ext
+			return
+		}
+	}
+	rtidptr := rt2id(reflect.PtrTo(rt))
+	x.extHandle = append(x.extHandle, extTypeTagFn{rtid, rtidptr, rt, tag, ext})
+	return
 }
 
+// initHandle should be called only from codec.initHandle global function.
+// make it uninlineable, as it is called at most once for each handle.
+//
 //go:noinline
-func (x *BasicHandle) init(hh Handle) {
-	// make it uninlineable, as it is called at most once
-	x.mu.Lock()
+func (x *BasicHandle) initHandle(hh Handle) {
+	handleInitMu.Lock()
+	defer handleInitMu.Unlock() // use defer, as halt may panic below
 	if x.inited == 0 {
-		var f = initedHandleFlag
-		if hh.isBinary() {
-			f |= binaryHandleFlag
-		}
-		if _, b := hh.(*JsonHandle); b {
-			f |= jsonHandleFlag
+		if x.basicHandleRuntimeState == nil {
+			x.basicHandleRuntimeState = new(basicHandleRuntimeState)
 		}
-		atomic.StoreUint32(&x.inited, uint32(f))
+		x.jsonHandle = hh.isJson()
+		x.binaryHandle = hh.isBinary()
 		// ensure MapType and SliceType are of correct type
 		if x.MapType != nil && x.MapType.Kind() != reflect.Map {
 			halt.onerror(errMapTypeNotMapKind)
@@ -743,19 +994,25 @@ func (x *BasicHandle) init(hh Handle) {
 		if x.SliceType != nil && x.SliceType.Kind() != reflect.Slice {
 			halt.onerror(errSliceTypeNotSliceKind)
 		}
+		x.basicInit()
+		hh.init()
+		atomic.StoreUint32(&x.inited, 1)
 	}
-	x.mu.Unlock()
 }
 
 func (x *BasicHandle) getBasicHandle() *BasicHandle {
 	return x
 }
 
-func (x *BasicHandle) getTypeInfo(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
-	if x.TypeInfos == nil {
-		return defTypeInfos.get(rtid, rt)
+func (x *BasicHandle) typeInfos() *TypeInfos {
+	if x.TypeInfos != nil {
+		return x.TypeInfos
 	}
-	return x.TypeInfos.get(rtid, rt)
+	return defTypeInfos
+}
+
+func (x *BasicHandle) getTypeInfo(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
+	return x.typeInfos().get(rtid, rt)
 }
 
 func findRtidFn(s []codecRtidFn, rtid uintptr) (i uint, fn *codecFn) {
@@ -782,14 +1039,14 @@ LOOP:
 }
 
 func (x *BasicHandle) fn(rt reflect.Type) (fn *codecFn) {
-	return x.fnVia(rt, &x.rtidFns, true)
+	return x.fnVia(rt, x.typeInfos(), &x.rtidFns, x.CheckCircularRef, true)
 }
 
 func (x *BasicHandle) fnNoExt(rt reflect.Type) (fn *codecFn) {
-	return x.fnVia(rt, &x.rtidFnsNoExt, false)
+	return x.fnVia(rt, x.typeInfos(), &x.rtidFnsNoExt, x.CheckCircularRef, false)
 }
 
-func (x *BasicHandle) fnVia(rt reflect.Type, fs *atomicRtidFnSlice, checkExt bool) (fn *codecFn) {
+func (x *basicHandleRuntimeState) fnVia(rt reflect.Type, tinfos *TypeInfos, fs *atomicRtidFnSlice, checkCircularRef, checkExt bool) (fn *codecFn) {
 	rtid := rt2id(rt)
 	sp := fs.load()
 	if sp != nil {
@@ -798,7 +1055,7 @@ func (x *BasicHandle) fnVia(rt reflect.Type, fs *atomicRtidFnSlice, checkExt boo
 		}
 	}
 
-	fn = x.fnLoad(rt, rtid, checkExt)
+	fn = x.fnLoad(rt, rtid, tinfos, checkCircularRef, checkExt)
 	x.mu.Lock()
 	sp = fs.load()
 	// since this is an atomic load/store, we MUST use a different array each time,
@@ -810,8 +1067,8 @@ func (x *BasicHandle) fnVia(rt reflect.Type, fs *atomicRtidFnSlice, checkExt boo
 		idx, fn2 := findRtidFn(sp, rtid)
 		if fn2 == nil {
 			sp2 := make([]codecRtidFn, len(sp)+1)
-			copy(sp2, sp[:idx])
 			copy(sp2[idx+1:], sp[idx:])
+			copy(sp2, sp[:idx])
 			sp2[idx] = codecRtidFn{rtid, fn}
 			fs.store(sp2)
 		}
@@ -820,10 +1077,27 @@ func (x *BasicHandle) fnVia(rt reflect.Type, fs *atomicRtidFnSlice, checkExt boo
 	return
 }
 
-func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *codecFn) {
+func fnloadFastpathUnderlying(ti *typeInfo) (f *fastpathE, u reflect.Type) {
+	var rtid uintptr
+	var idx int
+	rtid = rt2id(ti.fastpathUnderlying)
+	idx = fastpathAvIndex(rtid)
+	if idx == -1 {
+		return
+	}
+	f = &fastpathAv[idx]
+	if uint8(reflect.Array) == ti.kind {
+		u = reflectArrayOf(ti.rt.Len(), ti.elem)
+	} else {
+		u = f.rt
+	}
+	return
+}
+
+func (x *basicHandleRuntimeState) fnLoad(rt reflect.Type, rtid uintptr, tinfos *TypeInfos, checkCircularRef, checkExt bool) (fn *codecFn) {
 	fn = new(codecFn)
 	fi := &(fn.i)
-	ti := x.getTypeInfo(rtid, rt)
+	ti := tinfos.get(rtid, rt)
 	fi.ti = ti
 	rk := reflect.Kind(ti.kind)
 
@@ -832,9 +1106,9 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 	// it implementes one of the pre-declared interfaces.
 
 	fi.addrDf = true
-	fi.addrEf = true
+	// fi.addrEf = true
 
-	if rtid == timeTypId && !x.TimeNotBuiltin {
+	if rtid == timeTypId && x.timeBuiltin {
 		fn.fe = (*Encoder).kTime
 		fn.fd = (*Decoder).kTime
 	} else if rtid == rawTypId {
@@ -853,7 +1127,9 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 		if rk == reflect.Struct || rk == reflect.Array {
 			fi.addrE = true
 		}
-	} else if ti.flagSelfer || ti.flagSelferPtr {
+	} else if (ti.flagSelfer || ti.flagSelferPtr) &&
+		!(checkCircularRef && ti.flagSelferViaCodecgen && ti.kind == byte(reflect.Struct)) {
+		// do not use Selfer generated by codecgen if it is a struct and CheckCircularRef=true
 		fn.fe = (*Encoder).selferMarshal
 		fn.fd = (*Decoder).selferUnmarshal
 		fi.addrD = ti.flagSelferPtr
@@ -881,39 +1157,58 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 		fi.addrD = ti.flagTextUnmarshalerPtr
 		fi.addrE = ti.flagTextMarshalerPtr
 	} else {
-		if fastpathEnabled && (rk == reflect.Map || rk == reflect.Slice) {
-			if ti.pkgpath == "" { // un-named slice or map
-				if idx := fastpathAvIndex(rtid); idx != -1 {
+		if fastpathEnabled && (rk == reflect.Map || rk == reflect.Slice || rk == reflect.Array) {
+			// by default (without using unsafe),
+			// if an array is not addressable, converting from an array to a slice
+			// requires an allocation (see helper_not_unsafe.go: func rvGetSlice4Array).
+			//
+			// (Non-addressable arrays mostly occur as keys/values from a map).
+			//
+			// However, fastpath functions are mostly for slices of numbers or strings,
+			// which are small by definition and thus allocation should be fast/cheap in time.
+			//
+			// Consequently, the value of doing this quick allocation to elide the overhead cost of
+			// non-optimized (not-unsafe) reflection is a fair price.
+			var rtid2 uintptr
+			if !ti.flagHasPkgPath { // un-named type (slice or mpa or array)
+				rtid2 = rtid
+				if rk == reflect.Array {
+					rtid2 = rt2id(ti.key) // ti.key for arrays = reflect.SliceOf(ti.elem)
+				}
+				if idx := fastpathAvIndex(rtid2); idx != -1 {
 					fn.fe = fastpathAv[idx].encfn
 					fn.fd = fastpathAv[idx].decfn
 					fi.addrD = true
 					fi.addrDf = false
-				}
-			} else {
-				// use mapping for underlying type if there
-				var rtu reflect.Type
-				if rk == reflect.Map {
-					rtu = reflect.MapOf(ti.key, ti.elem)
-				} else {
-					rtu = reflect.SliceOf(ti.elem)
-				}
-				rtuid := rt2id(rtu)
-				if idx := fastpathAvIndex(rtuid); idx != -1 {
-					xfnf := fastpathAv[idx].encfn
-					xrt := fastpathAv[idx].rt
-					fn.fe = func(e *Encoder, xf *codecFnInfo, xrv reflect.Value) {
-						xfnf(e, xf, rvConvert(xrv, xrt))
+					if rk == reflect.Array {
+						fi.addrD = false // decode directly into array value (slice made from it)
 					}
-					fi.addrD = true
-					fi.addrDf = false // meaning it can be an address(ptr) or a value
-					xfnf2 := fastpathAv[idx].decfn
-					xptr2rt := reflect.PtrTo(xrt)
-					fn.fd = func(d *Decoder, xf *codecFnInfo, xrv reflect.Value) {
-						if xrv.Kind() == reflect.Ptr {
-							xfnf2(d, xf, rvConvert(xrv, xptr2rt))
-						} else {
+				}
+			} else { // named type (with underlying type of map or slice or array)
+				// try to use mapping for underlying type
+				xfe, xrt := fnloadFastpathUnderlying(ti)
+				if xfe != nil {
+					xfnf := xfe.encfn
+					xfnf2 := xfe.decfn
+					if rk == reflect.Array {
+						fi.addrD = false // decode directly into array value (slice made from it)
+						fn.fd = func(d *Decoder, xf *codecFnInfo, xrv reflect.Value) {
 							xfnf2(d, xf, rvConvert(xrv, xrt))
 						}
+					} else {
+						fi.addrD = true
+						fi.addrDf = false // meaning it can be an address(ptr) or a value
+						xptr2rt := reflect.PtrTo(xrt)
+						fn.fd = func(d *Decoder, xf *codecFnInfo, xrv reflect.Value) {
+							if xrv.Kind() == reflect.Ptr {
+								xfnf2(d, xf, rvConvert(xrv, xptr2rt))
+							} else {
+								xfnf2(d, xf, rvConvert(xrv, xrt))
+							}
+						}
+					}
+					fn.fe = func(e *Encoder, xf *codecFnInfo, xrv reflect.Value) {
+						xfnf(e, xf, rvConvert(xrv, xrt))
 					}
 				}
 			}
@@ -924,9 +1219,9 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 				fn.fe = (*Encoder).kBool
 				fn.fd = (*Decoder).kBool
 			case reflect.String:
-				// Do not use different functions based on StringToRaw option,
-				// as that will statically set the function for a string type,
-				// and if the Handle is modified thereafter, behaviour is non-deterministic.
+				// Do not use different functions based on StringToRaw option, as that will statically
+				// set the function for a string type, and if the Handle is modified thereafter,
+				// behaviour is non-deterministic
 				// i.e. DO NOT DO:
 				//   if x.StringToRaw {
 				//   	fn.fe = (*Encoder).kStringToRaw
@@ -975,22 +1270,22 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 			case reflect.Float64:
 				fn.fe = (*Encoder).kFloat64
 				fn.fd = (*Decoder).kFloat64
+			case reflect.Complex64:
+				fn.fe = (*Encoder).kComplex64
+				fn.fd = (*Decoder).kComplex64
+			case reflect.Complex128:
+				fn.fe = (*Encoder).kComplex128
+				fn.fd = (*Decoder).kComplex128
 			case reflect.Chan:
-				fi.seq = seqTypeChan
 				fn.fe = (*Encoder).kChan
-				fn.fd = (*Decoder).kSliceForChan
+				fn.fd = (*Decoder).kChan
 			case reflect.Slice:
-				fi.seq = seqTypeSlice
 				fn.fe = (*Encoder).kSlice
 				fn.fd = (*Decoder).kSlice
 			case reflect.Array:
-				fi.seq = seqTypeArray
+				fi.addrD = false // decode directly into array value (slice made from it)
 				fn.fe = (*Encoder).kArray
-				rt2 := reflect.SliceOf(ti.elem)
-				fn.fd = func(d *Decoder, xf *codecFnInfo, xrv reflect.Value) {
-					// call fnVia directly, so fn(...) is not recursive, and this can be inlined
-					d.h.fnVia(rt2, &x.rtidFns, true).fd(d, xf, rvGetSlice4Array(xrv, rt2))
-				}
+				fn.fd = (*Decoder).kArray
 			case reflect.Struct:
 				if ti.anyOmitEmpty ||
 					ti.flagMissingFielder ||
@@ -1034,14 +1329,15 @@ func (x *BasicHandle) fnLoad(rt reflect.Type, rtid uintptr, checkExt bool) (fn *
 // Such a pre-configured Handle is safe for concurrent access.
 type Handle interface {
 	Name() string
-	// return the basic handle. It may not have been inited.
-	// Prefer to use basicHandle() helper function that ensures it has been inited.
 	getBasicHandle() *BasicHandle
 	newEncDriver() encDriver
 	newDecDriver() decDriver
 	isBinary() bool
+	isJson() bool // json is special for now, so track it
 	// desc describes the current byte descriptor, or returns "unknown[XXX]" if not understood.
 	desc(bd byte) string
+	// init initializes the handle based on handle-specific info (beyond what is in BasicHandle)
+	init()
 }
 
 // Raw represents raw formatted bytes.
@@ -1067,6 +1363,14 @@ type RawExt struct {
 	Value interface{}
 }
 
+func (re *RawExt) setData(xbs []byte, zerocopy bool) {
+	if zerocopy {
+		re.Data = xbs
+	} else {
+		re.Data = append(re.Data[:0], xbs...)
+	}
+}
+
 // BytesExt handles custom (de)serialization of types to/from []byte.
 // It is used by codecs (e.g. binc, msgpack, simple) which do custom serialization of the types.
 type BytesExt interface {
@@ -1112,13 +1416,13 @@ type addExtWrapper struct {
 }
 
 func (x addExtWrapper) WriteExt(v interface{}) []byte {
-	bs, err := x.encFn(rv4i(v))
+	bs, err := x.encFn(reflect.ValueOf(v))
 	halt.onerror(err)
 	return bs
 }
 
 func (x addExtWrapper) ReadExt(v interface{}, bs []byte) {
-	halt.onerror(x.decFn(rv4i(v), bs))
+	halt.onerror(x.decFn(reflect.ValueOf(v), bs))
 }
 
 func (x addExtWrapper) ConvertExt(v interface{}) interface{} {
@@ -1167,10 +1471,16 @@ type extFailWrapper struct {
 type binaryEncodingType struct{}
 
 func (binaryEncodingType) isBinary() bool { return true }
+func (binaryEncodingType) isJson() bool   { return false }
 
 type textEncodingType struct{}
 
 func (textEncodingType) isBinary() bool { return false }
+func (textEncodingType) isJson() bool   { return false }
+
+type notJsonType struct{}
+
+func (notJsonType) isJson() bool { return false }
 
 // noBuiltInTypes is embedded into many types which do not support builtins
 // e.g. msgpack, simple, cbor.
@@ -1252,6 +1562,10 @@ func (z bigenHelper) writeUint16(w *encWr, v uint16) {
 }
 
 func (z bigenHelper) writeUint32(w *encWr, v uint32) {
+	// w.writeb((z.PutUint32(v))[:])
+	// x := z.PutUint32(v)
+	// w.writeb(x[:])
+	// w.writen4(x[0], x[1], x[2], x[3])
 	w.writen4(z.PutUint32(v))
 }
 
@@ -1273,13 +1587,13 @@ type extHandle []extTypeTagFn
 // To deregister an Ext, call AddExt with nil encfn and/or nil decfn.
 //
 // Deprecated: Use SetBytesExt or SetInterfaceExt on the Handle instead.
-func (o *extHandle) AddExt(rt reflect.Type, tag byte,
+func (x *BasicHandle) AddExt(rt reflect.Type, tag byte,
 	encfn func(reflect.Value) ([]byte, error),
 	decfn func(reflect.Value, []byte) error) (err error) {
 	if encfn == nil || decfn == nil {
-		return o.SetExt(rt, uint64(tag), nil)
+		return x.SetExt(rt, uint64(tag), nil)
 	}
-	return o.SetExt(rt, uint64(tag), addExtWrapper{encfn, decfn})
+	return x.SetExt(rt, uint64(tag), addExtWrapper{encfn, decfn})
 }
 
 // SetExt will set the extension for a tag and reflect.Type.
@@ -1288,39 +1602,14 @@ func (o *extHandle) AddExt(rt reflect.Type, tag byte,
 // To Deregister an ext, call SetExt with nil Ext.
 //
 // Deprecated: Use SetBytesExt or SetInterfaceExt on the Handle instead.
-func (o *extHandle) SetExt(rt reflect.Type, tag uint64, ext Ext) (err error) {
-	// o is a pointer, because we may need to initialize it
-	// We EXPECT *o is a pointer to a non-nil extHandle.
-
-	rk := rt.Kind()
-	for rk == reflect.Ptr {
-		rt = rt.Elem()
-		rk = rt.Kind()
-	}
-
-	if rt.PkgPath() == "" || rk == reflect.Interface { // || rk == reflect.Ptr {
-		return fmt.Errorf("codec.Handle.SetExt: Takes named type, not a pointer or interface: %v", rt)
+func (x *BasicHandle) SetExt(rt reflect.Type, tag uint64, ext Ext) (err error) {
+	if x.isInited() {
+		return errHandleInited
 	}
-
-	rtid := rt2id(rt)
-	switch rtid {
-	case timeTypId, rawTypId, rawExtTypId:
-		// all natively supported type, so cannot have an extension.
-		// However, we do not return an error for these, as we do not document that.
-		// Instead, we silently treat as a no-op, and return.
-		return
+	if x.basicHandleRuntimeState == nil {
+		x.basicHandleRuntimeState = new(basicHandleRuntimeState)
 	}
-	o2 := *o
-	for i := range o2 {
-		v := &o2[i]
-		if v.rtid == rtid {
-			v.tag, v.ext = tag, ext
-			return
-		}
-	}
-	rtidptr := rt2id(reflect.PtrTo(rt))
-	*o = append(o2, extTypeTagFn{rtid, rtidptr, rt, tag, ext})
-	return
+	return x.basicHandleRuntimeState.setExt(rt, tag, ext)
 }
 
 func (o extHandle) getExtForI(x interface{}) (v *extTypeTagFn) {
@@ -1454,7 +1743,7 @@ func (path *structFieldInfoPathNode) fieldAlloc(v reflect.Value) (rv2 reflect.Va
 		v = parent.fieldAlloc(v)
 		for j, k := uint8(0), parent.numderef; j < k; j++ {
 			if rvIsNil(v) {
-				rvSetDirect(v, reflect.New(rvType(v).Elem()))
+				rvSetDirect(v, reflect.New(v.Type().Elem()))
 			}
 			v = v.Elem()
 		}
@@ -1465,6 +1754,8 @@ func (path *structFieldInfoPathNode) fieldAlloc(v reflect.Value) (rv2 reflect.Va
 type structFieldInfo struct {
 	encName string // encode name
 
+	// encNameHash uintptr
+
 	// fieldName string // currently unused
 
 	// encNameAsciiAlphaNum and omitEmpty should be here,
@@ -1478,7 +1769,11 @@ func parseStructInfo(stag string) (toArray, omitEmpty bool, keytype valueType) {
 	if stag == "" {
 		return
 	}
-	for _, s := range strings.Split(stag, ",")[1:] {
+	ss := strings.Split(stag, ",")
+	if len(ss) < 2 {
+		return
+	}
+	for _, s := range ss[1:] {
 		switch s {
 		case "omitempty":
 			omitEmpty = true
@@ -1520,8 +1815,28 @@ func (si *structFieldInfo) parseTag(stag string) {
 type sfiSortedByEncName []*structFieldInfo
 
 func (p sfiSortedByEncName) Len() int           { return len(p) }
-func (p sfiSortedByEncName) Less(i, j int) bool { return p[uint(i)].encName < p[uint(j)].encName }
 func (p sfiSortedByEncName) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p sfiSortedByEncName) Less(i, j int) bool { return p[uint(i)].encName < p[uint(j)].encName }
+
+// typeInfo4Container holds information that is only available for
+// containers like map, array, chan, slice.
+type typeInfo4Container struct {
+	elem reflect.Type
+	// key is:
+	//   - if map kind: map key
+	//   - if array kind: sliceOf(elem)
+	//   - if chan kind: sliceof(elem)
+	key reflect.Type
+
+	// fastpathUnderlying is underlying type of a named slice/map/array, as defined by go spec,
+	// that is used by fastpath where we defined fastpath functions for the underlying type.
+	//
+	// for a map, it's a map; for a slice or array, it's a slice; else its nil.
+	fastpathUnderlying reflect.Type
+
+	tikey  *typeInfo
+	tielem *typeInfo
+}
 
 // typeInfo keeps static (non-changing readonly)information
 // about each (non-ptr) type referenced in the encode/decode sequence.
@@ -1533,9 +1848,10 @@ func (p sfiSortedByEncName) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uin
 //   - If type is text(M/Unm)arshaler, call Text(M/Unm)arshal method
 //   - Else decode appropriately based on the reflect.Kind
 type typeInfo struct {
-	rt      reflect.Type
-	elem    reflect.Type
-	pkgpath string
+	rt  reflect.Type
+	ptr reflect.Type
+
+	// pkgpath string
 
 	rtid uintptr
 
@@ -1548,20 +1864,24 @@ type typeInfo struct {
 	keyType      valueType // if struct, how is the field name stored in a stream? default is string
 	mbs          bool      // base type (T or *T) is a MapBySlice
 
-	// ---- cpu cache line boundary?
-	sfiSort []*structFieldInfo // sorted. Used when enc/dec struct to map.
-	sfiSrc  []*structFieldInfo // unsorted. Used when enc/dec struct to array.
-
-	sfi4Name map[string]*structFieldInfo
+	sfi4Name map[string]*structFieldInfo // map. used for finding sfi given a name
 
-	key reflect.Type
+	*typeInfo4Container
 
 	// ---- cpu cache line boundary?
 
 	size, keysize, elemsize uint32
 
-	// other flags, with individual bits representing if set.
-	flagComparable  bool
+	keykind, elemkind uint8
+
+	flagHasPkgPath   bool // Type.PackagePath != ""
+	flagComparable   bool
+	flagCanTransient bool
+
+	flagMarshalInterface  bool // does this have custom (un)marshal implementation?
+	flagSelferViaCodecgen bool
+
+	// custom implementation flags
 	flagIsZeroer    bool
 	flagIsZeroerPtr bool
 
@@ -1594,27 +1914,23 @@ type typeInfo struct {
 
 	infoFieldOmitempty bool
 
-	keykind, elemkind uint8
+	sfi structFieldInfos
 }
 
-func (ti *typeInfo) siForEncName(name string) (si *structFieldInfo) {
-	// binary search for map lookup is expensive, as it has to compare strings byte by byte.
-	// map (hash) lookup is faster, as it can leverage string length in disambiguation.
-	return ti.sfi4Name[name]
+func (ti *typeInfo) siForEncName(name []byte) (si *structFieldInfo) {
+	return ti.sfi4Name[string(name)]
 }
 
-// resolves the struct field info got from a call to rget.
-// Returns a trimmed, unsorted and sorted []*structFieldInfo.
-func (ti *typeInfo) init(x []structFieldInfo, ss map[string]uint16) {
-	n := len(x)
+func (ti *typeInfo) resolve(x []structFieldInfo, ss map[string]uint16) (n int) {
+	n = len(x)
 
 	for i := range x {
 		ui := uint16(i)
-		xn := x[ui].encName // fieldName or encName? use encName for now.
+		xn := x[i].encName
 		j, ok := ss[xn]
 		if ok {
-			i2clear := ui                               // index to be cleared
-			if x[ui].path.depth() < x[j].path.depth() { // this one is shallower
+			i2clear := ui                              // index to be cleared
+			if x[i].path.depth() < x[j].path.depth() { // this one is shallower
 				ss[xn] = ui
 				i2clear = j
 			}
@@ -1627,12 +1943,18 @@ func (ti *typeInfo) init(x []structFieldInfo, ss map[string]uint16) {
 		}
 	}
 
+	return
+}
+
+func (ti *typeInfo) init(x []structFieldInfo, n int) {
 	var anyOmitEmpty bool
 
 	// remove all the nils (non-ready)
-	m := make(map[string]*structFieldInfo)
+	m := make(map[string]*structFieldInfo, n)
 	w := make([]structFieldInfo, n)
-	y := make([]*structFieldInfo, n)
+	y := make([]*structFieldInfo, n+n)
+	z := y[n:]
+	y = y[:n]
 	n = 0
 	for i := range x {
 		if x[i].encName == "" {
@@ -1650,16 +1972,72 @@ func (ti *typeInfo) init(x []structFieldInfo, ss map[string]uint16) {
 		halt.errorf("failure reading struct %v - expecting %d of %d valid fields, got %d", ti.rt, len(y), len(x), n)
 	}
 
-	z := make([]*structFieldInfo, len(y))
 	copy(z, y)
 	sort.Sort(sfiSortedByEncName(z))
 
 	ti.anyOmitEmpty = anyOmitEmpty
-	ti.sfiSrc = y
-	ti.sfiSort = z
+	ti.sfi.load(y, z)
 	ti.sfi4Name = m
 }
 
+// Handling flagCanTransient
+//
+// We support transient optimization if the kind of the type is
+// a number, bool, string, or slice (of number/bool).
+// In addition, we also support if the kind is struct or array,
+// and the type does not contain any pointers recursively).
+//
+// Noteworthy that all reference types (string, slice, func, map, ptr, interface, etc) have pointers.
+//
+// If using transient for a type with a pointer, there is the potential for data corruption
+// when GC tries to follow a "transient" pointer which may become a non-pointer soon after.
+//
+
+func transientBitsetFlags() *bitset32 {
+	if transientValueHasStringSlice {
+		return &numBoolStrSliceBitset
+	}
+	return &numBoolBitset
+}
+
+func isCanTransient(t reflect.Type, k reflect.Kind) (v bool) {
+	var bs = transientBitsetFlags()
+	if bs.isset(byte(k)) {
+		v = true
+	} else if k == reflect.Slice {
+		elem := t.Elem()
+		v = numBoolBitset.isset(byte(elem.Kind()))
+	} else if k == reflect.Array {
+		elem := t.Elem()
+		v = isCanTransient(elem, elem.Kind())
+	} else if k == reflect.Struct {
+		v = true
+		for j, jlen := 0, t.NumField(); j < jlen; j++ {
+			f := t.Field(j)
+			if !isCanTransient(f.Type, f.Type.Kind()) {
+				v = false
+				return
+			}
+		}
+	} else {
+		v = false
+	}
+	return
+}
+
+func (ti *typeInfo) doSetFlagCanTransient() {
+	if transientSizeMax > 0 {
+		ti.flagCanTransient = ti.size <= transientSizeMax
+	} else {
+		ti.flagCanTransient = true
+	}
+	if ti.flagCanTransient {
+		if !transientBitsetFlags().isset(ti.kind) {
+			ti.flagCanTransient = isCanTransient(ti.rt, reflect.Kind(ti.kind))
+		}
+	}
+}
+
 type rtid2ti struct {
 	rtid uintptr
 	ti   *typeInfo
@@ -1720,32 +2098,45 @@ LOOP:
 }
 
 func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
+	if pti = x.find(rtid); pti == nil {
+		pti = x.load(rt)
+	}
+	return
+}
+
+func (x *TypeInfos) find(rtid uintptr) (pti *typeInfo) {
 	sp := x.infos.load()
 	if sp != nil {
 		_, pti = findTypeInfo(sp, rtid)
-		if pti != nil {
-			return
-		}
 	}
+	return
+}
 
+func (x *TypeInfos) load(rt reflect.Type) (pti *typeInfo) {
 	rk := rt.Kind()
 
 	if rk == reflect.Ptr { // || (rk == reflect.Interface && rtid != intfTypId) {
 		halt.errorf("invalid kind passed to TypeInfos.get: %v - %v", rk, rt)
 	}
 
+	rtid := rt2id(rt)
+
 	// do not hold lock while computing this.
 	// it may lead to duplication, but that's ok.
 	ti := typeInfo{
 		rt:      rt,
+		ptr:     reflect.PtrTo(rt),
 		rtid:    rtid,
 		kind:    uint8(rk),
 		size:    uint32(rt.Size()),
 		numMeth: uint16(rt.NumMethod()),
-		pkgpath: rt.PkgPath(),
 		keyType: valueTypeString, // default it - so it's never 0
+
+		// pkgpath: rt.PkgPath(),
+		flagHasPkgPath: rt.PkgPath() != "",
 	}
 
+	// bset sets custom implementation flags
 	bset := func(when bool, b *bool) {
 		if when {
 			*b = true
@@ -1784,9 +2175,26 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 	b1, b2 = implIntf(rt, isCodecEmptyerTyp)
 	bset(b1, &ti.flagIsCodecEmptyer)
 	bset(b2, &ti.flagIsCodecEmptyerPtr)
+
+	b1, b2 = implIntf(rt, isSelferViaCodecgenerTyp)
+	ti.flagSelferViaCodecgen = b1 || b2
+
+	ti.flagMarshalInterface = ti.flagSelfer || ti.flagSelferPtr ||
+		ti.flagSelferViaCodecgen ||
+		ti.flagBinaryMarshaler || ti.flagBinaryMarshalerPtr ||
+		ti.flagBinaryUnmarshaler || ti.flagBinaryUnmarshalerPtr ||
+		ti.flagTextMarshaler || ti.flagTextMarshalerPtr ||
+		ti.flagTextUnmarshaler || ti.flagTextUnmarshalerPtr ||
+		ti.flagJsonMarshaler || ti.flagJsonMarshalerPtr ||
+		ti.flagJsonUnmarshaler || ti.flagJsonUnmarshalerPtr
+
 	b1 = rt.Comparable()
-	bset(b1, &ti.flagComparable)
+	// bset(b1, &ti.flagComparable)
+	ti.flagComparable = b1
+
+	ti.doSetFlagCanTransient()
 
+	var tt reflect.Type
 	switch rk {
 	case reflect.Struct:
 		var omitEmpty bool
@@ -1800,30 +2208,55 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 		pv := pi.(*typeInfoLoad)
 		pv.reset()
 		pv.etypes = append(pv.etypes, ti.rtid)
-		x.rget(rt, rtid, omitEmpty, nil, pv)
-		ti.init(pv.sfis, pv.sfiNames)
+		x.rget(rt, rtid, nil, pv, omitEmpty)
+		n := ti.resolve(pv.sfis, pv.sfiNames)
+		ti.init(pv.sfis, n)
 		pp.Put(pi)
 	case reflect.Map:
+		ti.typeInfo4Container = new(typeInfo4Container)
 		ti.elem = rt.Elem()
+		for tt = ti.elem; tt.Kind() == reflect.Ptr; tt = tt.Elem() {
+		}
+		ti.tielem = x.get(rt2id(tt), tt)
 		ti.elemkind = uint8(ti.elem.Kind())
 		ti.elemsize = uint32(ti.elem.Size())
 		ti.key = rt.Key()
+		for tt = ti.key; tt.Kind() == reflect.Ptr; tt = tt.Elem() {
+		}
+		ti.tikey = x.get(rt2id(tt), tt)
 		ti.keykind = uint8(ti.key.Kind())
 		ti.keysize = uint32(ti.key.Size())
+		if ti.flagHasPkgPath {
+			ti.fastpathUnderlying = reflect.MapOf(ti.key, ti.elem)
+		}
 	case reflect.Slice:
+		ti.typeInfo4Container = new(typeInfo4Container)
 		ti.mbs, b2 = implIntf(rt, mapBySliceTyp)
 		if !ti.mbs && b2 {
 			ti.mbs = b2
 		}
 		ti.elem = rt.Elem()
+		for tt = ti.elem; tt.Kind() == reflect.Ptr; tt = tt.Elem() {
+		}
+		ti.tielem = x.get(rt2id(tt), tt)
 		ti.elemkind = uint8(ti.elem.Kind())
 		ti.elemsize = uint32(ti.elem.Size())
+		if ti.flagHasPkgPath {
+			ti.fastpathUnderlying = reflect.SliceOf(ti.elem)
+		}
 	case reflect.Chan:
+		ti.typeInfo4Container = new(typeInfo4Container)
 		ti.elem = rt.Elem()
+		for tt = ti.elem; tt.Kind() == reflect.Ptr; tt = tt.Elem() {
+		}
+		ti.tielem = x.get(rt2id(tt), tt)
 		ti.elemkind = uint8(ti.elem.Kind())
 		ti.elemsize = uint32(ti.elem.Size())
 		ti.chandir = uint8(rt.ChanDir())
+		ti.key = reflect.SliceOf(ti.elem)
+		ti.keykind = uint8(reflect.Slice)
 	case reflect.Array:
+		ti.typeInfo4Container = new(typeInfo4Container)
 		ti.mbs, b2 = implIntf(rt, mapBySliceTyp)
 		if !ti.mbs && b2 {
 			ti.mbs = b2
@@ -1831,14 +2264,25 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 		ti.elem = rt.Elem()
 		ti.elemkind = uint8(ti.elem.Kind())
 		ti.elemsize = uint32(ti.elem.Size())
-	case reflect.Ptr:
-		ti.elem = rt.Elem()
-		ti.elemkind = uint8(ti.elem.Kind())
-		ti.elemsize = uint32(ti.elem.Size())
+		for tt = ti.elem; tt.Kind() == reflect.Ptr; tt = tt.Elem() {
+		}
+		ti.tielem = x.get(rt2id(tt), tt)
+		ti.key = reflect.SliceOf(ti.elem)
+		ti.keykind = uint8(reflect.Slice)
+		ti.keysize = uint32(ti.key.Size())
+		if ti.flagHasPkgPath {
+			ti.fastpathUnderlying = ti.key
+		}
+
+		// MARKER: reflect.Ptr cannot happen here, as we halt early if reflect.Ptr passed in
+		// case reflect.Ptr:
+		// 	ti.elem = rt.Elem()
+		// 	ti.elemkind = uint8(ti.elem.Kind())
+		// 	ti.elemsize = uint32(ti.elem.Size())
 	}
 
 	x.mu.Lock()
-	sp = x.infos.load()
+	sp := x.infos.load()
 	// since this is an atomic load/store, we MUST use a different array each time,
 	// else we have a data race when a store is happening simultaneously with a findRtidFn call.
 	if sp == nil {
@@ -1851,8 +2295,8 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 		if pti == nil {
 			pti = &ti
 			sp2 := make([]rtid2ti, len(sp)+1)
-			copy(sp2, sp[:idx])
 			copy(sp2[idx+1:], sp[idx:])
+			copy(sp2, sp[:idx])
 			sp2[idx] = rtid2ti{rtid, pti}
 			x.infos.store(sp2)
 		}
@@ -1861,8 +2305,8 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 	return
 }
 
-func (x *TypeInfos) rget(rt reflect.Type, rtid uintptr, omitEmpty bool,
-	path *structFieldInfoPathNode, pv *typeInfoLoad) {
+func (x *TypeInfos) rget(rt reflect.Type, rtid uintptr,
+	path *structFieldInfoPathNode, pv *typeInfoLoad, omitEmpty bool) {
 	// Read up fields and store how to access the value.
 	//
 	// It uses go's rules for message selectors,
@@ -1876,9 +2320,10 @@ LOOP:
 	for j, jlen := uint16(0), uint16(flen); j < jlen; j++ {
 		f := rt.Field(int(j))
 		fkind := f.Type.Kind()
+
 		// skip if a func type, or is unexported, or structTag value == "-"
 		switch fkind {
-		case reflect.Func, reflect.Complex64, reflect.Complex128, reflect.UnsafePointer:
+		case reflect.Func, reflect.UnsafePointer:
 			continue LOOP
 		}
 
@@ -1950,7 +2395,7 @@ LOOP:
 						kind:     uint8(fkind),
 						numderef: numderef,
 					}
-					x.rget(ft, ftid, omitEmpty, path2, pv)
+					x.rget(ft, ftid, path2, pv, omitEmpty)
 				}
 				continue
 			}
@@ -1982,12 +2427,12 @@ LOOP:
 			si.encName = f.Name
 		}
 
+		// si.encNameHash = maxUintptr() // hashShortString(bytesView(si.encName))
+
 		if omitEmpty {
 			si.path.omitEmpty = true
 		}
 
-		// si.fieldName = f.Name
-		// si.path.encNameAsciiAlphaNum = true
 		for i := len(si.encName) - 1; i >= 0; i-- { // bounds-check elimination
 			if !asciiAlphaNumBitset.isset(si.encName[i]) {
 				si.path.encNameAsciiAlphaNum = false
@@ -2018,60 +2463,12 @@ func implIntf(rt, iTyp reflect.Type) (base bool, indir bool) {
 	return
 }
 
-// isEmptyStruct is only called from isEmptyValue, and checks if a struct is empty:
-//    - does it implement IsZero() bool
-//    - is it comparable, and can i compare directly using ==
-//    - if checkStruct, then walk through the encodable fields
-//      and check if they are empty or not.
-func isEmptyStruct(v reflect.Value, tinfos *TypeInfos, recursive bool) bool {
-	// v is a struct kind - no need to check again.
-	// We only check isZero on a struct kind, to reduce the amount of times
-	// that we lookup the rtid and typeInfo for each type as we walk the tree.
-
-	vt := rvType(v)
-	rtid := rt2id(vt)
-	if tinfos == nil {
-		tinfos = defTypeInfos
-	}
-	ti := tinfos.get(rtid, vt)
-	if ti.rtid == timeTypId {
-		return rv2i(v).(time.Time).IsZero()
-	}
-	if ti.flagIsZeroerPtr && v.CanAddr() {
-		return rv2i(v.Addr()).(isZeroer).IsZero()
-	}
-	if ti.flagIsZeroer {
-		return rv2i(v).(isZeroer).IsZero()
-	}
-	if ti.flagIsCodecEmptyerPtr && v.CanAddr() {
-		return rv2i(v.Addr()).(isCodecEmptyer).IsCodecEmpty()
-	}
-	if ti.flagIsCodecEmptyer {
-		return rv2i(v).(isCodecEmptyer).IsCodecEmpty()
-	}
-	if ti.flagComparable {
-		return rv2i(v) == rv2i(rvZeroK(vt, reflect.Struct))
-	}
-	if !recursive {
-		return false
-	}
-	// We only care about what we can encode/decode,
-	// so that is what we use to check omitEmpty.
-	for _, si := range ti.sfiSrc {
-		sfv := si.path.field(v)
-		if sfv.IsValid() && !isEmptyValue(sfv, tinfos, recursive) {
-			return false
-		}
-	}
-	return true
-}
-
-func panicToErr(h errDecorator, err *error) {
-	// Note: This method MUST be called directly from defer i.e. defer panicToErr ...
-	// else it seems the recover is not fully handled
-	if x := recover(); x != nil {
-		panicValToErr(h, x, err)
+func bool2int(b bool) (v uint8) {
+	// MARKER: optimized to be a single instruction
+	if b {
+		v = 1
 	}
+	return
 }
 
 func isSliceBoundsError(s string) bool {
@@ -2079,7 +2476,14 @@ func isSliceBoundsError(s string) bool {
 		strings.Contains(s, "slice bounds out of range")
 }
 
+func sprintf(format string, v ...interface{}) string {
+	return fmt.Sprintf(format, v...)
+}
+
 func panicValToErr(h errDecorator, v interface{}, err *error) {
+	if v == *err {
+		return
+	}
 	switch xerr := v.(type) {
 	case nil:
 	case runtime.Error:
@@ -2104,18 +2508,24 @@ func panicValToErr(h errDecorator, v interface{}, err *error) {
 	}
 }
 
-func isImmutableKind(k reflect.Kind) (v bool) {
-	return scalarBitset.isset(byte(k))
-}
-
-func usableByteSlice(bs []byte, slen int) []byte {
-	if cap(bs) < slen {
-		return make([]byte, slen)
+func usableByteSlice(bs []byte, slen int) (out []byte, changed bool) {
+	const maxCap = 1024 * 1024 * 64 // 64MB
+	const skipMaxCap = false        // allow to test
+	if slen <= 0 {
+		return []byte{}, true
+	}
+	if slen <= cap(bs) {
+		return bs[:slen], false
 	}
-	if bs == nil {
-		return []byte{}
+	// slen > cap(bs) ... handle memory overload appropriately
+	if skipMaxCap || slen <= maxCap {
+		return make([]byte, slen), true
 	}
-	return bs[:slen]
+	return make([]byte, maxCap), true
+}
+
+func mapKeyFastKindFor(k reflect.Kind) mapKeyFastKind {
+	return mapKeyFastKindVals[k&31]
 }
 
 // ----
@@ -2124,11 +2534,10 @@ type codecFnInfo struct {
 	ti     *typeInfo
 	xfFn   Ext
 	xfTag  uint64
-	seq    seqType
 	addrD  bool
 	addrDf bool // force: if addrD, then decode function MUST take a ptr
 	addrE  bool
-	addrEf bool // force: if addrE, then encode function MUST take a ptr
+	// addrEf bool // force: if addrE, then encode function MUST take a ptr
 }
 
 // codecFn encapsulates the captured variables and the encode function.
@@ -2139,7 +2548,7 @@ type codecFn struct {
 	i  codecFnInfo
 	fe func(*Encoder, *codecFnInfo, reflect.Value)
 	fd func(*Decoder, *codecFnInfo, reflect.Value)
-	_  [1]uint64 // padding (cache-aligned)
+	// _  [1]uint64 // padding (cache-aligned)
 }
 
 type codecRtidFn struct {
@@ -2148,12 +2557,7 @@ type codecRtidFn struct {
 }
 
 func makeExt(ext interface{}) Ext {
-	if ext == nil {
-		return &extFailWrapper{}
-	}
 	switch t := ext.(type) {
-	case nil:
-		return &extFailWrapper{}
 	case Ext:
 		return t
 	case BytesExt:
@@ -2165,7 +2569,8 @@ func makeExt(ext interface{}) Ext {
 }
 
 func baseRV(v interface{}) (rv reflect.Value) {
-	for rv = rv4i(v); rv.Kind() == reflect.Ptr; rv = rv.Elem() {
+	// use reflect.ValueOf, not rv4i, as of go 1.16beta, rv4i was not inlineable
+	for rv = reflect.ValueOf(v); rv.Kind() == reflect.Ptr; rv = rv.Elem() {
 	}
 	return
 }
@@ -2204,17 +2609,22 @@ func (checkOverflow) Uint2Int(v uint64, neg bool) (overflow bool) {
 
 func (checkOverflow) SignedInt(v uint64) (overflow bool) {
 	//e.g. -127 to 128 for int8
-	pos := (v >> 63) == 0
-	ui2 := v & 0x7fffffffffffffff
-	if pos {
-		if ui2 > math.MaxInt64 {
-			overflow = true
-		}
-	} else {
-		if ui2 > math.MaxInt64-1 {
-			overflow = true
-		}
-	}
+	// pos := (v >> 63) == 0
+	// ui2 := v & 0x7fffffffffffffff
+	// if pos {
+	// 	if ui2 > math.MaxInt64 {
+	// 		overflow = true
+	// 	}
+	// } else {
+	// 	if ui2 > math.MaxInt64-1 {
+	// 		overflow = true
+	// 	}
+	// }
+
+	// a signed integer has overflow if the sign (first) bit is 1 (negative)
+	// and the numbers after the sign bit is > maxint64 - 1
+	overflow = (v>>63) != 0 && v&0x7fffffffffffffff > math.MaxInt64-1
+
 	return
 }
 
@@ -2265,22 +2675,12 @@ func isNumberChar(v byte) bool {
 	// return v > 42 && v < 102 && numCharWithExpBitset64.isset(v-42)
 }
 
-// func isDigitChar(v byte) bool {
-// 	// these are in order of speed below ...
-// 	return digitCharBitset.isset(v)
-// 	// return v >= '0' && v <= '9'
-// }
-
 // -----------------------
 
 type ioFlusher interface {
 	Flush() error
 }
 
-// type ioPeeker interface {
-// 	Peek(int) ([]byte, error)
-// }
-
 type ioBuffered interface {
 	Buffered() int
 }
@@ -2324,16 +2724,6 @@ func (x *bitset32) isset(pos byte) bool {
 	return x[pos&31] // x[pos%32]
 }
 
-// type bitset64 [64]bool
-
-// func (x *bitset64) set(pos byte) *bitset64 {
-// 	x[pos%64] = true
-// 	return x
-// }
-// func (x *bitset64) isset(pos byte) bool {
-// 	return x[pos%64]
-// }
-
 type bitset256 [256]bool
 
 func (x *bitset256) set(pos byte) *bitset256 {
@@ -2344,41 +2734,6 @@ func (x *bitset256) isset(pos byte) bool {
 	return x[pos]
 }
 
-// ----
-// type bitset32 uint32
-
-// func (x *bitset32) set(pos byte) *bitset32 {
-// 	*x = *x | (1 << pos)
-// 	return x
-// }
-// func (x bitset32) isset(pos byte) bool {
-// 	return uint32(x)&(1<<pos) != 0
-// }
-
-// type bitset64 uint64
-
-// func (x *bitset64) set(pos byte) *bitset64 {
-// 	*x = *x | (1 << pos)
-// 	return x
-// }
-// func (x bitset64) isset(pos byte) bool {
-// 	return uint64(x)&(1<<pos) != 0
-// }
-
-// type bitset256 [32]byte
-
-// func (x *bitset256) set(pos byte) *bitset256 {
-// 	x[pos>>3] |= (1 << (pos & 7))
-// 	return x
-// }
-// func (x *bitset256) check(pos byte) uint8 {
-// 	return x[pos>>3] & (1 << (pos & 7))
-// }
-// func (x *bitset256) isset(pos byte) bool {
-// 	return x.check(pos) != 0
-// 	// return x[pos>>3]&(1<<(pos&7)) != 0
-// }
-
 // ------------
 
 type panicHdl struct{}
@@ -2391,10 +2746,14 @@ func (panicHdl) onerror(err error) {
 }
 
 // errorf will always panic, using the parameters passed.
+//
+// Note: it is ok to pass in a stringView, as it will just pass it directly
+// to a fmt.Sprintf call and not hold onto it.
+//
 //go:noinline
 func (panicHdl) errorf(format string, params ...interface{}) {
 	if format == "" {
-		panic(errPanicHdlUndefinedErr)
+		panic(errPanicUndefined)
 	}
 	if len(params) == 0 {
 		panic(errors.New(format))
@@ -2446,16 +2805,65 @@ func freelistCapacity(length int) (capacity int) {
 // In anecdotal testing (running go test -tsd 1..6), we couldn't get
 // the length ofthe list > 4 at any time. So we believe a linear search
 // without bounds checking is sufficient.
+//
+// Typical usage model:
+//
+//	peek may go together with put, iff pop=true. peek gets largest byte slice temporarily.
+//	check is used to switch a []byte if necessary
+//	get/put go together
+//
+// Given that folks may get a []byte, and then append to it a lot which may re-allocate
+// a new []byte, we should try to return both (one received from blist and new one allocated).
+//
+// Typical usage model for get/put, when we don't know whether we may need more than requested
+//
+//	v0 := blist.get()
+//	v1 := v0
+//	... use v1 ...
+//	blist.put(v1)
+//	if byteSliceAddr(v0) != byteSliceAddr(v1) {
+//	  blist.put(v0)
+//	}
 type bytesFreelist [][]byte
 
-// return a slice of possibly non-zero'ed bytes, with len=0,
+// peek returns a slice of possibly non-zero'ed bytes, with len=0,
+// and with the largest capacity from the list.
+func (x *bytesFreelist) peek(length int, pop bool) (out []byte) {
+	if bytesFreeListNoCache {
+		return make([]byte, 0, freelistCapacity(length))
+	}
+	y := *x
+	if len(y) > 0 {
+		out = y[len(y)-1]
+	}
+	// start buf with a minimum of 64 bytes
+	const minLenBytes = 64
+	if length < minLenBytes {
+		length = minLenBytes
+	}
+	if cap(out) < length {
+		out = make([]byte, 0, freelistCapacity(length))
+		y = append(y, out)
+		*x = y
+	}
+	if pop && len(y) > 0 {
+		y = y[:len(y)-1]
+		*x = y
+	}
+	return
+}
+
+// get returns a slice of possibly non-zero'ed bytes, with len=0,
 // and with cap >= length requested.
 func (x *bytesFreelist) get(length int) (out []byte) {
 	if bytesFreeListNoCache {
 		return make([]byte, 0, freelistCapacity(length))
 	}
 	y := *x
-	for i, v := range y {
+	// MARKER: do not use range, as range is not currently inlineable as of go 1.16-beta
+	// for i, v := range y {
+	for i := 0; i < len(y); i++ {
+		v := y[i]
 		if cap(v) >= length {
 			// *x = append(y[:i], y[i+1:]...)
 			copy(y[i:], y[i+1:])
@@ -2476,7 +2884,10 @@ func (x *bytesFreelist) put(v []byte) {
 	// append the new value, then try to put it in a better position
 	y := append(*x, v)
 	*x = y
-	for i, z := range y[:len(y)-1] {
+	// MARKER: do not use range, as range is not currently inlineable as of go 1.16-beta
+	// for i, z := range y[:len(y)-1] {
+	for i := 0; i < len(y)-1; i++ {
+		z := y[i]
 		if cap(z) > cap(v) {
 			copy(y[i+1:], y[i:])
 			y[i] = v
@@ -2486,11 +2897,51 @@ func (x *bytesFreelist) put(v []byte) {
 }
 
 func (x *bytesFreelist) check(v []byte, length int) (out []byte) {
+	// ensure inlineable, by moving slow-path out to its own function
 	if cap(v) >= length {
 		return v[:0]
 	}
-	x.put(v)
-	return x.get(length)
+	return x.checkPutGet(v, length)
+}
+
+func (x *bytesFreelist) checkPutGet(v []byte, length int) []byte {
+	// checkPutGet broken out into its own function, so check is inlineable in general case
+	const useSeparateCalls = false
+
+	if useSeparateCalls {
+		x.put(v)
+		return x.get(length)
+	}
+
+	if bytesFreeListNoCache {
+		return make([]byte, 0, freelistCapacity(length))
+	}
+
+	// assume cap(v) < length, so put must happen before get
+	y := *x
+	var put = cap(v) == 0 // if empty, consider it already put
+	if !put {
+		y = append(y, v)
+		*x = y
+	}
+	for i := 0; i < len(y); i++ {
+		z := y[i]
+		if put {
+			if cap(z) >= length {
+				copy(y[i:], y[i+1:])
+				y = y[:len(y)-1]
+				*x = y
+				return z
+			}
+		} else {
+			if cap(z) > cap(v) {
+				copy(y[i+1:], y[i:])
+				y[i] = v
+				put = true
+			}
+		}
+	}
+	return make([]byte, 0, freelistCapacity(length))
 }
 
 // -------------------------
@@ -2508,7 +2959,11 @@ type sfiRvFreelist [][]sfiRv
 
 func (x *sfiRvFreelist) get(length int) (out []sfiRv) {
 	y := *x
-	for i, v := range y {
+
+	// MARKER: do not use range, as range is not currently inlineable as of go 1.16-beta
+	// for i, v := range y {
+	for i := 0; i < len(y); i++ {
+		v := y[i]
 		if cap(v) >= length {
 			// *x = append(y[:i], y[i+1:]...)
 			copy(y[i:], y[i+1:])
@@ -2526,7 +2981,10 @@ func (x *sfiRvFreelist) put(v []sfiRv) {
 	// append the new value, then try to put it in a better position
 	y := append(*x, v)
 	*x = y
-	for i, z := range y[:len(y)-1] {
+	// MARKER: do not use range, as range is not currently inlineable as of go 1.16-beta
+	// for i, z := range y[:len(y)-1] {
+	for i := 0; i < len(y)-1; i++ {
+		z := y[i]
 		if cap(z) > cap(v) {
 			copy(y[i+1:], y[i:])
 			y[i] = v
@@ -2534,3 +2992,30 @@ func (x *sfiRvFreelist) put(v []sfiRv) {
 		}
 	}
 }
+
+// ---- multiple interner implementations ----
+
+// Hard to tell which is most performant:
+//   - use a map[string]string - worst perf, no collisions, and unlimited entries
+//   - use a linear search with move to front heuristics - no collisions, and maxed at 64 entries
+//   - use a computationally-intensive hash - best performance, some collisions, maxed at 64 entries
+
+const (
+	internMaxStrLen = 16     // if more than 16 bytes, faster to copy than compare bytes
+	internCap       = 64 * 2 // 64 uses 1K bytes RAM, so 128 (anecdotal sweet spot) uses 2K bytes
+)
+
+type internerMap map[string]string
+
+func (x *internerMap) init() {
+	*x = make(map[string]string, internCap)
+}
+
+func (x internerMap) string(v []byte) (s string) {
+	s, ok := x[string(v)] // no allocation here, per go implementation
+	if !ok {
+		s = string(v) // new allocation here
+		x[s] = s
+	}
+	return
+}
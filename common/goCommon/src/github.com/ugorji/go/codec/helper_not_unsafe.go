@@ -1,72 +1,99 @@
-// +build !go1.7 safe appengine
-
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
 
+//go:build !go1.9 || safe || codec.safe || appengine
+// +build !go1.9 safe codec.safe appengine
+
 package codec
 
 import (
+	// "hash/adler32"
 	"math"
 	"reflect"
 	"sync/atomic"
 	"time"
 )
 
+// This file has safe variants of some helper functions.
+// MARKER: See helper_unsafe.go for the usage documentation.
+
 const safeMode = true
 
-// stringView returns a view of the []byte as a string.
-// In unsafe mode, it doesn't incur allocation and copying caused by conversion.
-// In regular safe mode, it is an allocation and copy.
-//
-// Usage: Always maintain a reference to v while result of this call is in use,
-//        and call keepAlive4BytesView(v) at point where done with view.
+const transientSizeMax = 0
+const transientValueHasStringSlice = true
+
+func byteAt(b []byte, index uint) byte {
+	return b[index]
+}
+
+func setByteAt(b []byte, index uint, val byte) {
+	b[index] = val
+}
+
+func byteSliceOf(b []byte, start, end uint) []byte {
+	return b[start:end]
+}
+
+// func byteSliceWithLen(b []byte, length uint) []byte {
+// 	return b[:length]
+// }
+
 func stringView(v []byte) string {
 	return string(v)
 }
 
-// bytesView returns a view of the string as a []byte.
-// In unsafe mode, it doesn't incur allocation and copying caused by conversion.
-// In regular safe mode, it is an allocation and copy.
-//
-// Usage: Always maintain a reference to v while result of this call is in use,
-//        and call keepAlive4BytesView(v) at point where done with view.
 func bytesView(v string) []byte {
 	return []byte(v)
 }
 
-// func copyBytes(dst []byte, src []byte) {
-// 	copy(dst, src)
-// }
+func byteSliceSameData(v1 []byte, v2 []byte) bool {
+	return cap(v1) != 0 && cap(v2) != 0 && &(v1[:1][0]) == &(v2[:1][0])
+}
+
+func okBytes2(b []byte) (v [2]byte) {
+	copy(v[:], b)
+	return
+}
+
+func okBytes3(b []byte) (v [3]byte) {
+	copy(v[:], b)
+	return
+}
+
+func okBytes4(b []byte) (v [4]byte) {
+	copy(v[:], b)
+	return
+}
+
+func okBytes8(b []byte) (v [8]byte) {
+	copy(v[:], b)
+	return
+}
 
-// isNil says whether the value v is nil.
-// This applies to references like map/ptr/unsafepointer/chan/func,
-// and non-reference values like interface/slice.
 func isNil(v interface{}) (rv reflect.Value, isnil bool) {
-	rv = rv4i(v)
+	rv = reflect.ValueOf(v)
 	if isnilBitset.isset(byte(rv.Kind())) {
 		isnil = rv.IsNil()
 	}
 	return
 }
 
-// func rvAddr(rv reflect.Value) uintptr {
-// 	return rv.UnsafeAddr()
-// }
-
 func eq4i(i0, i1 interface{}) bool {
 	return i0 == i1
 }
 
-func rv4i(i interface{}) reflect.Value {
-	return reflect.ValueOf(i)
-}
+func rv4iptr(i interface{}) reflect.Value { return reflect.ValueOf(i) }
+func rv4istr(i interface{}) reflect.Value { return reflect.ValueOf(i) }
+
+// func rv4i(i interface{}) reflect.Value { return reflect.ValueOf(i) }
+// func rv4iK(i interface{}, kind byte, isref bool) reflect.Value { return reflect.ValueOf(i) }
 
 func rv2i(rv reflect.Value) interface{} {
 	return rv.Interface()
 }
 
-func rvType(rv reflect.Value) reflect.Type {
-	return rv.Type()
+func rvAddr(rv reflect.Value, ptrType reflect.Type) reflect.Value {
+	return rv.Addr()
 }
 
 func rvIsNil(rv reflect.Value) bool {
@@ -81,28 +108,25 @@ func rvZeroAddrK(t reflect.Type, k reflect.Kind) reflect.Value {
 	return reflect.New(t).Elem()
 }
 
-func rvZeroAddr(t reflect.Type) reflect.Value {
-	return reflect.New(t).Elem()
-}
-
 func rvZeroK(t reflect.Type, k reflect.Kind) reflect.Value {
 	return reflect.Zero(t)
 }
 
-func rvZero(t reflect.Type) reflect.Value {
-	return reflect.Zero(t)
-}
-
 func rvConvert(v reflect.Value, t reflect.Type) (rv reflect.Value) {
+	// Note that reflect.Value.Convert(...) will make a copy if it is addressable.
+	// Since we decode into the passed value, we must try to convert the addressable value..
+	if v.CanAddr() {
+		return v.Addr().Convert(reflect.PtrTo(t)).Elem()
+	}
 	return v.Convert(t)
 }
 
 func rt2id(rt reflect.Type) uintptr {
-	return rv4i(rt).Pointer()
+	return reflect.ValueOf(rt).Pointer()
 }
 
 func i2rtid(i interface{}) uintptr {
-	return rv4i(reflect.TypeOf(i)).Pointer()
+	return reflect.ValueOf(reflect.TypeOf(i)).Pointer()
 }
 
 // --------------------------
@@ -111,8 +135,19 @@ func isEmptyValue(v reflect.Value, tinfos *TypeInfos, recursive bool) bool {
 	switch v.Kind() {
 	case reflect.Invalid:
 		return true
-	case reflect.Array, reflect.String:
+	case reflect.String:
 		return v.Len() == 0
+	case reflect.Array:
+		// zero := reflect.Zero(v.Type().Elem())
+		// can I just check if the whole value is equal to zeros? seems not.
+		// can I just check if the whole value is equal to its zero value? no.
+		// Well, then we check if each value is empty without recursive.
+		for i, vlen := 0, v.Len(); i < vlen; i++ {
+			if !isEmptyValue(v.Index(i), tinfos, false) {
+				return false
+			}
+		}
+		return true
 	case reflect.Map, reflect.Slice, reflect.Chan:
 		return v.IsNil() || v.Len() == 0
 	case reflect.Bool:
@@ -140,7 +175,140 @@ func isEmptyValue(v reflect.Value, tinfos *TypeInfos, recursive bool) bool {
 	return false
 }
 
+// isEmptyStruct is only called from isEmptyValue, and checks if a struct is empty:
+//   - does it implement IsZero() bool
+//   - is it comparable, and can i compare directly using ==
+//   - if checkStruct, then walk through the encodable fields
+//     and check if they are empty or not.
+func isEmptyStruct(v reflect.Value, tinfos *TypeInfos, recursive bool) bool {
+	// v is a struct kind - no need to check again.
+	// We only check isZero on a struct kind, to reduce the amount of times
+	// that we lookup the rtid and typeInfo for each type as we walk the tree.
+
+	vt := v.Type()
+	rtid := rt2id(vt)
+	if tinfos == nil {
+		tinfos = defTypeInfos
+	}
+	ti := tinfos.get(rtid, vt)
+	if ti.rtid == timeTypId {
+		return rv2i(v).(time.Time).IsZero()
+	}
+	if ti.flagIsZeroer {
+		return rv2i(v).(isZeroer).IsZero()
+	}
+	if ti.flagIsZeroerPtr && v.CanAddr() {
+		return rv2i(v.Addr()).(isZeroer).IsZero()
+	}
+	if ti.flagIsCodecEmptyer {
+		return rv2i(v).(isCodecEmptyer).IsCodecEmpty()
+	}
+	if ti.flagIsCodecEmptyerPtr && v.CanAddr() {
+		return rv2i(v.Addr()).(isCodecEmptyer).IsCodecEmpty()
+	}
+	if ti.flagComparable {
+		return rv2i(v) == rv2i(rvZeroK(vt, reflect.Struct))
+	}
+	if !recursive {
+		return false
+	}
+	// We only care about what we can encode/decode,
+	// so that is what we use to check omitEmpty.
+	for _, si := range ti.sfi.source() {
+		sfv := si.path.field(v)
+		if sfv.IsValid() && !isEmptyValue(sfv, tinfos, recursive) {
+			return false
+		}
+	}
+	return true
+}
+
 // --------------------------
+
+type perTypeElem struct {
+	t    reflect.Type
+	rtid uintptr
+	zero reflect.Value
+	addr [2]reflect.Value
+}
+
+func (x *perTypeElem) get(index uint8) (v reflect.Value) {
+	v = x.addr[index%2]
+	if v.IsValid() {
+		v.Set(x.zero)
+	} else {
+		v = reflect.New(x.t).Elem()
+		x.addr[index%2] = v
+	}
+	return
+}
+
+type perType struct {
+	v []perTypeElem
+}
+
+type decPerType struct {
+	perType
+}
+
+type encPerType struct {
+	perType
+}
+
+func (x *perType) elem(t reflect.Type) *perTypeElem {
+	rtid := rt2id(t)
+	var h, i uint
+	var j = uint(len(x.v))
+LOOP:
+	if i < j {
+		h = (i + j) >> 1 // avoid overflow when computing h // h = i + (j-i)/2
+		if x.v[h].rtid < rtid {
+			i = h + 1
+		} else {
+			j = h
+		}
+		goto LOOP
+	}
+	if i < uint(len(x.v)) {
+		if x.v[i].rtid != rtid {
+			x.v = append(x.v, perTypeElem{})
+			copy(x.v[i+1:], x.v[i:])
+			x.v[i] = perTypeElem{t: t, rtid: rtid, zero: reflect.Zero(t)}
+		}
+	} else {
+		x.v = append(x.v, perTypeElem{t: t, rtid: rtid, zero: reflect.Zero(t)})
+	}
+	return &x.v[i]
+}
+
+func (x *perType) TransientAddrK(t reflect.Type, k reflect.Kind) (rv reflect.Value) {
+	return x.elem(t).get(0)
+}
+
+func (x *perType) TransientAddr2K(t reflect.Type, k reflect.Kind) (rv reflect.Value) {
+	return x.elem(t).get(1)
+}
+
+func (x *perType) AddressableRO(v reflect.Value) (rv reflect.Value) {
+	rv = x.elem(v.Type()).get(0)
+	rvSetDirect(rv, v)
+	return
+}
+
+// --------------------------
+type structFieldInfos struct {
+	c []*structFieldInfo
+	s []*structFieldInfo
+}
+
+func (x *structFieldInfos) load(source, sorted []*structFieldInfo) {
+	x.c = source
+	x.s = sorted
+}
+
+func (x *structFieldInfos) sorted() (v []*structFieldInfo) { return x.s }
+func (x *structFieldInfos) source() (v []*structFieldInfo) { return x.c }
+
 type atomicClsErr struct {
 	v atomic.Value
 }
@@ -190,25 +358,25 @@ func (x *atomicRtidFnSlice) store(p []codecRtidFn) {
 
 // --------------------------
 func (n *fauxUnion) ru() reflect.Value {
-	return rv4i(&n.u).Elem()
+	return reflect.ValueOf(&n.u).Elem()
 }
 func (n *fauxUnion) ri() reflect.Value {
-	return rv4i(&n.i).Elem()
+	return reflect.ValueOf(&n.i).Elem()
 }
 func (n *fauxUnion) rf() reflect.Value {
-	return rv4i(&n.f).Elem()
+	return reflect.ValueOf(&n.f).Elem()
 }
 func (n *fauxUnion) rl() reflect.Value {
-	return rv4i(&n.l).Elem()
+	return reflect.ValueOf(&n.l).Elem()
 }
 func (n *fauxUnion) rs() reflect.Value {
-	return rv4i(&n.s).Elem()
+	return reflect.ValueOf(&n.s).Elem()
 }
 func (n *fauxUnion) rt() reflect.Value {
-	return rv4i(&n.t).Elem()
+	return reflect.ValueOf(&n.t).Elem()
 }
 func (n *fauxUnion) rb() reflect.Value {
-	return rv4i(&n.b).Elem()
+	return reflect.ValueOf(&n.b).Elem()
 }
 
 // --------------------------
@@ -225,7 +393,7 @@ func rvSetBool(rv reflect.Value, v bool) {
 }
 
 func rvSetTime(rv reflect.Value, v time.Time) {
-	rv.Set(rv4i(v))
+	rv.Set(reflect.ValueOf(v))
 }
 
 func rvSetFloat32(rv reflect.Value, v float32) {
@@ -236,6 +404,14 @@ func rvSetFloat64(rv reflect.Value, v float64) {
 	rv.SetFloat(v)
 }
 
+func rvSetComplex64(rv reflect.Value, v complex64) {
+	rv.SetComplex(complex128(v))
+}
+
+func rvSetComplex128(rv reflect.Value, v complex128) {
+	rv.SetComplex(v)
+}
+
 func rvSetInt(rv reflect.Value, v int) {
 	rv.SetInt(int64(v))
 }
@@ -282,7 +458,6 @@ func rvSetUint64(rv reflect.Value, v uint64) {
 
 // ----------------
 
-// rvSetDirect is rv.Set for all kinds except reflect.Interface
 func rvSetDirect(rv reflect.Value, v reflect.Value) {
 	rv.Set(v)
 }
@@ -291,17 +466,49 @@ func rvSetDirectZero(rv reflect.Value) {
 	rv.Set(reflect.Zero(rv.Type()))
 }
 
-// rvSlice returns a slice of the slice of lenth
+// func rvSet(rv reflect.Value, v reflect.Value) {
+// 	rv.Set(v)
+// }
+
+func rvSetIntf(rv reflect.Value, v reflect.Value) {
+	rv.Set(v)
+}
+
+func rvSetZero(rv reflect.Value) {
+	rv.Set(reflect.Zero(rv.Type()))
+}
+
 func rvSlice(rv reflect.Value, length int) reflect.Value {
 	return rv.Slice(0, length)
 }
 
+func rvMakeSlice(rv reflect.Value, ti *typeInfo, xlen, xcap int) (v reflect.Value, set bool) {
+	v = reflect.MakeSlice(ti.rt, xlen, xcap)
+	if rv.Len() > 0 {
+		reflect.Copy(v, rv)
+	}
+	return
+}
+
+func rvGrowSlice(rv reflect.Value, ti *typeInfo, cap, incr int) (v reflect.Value, newcap int, set bool) {
+	newcap = int(growCap(uint(cap), uint(ti.elemsize), uint(incr)))
+	v = reflect.MakeSlice(ti.rt, newcap, newcap)
+	if rv.Len() > 0 {
+		reflect.Copy(v, rv)
+	}
+	return
+}
+
 // ----------------
 
 func rvSliceIndex(rv reflect.Value, i int, ti *typeInfo) reflect.Value {
 	return rv.Index(i)
 }
 
+func rvArrayIndex(rv reflect.Value, i int, ti *typeInfo) reflect.Value {
+	return rv.Index(i)
+}
+
 func rvSliceZeroCap(t reflect.Type) (v reflect.Value) {
 	return reflect.MakeSlice(t, 0, 0)
 }
@@ -314,10 +521,10 @@ func rvCapSlice(rv reflect.Value) int {
 	return rv.Cap()
 }
 
-func rvGetArrayBytesRO(rv reflect.Value, scratch []byte) (bs []byte) {
+func rvGetArrayBytes(rv reflect.Value, scratch []byte) (bs []byte) {
 	l := rv.Len()
-	if rv.CanAddr() {
-		return rvGetBytes(rv.Slice(0, l))
+	if scratch == nil || rv.CanAddr() {
+		return rv.Slice(0, l).Bytes()
 	}
 
 	if l <= cap(scratch) {
@@ -325,21 +532,36 @@ func rvGetArrayBytesRO(rv reflect.Value, scratch []byte) (bs []byte) {
 	} else {
 		bs = make([]byte, l)
 	}
-	reflect.Copy(rv4i(bs), rv)
+	reflect.Copy(reflect.ValueOf(bs), rv)
 	return
 }
 
 func rvGetArray4Slice(rv reflect.Value) (v reflect.Value) {
-	v = rvZeroAddrK(reflectArrayOf(rvLenSlice(rv), rvType(rv).Elem()), reflect.Array)
+	v = rvZeroAddrK(reflectArrayOf(rvLenSlice(rv), rv.Type().Elem()), reflect.Array)
 	reflect.Copy(v, rv)
 	return
 }
 
-func rvGetSlice4Array(rv reflect.Value, tslice reflect.Type) (v reflect.Value) {
-	return rv.Slice(0, rv.Len())
+func rvGetSlice4Array(rv reflect.Value, v interface{}) {
+	// v is a pointer to a slice to be populated
+
+	// rv.Slice fails if address is not addressable, which can occur during encoding.
+	// Consequently, check if non-addressable, and if so, make new slice and copy into it first.
+	// MARKER: this *may* cause allocation if non-addressable, unfortunately.
+
+	rve := reflect.ValueOf(v).Elem()
+	l := rv.Len()
+	if rv.CanAddr() {
+		rve.Set(rv.Slice(0, l))
+	} else {
+		rvs := reflect.MakeSlice(rve.Type(), l, l)
+		reflect.Copy(rvs, rv)
+		rve.Set(rvs)
+	}
+	// reflect.ValueOf(v).Elem().Set(rv.Slice(0, rv.Len()))
 }
 
-func rvCopySlice(dest, src reflect.Value) {
+func rvCopySlice(dest, src reflect.Value, _ reflect.Type) {
 	reflect.Copy(dest, src)
 }
 
@@ -369,6 +591,14 @@ func rvGetFloat32(rv reflect.Value) float32 {
 	return float32(rv.Float())
 }
 
+func rvGetComplex64(rv reflect.Value) complex64 {
+	return complex64(rv.Complex())
+}
+
+func rvGetComplex128(rv reflect.Value) complex128 {
+	return rv.Complex()
+}
+
 func rvGetInt(rv reflect.Value) int {
 	return int(rv.Int())
 }
@@ -417,27 +647,32 @@ func rvLenMap(rv reflect.Value) int {
 	return rv.Len()
 }
 
-func rvLenArray(rv reflect.Value) int {
-	return rv.Len()
-}
+// func copybytes(to, from []byte) int {
+// 	return copy(to, from)
+// }
+
+// func copybytestr(to []byte, from string) int {
+// 	return copy(to, from)
+// }
+
+// func rvLenArray(rv reflect.Value) int {	return rv.Len() }
 
 // ------------ map range and map indexing ----------
 
-func mapGet(m, k, v reflect.Value) (vv reflect.Value) {
-	return m.MapIndex(k)
-}
+func mapStoresElemIndirect(elemsize uintptr) bool { return false }
 
-func mapSet(m, k, v reflect.Value) {
+func mapSet(m, k, v reflect.Value, keyFastKind mapKeyFastKind, _, _ bool) {
 	m.SetMapIndex(k, v)
 }
 
+func mapGet(m, k, v reflect.Value, keyFastKind mapKeyFastKind, _, _ bool) (vv reflect.Value) {
+	return m.MapIndex(k)
+}
+
 // func mapDelete(m, k reflect.Value) {
 // 	m.SetMapIndex(k, reflect.Value{})
 // }
 
-// return an addressable reflect value that can be used in mapRange and mapGet operations.
-//
-// all calls to mapGet or mapRange will call here to get an addressable reflect.Value.
 func mapAddrLoopvarRV(t reflect.Type, k reflect.Kind) (r reflect.Value) {
 	return // reflect.New(t).Elem()
 }
@@ -450,16 +685,22 @@ func (e *Encoder) jsondriver() *jsonEncDriver {
 
 // ---------- DECODER optimized ---------------
 
-func (d *Decoder) checkBreak() bool {
-	return d.d.CheckBreak()
-}
-
 func (d *Decoder) jsondriver() *jsonDecDriver {
 	return d.d.(*jsonDecDriver)
 }
 
+func (d *Decoder) stringZC(v []byte) (s string) {
+	return d.string(v)
+}
+
+func (d *Decoder) mapKeyString(callFnRvk *bool, kstrbs, kstr2bs *[]byte) string {
+	return d.string(*kstr2bs)
+}
+
 // ---------- structFieldInfo optimized ---------------
 
 func (n *structFieldInfoPathNode) rvField(v reflect.Value) reflect.Value {
 	return v.Field(int(n.index))
 }
+
+// ---------- others ---------------
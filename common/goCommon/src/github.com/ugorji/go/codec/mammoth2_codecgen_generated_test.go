@@ -1,5 +1,6 @@
+//go:build go1.6 && ((codecgen && !codec.notfastpath && !codec.notmammoth) || (generated && !codec.notfastpath && !codec.notmammoth))
 // +build go1.6
-// +build codecgen,!notfastpath generated,!notfastpath
+// +build codecgen,!codec.notfastpath,!codec.notmammoth generated,!codec.notfastpath,!codec.notmammoth
 
 // Code generated by codecgen - DO NOT EDIT.
 
@@ -8,6 +9,7 @@ package codec
 import (
 	"errors"
 	"runtime"
+	"sort"
 	"strconv"
 )
 
@@ -28,7 +30,8 @@ const (
 )
 
 var (
-	errCodecSelferOnlyMapOrArrayEncodeToStruct19781 = errors.New(`only encoded map or array can be decoded into a struct`)
+	errCodecSelferOnlyMapOrArrayEncodeToStruct19781                = errors.New(`only encoded map or array can be decoded into a struct`)
+	_                                               sort.Interface = nil
 )
 
 type codecSelfer19781 struct{}
@@ -36,18 +39,47 @@ type codecSelfer19781 struct{}
 func codecSelfer19781False() bool { return false }
 func codecSelfer19781True() bool  { return true }
 
+type codecSelfer19781stringSlice []string
+
+func (p codecSelfer19781stringSlice) Len() int           { return len(p) }
+func (p codecSelfer19781stringSlice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19781stringSlice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19781uint64Slice []uint64
+
+func (p codecSelfer19781uint64Slice) Len() int           { return len(p) }
+func (p codecSelfer19781uint64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19781uint64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19781int64Slice []int64
+
+func (p codecSelfer19781int64Slice) Len() int           { return len(p) }
+func (p codecSelfer19781int64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19781int64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19781float64Slice []float64
+
+func (p codecSelfer19781float64Slice) Len() int           { return len(p) }
+func (p codecSelfer19781float64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19781float64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
 func init() {
-	if GenVersion != 20 {
+	if GenVersion != 28 {
 		_, file, _, _ := runtime.Caller(0)
 		ver := strconv.FormatInt(int64(GenVersion), 10)
-		panic(errors.New("codecgen version mismatch: current: 20, need " + ver + ". Re-generate file: " + file))
+		panic(errors.New("codecgen version mismatch: current: 28, need " + ver + ". Re-generate file: " + file))
 	}
 }
 
+func (TestMammoth2) codecSelferViaCodecgen() {}
 func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -74,59 +106,61 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 		var yyn38 bool = x.FptrSliceIntf == nil
 		var yyn40 bool = x.FptrSliceString == nil
 		var yyn42 bool = x.FptrSliceBytes == nil
-		var yyn44 bool = x.FptrSliceFloat64 == nil
-		var yyn46 bool = x.FptrSliceUint64 == nil
-		var yyn48 bool = x.FptrSliceInt == nil
-		var yyn50 bool = x.FptrSliceInt32 == nil
-		var yyn52 bool = x.FptrSliceInt64 == nil
-		var yyn54 bool = x.FptrSliceBool == nil
-		var yyn56 bool = x.FptrMapStringIntf == nil
-		var yyn58 bool = x.FptrMapStringString == nil
-		var yyn60 bool = x.FptrMapStringBytes == nil
-		var yyn62 bool = x.FptrMapStringUint8 == nil
-		var yyn64 bool = x.FptrMapStringUint64 == nil
-		var yyn66 bool = x.FptrMapStringInt == nil
-		var yyn68 bool = x.FptrMapStringInt64 == nil
-		var yyn70 bool = x.FptrMapStringFloat64 == nil
-		var yyn72 bool = x.FptrMapStringBool == nil
-		var yyn74 bool = x.FptrMapUint8Intf == nil
-		var yyn76 bool = x.FptrMapUint8String == nil
-		var yyn78 bool = x.FptrMapUint8Bytes == nil
-		var yyn80 bool = x.FptrMapUint8Uint8 == nil
-		var yyn82 bool = x.FptrMapUint8Uint64 == nil
-		var yyn84 bool = x.FptrMapUint8Int == nil
-		var yyn86 bool = x.FptrMapUint8Int64 == nil
-		var yyn88 bool = x.FptrMapUint8Float64 == nil
-		var yyn90 bool = x.FptrMapUint8Bool == nil
-		var yyn92 bool = x.FptrMapUint64Intf == nil
-		var yyn94 bool = x.FptrMapUint64String == nil
-		var yyn96 bool = x.FptrMapUint64Bytes == nil
-		var yyn98 bool = x.FptrMapUint64Uint8 == nil
-		var yyn100 bool = x.FptrMapUint64Uint64 == nil
-		var yyn102 bool = x.FptrMapUint64Int == nil
-		var yyn104 bool = x.FptrMapUint64Int64 == nil
-		var yyn106 bool = x.FptrMapUint64Float64 == nil
-		var yyn108 bool = x.FptrMapUint64Bool == nil
-		var yyn110 bool = x.FptrMapIntIntf == nil
-		var yyn112 bool = x.FptrMapIntString == nil
-		var yyn114 bool = x.FptrMapIntBytes == nil
-		var yyn116 bool = x.FptrMapIntUint8 == nil
-		var yyn118 bool = x.FptrMapIntUint64 == nil
-		var yyn120 bool = x.FptrMapIntInt == nil
-		var yyn122 bool = x.FptrMapIntInt64 == nil
-		var yyn124 bool = x.FptrMapIntFloat64 == nil
-		var yyn126 bool = x.FptrMapIntBool == nil
-		var yyn128 bool = x.FptrMapInt64Intf == nil
-		var yyn130 bool = x.FptrMapInt64String == nil
-		var yyn132 bool = x.FptrMapInt64Bytes == nil
-		var yyn134 bool = x.FptrMapInt64Uint8 == nil
-		var yyn136 bool = x.FptrMapInt64Uint64 == nil
-		var yyn138 bool = x.FptrMapInt64Int == nil
-		var yyn140 bool = x.FptrMapInt64Int64 == nil
-		var yyn142 bool = x.FptrMapInt64Float64 == nil
-		var yyn144 bool = x.FptrMapInt64Bool == nil
+		var yyn44 bool = x.FptrSliceFloat32 == nil
+		var yyn46 bool = x.FptrSliceFloat64 == nil
+		var yyn48 bool = x.FptrSliceUint8 == nil
+		var yyn50 bool = x.FptrSliceUint64 == nil
+		var yyn52 bool = x.FptrSliceInt == nil
+		var yyn54 bool = x.FptrSliceInt32 == nil
+		var yyn56 bool = x.FptrSliceInt64 == nil
+		var yyn58 bool = x.FptrSliceBool == nil
+		var yyn60 bool = x.FptrMapStringIntf == nil
+		var yyn62 bool = x.FptrMapStringString == nil
+		var yyn64 bool = x.FptrMapStringBytes == nil
+		var yyn66 bool = x.FptrMapStringUint8 == nil
+		var yyn68 bool = x.FptrMapStringUint64 == nil
+		var yyn70 bool = x.FptrMapStringInt == nil
+		var yyn72 bool = x.FptrMapStringInt32 == nil
+		var yyn74 bool = x.FptrMapStringFloat64 == nil
+		var yyn76 bool = x.FptrMapStringBool == nil
+		var yyn78 bool = x.FptrMapUint8Intf == nil
+		var yyn80 bool = x.FptrMapUint8String == nil
+		var yyn82 bool = x.FptrMapUint8Bytes == nil
+		var yyn84 bool = x.FptrMapUint8Uint8 == nil
+		var yyn86 bool = x.FptrMapUint8Uint64 == nil
+		var yyn88 bool = x.FptrMapUint8Int == nil
+		var yyn90 bool = x.FptrMapUint8Int32 == nil
+		var yyn92 bool = x.FptrMapUint8Float64 == nil
+		var yyn94 bool = x.FptrMapUint8Bool == nil
+		var yyn96 bool = x.FptrMapUint64Intf == nil
+		var yyn98 bool = x.FptrMapUint64String == nil
+		var yyn100 bool = x.FptrMapUint64Bytes == nil
+		var yyn102 bool = x.FptrMapUint64Uint8 == nil
+		var yyn104 bool = x.FptrMapUint64Uint64 == nil
+		var yyn106 bool = x.FptrMapUint64Int == nil
+		var yyn108 bool = x.FptrMapUint64Int32 == nil
+		var yyn110 bool = x.FptrMapUint64Float64 == nil
+		var yyn112 bool = x.FptrMapUint64Bool == nil
+		var yyn114 bool = x.FptrMapIntIntf == nil
+		var yyn116 bool = x.FptrMapIntString == nil
+		var yyn118 bool = x.FptrMapIntBytes == nil
+		var yyn120 bool = x.FptrMapIntUint8 == nil
+		var yyn122 bool = x.FptrMapIntUint64 == nil
+		var yyn124 bool = x.FptrMapIntInt == nil
+		var yyn126 bool = x.FptrMapIntInt32 == nil
+		var yyn128 bool = x.FptrMapIntFloat64 == nil
+		var yyn130 bool = x.FptrMapIntBool == nil
+		var yyn132 bool = x.FptrMapInt32Intf == nil
+		var yyn134 bool = x.FptrMapInt32String == nil
+		var yyn136 bool = x.FptrMapInt32Bytes == nil
+		var yyn138 bool = x.FptrMapInt32Uint8 == nil
+		var yyn140 bool = x.FptrMapInt32Uint64 == nil
+		var yyn142 bool = x.FptrMapInt32Int == nil
+		var yyn144 bool = x.FptrMapInt32Int32 == nil
+		var yyn146 bool = x.FptrMapInt32Float64 == nil
+		var yyn148 bool = x.FptrMapInt32Bool == nil
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(142)
+			z.EncWriteArrayStart(146)
 			z.EncWriteArrayElem()
 			z.EncFallback(x.FIntf)
 			if yyn4 {
@@ -134,8 +168,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy146 := *x.FptrIntf
-				z.EncFallback(yy146)
+				yy150 := *x.FptrIntf
+				z.EncFallback(yy150)
 			}
 			z.EncWriteArrayElem()
 			r.EncodeString(string(x.FString))
@@ -144,8 +178,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy149 := *x.FptrString
-				r.EncodeString(string(yy149))
+				yy153 := *x.FptrString
+				r.EncodeString(string(yy153))
 			}
 			z.EncWriteArrayElem()
 			if x.FBytes == nil {
@@ -158,12 +192,12 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy152 := *x.FptrBytes
-				if yy152 == nil {
+				yy156 := *x.FptrBytes
+				if yy156 == nil {
 					r.EncodeNil()
 				} else {
-					r.EncodeStringBytesRaw([]byte(yy152))
-				} // end block: if yy152 slice == nil
+					r.EncodeStringBytesRaw([]byte(yy156))
+				} // end block: if yy156 slice == nil
 			}
 			z.EncWriteArrayElem()
 			r.EncodeFloat32(float32(x.FFloat32))
@@ -172,8 +206,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy155 := *x.FptrFloat32
-				r.EncodeFloat32(float32(yy155))
+				yy159 := *x.FptrFloat32
+				r.EncodeFloat32(float32(yy159))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeFloat64(float64(x.FFloat64))
@@ -182,8 +216,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy158 := *x.FptrFloat64
-				r.EncodeFloat64(float64(yy158))
+				yy162 := *x.FptrFloat64
+				r.EncodeFloat64(float64(yy162))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUint))
@@ -192,8 +226,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy161 := *x.FptrUint
-				r.EncodeUint(uint64(yy161))
+				yy165 := *x.FptrUint
+				r.EncodeUint(uint64(yy165))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUint8))
@@ -202,8 +236,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy164 := *x.FptrUint8
-				r.EncodeUint(uint64(yy164))
+				yy168 := *x.FptrUint8
+				r.EncodeUint(uint64(yy168))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUint16))
@@ -212,8 +246,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy167 := *x.FptrUint16
-				r.EncodeUint(uint64(yy167))
+				yy171 := *x.FptrUint16
+				r.EncodeUint(uint64(yy171))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUint32))
@@ -222,8 +256,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy170 := *x.FptrUint32
-				r.EncodeUint(uint64(yy170))
+				yy174 := *x.FptrUint32
+				r.EncodeUint(uint64(yy174))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUint64))
@@ -232,8 +266,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy173 := *x.FptrUint64
-				r.EncodeUint(uint64(yy173))
+				yy177 := *x.FptrUint64
+				r.EncodeUint(uint64(yy177))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.FUintptr))
@@ -242,8 +276,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy176 := *x.FptrUintptr
-				r.EncodeUint(uint64(yy176))
+				yy180 := *x.FptrUintptr
+				r.EncodeUint(uint64(yy180))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.FInt))
@@ -252,8 +286,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy179 := *x.FptrInt
-				r.EncodeInt(int64(yy179))
+				yy183 := *x.FptrInt
+				r.EncodeInt(int64(yy183))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.FInt8))
@@ -262,8 +296,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy182 := *x.FptrInt8
-				r.EncodeInt(int64(yy182))
+				yy186 := *x.FptrInt8
+				r.EncodeInt(int64(yy186))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.FInt16))
@@ -272,8 +306,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy185 := *x.FptrInt16
-				r.EncodeInt(int64(yy185))
+				yy189 := *x.FptrInt16
+				r.EncodeInt(int64(yy189))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.FInt32))
@@ -282,8 +316,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy188 := *x.FptrInt32
-				r.EncodeInt(int64(yy188))
+				yy192 := *x.FptrInt32
+				r.EncodeInt(int64(yy192))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.FInt64))
@@ -292,8 +326,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy191 := *x.FptrInt64
-				r.EncodeInt(int64(yy191))
+				yy195 := *x.FptrInt64
+				r.EncodeInt(int64(yy195))
 			}
 			z.EncWriteArrayElem()
 			r.EncodeBool(bool(x.FBool))
@@ -302,8 +336,8 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy194 := *x.FptrBool
-				r.EncodeBool(bool(yy194))
+				yy198 := *x.FptrBool
+				r.EncodeBool(bool(yy198))
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceIntf == nil {
@@ -316,12 +350,12 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy197 := *x.FptrSliceIntf
-				if yy197 == nil {
+				yy201 := *x.FptrSliceIntf
+				if yy201 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceIntfV(yy197, e)
-				} // end block: if yy197 slice == nil
+					z.F.EncSliceIntfV(yy201, e)
+				} // end block: if yy201 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceString == nil {
@@ -334,12 +368,12 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy200 := *x.FptrSliceString
-				if yy200 == nil {
+				yy204 := *x.FptrSliceString
+				if yy204 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceStringV(yy200, e)
-				} // end block: if yy200 slice == nil
+					z.F.EncSliceStringV(yy204, e)
+				} // end block: if yy204 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceBytes == nil {
@@ -352,12 +386,30 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy203 := *x.FptrSliceBytes
-				if yy203 == nil {
+				yy207 := *x.FptrSliceBytes
+				if yy207 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(yy207, e)
+				} // end block: if yy207 slice == nil
+			}
+			z.EncWriteArrayElem()
+			if x.FSliceFloat32 == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceFloat32V(x.FSliceFloat32, e)
+			} // end block: if x.FSliceFloat32 slice == nil
+			if yyn44 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				yy210 := *x.FptrSliceFloat32
+				if yy210 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBytesV(yy203, e)
-				} // end block: if yy203 slice == nil
+					z.F.EncSliceFloat32V(yy210, e)
+				} // end block: if yy210 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceFloat64 == nil {
@@ -365,17 +417,35 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceFloat64V(x.FSliceFloat64, e)
 			} // end block: if x.FSliceFloat64 slice == nil
-			if yyn44 {
+			if yyn46 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				yy213 := *x.FptrSliceFloat64
+				if yy213 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(yy213, e)
+				} // end block: if yy213 slice == nil
+			}
+			z.EncWriteArrayElem()
+			if x.FSliceUint8 == nil {
+				r.EncodeNil()
+			} else {
+				r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+			} // end block: if x.FSliceUint8 slice == nil
+			if yyn48 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy206 := *x.FptrSliceFloat64
-				if yy206 == nil {
+				yy216 := *x.FptrSliceUint8
+				if yy216 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceFloat64V(yy206, e)
-				} // end block: if yy206 slice == nil
+					r.EncodeStringBytesRaw([]byte(yy216))
+				} // end block: if yy216 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceUint64 == nil {
@@ -383,17 +453,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceUint64V(x.FSliceUint64, e)
 			} // end block: if x.FSliceUint64 slice == nil
-			if yyn46 {
+			if yyn50 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy209 := *x.FptrSliceUint64
-				if yy209 == nil {
+				yy219 := *x.FptrSliceUint64
+				if yy219 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceUint64V(yy209, e)
-				} // end block: if yy209 slice == nil
+					z.F.EncSliceUint64V(yy219, e)
+				} // end block: if yy219 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceInt == nil {
@@ -401,17 +471,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceIntV(x.FSliceInt, e)
 			} // end block: if x.FSliceInt slice == nil
-			if yyn48 {
+			if yyn52 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy212 := *x.FptrSliceInt
-				if yy212 == nil {
+				yy222 := *x.FptrSliceInt
+				if yy222 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceIntV(yy212, e)
-				} // end block: if yy212 slice == nil
+					z.F.EncSliceIntV(yy222, e)
+				} // end block: if yy222 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceInt32 == nil {
@@ -419,17 +489,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceInt32V(x.FSliceInt32, e)
 			} // end block: if x.FSliceInt32 slice == nil
-			if yyn50 {
+			if yyn54 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy215 := *x.FptrSliceInt32
-				if yy215 == nil {
+				yy225 := *x.FptrSliceInt32
+				if yy225 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceInt32V(yy215, e)
-				} // end block: if yy215 slice == nil
+					z.F.EncSliceInt32V(yy225, e)
+				} // end block: if yy225 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceInt64 == nil {
@@ -437,17 +507,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceInt64V(x.FSliceInt64, e)
 			} // end block: if x.FSliceInt64 slice == nil
-			if yyn52 {
+			if yyn56 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy218 := *x.FptrSliceInt64
-				if yy218 == nil {
+				yy228 := *x.FptrSliceInt64
+				if yy228 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceInt64V(yy218, e)
-				} // end block: if yy218 slice == nil
+					z.F.EncSliceInt64V(yy228, e)
+				} // end block: if yy228 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FSliceBool == nil {
@@ -455,17 +525,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncSliceBoolV(x.FSliceBool, e)
 			} // end block: if x.FSliceBool slice == nil
-			if yyn54 {
+			if yyn58 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy221 := *x.FptrSliceBool
-				if yy221 == nil {
+				yy231 := *x.FptrSliceBool
+				if yy231 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBoolV(yy221, e)
-				} // end block: if yy221 slice == nil
+					z.F.EncSliceBoolV(yy231, e)
+				} // end block: if yy231 slice == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringIntf == nil {
@@ -473,17 +543,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringIntfV(x.FMapStringIntf, e)
 			} // end block: if x.FMapStringIntf map == nil
-			if yyn56 {
+			if yyn60 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy224 := *x.FptrMapStringIntf
-				if yy224 == nil {
+				yy234 := *x.FptrMapStringIntf
+				if yy234 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringIntfV(yy224, e)
-				} // end block: if yy224 map == nil
+					z.F.EncMapStringIntfV(yy234, e)
+				} // end block: if yy234 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringString == nil {
@@ -491,17 +561,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringStringV(x.FMapStringString, e)
 			} // end block: if x.FMapStringString map == nil
-			if yyn58 {
+			if yyn62 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy227 := *x.FptrMapStringString
-				if yy227 == nil {
+				yy237 := *x.FptrMapStringString
+				if yy237 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringStringV(yy227, e)
-				} // end block: if yy227 map == nil
+					z.F.EncMapStringStringV(yy237, e)
+				} // end block: if yy237 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringBytes == nil {
@@ -509,17 +579,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringBytesV(x.FMapStringBytes, e)
 			} // end block: if x.FMapStringBytes map == nil
-			if yyn60 {
+			if yyn64 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy230 := *x.FptrMapStringBytes
-				if yy230 == nil {
+				yy240 := *x.FptrMapStringBytes
+				if yy240 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringBytesV(yy230, e)
-				} // end block: if yy230 map == nil
+					z.F.EncMapStringBytesV(yy240, e)
+				} // end block: if yy240 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringUint8 == nil {
@@ -527,17 +597,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringUint8V(x.FMapStringUint8, e)
 			} // end block: if x.FMapStringUint8 map == nil
-			if yyn62 {
+			if yyn66 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy233 := *x.FptrMapStringUint8
-				if yy233 == nil {
+				yy243 := *x.FptrMapStringUint8
+				if yy243 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringUint8V(yy233, e)
-				} // end block: if yy233 map == nil
+					z.F.EncMapStringUint8V(yy243, e)
+				} // end block: if yy243 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringUint64 == nil {
@@ -545,17 +615,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringUint64V(x.FMapStringUint64, e)
 			} // end block: if x.FMapStringUint64 map == nil
-			if yyn64 {
+			if yyn68 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy236 := *x.FptrMapStringUint64
-				if yy236 == nil {
+				yy246 := *x.FptrMapStringUint64
+				if yy246 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringUint64V(yy236, e)
-				} // end block: if yy236 map == nil
+					z.F.EncMapStringUint64V(yy246, e)
+				} // end block: if yy246 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringInt == nil {
@@ -563,35 +633,35 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringIntV(x.FMapStringInt, e)
 			} // end block: if x.FMapStringInt map == nil
-			if yyn66 {
+			if yyn70 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy239 := *x.FptrMapStringInt
-				if yy239 == nil {
+				yy249 := *x.FptrMapStringInt
+				if yy249 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringIntV(yy239, e)
-				} // end block: if yy239 map == nil
+					z.F.EncMapStringIntV(yy249, e)
+				} // end block: if yy249 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapStringInt64 == nil {
+			if x.FMapStringInt32 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapStringInt64V(x.FMapStringInt64, e)
-			} // end block: if x.FMapStringInt64 map == nil
-			if yyn68 {
+				z.F.EncMapStringInt32V(x.FMapStringInt32, e)
+			} // end block: if x.FMapStringInt32 map == nil
+			if yyn72 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy242 := *x.FptrMapStringInt64
-				if yy242 == nil {
+				yy252 := *x.FptrMapStringInt32
+				if yy252 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringInt64V(yy242, e)
-				} // end block: if yy242 map == nil
+					z.F.EncMapStringInt32V(yy252, e)
+				} // end block: if yy252 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringFloat64 == nil {
@@ -599,17 +669,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringFloat64V(x.FMapStringFloat64, e)
 			} // end block: if x.FMapStringFloat64 map == nil
-			if yyn70 {
+			if yyn74 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy245 := *x.FptrMapStringFloat64
-				if yy245 == nil {
+				yy255 := *x.FptrMapStringFloat64
+				if yy255 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringFloat64V(yy245, e)
-				} // end block: if yy245 map == nil
+					z.F.EncMapStringFloat64V(yy255, e)
+				} // end block: if yy255 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapStringBool == nil {
@@ -617,17 +687,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapStringBoolV(x.FMapStringBool, e)
 			} // end block: if x.FMapStringBool map == nil
-			if yyn72 {
+			if yyn76 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy248 := *x.FptrMapStringBool
-				if yy248 == nil {
+				yy258 := *x.FptrMapStringBool
+				if yy258 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringBoolV(yy248, e)
-				} // end block: if yy248 map == nil
+					z.F.EncMapStringBoolV(yy258, e)
+				} // end block: if yy258 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Intf == nil {
@@ -635,17 +705,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8IntfV(x.FMapUint8Intf, e)
 			} // end block: if x.FMapUint8Intf map == nil
-			if yyn74 {
+			if yyn78 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy251 := *x.FptrMapUint8Intf
-				if yy251 == nil {
+				yy261 := *x.FptrMapUint8Intf
+				if yy261 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8IntfV(yy251, e)
-				} // end block: if yy251 map == nil
+					z.F.EncMapUint8IntfV(yy261, e)
+				} // end block: if yy261 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8String == nil {
@@ -653,17 +723,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8StringV(x.FMapUint8String, e)
 			} // end block: if x.FMapUint8String map == nil
-			if yyn76 {
+			if yyn80 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy254 := *x.FptrMapUint8String
-				if yy254 == nil {
+				yy264 := *x.FptrMapUint8String
+				if yy264 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8StringV(yy254, e)
-				} // end block: if yy254 map == nil
+					z.F.EncMapUint8StringV(yy264, e)
+				} // end block: if yy264 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Bytes == nil {
@@ -671,17 +741,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8BytesV(x.FMapUint8Bytes, e)
 			} // end block: if x.FMapUint8Bytes map == nil
-			if yyn78 {
+			if yyn82 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy257 := *x.FptrMapUint8Bytes
-				if yy257 == nil {
+				yy267 := *x.FptrMapUint8Bytes
+				if yy267 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8BytesV(yy257, e)
-				} // end block: if yy257 map == nil
+					z.F.EncMapUint8BytesV(yy267, e)
+				} // end block: if yy267 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Uint8 == nil {
@@ -689,17 +759,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8Uint8V(x.FMapUint8Uint8, e)
 			} // end block: if x.FMapUint8Uint8 map == nil
-			if yyn80 {
+			if yyn84 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy260 := *x.FptrMapUint8Uint8
-				if yy260 == nil {
+				yy270 := *x.FptrMapUint8Uint8
+				if yy270 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Uint8V(yy260, e)
-				} // end block: if yy260 map == nil
+					z.F.EncMapUint8Uint8V(yy270, e)
+				} // end block: if yy270 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Uint64 == nil {
@@ -707,17 +777,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8Uint64V(x.FMapUint8Uint64, e)
 			} // end block: if x.FMapUint8Uint64 map == nil
-			if yyn82 {
+			if yyn86 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy263 := *x.FptrMapUint8Uint64
-				if yy263 == nil {
+				yy273 := *x.FptrMapUint8Uint64
+				if yy273 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Uint64V(yy263, e)
-				} // end block: if yy263 map == nil
+					z.F.EncMapUint8Uint64V(yy273, e)
+				} // end block: if yy273 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Int == nil {
@@ -725,35 +795,35 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8IntV(x.FMapUint8Int, e)
 			} // end block: if x.FMapUint8Int map == nil
-			if yyn84 {
+			if yyn88 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy266 := *x.FptrMapUint8Int
-				if yy266 == nil {
+				yy276 := *x.FptrMapUint8Int
+				if yy276 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8IntV(yy266, e)
-				} // end block: if yy266 map == nil
+					z.F.EncMapUint8IntV(yy276, e)
+				} // end block: if yy276 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapUint8Int64 == nil {
+			if x.FMapUint8Int32 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapUint8Int64V(x.FMapUint8Int64, e)
-			} // end block: if x.FMapUint8Int64 map == nil
-			if yyn86 {
+				z.F.EncMapUint8Int32V(x.FMapUint8Int32, e)
+			} // end block: if x.FMapUint8Int32 map == nil
+			if yyn90 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy269 := *x.FptrMapUint8Int64
-				if yy269 == nil {
+				yy279 := *x.FptrMapUint8Int32
+				if yy279 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Int64V(yy269, e)
-				} // end block: if yy269 map == nil
+					z.F.EncMapUint8Int32V(yy279, e)
+				} // end block: if yy279 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Float64 == nil {
@@ -761,17 +831,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8Float64V(x.FMapUint8Float64, e)
 			} // end block: if x.FMapUint8Float64 map == nil
-			if yyn88 {
+			if yyn92 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy272 := *x.FptrMapUint8Float64
-				if yy272 == nil {
+				yy282 := *x.FptrMapUint8Float64
+				if yy282 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Float64V(yy272, e)
-				} // end block: if yy272 map == nil
+					z.F.EncMapUint8Float64V(yy282, e)
+				} // end block: if yy282 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint8Bool == nil {
@@ -779,17 +849,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint8BoolV(x.FMapUint8Bool, e)
 			} // end block: if x.FMapUint8Bool map == nil
-			if yyn90 {
+			if yyn94 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy275 := *x.FptrMapUint8Bool
-				if yy275 == nil {
+				yy285 := *x.FptrMapUint8Bool
+				if yy285 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8BoolV(yy275, e)
-				} // end block: if yy275 map == nil
+					z.F.EncMapUint8BoolV(yy285, e)
+				} // end block: if yy285 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Intf == nil {
@@ -797,17 +867,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64IntfV(x.FMapUint64Intf, e)
 			} // end block: if x.FMapUint64Intf map == nil
-			if yyn92 {
+			if yyn96 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy278 := *x.FptrMapUint64Intf
-				if yy278 == nil {
+				yy288 := *x.FptrMapUint64Intf
+				if yy288 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64IntfV(yy278, e)
-				} // end block: if yy278 map == nil
+					z.F.EncMapUint64IntfV(yy288, e)
+				} // end block: if yy288 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64String == nil {
@@ -815,17 +885,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64StringV(x.FMapUint64String, e)
 			} // end block: if x.FMapUint64String map == nil
-			if yyn94 {
+			if yyn98 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy281 := *x.FptrMapUint64String
-				if yy281 == nil {
+				yy291 := *x.FptrMapUint64String
+				if yy291 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64StringV(yy281, e)
-				} // end block: if yy281 map == nil
+					z.F.EncMapUint64StringV(yy291, e)
+				} // end block: if yy291 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Bytes == nil {
@@ -833,17 +903,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64BytesV(x.FMapUint64Bytes, e)
 			} // end block: if x.FMapUint64Bytes map == nil
-			if yyn96 {
+			if yyn100 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy284 := *x.FptrMapUint64Bytes
-				if yy284 == nil {
+				yy294 := *x.FptrMapUint64Bytes
+				if yy294 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64BytesV(yy284, e)
-				} // end block: if yy284 map == nil
+					z.F.EncMapUint64BytesV(yy294, e)
+				} // end block: if yy294 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Uint8 == nil {
@@ -851,17 +921,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64Uint8V(x.FMapUint64Uint8, e)
 			} // end block: if x.FMapUint64Uint8 map == nil
-			if yyn98 {
+			if yyn102 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy287 := *x.FptrMapUint64Uint8
-				if yy287 == nil {
+				yy297 := *x.FptrMapUint64Uint8
+				if yy297 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Uint8V(yy287, e)
-				} // end block: if yy287 map == nil
+					z.F.EncMapUint64Uint8V(yy297, e)
+				} // end block: if yy297 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Uint64 == nil {
@@ -869,17 +939,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64Uint64V(x.FMapUint64Uint64, e)
 			} // end block: if x.FMapUint64Uint64 map == nil
-			if yyn100 {
+			if yyn104 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy290 := *x.FptrMapUint64Uint64
-				if yy290 == nil {
+				yy300 := *x.FptrMapUint64Uint64
+				if yy300 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Uint64V(yy290, e)
-				} // end block: if yy290 map == nil
+					z.F.EncMapUint64Uint64V(yy300, e)
+				} // end block: if yy300 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Int == nil {
@@ -887,35 +957,35 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64IntV(x.FMapUint64Int, e)
 			} // end block: if x.FMapUint64Int map == nil
-			if yyn102 {
+			if yyn106 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy293 := *x.FptrMapUint64Int
-				if yy293 == nil {
+				yy303 := *x.FptrMapUint64Int
+				if yy303 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64IntV(yy293, e)
-				} // end block: if yy293 map == nil
+					z.F.EncMapUint64IntV(yy303, e)
+				} // end block: if yy303 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapUint64Int64 == nil {
+			if x.FMapUint64Int32 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapUint64Int64V(x.FMapUint64Int64, e)
-			} // end block: if x.FMapUint64Int64 map == nil
-			if yyn104 {
+				z.F.EncMapUint64Int32V(x.FMapUint64Int32, e)
+			} // end block: if x.FMapUint64Int32 map == nil
+			if yyn108 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy296 := *x.FptrMapUint64Int64
-				if yy296 == nil {
+				yy306 := *x.FptrMapUint64Int32
+				if yy306 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Int64V(yy296, e)
-				} // end block: if yy296 map == nil
+					z.F.EncMapUint64Int32V(yy306, e)
+				} // end block: if yy306 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Float64 == nil {
@@ -923,17 +993,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64Float64V(x.FMapUint64Float64, e)
 			} // end block: if x.FMapUint64Float64 map == nil
-			if yyn106 {
+			if yyn110 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy299 := *x.FptrMapUint64Float64
-				if yy299 == nil {
+				yy309 := *x.FptrMapUint64Float64
+				if yy309 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Float64V(yy299, e)
-				} // end block: if yy299 map == nil
+					z.F.EncMapUint64Float64V(yy309, e)
+				} // end block: if yy309 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapUint64Bool == nil {
@@ -941,17 +1011,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapUint64BoolV(x.FMapUint64Bool, e)
 			} // end block: if x.FMapUint64Bool map == nil
-			if yyn108 {
+			if yyn112 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy302 := *x.FptrMapUint64Bool
-				if yy302 == nil {
+				yy312 := *x.FptrMapUint64Bool
+				if yy312 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64BoolV(yy302, e)
-				} // end block: if yy302 map == nil
+					z.F.EncMapUint64BoolV(yy312, e)
+				} // end block: if yy312 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntIntf == nil {
@@ -959,17 +1029,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntIntfV(x.FMapIntIntf, e)
 			} // end block: if x.FMapIntIntf map == nil
-			if yyn110 {
+			if yyn114 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy305 := *x.FptrMapIntIntf
-				if yy305 == nil {
+				yy315 := *x.FptrMapIntIntf
+				if yy315 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntIntfV(yy305, e)
-				} // end block: if yy305 map == nil
+					z.F.EncMapIntIntfV(yy315, e)
+				} // end block: if yy315 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntString == nil {
@@ -977,17 +1047,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntStringV(x.FMapIntString, e)
 			} // end block: if x.FMapIntString map == nil
-			if yyn112 {
+			if yyn116 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy308 := *x.FptrMapIntString
-				if yy308 == nil {
+				yy318 := *x.FptrMapIntString
+				if yy318 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntStringV(yy308, e)
-				} // end block: if yy308 map == nil
+					z.F.EncMapIntStringV(yy318, e)
+				} // end block: if yy318 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntBytes == nil {
@@ -995,17 +1065,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntBytesV(x.FMapIntBytes, e)
 			} // end block: if x.FMapIntBytes map == nil
-			if yyn114 {
+			if yyn118 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy311 := *x.FptrMapIntBytes
-				if yy311 == nil {
+				yy321 := *x.FptrMapIntBytes
+				if yy321 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntBytesV(yy311, e)
-				} // end block: if yy311 map == nil
+					z.F.EncMapIntBytesV(yy321, e)
+				} // end block: if yy321 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntUint8 == nil {
@@ -1013,17 +1083,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntUint8V(x.FMapIntUint8, e)
 			} // end block: if x.FMapIntUint8 map == nil
-			if yyn116 {
+			if yyn120 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy314 := *x.FptrMapIntUint8
-				if yy314 == nil {
+				yy324 := *x.FptrMapIntUint8
+				if yy324 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntUint8V(yy314, e)
-				} // end block: if yy314 map == nil
+					z.F.EncMapIntUint8V(yy324, e)
+				} // end block: if yy324 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntUint64 == nil {
@@ -1031,17 +1101,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntUint64V(x.FMapIntUint64, e)
 			} // end block: if x.FMapIntUint64 map == nil
-			if yyn118 {
+			if yyn122 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy317 := *x.FptrMapIntUint64
-				if yy317 == nil {
+				yy327 := *x.FptrMapIntUint64
+				if yy327 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntUint64V(yy317, e)
-				} // end block: if yy317 map == nil
+					z.F.EncMapIntUint64V(yy327, e)
+				} // end block: if yy327 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntInt == nil {
@@ -1049,35 +1119,35 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntIntV(x.FMapIntInt, e)
 			} // end block: if x.FMapIntInt map == nil
-			if yyn120 {
+			if yyn124 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy320 := *x.FptrMapIntInt
-				if yy320 == nil {
+				yy330 := *x.FptrMapIntInt
+				if yy330 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntIntV(yy320, e)
-				} // end block: if yy320 map == nil
+					z.F.EncMapIntIntV(yy330, e)
+				} // end block: if yy330 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapIntInt64 == nil {
+			if x.FMapIntInt32 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapIntInt64V(x.FMapIntInt64, e)
-			} // end block: if x.FMapIntInt64 map == nil
-			if yyn122 {
+				z.F.EncMapIntInt32V(x.FMapIntInt32, e)
+			} // end block: if x.FMapIntInt32 map == nil
+			if yyn126 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy323 := *x.FptrMapIntInt64
-				if yy323 == nil {
+				yy333 := *x.FptrMapIntInt32
+				if yy333 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntInt64V(yy323, e)
-				} // end block: if yy323 map == nil
+					z.F.EncMapIntInt32V(yy333, e)
+				} // end block: if yy333 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntFloat64 == nil {
@@ -1085,17 +1155,17 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntFloat64V(x.FMapIntFloat64, e)
 			} // end block: if x.FMapIntFloat64 map == nil
-			if yyn124 {
+			if yyn128 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy326 := *x.FptrMapIntFloat64
-				if yy326 == nil {
+				yy336 := *x.FptrMapIntFloat64
+				if yy336 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntFloat64V(yy326, e)
-				} // end block: if yy326 map == nil
+					z.F.EncMapIntFloat64V(yy336, e)
+				} // end block: if yy336 map == nil
 			}
 			z.EncWriteArrayElem()
 			if x.FMapIntBool == nil {
@@ -1103,2113 +1173,4163 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.F.EncMapIntBoolV(x.FMapIntBool, e)
 			} // end block: if x.FMapIntBool map == nil
-			if yyn126 {
+			if yyn130 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy329 := *x.FptrMapIntBool
-				if yy329 == nil {
+				yy339 := *x.FptrMapIntBool
+				if yy339 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntBoolV(yy329, e)
-				} // end block: if yy329 map == nil
+					z.F.EncMapIntBoolV(yy339, e)
+				} // end block: if yy339 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Intf == nil {
+			if x.FMapInt32Intf == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64IntfV(x.FMapInt64Intf, e)
-			} // end block: if x.FMapInt64Intf map == nil
-			if yyn128 {
+				z.F.EncMapInt32IntfV(x.FMapInt32Intf, e)
+			} // end block: if x.FMapInt32Intf map == nil
+			if yyn132 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy332 := *x.FptrMapInt64Intf
-				if yy332 == nil {
+				yy342 := *x.FptrMapInt32Intf
+				if yy342 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64IntfV(yy332, e)
-				} // end block: if yy332 map == nil
+					z.F.EncMapInt32IntfV(yy342, e)
+				} // end block: if yy342 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64String == nil {
+			if x.FMapInt32String == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64StringV(x.FMapInt64String, e)
-			} // end block: if x.FMapInt64String map == nil
-			if yyn130 {
+				z.F.EncMapInt32StringV(x.FMapInt32String, e)
+			} // end block: if x.FMapInt32String map == nil
+			if yyn134 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy335 := *x.FptrMapInt64String
-				if yy335 == nil {
+				yy345 := *x.FptrMapInt32String
+				if yy345 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64StringV(yy335, e)
-				} // end block: if yy335 map == nil
+					z.F.EncMapInt32StringV(yy345, e)
+				} // end block: if yy345 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Bytes == nil {
+			if x.FMapInt32Bytes == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64BytesV(x.FMapInt64Bytes, e)
-			} // end block: if x.FMapInt64Bytes map == nil
-			if yyn132 {
+				z.F.EncMapInt32BytesV(x.FMapInt32Bytes, e)
+			} // end block: if x.FMapInt32Bytes map == nil
+			if yyn136 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy338 := *x.FptrMapInt64Bytes
-				if yy338 == nil {
+				yy348 := *x.FptrMapInt32Bytes
+				if yy348 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64BytesV(yy338, e)
-				} // end block: if yy338 map == nil
+					z.F.EncMapInt32BytesV(yy348, e)
+				} // end block: if yy348 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Uint8 == nil {
+			if x.FMapInt32Uint8 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64Uint8V(x.FMapInt64Uint8, e)
-			} // end block: if x.FMapInt64Uint8 map == nil
-			if yyn134 {
+				z.F.EncMapInt32Uint8V(x.FMapInt32Uint8, e)
+			} // end block: if x.FMapInt32Uint8 map == nil
+			if yyn138 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy341 := *x.FptrMapInt64Uint8
-				if yy341 == nil {
+				yy351 := *x.FptrMapInt32Uint8
+				if yy351 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Uint8V(yy341, e)
-				} // end block: if yy341 map == nil
+					z.F.EncMapInt32Uint8V(yy351, e)
+				} // end block: if yy351 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Uint64 == nil {
+			if x.FMapInt32Uint64 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64Uint64V(x.FMapInt64Uint64, e)
-			} // end block: if x.FMapInt64Uint64 map == nil
-			if yyn136 {
+				z.F.EncMapInt32Uint64V(x.FMapInt32Uint64, e)
+			} // end block: if x.FMapInt32Uint64 map == nil
+			if yyn140 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy344 := *x.FptrMapInt64Uint64
-				if yy344 == nil {
+				yy354 := *x.FptrMapInt32Uint64
+				if yy354 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Uint64V(yy344, e)
-				} // end block: if yy344 map == nil
+					z.F.EncMapInt32Uint64V(yy354, e)
+				} // end block: if yy354 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Int == nil {
+			if x.FMapInt32Int == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64IntV(x.FMapInt64Int, e)
-			} // end block: if x.FMapInt64Int map == nil
-			if yyn138 {
+				z.F.EncMapInt32IntV(x.FMapInt32Int, e)
+			} // end block: if x.FMapInt32Int map == nil
+			if yyn142 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy347 := *x.FptrMapInt64Int
-				if yy347 == nil {
+				yy357 := *x.FptrMapInt32Int
+				if yy357 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64IntV(yy347, e)
-				} // end block: if yy347 map == nil
+					z.F.EncMapInt32IntV(yy357, e)
+				} // end block: if yy357 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Int64 == nil {
+			if x.FMapInt32Int32 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64Int64V(x.FMapInt64Int64, e)
-			} // end block: if x.FMapInt64Int64 map == nil
-			if yyn140 {
+				z.F.EncMapInt32Int32V(x.FMapInt32Int32, e)
+			} // end block: if x.FMapInt32Int32 map == nil
+			if yyn144 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy350 := *x.FptrMapInt64Int64
-				if yy350 == nil {
+				yy360 := *x.FptrMapInt32Int32
+				if yy360 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Int64V(yy350, e)
-				} // end block: if yy350 map == nil
+					z.F.EncMapInt32Int32V(yy360, e)
+				} // end block: if yy360 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Float64 == nil {
+			if x.FMapInt32Float64 == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64Float64V(x.FMapInt64Float64, e)
-			} // end block: if x.FMapInt64Float64 map == nil
-			if yyn142 {
+				z.F.EncMapInt32Float64V(x.FMapInt32Float64, e)
+			} // end block: if x.FMapInt32Float64 map == nil
+			if yyn146 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy353 := *x.FptrMapInt64Float64
-				if yy353 == nil {
+				yy363 := *x.FptrMapInt32Float64
+				if yy363 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Float64V(yy353, e)
-				} // end block: if yy353 map == nil
+					z.F.EncMapInt32Float64V(yy363, e)
+				} // end block: if yy363 map == nil
 			}
 			z.EncWriteArrayElem()
-			if x.FMapInt64Bool == nil {
+			if x.FMapInt32Bool == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapInt64BoolV(x.FMapInt64Bool, e)
-			} // end block: if x.FMapInt64Bool map == nil
-			if yyn144 {
+				z.F.EncMapInt32BoolV(x.FMapInt32Bool, e)
+			} // end block: if x.FMapInt32Bool map == nil
+			if yyn148 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy356 := *x.FptrMapInt64Bool
-				if yy356 == nil {
+				yy366 := *x.FptrMapInt32Bool
+				if yy366 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64BoolV(yy356, e)
-				} // end block: if yy356 map == nil
+					z.F.EncMapInt32BoolV(yy366, e)
+				} // end block: if yy366 map == nil
 			}
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(142)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FIntf\"")
-			} else {
-				r.EncodeString(`FIntf`)
-			}
-			z.EncWriteMapElemValue()
-			z.EncFallback(x.FIntf)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrIntf\"")
-			} else {
-				r.EncodeString(`FptrIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn4 {
-				r.EncodeNil()
-			} else {
-				yy359 := *x.FptrIntf
-				z.EncFallback(yy359)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FString\"")
-			} else {
-				r.EncodeString(`FString`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.FString))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrString\"")
-			} else {
-				r.EncodeString(`FptrString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn6 {
-				r.EncodeNil()
-			} else {
-				yy362 := *x.FptrString
-				r.EncodeString(string(yy362))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FBytes\"")
-			} else {
-				r.EncodeString(`FBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FBytes == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.FBytes))
-			} // end block: if x.FBytes slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrBytes\"")
-			} else {
-				r.EncodeString(`FptrBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn8 {
-				r.EncodeNil()
-			} else {
-				yy365 := *x.FptrBytes
-				if yy365 == nil {
+			z.EncWriteMapStart(146)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FBool\"")
+				} else {
+					r.EncodeString(`FBool`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.FBool))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FBytes\"")
+				} else {
+					r.EncodeString(`FBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FBytes == nil {
 					r.EncodeNil()
 				} else {
-					r.EncodeStringBytesRaw([]byte(yy365))
-				} // end block: if yy365 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FFloat32\"")
-			} else {
-				r.EncodeString(`FFloat32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat32(float32(x.FFloat32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrFloat32\"")
-			} else {
-				r.EncodeString(`FptrFloat32`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn10 {
-				r.EncodeNil()
-			} else {
-				yy368 := *x.FptrFloat32
-				r.EncodeFloat32(float32(yy368))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FFloat64\"")
-			} else {
-				r.EncodeString(`FFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat64(float64(x.FFloat64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrFloat64\"")
-			} else {
-				r.EncodeString(`FptrFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn12 {
-				r.EncodeNil()
-			} else {
-				yy371 := *x.FptrFloat64
-				r.EncodeFloat64(float64(yy371))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUint\"")
-			} else {
-				r.EncodeString(`FUint`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUint))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUint\"")
-			} else {
-				r.EncodeString(`FptrUint`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn14 {
-				r.EncodeNil()
-			} else {
-				yy374 := *x.FptrUint
-				r.EncodeUint(uint64(yy374))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUint8\"")
-			} else {
-				r.EncodeString(`FUint8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUint8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUint8\"")
-			} else {
-				r.EncodeString(`FptrUint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn16 {
-				r.EncodeNil()
-			} else {
-				yy377 := *x.FptrUint8
-				r.EncodeUint(uint64(yy377))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUint16\"")
-			} else {
-				r.EncodeString(`FUint16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUint16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUint16\"")
-			} else {
-				r.EncodeString(`FptrUint16`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn18 {
-				r.EncodeNil()
-			} else {
-				yy380 := *x.FptrUint16
-				r.EncodeUint(uint64(yy380))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUint32\"")
-			} else {
-				r.EncodeString(`FUint32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUint32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUint32\"")
-			} else {
-				r.EncodeString(`FptrUint32`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn20 {
-				r.EncodeNil()
-			} else {
-				yy383 := *x.FptrUint32
-				r.EncodeUint(uint64(yy383))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUint64\"")
-			} else {
-				r.EncodeString(`FUint64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUint64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUint64\"")
-			} else {
-				r.EncodeString(`FptrUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn22 {
-				r.EncodeNil()
-			} else {
-				yy386 := *x.FptrUint64
-				r.EncodeUint(uint64(yy386))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FUintptr\"")
-			} else {
-				r.EncodeString(`FUintptr`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.FUintptr))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrUintptr\"")
-			} else {
-				r.EncodeString(`FptrUintptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn24 {
-				r.EncodeNil()
-			} else {
-				yy389 := *x.FptrUintptr
-				r.EncodeUint(uint64(yy389))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FInt\"")
-			} else {
-				r.EncodeString(`FInt`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.FInt))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrInt\"")
-			} else {
-				r.EncodeString(`FptrInt`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn26 {
-				r.EncodeNil()
-			} else {
-				yy392 := *x.FptrInt
-				r.EncodeInt(int64(yy392))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FInt8\"")
-			} else {
-				r.EncodeString(`FInt8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.FInt8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrInt8\"")
-			} else {
-				r.EncodeString(`FptrInt8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn28 {
-				r.EncodeNil()
-			} else {
-				yy395 := *x.FptrInt8
-				r.EncodeInt(int64(yy395))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FInt16\"")
-			} else {
-				r.EncodeString(`FInt16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.FInt16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrInt16\"")
-			} else {
-				r.EncodeString(`FptrInt16`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn30 {
-				r.EncodeNil()
-			} else {
-				yy398 := *x.FptrInt16
-				r.EncodeInt(int64(yy398))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FInt32\"")
-			} else {
-				r.EncodeString(`FInt32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.FInt32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrInt32\"")
-			} else {
-				r.EncodeString(`FptrInt32`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn32 {
-				r.EncodeNil()
-			} else {
-				yy401 := *x.FptrInt32
-				r.EncodeInt(int64(yy401))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FInt64\"")
-			} else {
-				r.EncodeString(`FInt64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.FInt64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrInt64\"")
-			} else {
-				r.EncodeString(`FptrInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn34 {
-				r.EncodeNil()
-			} else {
-				yy404 := *x.FptrInt64
-				r.EncodeInt(int64(yy404))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FBool\"")
-			} else {
-				r.EncodeString(`FBool`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.FBool))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrBool\"")
-			} else {
-				r.EncodeString(`FptrBool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn36 {
-				r.EncodeNil()
-			} else {
-				yy407 := *x.FptrBool
-				r.EncodeBool(bool(yy407))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceIntf\"")
-			} else {
-				r.EncodeString(`FSliceIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceIntf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceIntfV(x.FSliceIntf, e)
-			} // end block: if x.FSliceIntf slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceIntf\"")
-			} else {
-				r.EncodeString(`FptrSliceIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn38 {
-				r.EncodeNil()
+					r.EncodeStringBytesRaw([]byte(x.FBytes))
+				} // end block: if x.FBytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFloat32\"")
+				} else {
+					r.EncodeString(`FFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.FFloat32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFloat64\"")
+				} else {
+					r.EncodeString(`FFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.FFloat64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt\"")
+				} else {
+					r.EncodeString(`FInt`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt16\"")
+				} else {
+					r.EncodeString(`FInt16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt32\"")
+				} else {
+					r.EncodeString(`FInt32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt64\"")
+				} else {
+					r.EncodeString(`FInt64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt8\"")
+				} else {
+					r.EncodeString(`FInt8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FIntf\"")
+				} else {
+					r.EncodeString(`FIntf`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.FIntf)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Bool\"")
+				} else {
+					r.EncodeString(`FMapInt32Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Bool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32BoolV(x.FMapInt32Bool, e)
+				} // end block: if x.FMapInt32Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Bytes\"")
+				} else {
+					r.EncodeString(`FMapInt32Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Bytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32BytesV(x.FMapInt32Bytes, e)
+				} // end block: if x.FMapInt32Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Float64\"")
+				} else {
+					r.EncodeString(`FMapInt32Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Float64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32Float64V(x.FMapInt32Float64, e)
+				} // end block: if x.FMapInt32Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Int\"")
+				} else {
+					r.EncodeString(`FMapInt32Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Int == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32IntV(x.FMapInt32Int, e)
+				} // end block: if x.FMapInt32Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Int32\"")
+				} else {
+					r.EncodeString(`FMapInt32Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Int32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32Int32V(x.FMapInt32Int32, e)
+				} // end block: if x.FMapInt32Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Intf\"")
+				} else {
+					r.EncodeString(`FMapInt32Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Intf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32IntfV(x.FMapInt32Intf, e)
+				} // end block: if x.FMapInt32Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32String\"")
+				} else {
+					r.EncodeString(`FMapInt32String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32String == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32StringV(x.FMapInt32String, e)
+				} // end block: if x.FMapInt32String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Uint64\"")
+				} else {
+					r.EncodeString(`FMapInt32Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Uint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32Uint64V(x.FMapInt32Uint64, e)
+				} // end block: if x.FMapInt32Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Uint8\"")
+				} else {
+					r.EncodeString(`FMapInt32Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Uint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32Uint8V(x.FMapInt32Uint8, e)
+				} // end block: if x.FMapInt32Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntBool\"")
+				} else {
+					r.EncodeString(`FMapIntBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntBool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntBoolV(x.FMapIntBool, e)
+				} // end block: if x.FMapIntBool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntBytes\"")
+				} else {
+					r.EncodeString(`FMapIntBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntBytesV(x.FMapIntBytes, e)
+				} // end block: if x.FMapIntBytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntFloat64\"")
+				} else {
+					r.EncodeString(`FMapIntFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntFloat64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntFloat64V(x.FMapIntFloat64, e)
+				} // end block: if x.FMapIntFloat64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntInt\"")
+				} else {
+					r.EncodeString(`FMapIntInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntInt == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntIntV(x.FMapIntInt, e)
+				} // end block: if x.FMapIntInt map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntInt32\"")
+				} else {
+					r.EncodeString(`FMapIntInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntInt32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntInt32V(x.FMapIntInt32, e)
+				} // end block: if x.FMapIntInt32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntIntf\"")
+				} else {
+					r.EncodeString(`FMapIntIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntIntf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntIntfV(x.FMapIntIntf, e)
+				} // end block: if x.FMapIntIntf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntString\"")
+				} else {
+					r.EncodeString(`FMapIntString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntString == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntStringV(x.FMapIntString, e)
+				} // end block: if x.FMapIntString map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntUint64\"")
+				} else {
+					r.EncodeString(`FMapIntUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntUint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntUint64V(x.FMapIntUint64, e)
+				} // end block: if x.FMapIntUint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntUint8\"")
+				} else {
+					r.EncodeString(`FMapIntUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntUint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntUint8V(x.FMapIntUint8, e)
+				} // end block: if x.FMapIntUint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringBool\"")
+				} else {
+					r.EncodeString(`FMapStringBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringBool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.FMapStringBool, e)
+				} // end block: if x.FMapStringBool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringBytes\"")
+				} else {
+					r.EncodeString(`FMapStringBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.FMapStringBytes, e)
+				} // end block: if x.FMapStringBytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringFloat64\"")
+				} else {
+					r.EncodeString(`FMapStringFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringFloat64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringFloat64V(x.FMapStringFloat64, e)
+				} // end block: if x.FMapStringFloat64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringInt\"")
+				} else {
+					r.EncodeString(`FMapStringInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringInt == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.FMapStringInt, e)
+				} // end block: if x.FMapStringInt map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringInt32\"")
+				} else {
+					r.EncodeString(`FMapStringInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringInt32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringInt32V(x.FMapStringInt32, e)
+				} // end block: if x.FMapStringInt32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringIntf\"")
+				} else {
+					r.EncodeString(`FMapStringIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringIntf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntfV(x.FMapStringIntf, e)
+				} // end block: if x.FMapStringIntf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringString\"")
+				} else {
+					r.EncodeString(`FMapStringString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringString == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.FMapStringString, e)
+				} // end block: if x.FMapStringString map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringUint64\"")
+				} else {
+					r.EncodeString(`FMapStringUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringUint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.FMapStringUint64, e)
+				} // end block: if x.FMapStringUint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringUint8\"")
+				} else {
+					r.EncodeString(`FMapStringUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringUint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint8V(x.FMapStringUint8, e)
+				} // end block: if x.FMapStringUint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Bool\"")
+				} else {
+					r.EncodeString(`FMapUint64Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Bool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64BoolV(x.FMapUint64Bool, e)
+				} // end block: if x.FMapUint64Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Bytes\"")
+				} else {
+					r.EncodeString(`FMapUint64Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Bytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64BytesV(x.FMapUint64Bytes, e)
+				} // end block: if x.FMapUint64Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Float64\"")
+				} else {
+					r.EncodeString(`FMapUint64Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Float64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64Float64V(x.FMapUint64Float64, e)
+				} // end block: if x.FMapUint64Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Int\"")
+				} else {
+					r.EncodeString(`FMapUint64Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Int == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64IntV(x.FMapUint64Int, e)
+				} // end block: if x.FMapUint64Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Int32\"")
+				} else {
+					r.EncodeString(`FMapUint64Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Int32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64Int32V(x.FMapUint64Int32, e)
+				} // end block: if x.FMapUint64Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Intf\"")
+				} else {
+					r.EncodeString(`FMapUint64Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Intf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64IntfV(x.FMapUint64Intf, e)
+				} // end block: if x.FMapUint64Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64String\"")
+				} else {
+					r.EncodeString(`FMapUint64String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64String == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64StringV(x.FMapUint64String, e)
+				} // end block: if x.FMapUint64String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Uint64\"")
+				} else {
+					r.EncodeString(`FMapUint64Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Uint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64Uint64V(x.FMapUint64Uint64, e)
+				} // end block: if x.FMapUint64Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Uint8\"")
+				} else {
+					r.EncodeString(`FMapUint64Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Uint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64Uint8V(x.FMapUint64Uint8, e)
+				} // end block: if x.FMapUint64Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Bool\"")
+				} else {
+					r.EncodeString(`FMapUint8Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Bool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8BoolV(x.FMapUint8Bool, e)
+				} // end block: if x.FMapUint8Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Bytes\"")
+				} else {
+					r.EncodeString(`FMapUint8Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Bytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8BytesV(x.FMapUint8Bytes, e)
+				} // end block: if x.FMapUint8Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Float64\"")
+				} else {
+					r.EncodeString(`FMapUint8Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Float64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Float64V(x.FMapUint8Float64, e)
+				} // end block: if x.FMapUint8Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Int\"")
+				} else {
+					r.EncodeString(`FMapUint8Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Int == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8IntV(x.FMapUint8Int, e)
+				} // end block: if x.FMapUint8Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Int32\"")
+				} else {
+					r.EncodeString(`FMapUint8Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Int32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Int32V(x.FMapUint8Int32, e)
+				} // end block: if x.FMapUint8Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Intf\"")
+				} else {
+					r.EncodeString(`FMapUint8Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Intf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8IntfV(x.FMapUint8Intf, e)
+				} // end block: if x.FMapUint8Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8String\"")
+				} else {
+					r.EncodeString(`FMapUint8String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8String == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8StringV(x.FMapUint8String, e)
+				} // end block: if x.FMapUint8String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Uint64\"")
+				} else {
+					r.EncodeString(`FMapUint8Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Uint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Uint64V(x.FMapUint8Uint64, e)
+				} // end block: if x.FMapUint8Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Uint8\"")
+				} else {
+					r.EncodeString(`FMapUint8Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Uint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Uint8V(x.FMapUint8Uint8, e)
+				} // end block: if x.FMapUint8Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceBool\"")
+				} else {
+					r.EncodeString(`FSliceBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceBool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.FSliceBool, e)
+				} // end block: if x.FSliceBool slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceBytes\"")
+				} else {
+					r.EncodeString(`FSliceBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.FSliceBytes, e)
+				} // end block: if x.FSliceBytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceFloat32\"")
+				} else {
+					r.EncodeString(`FSliceFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceFloat32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.FSliceFloat32, e)
+				} // end block: if x.FSliceFloat32 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceFloat64\"")
+				} else {
+					r.EncodeString(`FSliceFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceFloat64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.FSliceFloat64, e)
+				} // end block: if x.FSliceFloat64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt\"")
+				} else {
+					r.EncodeString(`FSliceInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntV(x.FSliceInt, e)
+				} // end block: if x.FSliceInt slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt32\"")
+				} else {
+					r.EncodeString(`FSliceInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.FSliceInt32, e)
+				} // end block: if x.FSliceInt32 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt64\"")
+				} else {
+					r.EncodeString(`FSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.FSliceInt64, e)
+				} // end block: if x.FSliceInt64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceIntf\"")
+				} else {
+					r.EncodeString(`FSliceIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceIntf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntfV(x.FSliceIntf, e)
+				} // end block: if x.FSliceIntf slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceString\"")
+				} else {
+					r.EncodeString(`FSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceString == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.FSliceString, e)
+				} // end block: if x.FSliceString slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceUint64\"")
+				} else {
+					r.EncodeString(`FSliceUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceUint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.FSliceUint64, e)
+				} // end block: if x.FSliceUint64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceUint8\"")
+				} else {
+					r.EncodeString(`FSliceUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceUint8 == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+				} // end block: if x.FSliceUint8 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FString\"")
+				} else {
+					r.EncodeString(`FString`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.FString))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint\"")
+				} else {
+					r.EncodeString(`FUint`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint16\"")
+				} else {
+					r.EncodeString(`FUint16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint32\"")
+				} else {
+					r.EncodeString(`FUint32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint64\"")
+				} else {
+					r.EncodeString(`FUint64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint8\"")
+				} else {
+					r.EncodeString(`FUint8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUintptr\"")
+				} else {
+					r.EncodeString(`FUintptr`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUintptr))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrBool\"")
+				} else {
+					r.EncodeString(`FptrBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn36 {
+					r.EncodeNil()
+				} else {
+					yy441 := *x.FptrBool
+					r.EncodeBool(bool(yy441))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrBytes\"")
+				} else {
+					r.EncodeString(`FptrBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn8 {
+					r.EncodeNil()
+				} else {
+					yy443 := *x.FptrBytes
+					if yy443 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy443))
+					} // end block: if yy443 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrFloat32\"")
+				} else {
+					r.EncodeString(`FptrFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn10 {
+					r.EncodeNil()
+				} else {
+					yy445 := *x.FptrFloat32
+					r.EncodeFloat32(float32(yy445))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrFloat64\"")
+				} else {
+					r.EncodeString(`FptrFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn12 {
+					r.EncodeNil()
+				} else {
+					yy447 := *x.FptrFloat64
+					r.EncodeFloat64(float64(yy447))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt\"")
+				} else {
+					r.EncodeString(`FptrInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn26 {
+					r.EncodeNil()
+				} else {
+					yy449 := *x.FptrInt
+					r.EncodeInt(int64(yy449))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt16\"")
+				} else {
+					r.EncodeString(`FptrInt16`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn30 {
+					r.EncodeNil()
+				} else {
+					yy451 := *x.FptrInt16
+					r.EncodeInt(int64(yy451))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt32\"")
+				} else {
+					r.EncodeString(`FptrInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn32 {
+					r.EncodeNil()
+				} else {
+					yy453 := *x.FptrInt32
+					r.EncodeInt(int64(yy453))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt64\"")
+				} else {
+					r.EncodeString(`FptrInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn34 {
+					r.EncodeNil()
+				} else {
+					yy455 := *x.FptrInt64
+					r.EncodeInt(int64(yy455))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt8\"")
+				} else {
+					r.EncodeString(`FptrInt8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn28 {
+					r.EncodeNil()
+				} else {
+					yy457 := *x.FptrInt8
+					r.EncodeInt(int64(yy457))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrIntf\"")
+				} else {
+					r.EncodeString(`FptrIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn4 {
+					r.EncodeNil()
+				} else {
+					yy459 := *x.FptrIntf
+					z.EncFallback(yy459)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Bool\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn148 {
+					r.EncodeNil()
+				} else {
+					yy461 := *x.FptrMapInt32Bool
+					if yy461 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32BoolV(yy461, e)
+					} // end block: if yy461 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn136 {
+					r.EncodeNil()
+				} else {
+					yy463 := *x.FptrMapInt32Bytes
+					if yy463 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32BytesV(yy463, e)
+					} // end block: if yy463 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Float64\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn146 {
+					r.EncodeNil()
+				} else {
+					yy465 := *x.FptrMapInt32Float64
+					if yy465 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Float64V(yy465, e)
+					} // end block: if yy465 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Int\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn142 {
+					r.EncodeNil()
+				} else {
+					yy467 := *x.FptrMapInt32Int
+					if yy467 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32IntV(yy467, e)
+					} // end block: if yy467 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Int32\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn144 {
+					r.EncodeNil()
+				} else {
+					yy469 := *x.FptrMapInt32Int32
+					if yy469 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Int32V(yy469, e)
+					} // end block: if yy469 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Intf\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn132 {
+					r.EncodeNil()
+				} else {
+					yy471 := *x.FptrMapInt32Intf
+					if yy471 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32IntfV(yy471, e)
+					} // end block: if yy471 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32String\"")
+				} else {
+					r.EncodeString(`FptrMapInt32String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn134 {
+					r.EncodeNil()
+				} else {
+					yy473 := *x.FptrMapInt32String
+					if yy473 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32StringV(yy473, e)
+					} // end block: if yy473 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn140 {
+					r.EncodeNil()
+				} else {
+					yy475 := *x.FptrMapInt32Uint64
+					if yy475 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Uint64V(yy475, e)
+					} // end block: if yy475 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn138 {
+					r.EncodeNil()
+				} else {
+					yy477 := *x.FptrMapInt32Uint8
+					if yy477 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Uint8V(yy477, e)
+					} // end block: if yy477 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntBool\"")
+				} else {
+					r.EncodeString(`FptrMapIntBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn130 {
+					r.EncodeNil()
+				} else {
+					yy479 := *x.FptrMapIntBool
+					if yy479 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntBoolV(yy479, e)
+					} // end block: if yy479 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntBytes\"")
+				} else {
+					r.EncodeString(`FptrMapIntBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn118 {
+					r.EncodeNil()
+				} else {
+					yy481 := *x.FptrMapIntBytes
+					if yy481 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntBytesV(yy481, e)
+					} // end block: if yy481 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntFloat64\"")
+				} else {
+					r.EncodeString(`FptrMapIntFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn128 {
+					r.EncodeNil()
+				} else {
+					yy483 := *x.FptrMapIntFloat64
+					if yy483 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntFloat64V(yy483, e)
+					} // end block: if yy483 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntInt\"")
+				} else {
+					r.EncodeString(`FptrMapIntInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn124 {
+					r.EncodeNil()
+				} else {
+					yy485 := *x.FptrMapIntInt
+					if yy485 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntIntV(yy485, e)
+					} // end block: if yy485 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntInt32\"")
+				} else {
+					r.EncodeString(`FptrMapIntInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn126 {
+					r.EncodeNil()
+				} else {
+					yy487 := *x.FptrMapIntInt32
+					if yy487 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntInt32V(yy487, e)
+					} // end block: if yy487 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntIntf\"")
+				} else {
+					r.EncodeString(`FptrMapIntIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn114 {
+					r.EncodeNil()
+				} else {
+					yy489 := *x.FptrMapIntIntf
+					if yy489 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntIntfV(yy489, e)
+					} // end block: if yy489 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntString\"")
+				} else {
+					r.EncodeString(`FptrMapIntString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn116 {
+					r.EncodeNil()
+				} else {
+					yy491 := *x.FptrMapIntString
+					if yy491 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntStringV(yy491, e)
+					} // end block: if yy491 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntUint64\"")
+				} else {
+					r.EncodeString(`FptrMapIntUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn122 {
+					r.EncodeNil()
+				} else {
+					yy493 := *x.FptrMapIntUint64
+					if yy493 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntUint64V(yy493, e)
+					} // end block: if yy493 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntUint8\"")
+				} else {
+					r.EncodeString(`FptrMapIntUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn120 {
+					r.EncodeNil()
+				} else {
+					yy495 := *x.FptrMapIntUint8
+					if yy495 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntUint8V(yy495, e)
+					} // end block: if yy495 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringBool\"")
+				} else {
+					r.EncodeString(`FptrMapStringBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn76 {
+					r.EncodeNil()
+				} else {
+					yy497 := *x.FptrMapStringBool
+					if yy497 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBoolV(yy497, e)
+					} // end block: if yy497 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringBytes\"")
+				} else {
+					r.EncodeString(`FptrMapStringBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn64 {
+					r.EncodeNil()
+				} else {
+					yy499 := *x.FptrMapStringBytes
+					if yy499 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBytesV(yy499, e)
+					} // end block: if yy499 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringFloat64\"")
+				} else {
+					r.EncodeString(`FptrMapStringFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn74 {
+					r.EncodeNil()
+				} else {
+					yy501 := *x.FptrMapStringFloat64
+					if yy501 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringFloat64V(yy501, e)
+					} // end block: if yy501 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringInt\"")
+				} else {
+					r.EncodeString(`FptrMapStringInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn70 {
+					r.EncodeNil()
+				} else {
+					yy503 := *x.FptrMapStringInt
+					if yy503 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntV(yy503, e)
+					} // end block: if yy503 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringInt32\"")
+				} else {
+					r.EncodeString(`FptrMapStringInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn72 {
+					r.EncodeNil()
+				} else {
+					yy505 := *x.FptrMapStringInt32
+					if yy505 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringInt32V(yy505, e)
+					} // end block: if yy505 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringIntf\"")
+				} else {
+					r.EncodeString(`FptrMapStringIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn60 {
+					r.EncodeNil()
+				} else {
+					yy507 := *x.FptrMapStringIntf
+					if yy507 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntfV(yy507, e)
+					} // end block: if yy507 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringString\"")
+				} else {
+					r.EncodeString(`FptrMapStringString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn62 {
+					r.EncodeNil()
+				} else {
+					yy509 := *x.FptrMapStringString
+					if yy509 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringStringV(yy509, e)
+					} // end block: if yy509 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringUint64\"")
+				} else {
+					r.EncodeString(`FptrMapStringUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn68 {
+					r.EncodeNil()
+				} else {
+					yy511 := *x.FptrMapStringUint64
+					if yy511 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(yy511, e)
+					} // end block: if yy511 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringUint8\"")
+				} else {
+					r.EncodeString(`FptrMapStringUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn66 {
+					r.EncodeNil()
+				} else {
+					yy513 := *x.FptrMapStringUint8
+					if yy513 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint8V(yy513, e)
+					} // end block: if yy513 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Bool\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn112 {
+					r.EncodeNil()
+				} else {
+					yy515 := *x.FptrMapUint64Bool
+					if yy515 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64BoolV(yy515, e)
+					} // end block: if yy515 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn100 {
+					r.EncodeNil()
+				} else {
+					yy517 := *x.FptrMapUint64Bytes
+					if yy517 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64BytesV(yy517, e)
+					} // end block: if yy517 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Float64\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn110 {
+					r.EncodeNil()
+				} else {
+					yy519 := *x.FptrMapUint64Float64
+					if yy519 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Float64V(yy519, e)
+					} // end block: if yy519 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Int\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn106 {
+					r.EncodeNil()
+				} else {
+					yy521 := *x.FptrMapUint64Int
+					if yy521 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64IntV(yy521, e)
+					} // end block: if yy521 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Int32\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn108 {
+					r.EncodeNil()
+				} else {
+					yy523 := *x.FptrMapUint64Int32
+					if yy523 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Int32V(yy523, e)
+					} // end block: if yy523 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Intf\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn96 {
+					r.EncodeNil()
+				} else {
+					yy525 := *x.FptrMapUint64Intf
+					if yy525 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64IntfV(yy525, e)
+					} // end block: if yy525 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64String\"")
+				} else {
+					r.EncodeString(`FptrMapUint64String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn98 {
+					r.EncodeNil()
+				} else {
+					yy527 := *x.FptrMapUint64String
+					if yy527 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64StringV(yy527, e)
+					} // end block: if yy527 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn104 {
+					r.EncodeNil()
+				} else {
+					yy529 := *x.FptrMapUint64Uint64
+					if yy529 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Uint64V(yy529, e)
+					} // end block: if yy529 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn102 {
+					r.EncodeNil()
+				} else {
+					yy531 := *x.FptrMapUint64Uint8
+					if yy531 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Uint8V(yy531, e)
+					} // end block: if yy531 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Bool\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn94 {
+					r.EncodeNil()
+				} else {
+					yy533 := *x.FptrMapUint8Bool
+					if yy533 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8BoolV(yy533, e)
+					} // end block: if yy533 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn82 {
+					r.EncodeNil()
+				} else {
+					yy535 := *x.FptrMapUint8Bytes
+					if yy535 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8BytesV(yy535, e)
+					} // end block: if yy535 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Float64\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn92 {
+					r.EncodeNil()
+				} else {
+					yy537 := *x.FptrMapUint8Float64
+					if yy537 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Float64V(yy537, e)
+					} // end block: if yy537 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Int\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn88 {
+					r.EncodeNil()
+				} else {
+					yy539 := *x.FptrMapUint8Int
+					if yy539 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8IntV(yy539, e)
+					} // end block: if yy539 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Int32\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn90 {
+					r.EncodeNil()
+				} else {
+					yy541 := *x.FptrMapUint8Int32
+					if yy541 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Int32V(yy541, e)
+					} // end block: if yy541 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Intf\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn78 {
+					r.EncodeNil()
+				} else {
+					yy543 := *x.FptrMapUint8Intf
+					if yy543 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8IntfV(yy543, e)
+					} // end block: if yy543 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8String\"")
+				} else {
+					r.EncodeString(`FptrMapUint8String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn80 {
+					r.EncodeNil()
+				} else {
+					yy545 := *x.FptrMapUint8String
+					if yy545 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8StringV(yy545, e)
+					} // end block: if yy545 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn86 {
+					r.EncodeNil()
+				} else {
+					yy547 := *x.FptrMapUint8Uint64
+					if yy547 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Uint64V(yy547, e)
+					} // end block: if yy547 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn84 {
+					r.EncodeNil()
+				} else {
+					yy549 := *x.FptrMapUint8Uint8
+					if yy549 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Uint8V(yy549, e)
+					} // end block: if yy549 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceBool\"")
+				} else {
+					r.EncodeString(`FptrSliceBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy551 := *x.FptrSliceBool
+					if yy551 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBoolV(yy551, e)
+					} // end block: if yy551 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceBytes\"")
+				} else {
+					r.EncodeString(`FptrSliceBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn42 {
+					r.EncodeNil()
+				} else {
+					yy553 := *x.FptrSliceBytes
+					if yy553 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBytesV(yy553, e)
+					} // end block: if yy553 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceFloat32\"")
+				} else {
+					r.EncodeString(`FptrSliceFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn44 {
+					r.EncodeNil()
+				} else {
+					yy555 := *x.FptrSliceFloat32
+					if yy555 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat32V(yy555, e)
+					} // end block: if yy555 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceFloat64\"")
+				} else {
+					r.EncodeString(`FptrSliceFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn46 {
+					r.EncodeNil()
+				} else {
+					yy557 := *x.FptrSliceFloat64
+					if yy557 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat64V(yy557, e)
+					} // end block: if yy557 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt\"")
+				} else {
+					r.EncodeString(`FptrSliceInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn52 {
+					r.EncodeNil()
+				} else {
+					yy559 := *x.FptrSliceInt
+					if yy559 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntV(yy559, e)
+					} // end block: if yy559 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt32\"")
+				} else {
+					r.EncodeString(`FptrSliceInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy561 := *x.FptrSliceInt32
+					if yy561 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt32V(yy561, e)
+					} // end block: if yy561 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt64\"")
+				} else {
+					r.EncodeString(`FptrSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn56 {
+					r.EncodeNil()
+				} else {
+					yy563 := *x.FptrSliceInt64
+					if yy563 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(yy563, e)
+					} // end block: if yy563 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceIntf\"")
+				} else {
+					r.EncodeString(`FptrSliceIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn38 {
+					r.EncodeNil()
+				} else {
+					yy565 := *x.FptrSliceIntf
+					if yy565 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntfV(yy565, e)
+					} // end block: if yy565 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceString\"")
+				} else {
+					r.EncodeString(`FptrSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn40 {
+					r.EncodeNil()
+				} else {
+					yy567 := *x.FptrSliceString
+					if yy567 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(yy567, e)
+					} // end block: if yy567 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceUint64\"")
+				} else {
+					r.EncodeString(`FptrSliceUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn50 {
+					r.EncodeNil()
+				} else {
+					yy569 := *x.FptrSliceUint64
+					if yy569 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(yy569, e)
+					} // end block: if yy569 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceUint8\"")
+				} else {
+					r.EncodeString(`FptrSliceUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn48 {
+					r.EncodeNil()
+				} else {
+					yy571 := *x.FptrSliceUint8
+					if yy571 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy571))
+					} // end block: if yy571 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrString\"")
+				} else {
+					r.EncodeString(`FptrString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn6 {
+					r.EncodeNil()
+				} else {
+					yy573 := *x.FptrString
+					r.EncodeString(string(yy573))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint\"")
+				} else {
+					r.EncodeString(`FptrUint`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn14 {
+					r.EncodeNil()
+				} else {
+					yy575 := *x.FptrUint
+					r.EncodeUint(uint64(yy575))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint16\"")
+				} else {
+					r.EncodeString(`FptrUint16`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn18 {
+					r.EncodeNil()
+				} else {
+					yy577 := *x.FptrUint16
+					r.EncodeUint(uint64(yy577))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint32\"")
+				} else {
+					r.EncodeString(`FptrUint32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn20 {
+					r.EncodeNil()
+				} else {
+					yy579 := *x.FptrUint32
+					r.EncodeUint(uint64(yy579))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint64\"")
+				} else {
+					r.EncodeString(`FptrUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn22 {
+					r.EncodeNil()
+				} else {
+					yy581 := *x.FptrUint64
+					r.EncodeUint(uint64(yy581))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint8\"")
+				} else {
+					r.EncodeString(`FptrUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn16 {
+					r.EncodeNil()
+				} else {
+					yy583 := *x.FptrUint8
+					r.EncodeUint(uint64(yy583))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUintptr\"")
+				} else {
+					r.EncodeString(`FptrUintptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn24 {
+					r.EncodeNil()
+				} else {
+					yy585 := *x.FptrUintptr
+					r.EncodeUint(uint64(yy585))
+				}
 			} else {
-				yy410 := *x.FptrSliceIntf
-				if yy410 == nil {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FIntf\"")
+				} else {
+					r.EncodeString(`FIntf`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.FIntf)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrIntf\"")
+				} else {
+					r.EncodeString(`FptrIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn4 {
+					r.EncodeNil()
+				} else {
+					yy588 := *x.FptrIntf
+					z.EncFallback(yy588)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FString\"")
+				} else {
+					r.EncodeString(`FString`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.FString))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrString\"")
+				} else {
+					r.EncodeString(`FptrString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn6 {
+					r.EncodeNil()
+				} else {
+					yy591 := *x.FptrString
+					r.EncodeString(string(yy591))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FBytes\"")
+				} else {
+					r.EncodeString(`FBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FBytes == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.FBytes))
+				} // end block: if x.FBytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrBytes\"")
+				} else {
+					r.EncodeString(`FptrBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn8 {
+					r.EncodeNil()
+				} else {
+					yy594 := *x.FptrBytes
+					if yy594 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy594))
+					} // end block: if yy594 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFloat32\"")
+				} else {
+					r.EncodeString(`FFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.FFloat32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrFloat32\"")
+				} else {
+					r.EncodeString(`FptrFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn10 {
+					r.EncodeNil()
+				} else {
+					yy597 := *x.FptrFloat32
+					r.EncodeFloat32(float32(yy597))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFloat64\"")
+				} else {
+					r.EncodeString(`FFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.FFloat64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrFloat64\"")
+				} else {
+					r.EncodeString(`FptrFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn12 {
+					r.EncodeNil()
+				} else {
+					yy600 := *x.FptrFloat64
+					r.EncodeFloat64(float64(yy600))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint\"")
+				} else {
+					r.EncodeString(`FUint`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint\"")
+				} else {
+					r.EncodeString(`FptrUint`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn14 {
+					r.EncodeNil()
+				} else {
+					yy603 := *x.FptrUint
+					r.EncodeUint(uint64(yy603))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint8\"")
+				} else {
+					r.EncodeString(`FUint8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint8\"")
+				} else {
+					r.EncodeString(`FptrUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn16 {
+					r.EncodeNil()
+				} else {
+					yy606 := *x.FptrUint8
+					r.EncodeUint(uint64(yy606))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint16\"")
+				} else {
+					r.EncodeString(`FUint16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint16\"")
+				} else {
+					r.EncodeString(`FptrUint16`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn18 {
+					r.EncodeNil()
+				} else {
+					yy609 := *x.FptrUint16
+					r.EncodeUint(uint64(yy609))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint32\"")
+				} else {
+					r.EncodeString(`FUint32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint32\"")
+				} else {
+					r.EncodeString(`FptrUint32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn20 {
+					r.EncodeNil()
+				} else {
+					yy612 := *x.FptrUint32
+					r.EncodeUint(uint64(yy612))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUint64\"")
+				} else {
+					r.EncodeString(`FUint64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUint64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUint64\"")
+				} else {
+					r.EncodeString(`FptrUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn22 {
+					r.EncodeNil()
+				} else {
+					yy615 := *x.FptrUint64
+					r.EncodeUint(uint64(yy615))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FUintptr\"")
+				} else {
+					r.EncodeString(`FUintptr`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.FUintptr))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrUintptr\"")
+				} else {
+					r.EncodeString(`FptrUintptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn24 {
+					r.EncodeNil()
+				} else {
+					yy618 := *x.FptrUintptr
+					r.EncodeUint(uint64(yy618))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt\"")
+				} else {
+					r.EncodeString(`FInt`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt\"")
+				} else {
+					r.EncodeString(`FptrInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn26 {
+					r.EncodeNil()
+				} else {
+					yy621 := *x.FptrInt
+					r.EncodeInt(int64(yy621))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt8\"")
+				} else {
+					r.EncodeString(`FInt8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt8\"")
+				} else {
+					r.EncodeString(`FptrInt8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn28 {
+					r.EncodeNil()
+				} else {
+					yy624 := *x.FptrInt8
+					r.EncodeInt(int64(yy624))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt16\"")
+				} else {
+					r.EncodeString(`FInt16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt16\"")
+				} else {
+					r.EncodeString(`FptrInt16`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn30 {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceIntfV(yy410, e)
-				} // end block: if yy410 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceString\"")
-			} else {
-				r.EncodeString(`FSliceString`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceString == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.FSliceString, e)
-			} // end block: if x.FSliceString slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceString\"")
-			} else {
-				r.EncodeString(`FptrSliceString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn40 {
-				r.EncodeNil()
-			} else {
-				yy413 := *x.FptrSliceString
-				if yy413 == nil {
+					yy627 := *x.FptrInt16
+					r.EncodeInt(int64(yy627))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt32\"")
+				} else {
+					r.EncodeString(`FInt32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt32\"")
+				} else {
+					r.EncodeString(`FptrInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn32 {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceStringV(yy413, e)
-				} // end block: if yy413 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceBytes\"")
-			} else {
-				r.EncodeString(`FSliceBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceBytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBytesV(x.FSliceBytes, e)
-			} // end block: if x.FSliceBytes slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceBytes\"")
-			} else {
-				r.EncodeString(`FptrSliceBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn42 {
-				r.EncodeNil()
-			} else {
-				yy416 := *x.FptrSliceBytes
-				if yy416 == nil {
+					yy630 := *x.FptrInt32
+					r.EncodeInt(int64(yy630))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FInt64\"")
+				} else {
+					r.EncodeString(`FInt64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.FInt64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrInt64\"")
+				} else {
+					r.EncodeString(`FptrInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn34 {
+					r.EncodeNil()
+				} else {
+					yy633 := *x.FptrInt64
+					r.EncodeInt(int64(yy633))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FBool\"")
+				} else {
+					r.EncodeString(`FBool`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.FBool))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrBool\"")
+				} else {
+					r.EncodeString(`FptrBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn36 {
+					r.EncodeNil()
+				} else {
+					yy636 := *x.FptrBool
+					r.EncodeBool(bool(yy636))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceIntf\"")
+				} else {
+					r.EncodeString(`FSliceIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceIntf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntfV(x.FSliceIntf, e)
+				} // end block: if x.FSliceIntf slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceIntf\"")
+				} else {
+					r.EncodeString(`FptrSliceIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn38 {
+					r.EncodeNil()
+				} else {
+					yy639 := *x.FptrSliceIntf
+					if yy639 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntfV(yy639, e)
+					} // end block: if yy639 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceString\"")
+				} else {
+					r.EncodeString(`FSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceString == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.FSliceString, e)
+				} // end block: if x.FSliceString slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceString\"")
+				} else {
+					r.EncodeString(`FptrSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn40 {
+					r.EncodeNil()
+				} else {
+					yy642 := *x.FptrSliceString
+					if yy642 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(yy642, e)
+					} // end block: if yy642 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceBytes\"")
+				} else {
+					r.EncodeString(`FSliceBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.FSliceBytes, e)
+				} // end block: if x.FSliceBytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceBytes\"")
+				} else {
+					r.EncodeString(`FptrSliceBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn42 {
+					r.EncodeNil()
+				} else {
+					yy645 := *x.FptrSliceBytes
+					if yy645 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBytesV(yy645, e)
+					} // end block: if yy645 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceFloat32\"")
+				} else {
+					r.EncodeString(`FSliceFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceFloat32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.FSliceFloat32, e)
+				} // end block: if x.FSliceFloat32 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceFloat32\"")
+				} else {
+					r.EncodeString(`FptrSliceFloat32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn44 {
+					r.EncodeNil()
+				} else {
+					yy648 := *x.FptrSliceFloat32
+					if yy648 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat32V(yy648, e)
+					} // end block: if yy648 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceFloat64\"")
+				} else {
+					r.EncodeString(`FSliceFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceFloat64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.FSliceFloat64, e)
+				} // end block: if x.FSliceFloat64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceFloat64\"")
+				} else {
+					r.EncodeString(`FptrSliceFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn46 {
+					r.EncodeNil()
+				} else {
+					yy651 := *x.FptrSliceFloat64
+					if yy651 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat64V(yy651, e)
+					} // end block: if yy651 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceUint8\"")
+				} else {
+					r.EncodeString(`FSliceUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceUint8 == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+				} // end block: if x.FSliceUint8 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceUint8\"")
+				} else {
+					r.EncodeString(`FptrSliceUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn48 {
+					r.EncodeNil()
+				} else {
+					yy654 := *x.FptrSliceUint8
+					if yy654 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy654))
+					} // end block: if yy654 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceUint64\"")
+				} else {
+					r.EncodeString(`FSliceUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceUint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.FSliceUint64, e)
+				} // end block: if x.FSliceUint64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceUint64\"")
+				} else {
+					r.EncodeString(`FptrSliceUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn50 {
+					r.EncodeNil()
+				} else {
+					yy657 := *x.FptrSliceUint64
+					if yy657 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(yy657, e)
+					} // end block: if yy657 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt\"")
+				} else {
+					r.EncodeString(`FSliceInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntV(x.FSliceInt, e)
+				} // end block: if x.FSliceInt slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt\"")
+				} else {
+					r.EncodeString(`FptrSliceInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn52 {
+					r.EncodeNil()
+				} else {
+					yy660 := *x.FptrSliceInt
+					if yy660 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntV(yy660, e)
+					} // end block: if yy660 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt32\"")
+				} else {
+					r.EncodeString(`FSliceInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.FSliceInt32, e)
+				} // end block: if x.FSliceInt32 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt32\"")
+				} else {
+					r.EncodeString(`FptrSliceInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy663 := *x.FptrSliceInt32
+					if yy663 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt32V(yy663, e)
+					} // end block: if yy663 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceInt64\"")
+				} else {
+					r.EncodeString(`FSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceInt64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.FSliceInt64, e)
+				} // end block: if x.FSliceInt64 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceInt64\"")
+				} else {
+					r.EncodeString(`FptrSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn56 {
+					r.EncodeNil()
+				} else {
+					yy666 := *x.FptrSliceInt64
+					if yy666 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(yy666, e)
+					} // end block: if yy666 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FSliceBool\"")
+				} else {
+					r.EncodeString(`FSliceBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FSliceBool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.FSliceBool, e)
+				} // end block: if x.FSliceBool slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrSliceBool\"")
+				} else {
+					r.EncodeString(`FptrSliceBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy669 := *x.FptrSliceBool
+					if yy669 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBoolV(yy669, e)
+					} // end block: if yy669 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringIntf\"")
+				} else {
+					r.EncodeString(`FMapStringIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringIntf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntfV(x.FMapStringIntf, e)
+				} // end block: if x.FMapStringIntf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringIntf\"")
+				} else {
+					r.EncodeString(`FptrMapStringIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn60 {
+					r.EncodeNil()
+				} else {
+					yy672 := *x.FptrMapStringIntf
+					if yy672 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntfV(yy672, e)
+					} // end block: if yy672 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringString\"")
+				} else {
+					r.EncodeString(`FMapStringString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringString == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.FMapStringString, e)
+				} // end block: if x.FMapStringString map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringString\"")
+				} else {
+					r.EncodeString(`FptrMapStringString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn62 {
+					r.EncodeNil()
+				} else {
+					yy675 := *x.FptrMapStringString
+					if yy675 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringStringV(yy675, e)
+					} // end block: if yy675 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringBytes\"")
+				} else {
+					r.EncodeString(`FMapStringBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.FMapStringBytes, e)
+				} // end block: if x.FMapStringBytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringBytes\"")
+				} else {
+					r.EncodeString(`FptrMapStringBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn64 {
+					r.EncodeNil()
+				} else {
+					yy678 := *x.FptrMapStringBytes
+					if yy678 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBytesV(yy678, e)
+					} // end block: if yy678 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringUint8\"")
+				} else {
+					r.EncodeString(`FMapStringUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringUint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint8V(x.FMapStringUint8, e)
+				} // end block: if x.FMapStringUint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringUint8\"")
+				} else {
+					r.EncodeString(`FptrMapStringUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn66 {
+					r.EncodeNil()
+				} else {
+					yy681 := *x.FptrMapStringUint8
+					if yy681 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint8V(yy681, e)
+					} // end block: if yy681 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringUint64\"")
+				} else {
+					r.EncodeString(`FMapStringUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringUint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.FMapStringUint64, e)
+				} // end block: if x.FMapStringUint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringUint64\"")
+				} else {
+					r.EncodeString(`FptrMapStringUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn68 {
+					r.EncodeNil()
+				} else {
+					yy684 := *x.FptrMapStringUint64
+					if yy684 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(yy684, e)
+					} // end block: if yy684 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringInt\"")
+				} else {
+					r.EncodeString(`FMapStringInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringInt == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.FMapStringInt, e)
+				} // end block: if x.FMapStringInt map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringInt\"")
+				} else {
+					r.EncodeString(`FptrMapStringInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn70 {
+					r.EncodeNil()
+				} else {
+					yy687 := *x.FptrMapStringInt
+					if yy687 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntV(yy687, e)
+					} // end block: if yy687 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringInt32\"")
+				} else {
+					r.EncodeString(`FMapStringInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringInt32 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringInt32V(x.FMapStringInt32, e)
+				} // end block: if x.FMapStringInt32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringInt32\"")
+				} else {
+					r.EncodeString(`FptrMapStringInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn72 {
+					r.EncodeNil()
+				} else {
+					yy690 := *x.FptrMapStringInt32
+					if yy690 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringInt32V(yy690, e)
+					} // end block: if yy690 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringFloat64\"")
+				} else {
+					r.EncodeString(`FMapStringFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringFloat64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringFloat64V(x.FMapStringFloat64, e)
+				} // end block: if x.FMapStringFloat64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringFloat64\"")
+				} else {
+					r.EncodeString(`FptrMapStringFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn74 {
+					r.EncodeNil()
+				} else {
+					yy693 := *x.FptrMapStringFloat64
+					if yy693 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringFloat64V(yy693, e)
+					} // end block: if yy693 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapStringBool\"")
+				} else {
+					r.EncodeString(`FMapStringBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapStringBool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.FMapStringBool, e)
+				} // end block: if x.FMapStringBool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapStringBool\"")
+				} else {
+					r.EncodeString(`FptrMapStringBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn76 {
+					r.EncodeNil()
+				} else {
+					yy696 := *x.FptrMapStringBool
+					if yy696 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBoolV(yy696, e)
+					} // end block: if yy696 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Intf\"")
+				} else {
+					r.EncodeString(`FMapUint8Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Intf == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8IntfV(x.FMapUint8Intf, e)
+				} // end block: if x.FMapUint8Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Intf\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn78 {
+					r.EncodeNil()
+				} else {
+					yy699 := *x.FptrMapUint8Intf
+					if yy699 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8IntfV(yy699, e)
+					} // end block: if yy699 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8String\"")
+				} else {
+					r.EncodeString(`FMapUint8String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8String == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8StringV(x.FMapUint8String, e)
+				} // end block: if x.FMapUint8String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8String\"")
+				} else {
+					r.EncodeString(`FptrMapUint8String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn80 {
+					r.EncodeNil()
+				} else {
+					yy702 := *x.FptrMapUint8String
+					if yy702 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8StringV(yy702, e)
+					} // end block: if yy702 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Bytes\"")
+				} else {
+					r.EncodeString(`FMapUint8Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Bytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8BytesV(x.FMapUint8Bytes, e)
+				} // end block: if x.FMapUint8Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn82 {
+					r.EncodeNil()
+				} else {
+					yy705 := *x.FptrMapUint8Bytes
+					if yy705 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8BytesV(yy705, e)
+					} // end block: if yy705 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Uint8\"")
+				} else {
+					r.EncodeString(`FMapUint8Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Uint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Uint8V(x.FMapUint8Uint8, e)
+				} // end block: if x.FMapUint8Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn84 {
+					r.EncodeNil()
+				} else {
+					yy708 := *x.FptrMapUint8Uint8
+					if yy708 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Uint8V(yy708, e)
+					} // end block: if yy708 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Uint64\"")
+				} else {
+					r.EncodeString(`FMapUint8Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Uint64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint8Uint64V(x.FMapUint8Uint64, e)
+				} // end block: if x.FMapUint8Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn86 {
+					r.EncodeNil()
+				} else {
+					yy711 := *x.FptrMapUint8Uint64
+					if yy711 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Uint64V(yy711, e)
+					} // end block: if yy711 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Int\"")
+				} else {
+					r.EncodeString(`FMapUint8Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Int == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBytesV(yy416, e)
-				} // end block: if yy416 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceFloat64\"")
-			} else {
-				r.EncodeString(`FSliceFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceFloat64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceFloat64V(x.FSliceFloat64, e)
-			} // end block: if x.FSliceFloat64 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceFloat64\"")
-			} else {
-				r.EncodeString(`FptrSliceFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn44 {
-				r.EncodeNil()
-			} else {
-				yy419 := *x.FptrSliceFloat64
-				if yy419 == nil {
+					z.F.EncMapUint8IntV(x.FMapUint8Int, e)
+				} // end block: if x.FMapUint8Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Int\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn88 {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceFloat64V(yy419, e)
-				} // end block: if yy419 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceUint64\"")
-			} else {
-				r.EncodeString(`FSliceUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceUint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.FSliceUint64, e)
-			} // end block: if x.FSliceUint64 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceUint64\"")
-			} else {
-				r.EncodeString(`FptrSliceUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn46 {
-				r.EncodeNil()
-			} else {
-				yy422 := *x.FptrSliceUint64
-				if yy422 == nil {
+					yy714 := *x.FptrMapUint8Int
+					if yy714 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8IntV(yy714, e)
+					} // end block: if yy714 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Int32\"")
+				} else {
+					r.EncodeString(`FMapUint8Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Int32 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceUint64V(yy422, e)
-				} // end block: if yy422 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceInt\"")
-			} else {
-				r.EncodeString(`FSliceInt`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceInt == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceIntV(x.FSliceInt, e)
-			} // end block: if x.FSliceInt slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceInt\"")
-			} else {
-				r.EncodeString(`FptrSliceInt`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn48 {
-				r.EncodeNil()
-			} else {
-				yy425 := *x.FptrSliceInt
-				if yy425 == nil {
+					z.F.EncMapUint8Int32V(x.FMapUint8Int32, e)
+				} // end block: if x.FMapUint8Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Int32\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn90 {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceIntV(yy425, e)
-				} // end block: if yy425 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceInt32\"")
-			} else {
-				r.EncodeString(`FSliceInt32`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceInt32 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt32V(x.FSliceInt32, e)
-			} // end block: if x.FSliceInt32 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceInt32\"")
-			} else {
-				r.EncodeString(`FptrSliceInt32`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn50 {
-				r.EncodeNil()
-			} else {
-				yy428 := *x.FptrSliceInt32
-				if yy428 == nil {
+					yy717 := *x.FptrMapUint8Int32
+					if yy717 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Int32V(yy717, e)
+					} // end block: if yy717 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Float64\"")
+				} else {
+					r.EncodeString(`FMapUint8Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Float64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceInt32V(yy428, e)
-				} // end block: if yy428 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceInt64\"")
-			} else {
-				r.EncodeString(`FSliceInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceInt64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.FSliceInt64, e)
-			} // end block: if x.FSliceInt64 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceInt64\"")
-			} else {
-				r.EncodeString(`FptrSliceInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn52 {
-				r.EncodeNil()
-			} else {
-				yy431 := *x.FptrSliceInt64
-				if yy431 == nil {
+					z.F.EncMapUint8Float64V(x.FMapUint8Float64, e)
+				} // end block: if x.FMapUint8Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Float64\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn92 {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceInt64V(yy431, e)
-				} // end block: if yy431 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FSliceBool\"")
-			} else {
-				r.EncodeString(`FSliceBool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FSliceBool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBoolV(x.FSliceBool, e)
-			} // end block: if x.FSliceBool slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrSliceBool\"")
-			} else {
-				r.EncodeString(`FptrSliceBool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn54 {
-				r.EncodeNil()
-			} else {
-				yy434 := *x.FptrSliceBool
-				if yy434 == nil {
+					yy720 := *x.FptrMapUint8Float64
+					if yy720 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8Float64V(yy720, e)
+					} // end block: if yy720 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint8Bool\"")
+				} else {
+					r.EncodeString(`FMapUint8Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint8Bool == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBoolV(yy434, e)
-				} // end block: if yy434 slice == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringIntf\"")
-			} else {
-				r.EncodeString(`FMapStringIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringIntf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringIntfV(x.FMapStringIntf, e)
-			} // end block: if x.FMapStringIntf map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringIntf\"")
-			} else {
-				r.EncodeString(`FptrMapStringIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn56 {
-				r.EncodeNil()
-			} else {
-				yy437 := *x.FptrMapStringIntf
-				if yy437 == nil {
+					z.F.EncMapUint8BoolV(x.FMapUint8Bool, e)
+				} // end block: if x.FMapUint8Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint8Bool\"")
+				} else {
+					r.EncodeString(`FptrMapUint8Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn94 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringIntfV(yy437, e)
-				} // end block: if yy437 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringString\"")
-			} else {
-				r.EncodeString(`FMapStringString`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringString == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringStringV(x.FMapStringString, e)
-			} // end block: if x.FMapStringString map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringString\"")
-			} else {
-				r.EncodeString(`FptrMapStringString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn58 {
-				r.EncodeNil()
-			} else {
-				yy440 := *x.FptrMapStringString
-				if yy440 == nil {
+					yy723 := *x.FptrMapUint8Bool
+					if yy723 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint8BoolV(yy723, e)
+					} // end block: if yy723 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Intf\"")
+				} else {
+					r.EncodeString(`FMapUint64Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Intf == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringStringV(yy440, e)
-				} // end block: if yy440 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringBytes\"")
-			} else {
-				r.EncodeString(`FMapStringBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringBytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBytesV(x.FMapStringBytes, e)
-			} // end block: if x.FMapStringBytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringBytes\"")
-			} else {
-				r.EncodeString(`FptrMapStringBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn60 {
-				r.EncodeNil()
-			} else {
-				yy443 := *x.FptrMapStringBytes
-				if yy443 == nil {
+					z.F.EncMapUint64IntfV(x.FMapUint64Intf, e)
+				} // end block: if x.FMapUint64Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Intf\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn96 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringBytesV(yy443, e)
-				} // end block: if yy443 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringUint8\"")
-			} else {
-				r.EncodeString(`FMapStringUint8`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringUint8 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringUint8V(x.FMapStringUint8, e)
-			} // end block: if x.FMapStringUint8 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringUint8\"")
-			} else {
-				r.EncodeString(`FptrMapStringUint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn62 {
-				r.EncodeNil()
-			} else {
-				yy446 := *x.FptrMapStringUint8
-				if yy446 == nil {
+					yy726 := *x.FptrMapUint64Intf
+					if yy726 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64IntfV(yy726, e)
+					} // end block: if yy726 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64String\"")
+				} else {
+					r.EncodeString(`FMapUint64String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64String == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringUint8V(yy446, e)
-				} // end block: if yy446 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringUint64\"")
-			} else {
-				r.EncodeString(`FMapStringUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringUint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringUint64V(x.FMapStringUint64, e)
-			} // end block: if x.FMapStringUint64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringUint64\"")
-			} else {
-				r.EncodeString(`FptrMapStringUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn64 {
-				r.EncodeNil()
-			} else {
-				yy449 := *x.FptrMapStringUint64
-				if yy449 == nil {
+					z.F.EncMapUint64StringV(x.FMapUint64String, e)
+				} // end block: if x.FMapUint64String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64String\"")
+				} else {
+					r.EncodeString(`FptrMapUint64String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn98 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringUint64V(yy449, e)
-				} // end block: if yy449 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringInt\"")
-			} else {
-				r.EncodeString(`FMapStringInt`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringInt == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringIntV(x.FMapStringInt, e)
-			} // end block: if x.FMapStringInt map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringInt\"")
-			} else {
-				r.EncodeString(`FptrMapStringInt`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn66 {
-				r.EncodeNil()
-			} else {
-				yy452 := *x.FptrMapStringInt
-				if yy452 == nil {
+					yy729 := *x.FptrMapUint64String
+					if yy729 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64StringV(yy729, e)
+					} // end block: if yy729 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Bytes\"")
+				} else {
+					r.EncodeString(`FMapUint64Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Bytes == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringIntV(yy452, e)
-				} // end block: if yy452 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringInt64\"")
-			} else {
-				r.EncodeString(`FMapStringInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringInt64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringInt64V(x.FMapStringInt64, e)
-			} // end block: if x.FMapStringInt64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringInt64\"")
-			} else {
-				r.EncodeString(`FptrMapStringInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn68 {
-				r.EncodeNil()
-			} else {
-				yy455 := *x.FptrMapStringInt64
-				if yy455 == nil {
+					z.F.EncMapUint64BytesV(x.FMapUint64Bytes, e)
+				} // end block: if x.FMapUint64Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn100 {
+					r.EncodeNil()
+				} else {
+					yy732 := *x.FptrMapUint64Bytes
+					if yy732 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64BytesV(yy732, e)
+					} // end block: if yy732 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Uint8\"")
+				} else {
+					r.EncodeString(`FMapUint64Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Uint8 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapUint64Uint8V(x.FMapUint64Uint8, e)
+				} // end block: if x.FMapUint64Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn102 {
+					r.EncodeNil()
+				} else {
+					yy735 := *x.FptrMapUint64Uint8
+					if yy735 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Uint8V(yy735, e)
+					} // end block: if yy735 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Uint64\"")
+				} else {
+					r.EncodeString(`FMapUint64Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Uint64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringInt64V(yy455, e)
-				} // end block: if yy455 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringFloat64\"")
-			} else {
-				r.EncodeString(`FMapStringFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringFloat64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringFloat64V(x.FMapStringFloat64, e)
-			} // end block: if x.FMapStringFloat64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringFloat64\"")
-			} else {
-				r.EncodeString(`FptrMapStringFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn70 {
-				r.EncodeNil()
-			} else {
-				yy458 := *x.FptrMapStringFloat64
-				if yy458 == nil {
+					z.F.EncMapUint64Uint64V(x.FMapUint64Uint64, e)
+				} // end block: if x.FMapUint64Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn104 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringFloat64V(yy458, e)
-				} // end block: if yy458 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapStringBool\"")
-			} else {
-				r.EncodeString(`FMapStringBool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapStringBool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBoolV(x.FMapStringBool, e)
-			} // end block: if x.FMapStringBool map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapStringBool\"")
-			} else {
-				r.EncodeString(`FptrMapStringBool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn72 {
-				r.EncodeNil()
-			} else {
-				yy461 := *x.FptrMapStringBool
-				if yy461 == nil {
+					yy738 := *x.FptrMapUint64Uint64
+					if yy738 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Uint64V(yy738, e)
+					} // end block: if yy738 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Int\"")
+				} else {
+					r.EncodeString(`FMapUint64Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Int == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringBoolV(yy461, e)
-				} // end block: if yy461 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Intf\"")
-			} else {
-				r.EncodeString(`FMapUint8Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Intf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8IntfV(x.FMapUint8Intf, e)
-			} // end block: if x.FMapUint8Intf map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Intf\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn74 {
-				r.EncodeNil()
-			} else {
-				yy464 := *x.FptrMapUint8Intf
-				if yy464 == nil {
+					z.F.EncMapUint64IntV(x.FMapUint64Int, e)
+				} // end block: if x.FMapUint64Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Int\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn106 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8IntfV(yy464, e)
-				} // end block: if yy464 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8String\"")
-			} else {
-				r.EncodeString(`FMapUint8String`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8String == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8StringV(x.FMapUint8String, e)
-			} // end block: if x.FMapUint8String map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8String\"")
-			} else {
-				r.EncodeString(`FptrMapUint8String`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn76 {
-				r.EncodeNil()
-			} else {
-				yy467 := *x.FptrMapUint8String
-				if yy467 == nil {
+					yy741 := *x.FptrMapUint64Int
+					if yy741 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64IntV(yy741, e)
+					} // end block: if yy741 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Int32\"")
+				} else {
+					r.EncodeString(`FMapUint64Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Int32 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8StringV(yy467, e)
-				} // end block: if yy467 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Bytes\"")
-			} else {
-				r.EncodeString(`FMapUint8Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Bytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8BytesV(x.FMapUint8Bytes, e)
-			} // end block: if x.FMapUint8Bytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Bytes\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn78 {
-				r.EncodeNil()
-			} else {
-				yy470 := *x.FptrMapUint8Bytes
-				if yy470 == nil {
+					z.F.EncMapUint64Int32V(x.FMapUint64Int32, e)
+				} // end block: if x.FMapUint64Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Int32\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn108 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8BytesV(yy470, e)
-				} // end block: if yy470 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Uint8\"")
-			} else {
-				r.EncodeString(`FMapUint8Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Uint8 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8Uint8V(x.FMapUint8Uint8, e)
-			} // end block: if x.FMapUint8Uint8 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Uint8\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn80 {
-				r.EncodeNil()
-			} else {
-				yy473 := *x.FptrMapUint8Uint8
-				if yy473 == nil {
+					yy744 := *x.FptrMapUint64Int32
+					if yy744 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Int32V(yy744, e)
+					} // end block: if yy744 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Float64\"")
+				} else {
+					r.EncodeString(`FMapUint64Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Float64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Uint8V(yy473, e)
-				} // end block: if yy473 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Uint64\"")
-			} else {
-				r.EncodeString(`FMapUint8Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Uint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8Uint64V(x.FMapUint8Uint64, e)
-			} // end block: if x.FMapUint8Uint64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Uint64\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn82 {
-				r.EncodeNil()
-			} else {
-				yy476 := *x.FptrMapUint8Uint64
-				if yy476 == nil {
+					z.F.EncMapUint64Float64V(x.FMapUint64Float64, e)
+				} // end block: if x.FMapUint64Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Float64\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn110 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Uint64V(yy476, e)
-				} // end block: if yy476 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Int\"")
-			} else {
-				r.EncodeString(`FMapUint8Int`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Int == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8IntV(x.FMapUint8Int, e)
-			} // end block: if x.FMapUint8Int map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Int\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Int`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn84 {
-				r.EncodeNil()
-			} else {
-				yy479 := *x.FptrMapUint8Int
-				if yy479 == nil {
+					yy747 := *x.FptrMapUint64Float64
+					if yy747 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64Float64V(yy747, e)
+					} // end block: if yy747 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapUint64Bool\"")
+				} else {
+					r.EncodeString(`FMapUint64Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapUint64Bool == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8IntV(yy479, e)
-				} // end block: if yy479 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Int64\"")
-			} else {
-				r.EncodeString(`FMapUint8Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Int64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8Int64V(x.FMapUint8Int64, e)
-			} // end block: if x.FMapUint8Int64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Int64\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn86 {
-				r.EncodeNil()
-			} else {
-				yy482 := *x.FptrMapUint8Int64
-				if yy482 == nil {
+					z.F.EncMapUint64BoolV(x.FMapUint64Bool, e)
+				} // end block: if x.FMapUint64Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapUint64Bool\"")
+				} else {
+					r.EncodeString(`FptrMapUint64Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn112 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Int64V(yy482, e)
-				} // end block: if yy482 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Float64\"")
-			} else {
-				r.EncodeString(`FMapUint8Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Float64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8Float64V(x.FMapUint8Float64, e)
-			} // end block: if x.FMapUint8Float64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Float64\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn88 {
-				r.EncodeNil()
-			} else {
-				yy485 := *x.FptrMapUint8Float64
-				if yy485 == nil {
+					yy750 := *x.FptrMapUint64Bool
+					if yy750 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapUint64BoolV(yy750, e)
+					} // end block: if yy750 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntIntf\"")
+				} else {
+					r.EncodeString(`FMapIntIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntIntf == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8Float64V(yy485, e)
-				} // end block: if yy485 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint8Bool\"")
-			} else {
-				r.EncodeString(`FMapUint8Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint8Bool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint8BoolV(x.FMapUint8Bool, e)
-			} // end block: if x.FMapUint8Bool map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint8Bool\"")
-			} else {
-				r.EncodeString(`FptrMapUint8Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn90 {
-				r.EncodeNil()
-			} else {
-				yy488 := *x.FptrMapUint8Bool
-				if yy488 == nil {
+					z.F.EncMapIntIntfV(x.FMapIntIntf, e)
+				} // end block: if x.FMapIntIntf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntIntf\"")
+				} else {
+					r.EncodeString(`FptrMapIntIntf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn114 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint8BoolV(yy488, e)
-				} // end block: if yy488 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Intf\"")
-			} else {
-				r.EncodeString(`FMapUint64Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Intf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64IntfV(x.FMapUint64Intf, e)
-			} // end block: if x.FMapUint64Intf map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Intf\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn92 {
-				r.EncodeNil()
-			} else {
-				yy491 := *x.FptrMapUint64Intf
-				if yy491 == nil {
+					yy753 := *x.FptrMapIntIntf
+					if yy753 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntIntfV(yy753, e)
+					} // end block: if yy753 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntString\"")
+				} else {
+					r.EncodeString(`FMapIntString`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntString == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64IntfV(yy491, e)
-				} // end block: if yy491 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64String\"")
-			} else {
-				r.EncodeString(`FMapUint64String`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64String == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64StringV(x.FMapUint64String, e)
-			} // end block: if x.FMapUint64String map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64String\"")
-			} else {
-				r.EncodeString(`FptrMapUint64String`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn94 {
-				r.EncodeNil()
-			} else {
-				yy494 := *x.FptrMapUint64String
-				if yy494 == nil {
+					z.F.EncMapIntStringV(x.FMapIntString, e)
+				} // end block: if x.FMapIntString map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntString\"")
+				} else {
+					r.EncodeString(`FptrMapIntString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn116 {
+					r.EncodeNil()
+				} else {
+					yy756 := *x.FptrMapIntString
+					if yy756 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntStringV(yy756, e)
+					} // end block: if yy756 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntBytes\"")
+				} else {
+					r.EncodeString(`FMapIntBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntBytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntBytesV(x.FMapIntBytes, e)
+				} // end block: if x.FMapIntBytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntBytes\"")
+				} else {
+					r.EncodeString(`FptrMapIntBytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn118 {
+					r.EncodeNil()
+				} else {
+					yy759 := *x.FptrMapIntBytes
+					if yy759 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntBytesV(yy759, e)
+					} // end block: if yy759 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntUint8\"")
+				} else {
+					r.EncodeString(`FMapIntUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntUint8 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64StringV(yy494, e)
-				} // end block: if yy494 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Bytes\"")
-			} else {
-				r.EncodeString(`FMapUint64Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Bytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64BytesV(x.FMapUint64Bytes, e)
-			} // end block: if x.FMapUint64Bytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Bytes\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn96 {
-				r.EncodeNil()
-			} else {
-				yy497 := *x.FptrMapUint64Bytes
-				if yy497 == nil {
+					z.F.EncMapIntUint8V(x.FMapIntUint8, e)
+				} // end block: if x.FMapIntUint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntUint8\"")
+				} else {
+					r.EncodeString(`FptrMapIntUint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn120 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64BytesV(yy497, e)
-				} // end block: if yy497 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Uint8\"")
-			} else {
-				r.EncodeString(`FMapUint64Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Uint8 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64Uint8V(x.FMapUint64Uint8, e)
-			} // end block: if x.FMapUint64Uint8 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Uint8\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn98 {
-				r.EncodeNil()
-			} else {
-				yy500 := *x.FptrMapUint64Uint8
-				if yy500 == nil {
+					yy762 := *x.FptrMapIntUint8
+					if yy762 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntUint8V(yy762, e)
+					} // end block: if yy762 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntUint64\"")
+				} else {
+					r.EncodeString(`FMapIntUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntUint64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Uint8V(yy500, e)
-				} // end block: if yy500 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Uint64\"")
-			} else {
-				r.EncodeString(`FMapUint64Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Uint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64Uint64V(x.FMapUint64Uint64, e)
-			} // end block: if x.FMapUint64Uint64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Uint64\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn100 {
-				r.EncodeNil()
-			} else {
-				yy503 := *x.FptrMapUint64Uint64
-				if yy503 == nil {
+					z.F.EncMapIntUint64V(x.FMapIntUint64, e)
+				} // end block: if x.FMapIntUint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntUint64\"")
+				} else {
+					r.EncodeString(`FptrMapIntUint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn122 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Uint64V(yy503, e)
-				} // end block: if yy503 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Int\"")
-			} else {
-				r.EncodeString(`FMapUint64Int`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Int == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64IntV(x.FMapUint64Int, e)
-			} // end block: if x.FMapUint64Int map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Int\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Int`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn102 {
-				r.EncodeNil()
-			} else {
-				yy506 := *x.FptrMapUint64Int
-				if yy506 == nil {
+					yy765 := *x.FptrMapIntUint64
+					if yy765 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntUint64V(yy765, e)
+					} // end block: if yy765 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntInt\"")
+				} else {
+					r.EncodeString(`FMapIntInt`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntInt == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64IntV(yy506, e)
-				} // end block: if yy506 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Int64\"")
-			} else {
-				r.EncodeString(`FMapUint64Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Int64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64Int64V(x.FMapUint64Int64, e)
-			} // end block: if x.FMapUint64Int64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Int64\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn104 {
-				r.EncodeNil()
-			} else {
-				yy509 := *x.FptrMapUint64Int64
-				if yy509 == nil {
+					z.F.EncMapIntIntV(x.FMapIntInt, e)
+				} // end block: if x.FMapIntInt map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntInt\"")
+				} else {
+					r.EncodeString(`FptrMapIntInt`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn124 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Int64V(yy509, e)
-				} // end block: if yy509 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Float64\"")
-			} else {
-				r.EncodeString(`FMapUint64Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Float64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64Float64V(x.FMapUint64Float64, e)
-			} // end block: if x.FMapUint64Float64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Float64\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn106 {
-				r.EncodeNil()
-			} else {
-				yy512 := *x.FptrMapUint64Float64
-				if yy512 == nil {
+					yy768 := *x.FptrMapIntInt
+					if yy768 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntIntV(yy768, e)
+					} // end block: if yy768 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntInt32\"")
+				} else {
+					r.EncodeString(`FMapIntInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntInt32 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64Float64V(yy512, e)
-				} // end block: if yy512 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapUint64Bool\"")
-			} else {
-				r.EncodeString(`FMapUint64Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapUint64Bool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapUint64BoolV(x.FMapUint64Bool, e)
-			} // end block: if x.FMapUint64Bool map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapUint64Bool\"")
-			} else {
-				r.EncodeString(`FptrMapUint64Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn108 {
-				r.EncodeNil()
-			} else {
-				yy515 := *x.FptrMapUint64Bool
-				if yy515 == nil {
+					z.F.EncMapIntInt32V(x.FMapIntInt32, e)
+				} // end block: if x.FMapIntInt32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntInt32\"")
+				} else {
+					r.EncodeString(`FptrMapIntInt32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn126 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapUint64BoolV(yy515, e)
-				} // end block: if yy515 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntIntf\"")
-			} else {
-				r.EncodeString(`FMapIntIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntIntf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntIntfV(x.FMapIntIntf, e)
-			} // end block: if x.FMapIntIntf map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntIntf\"")
-			} else {
-				r.EncodeString(`FptrMapIntIntf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn110 {
-				r.EncodeNil()
-			} else {
-				yy518 := *x.FptrMapIntIntf
-				if yy518 == nil {
+					yy771 := *x.FptrMapIntInt32
+					if yy771 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntInt32V(yy771, e)
+					} // end block: if yy771 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntFloat64\"")
+				} else {
+					r.EncodeString(`FMapIntFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntFloat64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntIntfV(yy518, e)
-				} // end block: if yy518 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntString\"")
-			} else {
-				r.EncodeString(`FMapIntString`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntString == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntStringV(x.FMapIntString, e)
-			} // end block: if x.FMapIntString map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntString\"")
-			} else {
-				r.EncodeString(`FptrMapIntString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn112 {
-				r.EncodeNil()
-			} else {
-				yy521 := *x.FptrMapIntString
-				if yy521 == nil {
+					z.F.EncMapIntFloat64V(x.FMapIntFloat64, e)
+				} // end block: if x.FMapIntFloat64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntFloat64\"")
+				} else {
+					r.EncodeString(`FptrMapIntFloat64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn128 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntStringV(yy521, e)
-				} // end block: if yy521 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntBytes\"")
-			} else {
-				r.EncodeString(`FMapIntBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntBytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntBytesV(x.FMapIntBytes, e)
-			} // end block: if x.FMapIntBytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntBytes\"")
-			} else {
-				r.EncodeString(`FptrMapIntBytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn114 {
-				r.EncodeNil()
-			} else {
-				yy524 := *x.FptrMapIntBytes
-				if yy524 == nil {
+					yy774 := *x.FptrMapIntFloat64
+					if yy774 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntFloat64V(yy774, e)
+					} // end block: if yy774 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapIntBool\"")
+				} else {
+					r.EncodeString(`FMapIntBool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapIntBool == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntBytesV(yy524, e)
-				} // end block: if yy524 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntUint8\"")
-			} else {
-				r.EncodeString(`FMapIntUint8`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntUint8 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntUint8V(x.FMapIntUint8, e)
-			} // end block: if x.FMapIntUint8 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntUint8\"")
-			} else {
-				r.EncodeString(`FptrMapIntUint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn116 {
-				r.EncodeNil()
-			} else {
-				yy527 := *x.FptrMapIntUint8
-				if yy527 == nil {
+					z.F.EncMapIntBoolV(x.FMapIntBool, e)
+				} // end block: if x.FMapIntBool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapIntBool\"")
+				} else {
+					r.EncodeString(`FptrMapIntBool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn130 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntUint8V(yy527, e)
-				} // end block: if yy527 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntUint64\"")
-			} else {
-				r.EncodeString(`FMapIntUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntUint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntUint64V(x.FMapIntUint64, e)
-			} // end block: if x.FMapIntUint64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntUint64\"")
-			} else {
-				r.EncodeString(`FptrMapIntUint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn118 {
-				r.EncodeNil()
-			} else {
-				yy530 := *x.FptrMapIntUint64
-				if yy530 == nil {
+					yy777 := *x.FptrMapIntBool
+					if yy777 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntBoolV(yy777, e)
+					} // end block: if yy777 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Intf\"")
+				} else {
+					r.EncodeString(`FMapInt32Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Intf == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntUint64V(yy530, e)
-				} // end block: if yy530 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntInt\"")
-			} else {
-				r.EncodeString(`FMapIntInt`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntInt == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntIntV(x.FMapIntInt, e)
-			} // end block: if x.FMapIntInt map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntInt\"")
-			} else {
-				r.EncodeString(`FptrMapIntInt`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn120 {
-				r.EncodeNil()
-			} else {
-				yy533 := *x.FptrMapIntInt
-				if yy533 == nil {
+					z.F.EncMapInt32IntfV(x.FMapInt32Intf, e)
+				} // end block: if x.FMapInt32Intf map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Intf\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Intf`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn132 {
+					r.EncodeNil()
+				} else {
+					yy780 := *x.FptrMapInt32Intf
+					if yy780 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32IntfV(yy780, e)
+					} // end block: if yy780 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32String\"")
+				} else {
+					r.EncodeString(`FMapInt32String`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32String == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32StringV(x.FMapInt32String, e)
+				} // end block: if x.FMapInt32String map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32String\"")
+				} else {
+					r.EncodeString(`FptrMapInt32String`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn134 {
+					r.EncodeNil()
+				} else {
+					yy783 := *x.FptrMapInt32String
+					if yy783 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32StringV(yy783, e)
+					} // end block: if yy783 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Bytes\"")
+				} else {
+					r.EncodeString(`FMapInt32Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Bytes == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntIntV(yy533, e)
-				} // end block: if yy533 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntInt64\"")
-			} else {
-				r.EncodeString(`FMapIntInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntInt64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntInt64V(x.FMapIntInt64, e)
-			} // end block: if x.FMapIntInt64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntInt64\"")
-			} else {
-				r.EncodeString(`FptrMapIntInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn122 {
-				r.EncodeNil()
-			} else {
-				yy536 := *x.FptrMapIntInt64
-				if yy536 == nil {
+					z.F.EncMapInt32BytesV(x.FMapInt32Bytes, e)
+				} // end block: if x.FMapInt32Bytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Bytes\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Bytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn136 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntInt64V(yy536, e)
-				} // end block: if yy536 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntFloat64\"")
-			} else {
-				r.EncodeString(`FMapIntFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntFloat64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntFloat64V(x.FMapIntFloat64, e)
-			} // end block: if x.FMapIntFloat64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntFloat64\"")
-			} else {
-				r.EncodeString(`FptrMapIntFloat64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn124 {
-				r.EncodeNil()
-			} else {
-				yy539 := *x.FptrMapIntFloat64
-				if yy539 == nil {
+					yy786 := *x.FptrMapInt32Bytes
+					if yy786 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32BytesV(yy786, e)
+					} // end block: if yy786 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Uint8\"")
+				} else {
+					r.EncodeString(`FMapInt32Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Uint8 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntFloat64V(yy539, e)
-				} // end block: if yy539 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapIntBool\"")
-			} else {
-				r.EncodeString(`FMapIntBool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapIntBool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapIntBoolV(x.FMapIntBool, e)
-			} // end block: if x.FMapIntBool map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapIntBool\"")
-			} else {
-				r.EncodeString(`FptrMapIntBool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn126 {
-				r.EncodeNil()
-			} else {
-				yy542 := *x.FptrMapIntBool
-				if yy542 == nil {
+					z.F.EncMapInt32Uint8V(x.FMapInt32Uint8, e)
+				} // end block: if x.FMapInt32Uint8 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Uint8\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Uint8`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn138 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapIntBoolV(yy542, e)
-				} // end block: if yy542 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Intf\"")
-			} else {
-				r.EncodeString(`FMapInt64Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Intf == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64IntfV(x.FMapInt64Intf, e)
-			} // end block: if x.FMapInt64Intf map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Intf\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Intf`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn128 {
-				r.EncodeNil()
-			} else {
-				yy545 := *x.FptrMapInt64Intf
-				if yy545 == nil {
+					yy789 := *x.FptrMapInt32Uint8
+					if yy789 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Uint8V(yy789, e)
+					} // end block: if yy789 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Uint64\"")
+				} else {
+					r.EncodeString(`FMapInt32Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Uint64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64IntfV(yy545, e)
-				} // end block: if yy545 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64String\"")
-			} else {
-				r.EncodeString(`FMapInt64String`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64String == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64StringV(x.FMapInt64String, e)
-			} // end block: if x.FMapInt64String map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64String\"")
-			} else {
-				r.EncodeString(`FptrMapInt64String`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn130 {
-				r.EncodeNil()
-			} else {
-				yy548 := *x.FptrMapInt64String
-				if yy548 == nil {
+					z.F.EncMapInt32Uint64V(x.FMapInt32Uint64, e)
+				} // end block: if x.FMapInt32Uint64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Uint64\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Uint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn140 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64StringV(yy548, e)
-				} // end block: if yy548 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Bytes\"")
-			} else {
-				r.EncodeString(`FMapInt64Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Bytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64BytesV(x.FMapInt64Bytes, e)
-			} // end block: if x.FMapInt64Bytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Bytes\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Bytes`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn132 {
-				r.EncodeNil()
-			} else {
-				yy551 := *x.FptrMapInt64Bytes
-				if yy551 == nil {
+					yy792 := *x.FptrMapInt32Uint64
+					if yy792 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Uint64V(yy792, e)
+					} // end block: if yy792 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Int\"")
+				} else {
+					r.EncodeString(`FMapInt32Int`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Int == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64BytesV(yy551, e)
-				} // end block: if yy551 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Uint8\"")
-			} else {
-				r.EncodeString(`FMapInt64Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Uint8 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64Uint8V(x.FMapInt64Uint8, e)
-			} // end block: if x.FMapInt64Uint8 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Uint8\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Uint8`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn134 {
-				r.EncodeNil()
-			} else {
-				yy554 := *x.FptrMapInt64Uint8
-				if yy554 == nil {
+					z.F.EncMapInt32IntV(x.FMapInt32Int, e)
+				} // end block: if x.FMapInt32Int map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Int\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Int`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn142 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Uint8V(yy554, e)
-				} // end block: if yy554 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Uint64\"")
-			} else {
-				r.EncodeString(`FMapInt64Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Uint64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64Uint64V(x.FMapInt64Uint64, e)
-			} // end block: if x.FMapInt64Uint64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Uint64\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Uint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn136 {
-				r.EncodeNil()
-			} else {
-				yy557 := *x.FptrMapInt64Uint64
-				if yy557 == nil {
+					yy795 := *x.FptrMapInt32Int
+					if yy795 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32IntV(yy795, e)
+					} // end block: if yy795 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Int32\"")
+				} else {
+					r.EncodeString(`FMapInt32Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Int32 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Uint64V(yy557, e)
-				} // end block: if yy557 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Int\"")
-			} else {
-				r.EncodeString(`FMapInt64Int`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Int == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64IntV(x.FMapInt64Int, e)
-			} // end block: if x.FMapInt64Int map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Int\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Int`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn138 {
-				r.EncodeNil()
-			} else {
-				yy560 := *x.FptrMapInt64Int
-				if yy560 == nil {
+					z.F.EncMapInt32Int32V(x.FMapInt32Int32, e)
+				} // end block: if x.FMapInt32Int32 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Int32\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Int32`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn144 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64IntV(yy560, e)
-				} // end block: if yy560 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Int64\"")
-			} else {
-				r.EncodeString(`FMapInt64Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Int64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64Int64V(x.FMapInt64Int64, e)
-			} // end block: if x.FMapInt64Int64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Int64\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Int64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn140 {
-				r.EncodeNil()
-			} else {
-				yy563 := *x.FptrMapInt64Int64
-				if yy563 == nil {
+					yy798 := *x.FptrMapInt32Int32
+					if yy798 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Int32V(yy798, e)
+					} // end block: if yy798 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Float64\"")
+				} else {
+					r.EncodeString(`FMapInt32Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Float64 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Int64V(yy563, e)
-				} // end block: if yy563 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Float64\"")
-			} else {
-				r.EncodeString(`FMapInt64Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Float64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64Float64V(x.FMapInt64Float64, e)
-			} // end block: if x.FMapInt64Float64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Float64\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Float64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn142 {
-				r.EncodeNil()
-			} else {
-				yy566 := *x.FptrMapInt64Float64
-				if yy566 == nil {
+					z.F.EncMapInt32Float64V(x.FMapInt32Float64, e)
+				} // end block: if x.FMapInt32Float64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Float64\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Float64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn146 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64Float64V(yy566, e)
-				} // end block: if yy566 map == nil
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FMapInt64Bool\"")
-			} else {
-				r.EncodeString(`FMapInt64Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if x.FMapInt64Bool == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapInt64BoolV(x.FMapInt64Bool, e)
-			} // end block: if x.FMapInt64Bool map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FptrMapInt64Bool\"")
-			} else {
-				r.EncodeString(`FptrMapInt64Bool`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn144 {
-				r.EncodeNil()
-			} else {
-				yy569 := *x.FptrMapInt64Bool
-				if yy569 == nil {
+					yy801 := *x.FptrMapInt32Float64
+					if yy801 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32Float64V(yy801, e)
+					} // end block: if yy801 map == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FMapInt32Bool\"")
+				} else {
+					r.EncodeString(`FMapInt32Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if x.FMapInt32Bool == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapInt32BoolV(x.FMapInt32Bool, e)
+				} // end block: if x.FMapInt32Bool map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FptrMapInt32Bool\"")
+				} else {
+					r.EncodeString(`FptrMapInt32Bool`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn148 {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapInt64BoolV(yy569, e)
-				} // end block: if yy569 map == nil
+					yy804 := *x.FptrMapInt32Bool
+					if yy804 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapInt32BoolV(yy804, e)
+					} // end block: if yy804 map == nil
+				}
 			}
 			z.EncWriteMapEnd()
 		}
@@ -3246,20 +5366,11 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "FIntf":
 			z.DecFallback(&x.FIntf, true)
 		case "FptrIntf":
@@ -3274,7 +5385,7 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				z.DecFallback(x.FptrIntf, true)
 			}
 		case "FString":
-			x.FString = (string)(string(r.DecodeStringAsBytes()))
+			x.FString = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "FptrString":
 			if r.TryNil() {
 				if x.FptrString != nil { // remove the if-true
@@ -3284,10 +5395,10 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.FptrString == nil {
 					x.FptrString = new(string)
 				}
-				*x.FptrString = (string)(string(r.DecodeStringAsBytes()))
+				*x.FptrString = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 			}
 		case "FBytes":
-			x.FBytes = r.DecodeBytes(([]byte)(x.FBytes), false)
+			x.FBytes = z.DecodeBytesInto(([]byte)(x.FBytes))
 		case "FptrBytes":
 			if r.TryNil() {
 				if x.FptrBytes != nil { // remove the if-true
@@ -3297,7 +5408,7 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.FptrBytes == nil {
 					x.FptrBytes = new([]uint8)
 				}
-				*x.FptrBytes = r.DecodeBytes(*(*[]byte)(x.FptrBytes), false)
+				*x.FptrBytes = z.DecodeBytesInto(*(*[]byte)(x.FptrBytes))
 			}
 		case "FFloat32":
 			x.FFloat32 = (float32)(z.DecDecodeFloat32())
@@ -3520,6 +5631,19 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecSliceBytesX(x.FptrSliceBytes, d)
 			}
+		case "FSliceFloat32":
+			z.F.DecSliceFloat32X(&x.FSliceFloat32, d)
+		case "FptrSliceFloat32":
+			if r.TryNil() {
+				if x.FptrSliceFloat32 != nil { // remove the if-true
+					x.FptrSliceFloat32 = nil
+				}
+			} else {
+				if x.FptrSliceFloat32 == nil {
+					x.FptrSliceFloat32 = new([]float32)
+				}
+				z.F.DecSliceFloat32X(x.FptrSliceFloat32, d)
+			}
 		case "FSliceFloat64":
 			z.F.DecSliceFloat64X(&x.FSliceFloat64, d)
 		case "FptrSliceFloat64":
@@ -3533,6 +5657,19 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecSliceFloat64X(x.FptrSliceFloat64, d)
 			}
+		case "FSliceUint8":
+			x.FSliceUint8 = z.DecodeBytesInto(([]byte)(x.FSliceUint8))
+		case "FptrSliceUint8":
+			if r.TryNil() {
+				if x.FptrSliceUint8 != nil { // remove the if-true
+					x.FptrSliceUint8 = nil
+				}
+			} else {
+				if x.FptrSliceUint8 == nil {
+					x.FptrSliceUint8 = new([]uint8)
+				}
+				*x.FptrSliceUint8 = z.DecodeBytesInto(*(*[]byte)(x.FptrSliceUint8))
+			}
 		case "FSliceUint64":
 			z.F.DecSliceUint64X(&x.FSliceUint64, d)
 		case "FptrSliceUint64":
@@ -3676,18 +5813,18 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecMapStringIntX(x.FptrMapStringInt, d)
 			}
-		case "FMapStringInt64":
-			z.F.DecMapStringInt64X(&x.FMapStringInt64, d)
-		case "FptrMapStringInt64":
+		case "FMapStringInt32":
+			z.F.DecMapStringInt32X(&x.FMapStringInt32, d)
+		case "FptrMapStringInt32":
 			if r.TryNil() {
-				if x.FptrMapStringInt64 != nil { // remove the if-true
-					x.FptrMapStringInt64 = nil
+				if x.FptrMapStringInt32 != nil { // remove the if-true
+					x.FptrMapStringInt32 = nil
 				}
 			} else {
-				if x.FptrMapStringInt64 == nil {
-					x.FptrMapStringInt64 = new(map[string]int64)
+				if x.FptrMapStringInt32 == nil {
+					x.FptrMapStringInt32 = new(map[string]int32)
 				}
-				z.F.DecMapStringInt64X(x.FptrMapStringInt64, d)
+				z.F.DecMapStringInt32X(x.FptrMapStringInt32, d)
 			}
 		case "FMapStringFloat64":
 			z.F.DecMapStringFloat64X(&x.FMapStringFloat64, d)
@@ -3793,18 +5930,18 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecMapUint8IntX(x.FptrMapUint8Int, d)
 			}
-		case "FMapUint8Int64":
-			z.F.DecMapUint8Int64X(&x.FMapUint8Int64, d)
-		case "FptrMapUint8Int64":
+		case "FMapUint8Int32":
+			z.F.DecMapUint8Int32X(&x.FMapUint8Int32, d)
+		case "FptrMapUint8Int32":
 			if r.TryNil() {
-				if x.FptrMapUint8Int64 != nil { // remove the if-true
-					x.FptrMapUint8Int64 = nil
+				if x.FptrMapUint8Int32 != nil { // remove the if-true
+					x.FptrMapUint8Int32 = nil
 				}
 			} else {
-				if x.FptrMapUint8Int64 == nil {
-					x.FptrMapUint8Int64 = new(map[uint8]int64)
+				if x.FptrMapUint8Int32 == nil {
+					x.FptrMapUint8Int32 = new(map[uint8]int32)
 				}
-				z.F.DecMapUint8Int64X(x.FptrMapUint8Int64, d)
+				z.F.DecMapUint8Int32X(x.FptrMapUint8Int32, d)
 			}
 		case "FMapUint8Float64":
 			z.F.DecMapUint8Float64X(&x.FMapUint8Float64, d)
@@ -3910,18 +6047,18 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecMapUint64IntX(x.FptrMapUint64Int, d)
 			}
-		case "FMapUint64Int64":
-			z.F.DecMapUint64Int64X(&x.FMapUint64Int64, d)
-		case "FptrMapUint64Int64":
+		case "FMapUint64Int32":
+			z.F.DecMapUint64Int32X(&x.FMapUint64Int32, d)
+		case "FptrMapUint64Int32":
 			if r.TryNil() {
-				if x.FptrMapUint64Int64 != nil { // remove the if-true
-					x.FptrMapUint64Int64 = nil
+				if x.FptrMapUint64Int32 != nil { // remove the if-true
+					x.FptrMapUint64Int32 = nil
 				}
 			} else {
-				if x.FptrMapUint64Int64 == nil {
-					x.FptrMapUint64Int64 = new(map[uint64]int64)
+				if x.FptrMapUint64Int32 == nil {
+					x.FptrMapUint64Int32 = new(map[uint64]int32)
 				}
-				z.F.DecMapUint64Int64X(x.FptrMapUint64Int64, d)
+				z.F.DecMapUint64Int32X(x.FptrMapUint64Int32, d)
 			}
 		case "FMapUint64Float64":
 			z.F.DecMapUint64Float64X(&x.FMapUint64Float64, d)
@@ -4027,18 +6164,18 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecMapIntIntX(x.FptrMapIntInt, d)
 			}
-		case "FMapIntInt64":
-			z.F.DecMapIntInt64X(&x.FMapIntInt64, d)
-		case "FptrMapIntInt64":
+		case "FMapIntInt32":
+			z.F.DecMapIntInt32X(&x.FMapIntInt32, d)
+		case "FptrMapIntInt32":
 			if r.TryNil() {
-				if x.FptrMapIntInt64 != nil { // remove the if-true
-					x.FptrMapIntInt64 = nil
+				if x.FptrMapIntInt32 != nil { // remove the if-true
+					x.FptrMapIntInt32 = nil
 				}
 			} else {
-				if x.FptrMapIntInt64 == nil {
-					x.FptrMapIntInt64 = new(map[int]int64)
+				if x.FptrMapIntInt32 == nil {
+					x.FptrMapIntInt32 = new(map[int]int32)
 				}
-				z.F.DecMapIntInt64X(x.FptrMapIntInt64, d)
+				z.F.DecMapIntInt32X(x.FptrMapIntInt32, d)
 			}
 		case "FMapIntFloat64":
 			z.F.DecMapIntFloat64X(&x.FMapIntFloat64, d)
@@ -4066,125 +6203,125 @@ func (x *TestMammoth2) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 				z.F.DecMapIntBoolX(x.FptrMapIntBool, d)
 			}
-		case "FMapInt64Intf":
-			z.F.DecMapInt64IntfX(&x.FMapInt64Intf, d)
-		case "FptrMapInt64Intf":
+		case "FMapInt32Intf":
+			z.F.DecMapInt32IntfX(&x.FMapInt32Intf, d)
+		case "FptrMapInt32Intf":
 			if r.TryNil() {
-				if x.FptrMapInt64Intf != nil { // remove the if-true
-					x.FptrMapInt64Intf = nil
+				if x.FptrMapInt32Intf != nil { // remove the if-true
+					x.FptrMapInt32Intf = nil
 				}
 			} else {
-				if x.FptrMapInt64Intf == nil {
-					x.FptrMapInt64Intf = new(map[int64]interface{})
+				if x.FptrMapInt32Intf == nil {
+					x.FptrMapInt32Intf = new(map[int32]interface{})
 				}
-				z.F.DecMapInt64IntfX(x.FptrMapInt64Intf, d)
+				z.F.DecMapInt32IntfX(x.FptrMapInt32Intf, d)
 			}
-		case "FMapInt64String":
-			z.F.DecMapInt64StringX(&x.FMapInt64String, d)
-		case "FptrMapInt64String":
+		case "FMapInt32String":
+			z.F.DecMapInt32StringX(&x.FMapInt32String, d)
+		case "FptrMapInt32String":
 			if r.TryNil() {
-				if x.FptrMapInt64String != nil { // remove the if-true
-					x.FptrMapInt64String = nil
+				if x.FptrMapInt32String != nil { // remove the if-true
+					x.FptrMapInt32String = nil
 				}
 			} else {
-				if x.FptrMapInt64String == nil {
-					x.FptrMapInt64String = new(map[int64]string)
+				if x.FptrMapInt32String == nil {
+					x.FptrMapInt32String = new(map[int32]string)
 				}
-				z.F.DecMapInt64StringX(x.FptrMapInt64String, d)
+				z.F.DecMapInt32StringX(x.FptrMapInt32String, d)
 			}
-		case "FMapInt64Bytes":
-			z.F.DecMapInt64BytesX(&x.FMapInt64Bytes, d)
-		case "FptrMapInt64Bytes":
+		case "FMapInt32Bytes":
+			z.F.DecMapInt32BytesX(&x.FMapInt32Bytes, d)
+		case "FptrMapInt32Bytes":
 			if r.TryNil() {
-				if x.FptrMapInt64Bytes != nil { // remove the if-true
-					x.FptrMapInt64Bytes = nil
+				if x.FptrMapInt32Bytes != nil { // remove the if-true
+					x.FptrMapInt32Bytes = nil
 				}
 			} else {
-				if x.FptrMapInt64Bytes == nil {
-					x.FptrMapInt64Bytes = new(map[int64][]uint8)
+				if x.FptrMapInt32Bytes == nil {
+					x.FptrMapInt32Bytes = new(map[int32][]uint8)
 				}
-				z.F.DecMapInt64BytesX(x.FptrMapInt64Bytes, d)
+				z.F.DecMapInt32BytesX(x.FptrMapInt32Bytes, d)
 			}
-		case "FMapInt64Uint8":
-			z.F.DecMapInt64Uint8X(&x.FMapInt64Uint8, d)
-		case "FptrMapInt64Uint8":
+		case "FMapInt32Uint8":
+			z.F.DecMapInt32Uint8X(&x.FMapInt32Uint8, d)
+		case "FptrMapInt32Uint8":
 			if r.TryNil() {
-				if x.FptrMapInt64Uint8 != nil { // remove the if-true
-					x.FptrMapInt64Uint8 = nil
+				if x.FptrMapInt32Uint8 != nil { // remove the if-true
+					x.FptrMapInt32Uint8 = nil
 				}
 			} else {
-				if x.FptrMapInt64Uint8 == nil {
-					x.FptrMapInt64Uint8 = new(map[int64]uint8)
+				if x.FptrMapInt32Uint8 == nil {
+					x.FptrMapInt32Uint8 = new(map[int32]uint8)
 				}
-				z.F.DecMapInt64Uint8X(x.FptrMapInt64Uint8, d)
+				z.F.DecMapInt32Uint8X(x.FptrMapInt32Uint8, d)
 			}
-		case "FMapInt64Uint64":
-			z.F.DecMapInt64Uint64X(&x.FMapInt64Uint64, d)
-		case "FptrMapInt64Uint64":
+		case "FMapInt32Uint64":
+			z.F.DecMapInt32Uint64X(&x.FMapInt32Uint64, d)
+		case "FptrMapInt32Uint64":
 			if r.TryNil() {
-				if x.FptrMapInt64Uint64 != nil { // remove the if-true
-					x.FptrMapInt64Uint64 = nil
+				if x.FptrMapInt32Uint64 != nil { // remove the if-true
+					x.FptrMapInt32Uint64 = nil
 				}
 			} else {
-				if x.FptrMapInt64Uint64 == nil {
-					x.FptrMapInt64Uint64 = new(map[int64]uint64)
+				if x.FptrMapInt32Uint64 == nil {
+					x.FptrMapInt32Uint64 = new(map[int32]uint64)
 				}
-				z.F.DecMapInt64Uint64X(x.FptrMapInt64Uint64, d)
+				z.F.DecMapInt32Uint64X(x.FptrMapInt32Uint64, d)
 			}
-		case "FMapInt64Int":
-			z.F.DecMapInt64IntX(&x.FMapInt64Int, d)
-		case "FptrMapInt64Int":
+		case "FMapInt32Int":
+			z.F.DecMapInt32IntX(&x.FMapInt32Int, d)
+		case "FptrMapInt32Int":
 			if r.TryNil() {
-				if x.FptrMapInt64Int != nil { // remove the if-true
-					x.FptrMapInt64Int = nil
+				if x.FptrMapInt32Int != nil { // remove the if-true
+					x.FptrMapInt32Int = nil
 				}
 			} else {
-				if x.FptrMapInt64Int == nil {
-					x.FptrMapInt64Int = new(map[int64]int)
+				if x.FptrMapInt32Int == nil {
+					x.FptrMapInt32Int = new(map[int32]int)
 				}
-				z.F.DecMapInt64IntX(x.FptrMapInt64Int, d)
+				z.F.DecMapInt32IntX(x.FptrMapInt32Int, d)
 			}
-		case "FMapInt64Int64":
-			z.F.DecMapInt64Int64X(&x.FMapInt64Int64, d)
-		case "FptrMapInt64Int64":
+		case "FMapInt32Int32":
+			z.F.DecMapInt32Int32X(&x.FMapInt32Int32, d)
+		case "FptrMapInt32Int32":
 			if r.TryNil() {
-				if x.FptrMapInt64Int64 != nil { // remove the if-true
-					x.FptrMapInt64Int64 = nil
+				if x.FptrMapInt32Int32 != nil { // remove the if-true
+					x.FptrMapInt32Int32 = nil
 				}
 			} else {
-				if x.FptrMapInt64Int64 == nil {
-					x.FptrMapInt64Int64 = new(map[int64]int64)
+				if x.FptrMapInt32Int32 == nil {
+					x.FptrMapInt32Int32 = new(map[int32]int32)
 				}
-				z.F.DecMapInt64Int64X(x.FptrMapInt64Int64, d)
+				z.F.DecMapInt32Int32X(x.FptrMapInt32Int32, d)
 			}
-		case "FMapInt64Float64":
-			z.F.DecMapInt64Float64X(&x.FMapInt64Float64, d)
-		case "FptrMapInt64Float64":
+		case "FMapInt32Float64":
+			z.F.DecMapInt32Float64X(&x.FMapInt32Float64, d)
+		case "FptrMapInt32Float64":
 			if r.TryNil() {
-				if x.FptrMapInt64Float64 != nil { // remove the if-true
-					x.FptrMapInt64Float64 = nil
+				if x.FptrMapInt32Float64 != nil { // remove the if-true
+					x.FptrMapInt32Float64 = nil
 				}
 			} else {
-				if x.FptrMapInt64Float64 == nil {
-					x.FptrMapInt64Float64 = new(map[int64]float64)
+				if x.FptrMapInt32Float64 == nil {
+					x.FptrMapInt32Float64 = new(map[int32]float64)
 				}
-				z.F.DecMapInt64Float64X(x.FptrMapInt64Float64, d)
+				z.F.DecMapInt32Float64X(x.FptrMapInt32Float64, d)
 			}
-		case "FMapInt64Bool":
-			z.F.DecMapInt64BoolX(&x.FMapInt64Bool, d)
-		case "FptrMapInt64Bool":
+		case "FMapInt32Bool":
+			z.F.DecMapInt32BoolX(&x.FMapInt32Bool, d)
+		case "FptrMapInt32Bool":
 			if r.TryNil() {
-				if x.FptrMapInt64Bool != nil { // remove the if-true
-					x.FptrMapInt64Bool = nil
+				if x.FptrMapInt32Bool != nil { // remove the if-true
+					x.FptrMapInt32Bool = nil
 				}
 			} else {
-				if x.FptrMapInt64Bool == nil {
-					x.FptrMapInt64Bool = new(map[int64]bool)
+				if x.FptrMapInt32Bool == nil {
+					x.FptrMapInt32Bool = new(map[int32]bool)
 				}
-				z.F.DecMapInt64BoolX(x.FptrMapInt64Bool, d)
+				z.F.DecMapInt32BoolX(x.FptrMapInt32Bool, d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -4193,28 +6330,19 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj273 int
-	var yyb273 bool
-	var yyhl273 bool = l >= 0
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	var yyj281 int
+	var yyb281 bool
+	var yyhl281 bool = l >= 0
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.DecFallback(&x.FIntf, true)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4229,25 +6357,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.DecFallback(x.FptrIntf, true)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.FString = (string)(string(r.DecodeStringAsBytes()))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	x.FString = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4260,27 +6380,19 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.FptrString == nil {
 			x.FptrString = new(string)
 		}
-		*x.FptrString = (string)(string(r.DecodeStringAsBytes()))
+		*x.FptrString = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.FBytes = r.DecodeBytes(([]byte)(x.FBytes), false)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	x.FBytes = z.DecodeBytesInto(([]byte)(x.FBytes))
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4293,27 +6405,19 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.FptrBytes == nil {
 			x.FptrBytes = new([]uint8)
 		}
-		*x.FptrBytes = r.DecodeBytes(*(*[]byte)(x.FptrBytes), false)
+		*x.FptrBytes = z.DecodeBytesInto(*(*[]byte)(x.FptrBytes))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FFloat32 = (float32)(z.DecDecodeFloat32())
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4328,25 +6432,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrFloat32 = (float32)(z.DecDecodeFloat32())
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FFloat64 = (float64)(r.DecodeFloat64())
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4361,25 +6457,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrFloat64 = (float64)(r.DecodeFloat64())
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUint = (uint)(z.C.UintV(r.DecodeUint64(), codecSelferBitsize19781))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4394,25 +6482,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUint = (uint)(z.C.UintV(r.DecodeUint64(), codecSelferBitsize19781))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUint8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4427,25 +6507,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUint8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUint16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4460,25 +6532,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUint16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUint32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4493,25 +6557,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUint32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUint64 = (uint64)(r.DecodeUint64())
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4526,25 +6582,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUint64 = (uint64)(r.DecodeUint64())
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FUintptr = (uintptr)(z.C.UintV(r.DecodeUint64(), codecSelferBitsize19781))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4559,25 +6607,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrUintptr = (uintptr)(z.C.UintV(r.DecodeUint64(), codecSelferBitsize19781))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FInt = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19781))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4592,25 +6632,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrInt = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19781))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FInt8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4625,25 +6657,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrInt8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FInt16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4658,25 +6682,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrInt16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FInt32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4691,25 +6707,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrInt32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FInt64 = (int64)(r.DecodeInt64())
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4724,25 +6732,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrInt64 = (int64)(r.DecodeInt64())
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.FBool = (bool)(r.DecodeBool())
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4757,25 +6757,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.FptrBool = (bool)(r.DecodeBool())
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceIntfX(&x.FSliceIntf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4790,25 +6782,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceIntfX(x.FptrSliceIntf, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.FSliceString, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4823,25 +6807,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceStringX(x.FptrSliceString, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBytesX(&x.FSliceBytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4856,25 +6832,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceBytesX(x.FptrSliceBytes, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb273 {
+	z.DecReadArrayElem()
+	z.F.DecSliceFloat32X(&x.FSliceFloat32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecSliceFloat64X(&x.FSliceFloat64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
+	if r.TryNil() {
+		if x.FptrSliceFloat32 != nil { // remove the if-true
+			x.FptrSliceFloat32 = nil
+		}
 	} else {
-		yyb273 = z.DecCheckBreak()
+		if x.FptrSliceFloat32 == nil {
+			x.FptrSliceFloat32 = new([]float32)
+		}
+		z.F.DecSliceFloat32X(x.FptrSliceFloat32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecSliceFloat64X(&x.FSliceFloat64, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4889,25 +6882,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceFloat64X(x.FptrSliceFloat64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb273 {
+	z.DecReadArrayElem()
+	x.FSliceUint8 = z.DecodeBytesInto(([]byte)(x.FSliceUint8))
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecSliceUint64X(&x.FSliceUint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
+	if r.TryNil() {
+		if x.FptrSliceUint8 != nil { // remove the if-true
+			x.FptrSliceUint8 = nil
+		}
 	} else {
-		yyb273 = z.DecCheckBreak()
+		if x.FptrSliceUint8 == nil {
+			x.FptrSliceUint8 = new([]uint8)
+		}
+		*x.FptrSliceUint8 = z.DecodeBytesInto(*(*[]byte)(x.FptrSliceUint8))
+	}
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb273 {
+	z.DecReadArrayElem()
+	z.F.DecSliceUint64X(&x.FSliceUint64, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4922,25 +6932,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceUint64X(x.FptrSliceUint64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceIntX(&x.FSliceInt, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4955,25 +6957,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceIntX(x.FptrSliceInt, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt32X(&x.FSliceInt32, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4988,25 +6982,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceInt32X(x.FptrSliceInt32, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.FSliceInt64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5021,25 +7007,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceInt64X(x.FptrSliceInt64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBoolX(&x.FSliceBool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5054,25 +7032,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceBoolX(x.FptrSliceBool, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringIntfX(&x.FMapStringIntf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5087,25 +7057,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringIntfX(x.FptrMapStringIntf, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringStringX(&x.FMapStringString, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5120,25 +7082,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringStringX(x.FptrMapStringString, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringBytesX(&x.FMapStringBytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5153,25 +7107,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringBytesX(x.FptrMapStringBytes, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringUint8X(&x.FMapStringUint8, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5186,25 +7132,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringUint8X(x.FptrMapStringUint8, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringUint64X(&x.FMapStringUint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5219,25 +7157,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringUint64X(x.FptrMapStringUint64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringIntX(&x.FMapStringInt, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5252,58 +7182,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringIntX(x.FptrMapStringInt, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringInt64X(&x.FMapStringInt64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapStringInt32X(&x.FMapStringInt32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapStringInt64 != nil { // remove the if-true
-			x.FptrMapStringInt64 = nil
+		if x.FptrMapStringInt32 != nil { // remove the if-true
+			x.FptrMapStringInt32 = nil
 		}
 	} else {
-		if x.FptrMapStringInt64 == nil {
-			x.FptrMapStringInt64 = new(map[string]int64)
+		if x.FptrMapStringInt32 == nil {
+			x.FptrMapStringInt32 = new(map[string]int32)
 		}
-		z.F.DecMapStringInt64X(x.FptrMapStringInt64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapStringInt32X(x.FptrMapStringInt32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringFloat64X(&x.FMapStringFloat64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5318,25 +7232,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringFloat64X(x.FptrMapStringFloat64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringBoolX(&x.FMapStringBool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5351,25 +7257,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringBoolX(x.FptrMapStringBool, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8IntfX(&x.FMapUint8Intf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5384,25 +7282,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8IntfX(x.FptrMapUint8Intf, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8StringX(&x.FMapUint8String, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5417,25 +7307,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8StringX(x.FptrMapUint8String, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8BytesX(&x.FMapUint8Bytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5450,25 +7332,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8BytesX(x.FptrMapUint8Bytes, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8Uint8X(&x.FMapUint8Uint8, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5483,25 +7357,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8Uint8X(x.FptrMapUint8Uint8, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8Uint64X(&x.FMapUint8Uint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5516,25 +7382,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8Uint64X(x.FptrMapUint8Uint64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8IntX(&x.FMapUint8Int, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5549,58 +7407,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8IntX(x.FptrMapUint8Int, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapUint8Int64X(&x.FMapUint8Int64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapUint8Int32X(&x.FMapUint8Int32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapUint8Int64 != nil { // remove the if-true
-			x.FptrMapUint8Int64 = nil
+		if x.FptrMapUint8Int32 != nil { // remove the if-true
+			x.FptrMapUint8Int32 = nil
 		}
 	} else {
-		if x.FptrMapUint8Int64 == nil {
-			x.FptrMapUint8Int64 = new(map[uint8]int64)
+		if x.FptrMapUint8Int32 == nil {
+			x.FptrMapUint8Int32 = new(map[uint8]int32)
 		}
-		z.F.DecMapUint8Int64X(x.FptrMapUint8Int64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapUint8Int32X(x.FptrMapUint8Int32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8Float64X(&x.FMapUint8Float64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5615,25 +7457,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8Float64X(x.FptrMapUint8Float64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint8BoolX(&x.FMapUint8Bool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5648,25 +7482,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint8BoolX(x.FptrMapUint8Bool, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64IntfX(&x.FMapUint64Intf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5681,25 +7507,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64IntfX(x.FptrMapUint64Intf, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64StringX(&x.FMapUint64String, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5714,25 +7532,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64StringX(x.FptrMapUint64String, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64BytesX(&x.FMapUint64Bytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5747,25 +7557,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64BytesX(x.FptrMapUint64Bytes, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64Uint8X(&x.FMapUint64Uint8, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5780,25 +7582,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64Uint8X(x.FptrMapUint64Uint8, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64Uint64X(&x.FMapUint64Uint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5813,25 +7607,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64Uint64X(x.FptrMapUint64Uint64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64IntX(&x.FMapUint64Int, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5846,58 +7632,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64IntX(x.FptrMapUint64Int, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapUint64Int64X(&x.FMapUint64Int64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapUint64Int32X(&x.FMapUint64Int32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapUint64Int64 != nil { // remove the if-true
-			x.FptrMapUint64Int64 = nil
+		if x.FptrMapUint64Int32 != nil { // remove the if-true
+			x.FptrMapUint64Int32 = nil
 		}
 	} else {
-		if x.FptrMapUint64Int64 == nil {
-			x.FptrMapUint64Int64 = new(map[uint64]int64)
+		if x.FptrMapUint64Int32 == nil {
+			x.FptrMapUint64Int32 = new(map[uint64]int32)
 		}
-		z.F.DecMapUint64Int64X(x.FptrMapUint64Int64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapUint64Int32X(x.FptrMapUint64Int32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64Float64X(&x.FMapUint64Float64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5912,25 +7682,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64Float64X(x.FptrMapUint64Float64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapUint64BoolX(&x.FMapUint64Bool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5945,25 +7707,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapUint64BoolX(x.FptrMapUint64Bool, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntIntfX(&x.FMapIntIntf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -5978,25 +7732,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntIntfX(x.FptrMapIntIntf, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntStringX(&x.FMapIntString, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6011,25 +7757,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntStringX(x.FptrMapIntString, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntBytesX(&x.FMapIntBytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6044,25 +7782,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntBytesX(x.FptrMapIntBytes, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntUint8X(&x.FMapIntUint8, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6077,25 +7807,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntUint8X(x.FptrMapIntUint8, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntUint64X(&x.FMapIntUint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6110,25 +7832,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntUint64X(x.FptrMapIntUint64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntIntX(&x.FMapIntInt, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6143,58 +7857,42 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntIntX(x.FptrMapIntInt, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapIntInt64X(&x.FMapIntInt64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapIntInt32X(&x.FMapIntInt32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapIntInt64 != nil { // remove the if-true
-			x.FptrMapIntInt64 = nil
+		if x.FptrMapIntInt32 != nil { // remove the if-true
+			x.FptrMapIntInt32 = nil
 		}
 	} else {
-		if x.FptrMapIntInt64 == nil {
-			x.FptrMapIntInt64 = new(map[int]int64)
+		if x.FptrMapIntInt32 == nil {
+			x.FptrMapIntInt32 = new(map[int]int32)
 		}
-		z.F.DecMapIntInt64X(x.FptrMapIntInt64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapIntInt32X(x.FptrMapIntInt32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntFloat64X(&x.FMapIntFloat64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6209,25 +7907,17 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntFloat64X(x.FptrMapIntFloat64, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapIntBoolX(&x.FMapIntBool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -6242,322 +7932,243 @@ func (x *TestMammoth2) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapIntBoolX(x.FptrMapIntBool, d)
 	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64IntfX(&x.FMapInt64Intf, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32IntfX(&x.FMapInt32Intf, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Intf != nil { // remove the if-true
-			x.FptrMapInt64Intf = nil
+		if x.FptrMapInt32Intf != nil { // remove the if-true
+			x.FptrMapInt32Intf = nil
 		}
 	} else {
-		if x.FptrMapInt64Intf == nil {
-			x.FptrMapInt64Intf = new(map[int64]interface{})
+		if x.FptrMapInt32Intf == nil {
+			x.FptrMapInt32Intf = new(map[int32]interface{})
 		}
-		z.F.DecMapInt64IntfX(x.FptrMapInt64Intf, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32IntfX(x.FptrMapInt32Intf, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64StringX(&x.FMapInt64String, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32StringX(&x.FMapInt32String, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64String != nil { // remove the if-true
-			x.FptrMapInt64String = nil
+		if x.FptrMapInt32String != nil { // remove the if-true
+			x.FptrMapInt32String = nil
 		}
 	} else {
-		if x.FptrMapInt64String == nil {
-			x.FptrMapInt64String = new(map[int64]string)
+		if x.FptrMapInt32String == nil {
+			x.FptrMapInt32String = new(map[int32]string)
 		}
-		z.F.DecMapInt64StringX(x.FptrMapInt64String, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32StringX(x.FptrMapInt32String, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64BytesX(&x.FMapInt64Bytes, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32BytesX(&x.FMapInt32Bytes, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Bytes != nil { // remove the if-true
-			x.FptrMapInt64Bytes = nil
+		if x.FptrMapInt32Bytes != nil { // remove the if-true
+			x.FptrMapInt32Bytes = nil
 		}
 	} else {
-		if x.FptrMapInt64Bytes == nil {
-			x.FptrMapInt64Bytes = new(map[int64][]uint8)
+		if x.FptrMapInt32Bytes == nil {
+			x.FptrMapInt32Bytes = new(map[int32][]uint8)
 		}
-		z.F.DecMapInt64BytesX(x.FptrMapInt64Bytes, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32BytesX(x.FptrMapInt32Bytes, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64Uint8X(&x.FMapInt64Uint8, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32Uint8X(&x.FMapInt32Uint8, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Uint8 != nil { // remove the if-true
-			x.FptrMapInt64Uint8 = nil
+		if x.FptrMapInt32Uint8 != nil { // remove the if-true
+			x.FptrMapInt32Uint8 = nil
 		}
 	} else {
-		if x.FptrMapInt64Uint8 == nil {
-			x.FptrMapInt64Uint8 = new(map[int64]uint8)
+		if x.FptrMapInt32Uint8 == nil {
+			x.FptrMapInt32Uint8 = new(map[int32]uint8)
 		}
-		z.F.DecMapInt64Uint8X(x.FptrMapInt64Uint8, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32Uint8X(x.FptrMapInt32Uint8, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64Uint64X(&x.FMapInt64Uint64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32Uint64X(&x.FMapInt32Uint64, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Uint64 != nil { // remove the if-true
-			x.FptrMapInt64Uint64 = nil
+		if x.FptrMapInt32Uint64 != nil { // remove the if-true
+			x.FptrMapInt32Uint64 = nil
 		}
 	} else {
-		if x.FptrMapInt64Uint64 == nil {
-			x.FptrMapInt64Uint64 = new(map[int64]uint64)
+		if x.FptrMapInt32Uint64 == nil {
+			x.FptrMapInt32Uint64 = new(map[int32]uint64)
 		}
-		z.F.DecMapInt64Uint64X(x.FptrMapInt64Uint64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32Uint64X(x.FptrMapInt32Uint64, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64IntX(&x.FMapInt64Int, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32IntX(&x.FMapInt32Int, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Int != nil { // remove the if-true
-			x.FptrMapInt64Int = nil
+		if x.FptrMapInt32Int != nil { // remove the if-true
+			x.FptrMapInt32Int = nil
 		}
 	} else {
-		if x.FptrMapInt64Int == nil {
-			x.FptrMapInt64Int = new(map[int64]int)
+		if x.FptrMapInt32Int == nil {
+			x.FptrMapInt32Int = new(map[int32]int)
 		}
-		z.F.DecMapInt64IntX(x.FptrMapInt64Int, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32IntX(x.FptrMapInt32Int, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64Int64X(&x.FMapInt64Int64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32Int32X(&x.FMapInt32Int32, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Int64 != nil { // remove the if-true
-			x.FptrMapInt64Int64 = nil
+		if x.FptrMapInt32Int32 != nil { // remove the if-true
+			x.FptrMapInt32Int32 = nil
 		}
 	} else {
-		if x.FptrMapInt64Int64 == nil {
-			x.FptrMapInt64Int64 = new(map[int64]int64)
+		if x.FptrMapInt32Int32 == nil {
+			x.FptrMapInt32Int32 = new(map[int32]int32)
 		}
-		z.F.DecMapInt64Int64X(x.FptrMapInt64Int64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32Int32X(x.FptrMapInt32Int32, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64Float64X(&x.FMapInt64Float64, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32Float64X(&x.FMapInt32Float64, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Float64 != nil { // remove the if-true
-			x.FptrMapInt64Float64 = nil
+		if x.FptrMapInt32Float64 != nil { // remove the if-true
+			x.FptrMapInt32Float64 = nil
 		}
 	} else {
-		if x.FptrMapInt64Float64 == nil {
-			x.FptrMapInt64Float64 = new(map[int64]float64)
+		if x.FptrMapInt32Float64 == nil {
+			x.FptrMapInt32Float64 = new(map[int32]float64)
 		}
-		z.F.DecMapInt64Float64X(x.FptrMapInt64Float64, d)
-	}
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
+		z.F.DecMapInt32Float64X(x.FptrMapInt32Float64, d)
 	}
-	if yyb273 {
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapInt64BoolX(&x.FMapInt64Bool, d)
-	yyj273++
-	if yyhl273 {
-		yyb273 = yyj273 > l
-	} else {
-		yyb273 = z.DecCheckBreak()
-	}
-	if yyb273 {
+	z.F.DecMapInt32BoolX(&x.FMapInt32Bool, d)
+	yyj281++
+	yyb281 = !z.DecContainerNext(yyj281, l, yyhl281)
+	if yyb281 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.FptrMapInt64Bool != nil { // remove the if-true
-			x.FptrMapInt64Bool = nil
+		if x.FptrMapInt32Bool != nil { // remove the if-true
+			x.FptrMapInt32Bool = nil
 		}
 	} else {
-		if x.FptrMapInt64Bool == nil {
-			x.FptrMapInt64Bool = new(map[int64]bool)
+		if x.FptrMapInt32Bool == nil {
+			x.FptrMapInt32Bool = new(map[int32]bool)
 		}
-		z.F.DecMapInt64BoolX(x.FptrMapInt64Bool, d)
+		z.F.DecMapInt32BoolX(x.FptrMapInt32Bool, d)
 	}
-	for {
-		yyj273++
-		if yyhl273 {
-			yyb273 = yyj273 > l
-		} else {
-			yyb273 = z.DecCheckBreak()
-		}
-		if yyb273 {
-			break
-		}
+	yyj281++
+	for ; z.DecContainerNext(yyj281, l, yyhl281); yyj281++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj273-1, "")
+		z.DecStructFieldNotFound(yyj281-1, "")
 	}
 }
 
 func (x *TestMammoth2) IsCodecEmpty() bool {
-	return !(x.FIntf != nil || x.FString != "" || len(x.FBytes) != 0 || x.FFloat32 != 0 || x.FFloat64 != 0 || x.FUint != 0 || x.FUint8 != 0 || x.FUint16 != 0 || x.FUint32 != 0 || x.FUint64 != 0 || x.FUintptr != 0 || x.FInt != 0 || x.FInt8 != 0 || x.FInt16 != 0 || x.FInt32 != 0 || x.FInt64 != 0 || bool(x.FBool) || len(x.FSliceIntf) != 0 || len(x.FSliceString) != 0 || len(x.FSliceBytes) != 0 || len(x.FSliceFloat64) != 0 || len(x.FSliceUint64) != 0 || len(x.FSliceInt) != 0 || len(x.FSliceInt32) != 0 || len(x.FSliceInt64) != 0 || len(x.FSliceBool) != 0 || len(x.FMapStringIntf) != 0 || len(x.FMapStringString) != 0 || len(x.FMapStringBytes) != 0 || len(x.FMapStringUint8) != 0 || len(x.FMapStringUint64) != 0 || len(x.FMapStringInt) != 0 || len(x.FMapStringInt64) != 0 || len(x.FMapStringFloat64) != 0 || len(x.FMapStringBool) != 0 || len(x.FMapUint8Intf) != 0 || len(x.FMapUint8String) != 0 || len(x.FMapUint8Bytes) != 0 || len(x.FMapUint8Uint8) != 0 || len(x.FMapUint8Uint64) != 0 || len(x.FMapUint8Int) != 0 || len(x.FMapUint8Int64) != 0 || len(x.FMapUint8Float64) != 0 || len(x.FMapUint8Bool) != 0 || len(x.FMapUint64Intf) != 0 || len(x.FMapUint64String) != 0 || len(x.FMapUint64Bytes) != 0 || len(x.FMapUint64Uint8) != 0 || len(x.FMapUint64Uint64) != 0 || len(x.FMapUint64Int) != 0 || len(x.FMapUint64Int64) != 0 || len(x.FMapUint64Float64) != 0 || len(x.FMapUint64Bool) != 0 || len(x.FMapIntIntf) != 0 || len(x.FMapIntString) != 0 || len(x.FMapIntBytes) != 0 || len(x.FMapIntUint8) != 0 || len(x.FMapIntUint64) != 0 || len(x.FMapIntInt) != 0 || len(x.FMapIntInt64) != 0 || len(x.FMapIntFloat64) != 0 || len(x.FMapIntBool) != 0 || len(x.FMapInt64Intf) != 0 || len(x.FMapInt64String) != 0 || len(x.FMapInt64Bytes) != 0 || len(x.FMapInt64Uint8) != 0 || len(x.FMapInt64Uint64) != 0 || len(x.FMapInt64Int) != 0 || len(x.FMapInt64Int64) != 0 || len(x.FMapInt64Float64) != 0 || len(x.FMapInt64Bool) != 0 || false)
+	return !(x.FIntf != nil || x.FString != "" || len(x.FBytes) != 0 || x.FFloat32 != 0 || x.FFloat64 != 0 || x.FUint != 0 || x.FUint8 != 0 || x.FUint16 != 0 || x.FUint32 != 0 || x.FUint64 != 0 || x.FUintptr != 0 || x.FInt != 0 || x.FInt8 != 0 || x.FInt16 != 0 || x.FInt32 != 0 || x.FInt64 != 0 || bool(x.FBool) || len(x.FSliceIntf) != 0 || len(x.FSliceString) != 0 || len(x.FSliceBytes) != 0 || len(x.FSliceFloat32) != 0 || len(x.FSliceFloat64) != 0 || len(x.FSliceUint8) != 0 || len(x.FSliceUint64) != 0 || len(x.FSliceInt) != 0 || len(x.FSliceInt32) != 0 || len(x.FSliceInt64) != 0 || len(x.FSliceBool) != 0 || len(x.FMapStringIntf) != 0 || len(x.FMapStringString) != 0 || len(x.FMapStringBytes) != 0 || len(x.FMapStringUint8) != 0 || len(x.FMapStringUint64) != 0 || len(x.FMapStringInt) != 0 || len(x.FMapStringInt32) != 0 || len(x.FMapStringFloat64) != 0 || len(x.FMapStringBool) != 0 || len(x.FMapUint8Intf) != 0 || len(x.FMapUint8String) != 0 || len(x.FMapUint8Bytes) != 0 || len(x.FMapUint8Uint8) != 0 || len(x.FMapUint8Uint64) != 0 || len(x.FMapUint8Int) != 0 || len(x.FMapUint8Int32) != 0 || len(x.FMapUint8Float64) != 0 || len(x.FMapUint8Bool) != 0 || len(x.FMapUint64Intf) != 0 || len(x.FMapUint64String) != 0 || len(x.FMapUint64Bytes) != 0 || len(x.FMapUint64Uint8) != 0 || len(x.FMapUint64Uint64) != 0 || len(x.FMapUint64Int) != 0 || len(x.FMapUint64Int32) != 0 || len(x.FMapUint64Float64) != 0 || len(x.FMapUint64Bool) != 0 || len(x.FMapIntIntf) != 0 || len(x.FMapIntString) != 0 || len(x.FMapIntBytes) != 0 || len(x.FMapIntUint8) != 0 || len(x.FMapIntUint64) != 0 || len(x.FMapIntInt) != 0 || len(x.FMapIntInt32) != 0 || len(x.FMapIntFloat64) != 0 || len(x.FMapIntBool) != 0 || len(x.FMapInt32Intf) != 0 || len(x.FMapInt32String) != 0 || len(x.FMapInt32Bytes) != 0 || len(x.FMapInt32Uint8) != 0 || len(x.FMapInt32Uint64) != 0 || len(x.FMapInt32Int) != 0 || len(x.FMapInt32Int32) != 0 || len(x.FMapInt32Float64) != 0 || len(x.FMapInt32Bool) != 0 || false)
 }
 
+func (testMammoth2Binary) codecSelferViaCodecgen() {}
 func (x testMammoth2Binary) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
@@ -6580,6 +8191,7 @@ func (x *testMammoth2Binary) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
+func (testMammoth2Text) codecSelferViaCodecgen() {}
 func (x testMammoth2Text) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
@@ -6602,6 +8214,7 @@ func (x *testMammoth2Text) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
+func (testMammoth2Json) codecSelferViaCodecgen() {}
 func (x testMammoth2Json) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
@@ -6624,6 +8237,7 @@ func (x *testMammoth2Json) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
+func (testMammoth2Basic) codecSelferViaCodecgen() {}
 func (x *testMammoth2Basic) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
@@ -6631,7 +8245,7 @@ func (x *testMammoth2Basic) CodecEncodeSelf(e *Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		h.enctestMammoth2Basic((*testMammoth2Basic)(x), e)
+		z.F.EncSliceUint64V(([]uint64)(x[:]), e)
 	}
 }
 
@@ -6639,52 +8253,58 @@ func (x *testMammoth2Basic) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	h.dectestMammoth2Basic((*testMammoth2Basic)(x), d)
+	z.F.DecSliceUint64N(([]uint64)(x[:]), d)
 }
 
+func (TestMammoth2Wrapper) codecSelferViaCodecgen() {}
 func (x *TestMammoth2Wrapper) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
 		yy2arr2 := z.EncBasicHandle().StructToArray
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
+		var yyn14 bool = x.Tpbytes == nil
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(8)
+			z.EncWriteArrayStart(12)
 			z.EncWriteArrayElem()
-			yy11 := &x.V
-			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
-				z.EncExtension(yy11, yyxt12)
+			yy15 := &x.V
+			if yyxt16 := z.Extension(yy15); yyxt16 != nil {
+				z.EncExtension(yy15, yyxt16)
 			} else {
-				yy11.CodecEncodeSelf(e)
+				yy15.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if yyxt13 := z.Extension(x.T); yyxt13 != nil {
-				z.EncExtension(x.T, yyxt13)
+			if yyxt17 := z.Extension(x.T); yyxt17 != nil {
+				z.EncExtension(x.T, yyxt17)
 			} else {
 				x.T.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if yyxt14 := z.Extension(x.B); yyxt14 != nil {
-				z.EncExtension(x.B, yyxt14)
+			if yyxt18 := z.Extension(x.B); yyxt18 != nil {
+				z.EncExtension(x.B, yyxt18)
 			} else {
 				x.B.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if yyxt15 := z.Extension(x.J); yyxt15 != nil {
-				z.EncExtension(x.J, yyxt15)
+			if yyxt19 := z.Extension(x.J); yyxt19 != nil {
+				z.EncExtension(x.J, yyxt19)
 			} else {
 				x.J.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			yy16 := &x.C
-			if yyxt17 := z.Extension(yy16); yyxt17 != nil {
-				z.EncExtension(yy16, yyxt17)
+			yy20 := &x.C
+			if yyxt21 := z.Extension(yy20); yyxt21 != nil {
+				z.EncExtension(yy20, yyxt21)
 			} else {
-				yy16.CodecEncodeSelf(e)
+				yy20.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
 			if x.M == nil {
@@ -6699,106 +8319,316 @@ func (x *TestMammoth2Wrapper) CodecEncodeSelf(e *Encoder) {
 				h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
 			} // end block: if x.L slice == nil
 			z.EncWriteArrayElem()
-			yy20 := &x.A
-			h.encArray4int64((*[4]int64)(yy20), e)
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(8)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"V\"")
-			} else {
-				r.EncodeString(`V`)
-			}
-			z.EncWriteMapElemValue()
-			yy22 := &x.V
-			if yyxt23 := z.Extension(yy22); yyxt23 != nil {
-				z.EncExtension(yy22, yyxt23)
-			} else {
-				yy22.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"T\"")
-			} else {
-				r.EncodeString(`T`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt24 := z.Extension(x.T); yyxt24 != nil {
-				z.EncExtension(x.T, yyxt24)
-			} else {
-				x.T.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt25 := z.Extension(x.B); yyxt25 != nil {
-				z.EncExtension(x.B, yyxt25)
-			} else {
-				x.B.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"J\"")
-			} else {
-				r.EncodeString(`J`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt26 := z.Extension(x.J); yyxt26 != nil {
-				z.EncExtension(x.J, yyxt26)
-			} else {
-				x.J.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"C\"")
-			} else {
-				r.EncodeString(`C`)
-			}
-			z.EncWriteMapElemValue()
-			yy27 := &x.C
-			if yyxt28 := z.Extension(yy27); yyxt28 != nil {
-				z.EncExtension(yy27, yyxt28)
-			} else {
-				yy27.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"M\"")
-			} else {
-				r.EncodeString(`M`)
-			}
-			z.EncWriteMapElemValue()
-			if x.M == nil {
+			yy24 := &x.A
+			h.encArray4int64((*[4]int64)(yy24), e)
+			z.EncWriteArrayElem()
+			z.EncEncodeComplex128(complex128(x.Tcomplex128))
+			z.EncWriteArrayElem()
+			z.EncEncodeComplex64(complex64(x.Tcomplex64))
+			z.EncWriteArrayElem()
+			if x.Tbytes == nil {
 				r.EncodeNil()
 			} else {
-				h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
-			} // end block: if x.M map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"L\"")
-			} else {
-				r.EncodeString(`L`)
-			}
-			z.EncWriteMapElemValue()
-			if x.L == nil {
+				r.EncodeStringBytesRaw([]byte(x.Tbytes))
+			} // end block: if x.Tbytes slice == nil
+			if yyn14 {
+				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
-				h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
-			} // end block: if x.L slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"A\"")
+				z.EncWriteArrayElem()
+				yy29 := *x.Tpbytes
+				if yy29 == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(yy29))
+				} // end block: if yy29 slice == nil
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(12)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				yy31 := &x.A
+				h.encArray4int64((*[4]int64)(yy31), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt33 := z.Extension(x.B); yyxt33 != nil {
+					z.EncExtension(x.B, yyxt33)
+				} else {
+					x.B.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"C\"")
+				} else {
+					r.EncodeString(`C`)
+				}
+				z.EncWriteMapElemValue()
+				yy34 := &x.C
+				if yyxt35 := z.Extension(yy34); yyxt35 != nil {
+					z.EncExtension(yy34, yyxt35)
+				} else {
+					yy34.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"J\"")
+				} else {
+					r.EncodeString(`J`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt36 := z.Extension(x.J); yyxt36 != nil {
+					z.EncExtension(x.J, yyxt36)
+				} else {
+					x.J.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"L\"")
+				} else {
+					r.EncodeString(`L`)
+				}
+				z.EncWriteMapElemValue()
+				if x.L == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+				} // end block: if x.L slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"M\"")
+				} else {
+					r.EncodeString(`M`)
+				}
+				z.EncWriteMapElemValue()
+				if x.M == nil {
+					r.EncodeNil()
+				} else {
+					h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+				} // end block: if x.M map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"T\"")
+				} else {
+					r.EncodeString(`T`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt39 := z.Extension(x.T); yyxt39 != nil {
+					z.EncExtension(x.T, yyxt39)
+				} else {
+					x.T.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tbytes\"")
+				} else {
+					r.EncodeString(`Tbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Tbytes == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Tbytes))
+				} // end block: if x.Tbytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tcomplex128\"")
+				} else {
+					r.EncodeString(`Tcomplex128`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncEncodeComplex128(complex128(x.Tcomplex128))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tcomplex64\"")
+				} else {
+					r.EncodeString(`Tcomplex64`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncEncodeComplex64(complex64(x.Tcomplex64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tpbytes\"")
+				} else {
+					r.EncodeString(`Tpbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn14 {
+					r.EncodeNil()
+				} else {
+					yy43 := *x.Tpbytes
+					if yy43 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy43))
+					} // end block: if yy43 slice == nil
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"V\"")
+				} else {
+					r.EncodeString(`V`)
+				}
+				z.EncWriteMapElemValue()
+				yy45 := &x.V
+				if yyxt46 := z.Extension(yy45); yyxt46 != nil {
+					z.EncExtension(yy45, yyxt46)
+				} else {
+					yy45.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeString(`A`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"V\"")
+				} else {
+					r.EncodeString(`V`)
+				}
+				z.EncWriteMapElemValue()
+				yy47 := &x.V
+				if yyxt48 := z.Extension(yy47); yyxt48 != nil {
+					z.EncExtension(yy47, yyxt48)
+				} else {
+					yy47.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"T\"")
+				} else {
+					r.EncodeString(`T`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt49 := z.Extension(x.T); yyxt49 != nil {
+					z.EncExtension(x.T, yyxt49)
+				} else {
+					x.T.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt50 := z.Extension(x.B); yyxt50 != nil {
+					z.EncExtension(x.B, yyxt50)
+				} else {
+					x.B.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"J\"")
+				} else {
+					r.EncodeString(`J`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt51 := z.Extension(x.J); yyxt51 != nil {
+					z.EncExtension(x.J, yyxt51)
+				} else {
+					x.J.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"C\"")
+				} else {
+					r.EncodeString(`C`)
+				}
+				z.EncWriteMapElemValue()
+				yy52 := &x.C
+				if yyxt53 := z.Extension(yy52); yyxt53 != nil {
+					z.EncExtension(yy52, yyxt53)
+				} else {
+					yy52.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"M\"")
+				} else {
+					r.EncodeString(`M`)
+				}
+				z.EncWriteMapElemValue()
+				if x.M == nil {
+					r.EncodeNil()
+				} else {
+					h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+				} // end block: if x.M map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"L\"")
+				} else {
+					r.EncodeString(`L`)
+				}
+				z.EncWriteMapElemValue()
+				if x.L == nil {
+					r.EncodeNil()
+				} else {
+					h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+				} // end block: if x.L slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				yy56 := &x.A
+				h.encArray4int64((*[4]int64)(yy56), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tcomplex128\"")
+				} else {
+					r.EncodeString(`Tcomplex128`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncEncodeComplex128(complex128(x.Tcomplex128))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tcomplex64\"")
+				} else {
+					r.EncodeString(`Tcomplex64`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncEncodeComplex64(complex64(x.Tcomplex64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tbytes\"")
+				} else {
+					r.EncodeString(`Tbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Tbytes == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Tbytes))
+				} // end block: if x.Tbytes slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tpbytes\"")
+				} else {
+					r.EncodeString(`Tpbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn14 {
+					r.EncodeNil()
+				} else {
+					yy61 := *x.Tpbytes
+					if yy61 == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(yy61))
+					} // end block: if yy61 slice == nil
+				}
 			}
-			z.EncWriteMapElemValue()
-			yy31 := &x.A
-			h.encArray4int64((*[4]int64)(yy31), e)
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6834,20 +8664,11 @@ func (x *TestMammoth2Wrapper) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "V":
 			if yyxt5 := z.Extension(x.V); yyxt5 != nil {
 				z.DecExtension(&x.V, yyxt5)
@@ -6884,8 +8705,25 @@ func (x *TestMammoth2Wrapper) codecDecodeSelfFromMap(l int, d *Decoder) {
 			h.decSliceTestMammoth2((*[]TestMammoth2)(&x.L), d)
 		case "A":
 			h.decArray4int64((*[4]int64)(&x.A), d)
+		case "Tcomplex128":
+			x.Tcomplex128 = (complex128)(complex(r.DecodeFloat64(), 0))
+		case "Tcomplex64":
+			x.Tcomplex64 = (complex64)(complex(z.DecDecodeFloat32(), 0))
+		case "Tbytes":
+			x.Tbytes = z.DecodeBytesInto(([]byte)(x.Tbytes))
+		case "Tpbytes":
+			if r.TryNil() {
+				if x.Tpbytes != nil { // remove the if-true
+					x.Tpbytes = nil
+				}
+			} else {
+				if x.Tpbytes == nil {
+					x.Tpbytes = new([]uint8)
+				}
+				*x.Tpbytes = z.DecodeBytesInto(*(*[]byte)(x.Tpbytes))
+			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6894,216 +8732,172 @@ func (x *TestMammoth2Wrapper) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj20 int
-	var yyb20 bool
-	var yyhl20 bool = l >= 0
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	var yyj26 int
+	var yyb26 bool
+	var yyhl26 bool = l >= 0
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt22 := z.Extension(x.V); yyxt22 != nil {
-		z.DecExtension(&x.V, yyxt22)
+	if yyxt28 := z.Extension(x.V); yyxt28 != nil {
+		z.DecExtension(&x.V, yyxt28)
 	} else {
 		x.V.CodecDecodeSelf(d)
 	}
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt24 := z.Extension(x.T); yyxt24 != nil {
-		z.DecExtension(&x.T, yyxt24)
+	if yyxt30 := z.Extension(x.T); yyxt30 != nil {
+		z.DecExtension(&x.T, yyxt30)
 	} else {
 		x.T.CodecDecodeSelf(d)
 	}
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt26 := z.Extension(x.B); yyxt26 != nil {
-		z.DecExtension(&x.B, yyxt26)
+	if yyxt32 := z.Extension(x.B); yyxt32 != nil {
+		z.DecExtension(&x.B, yyxt32)
 	} else {
 		x.B.CodecDecodeSelf(d)
 	}
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt28 := z.Extension(x.J); yyxt28 != nil {
-		z.DecExtension(&x.J, yyxt28)
+	if yyxt34 := z.Extension(x.J); yyxt34 != nil {
+		z.DecExtension(&x.J, yyxt34)
 	} else {
 		x.J.CodecDecodeSelf(d)
 	}
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt30 := z.Extension(x.C); yyxt30 != nil {
-		z.DecExtension(&x.C, yyxt30)
+	if yyxt36 := z.Extension(x.C); yyxt36 != nil {
+		z.DecExtension(&x.C, yyxt36)
 	} else {
 		x.C.CodecDecodeSelf(d)
 	}
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMaptestMammoth2BasicTestMammoth2((*map[testMammoth2Basic]TestMammoth2)(&x.M), d)
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSliceTestMammoth2((*[]TestMammoth2)(&x.L), d)
-	yyj20++
-	if yyhl20 {
-		yyb20 = yyj20 > l
-	} else {
-		yyb20 = z.DecCheckBreak()
-	}
-	if yyb20 {
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray4int64((*[4]int64)(&x.A), d)
-	for {
-		yyj20++
-		if yyhl20 {
-			yyb20 = yyj20 > l
-		} else {
-			yyb20 = z.DecCheckBreak()
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Tcomplex128 = (complex128)(complex(r.DecodeFloat64(), 0))
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Tcomplex64 = (complex64)(complex(z.DecDecodeFloat32(), 0))
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Tbytes = z.DecodeBytesInto(([]byte)(x.Tbytes))
+	yyj26++
+	yyb26 = !z.DecContainerNext(yyj26, l, yyhl26)
+	if yyb26 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.Tpbytes != nil { // remove the if-true
+			x.Tpbytes = nil
 		}
-		if yyb20 {
-			break
+	} else {
+		if x.Tpbytes == nil {
+			x.Tpbytes = new([]uint8)
 		}
+		*x.Tpbytes = z.DecodeBytesInto(*(*[]byte)(x.Tpbytes))
+	}
+	yyj26++
+	for ; z.DecContainerNext(yyj26, l, yyhl26); yyj26++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj20-1, "")
+		z.DecStructFieldNotFound(yyj26-1, "")
 	}
 }
 
 func (x *TestMammoth2Wrapper) IsCodecEmpty() bool {
-	return !(!(x.V.IsCodecEmpty()) || x.T != 0 || x.B != 0 || x.J != 0 || len(x.C) != 0 || len(x.M) != 0 || len(x.L) != 0 || len(x.A) != 0 || false)
+	return !(!(x.V.IsCodecEmpty()) || x.T != 0 || x.B != 0 || x.J != 0 || x.C != testMammoth2Basic{} || len(x.M) != 0 || len(x.L) != 0 || x.A != [4]int64{} || x.Tcomplex128 != 0 || x.Tcomplex64 != 0 || len(x.Tbytes) != 0 || false)
 }
 
-func (x codecSelfer19781) enctestMammoth2Basic(v *testMammoth2Basic, e *Encoder) {
+func (x codecSelfer19781) encMaptestMammoth2BasicTestMammoth2(v map[testMammoth2Basic]TestMammoth2, e *Encoder) {
 	var h codecSelfer19781
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		z.EncWriteArrayElem()
-		r.EncodeUint(uint64(yyv1))
-	}
-	z.EncWriteArrayEnd()
-}
-
-func (x codecSelfer19781) dectestMammoth2Basic(v *testMammoth2Basic, d *Decoder) {
-	var h codecSelfer19781
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	if yyl1 == 0 {
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
 	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			yyh1.ElemContainerState(yyj1)
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				z.DecArrayCannotExpand(len(v), yyj1+1)
-				yydb1 = true
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			yy2 := &yyk1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
 			}
-			if yydb1 {
-				z.DecSwallow()
+			z.EncWriteMapElemValue()
+			yy4 := &yyv1
+			if yyxt5 := z.Extension(yy4); yyxt5 != nil {
+				z.EncExtension(yy4, yyxt5)
 			} else {
-				yyv1[yyj1] = (uint64)(r.DecodeUint64())
+				yy4.CodecEncodeSelf(e)
 			}
 		}
+		z.EncWriteMapEnd()
 	}
-	yyh1.End()
-}
-
-func (x codecSelfer19781) encMaptestMammoth2BasicTestMammoth2(v map[testMammoth2Basic]TestMammoth2, e *Encoder) {
-	var h codecSelfer19781
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if v == nil {
-		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		yy2 := &yyk1
-		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
-			z.EncExtension(yy2, yyxt3)
-		} else {
-			yy2.CodecEncodeSelf(e)
-		}
-		z.EncWriteMapElemValue()
-		yy4 := &yyv1
-		if yyxt5 := z.Extension(yy4); yyxt5 != nil {
-			z.EncExtension(yy4, yyxt5)
-		} else {
-			yy4.CodecEncodeSelf(e)
-		}
-	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19781) decMaptestMammoth2BasicTestMammoth2(v *map[testMammoth2Basic]TestMammoth2, d *Decoder) {
@@ -7117,7 +8911,7 @@ func (x codecSelfer19781) decMaptestMammoth2BasicTestMammoth2(v *map[testMammoth
 		*v = nil
 	} else {
 		if yyv1 == nil {
-			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1344)
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1408)
 			yyv1 = make(map[testMammoth2Basic]TestMammoth2, yyrl1)
 			*v = yyv1
 		}
@@ -7129,7 +8923,7 @@ func (x codecSelfer19781) decMaptestMammoth2BasicTestMammoth2(v *map[testMammoth
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				if yyxt3 := z.Extension(yymk1); yyxt3 != nil {
 					z.DecExtension(&yymk1, yyxt3)
@@ -7150,7 +8944,7 @@ func (x codecSelfer19781) decMaptestMammoth2BasicTestMammoth2(v *map[testMammoth
 				}
 				if yymdn1 {
 					yyv1[yymk1] = TestMammoth2{}
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -7168,9 +8962,9 @@ func (x codecSelfer19781) encSliceTestMammoth2(v []TestMammoth2, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		yy2 := &yyv1
+		yy2 := &v[yyv1]
 		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
 			z.EncExtension(yy2, yyxt3)
 		} else {
@@ -7208,7 +9002,7 @@ func (x codecSelfer19781) decSliceTestMammoth2(v *[]TestMammoth2, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1312)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1376)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
@@ -7221,10 +9015,10 @@ func (x codecSelfer19781) decSliceTestMammoth2(v *[]TestMammoth2, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1312)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1376)
 				} else {
 					yyrl1 = 8
 				}
@@ -7251,7 +9045,7 @@ func (x codecSelfer19781) decSliceTestMammoth2(v *[]TestMammoth2, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]TestMammoth2, 0)
+			yyv1 = []TestMammoth2{}
 			yyc1 = true
 		}
 	}
@@ -7270,9 +9064,9 @@ func (x codecSelfer19781) encArray4int64(v *[4]int64, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		r.EncodeInt(int64(yyv1))
+		r.EncodeInt(int64(v[yyv1]))
 	}
 	z.EncWriteArrayEnd()
 }
@@ -7290,7 +9084,7 @@ func (x codecSelfer19781) decArray4int64(v *[4]int64, d *Decoder) {
 		var yyrl1 int
 		_ = yyrl1
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
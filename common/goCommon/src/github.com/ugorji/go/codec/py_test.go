@@ -1,8 +1,9 @@
-// +build x
-
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
 
+//go:build x
+// +build x
+
 package codec
 
 // These tests are used to verify msgpack and cbor implementations against their python libraries.
@@ -25,9 +26,9 @@ func TestCborPythonGenStreamsX(t *testing.T) {
 }
 
 func TestMsgpackRpcSpecGoClientToPythonSvcX(t *testing.T) {
-	doTestMsgpackRpcSpecGoClientToPythonSvc(t)
+	doTestMsgpackRpcSpecGoClientToPythonSvc(t, testMsgpackH)
 }
 
 func TestMsgpackRpcSpecPythonClientToGoSvcX(t *testing.T) {
-	doTestMsgpackRpcSpecPythonClientToGoSvc(t)
+	doTestMsgpackRpcSpecPythonClientToGoSvc(t, testMsgpackH)
 }
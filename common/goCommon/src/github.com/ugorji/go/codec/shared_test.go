@@ -100,7 +100,9 @@ var (
 
 	testMaxInitLen int
 
-	testUseReset bool
+	testUseReset    bool
+	testUseParallel bool
+
 	testSkipIntf bool
 
 	testUseIoEncDec  int
@@ -110,6 +112,8 @@ var (
 
 	testRpcBufsize       int
 	testMapStringKeyOnly bool
+
+	testBenchmarkNoConfig bool
 )
 
 // variables that are not flags, but which can configure the handles
@@ -127,6 +131,7 @@ func init() {
 		testMsgpackH, testBincH, testSimpleH, testCborH, testJsonH)
 	// JSON should do HTMLCharsAsIs by default
 	testJsonH.HTMLCharsAsIs = true
+	// testJsonH.InternString = true
 	testInitFlags()
 	benchInitFlags()
 }
@@ -140,6 +145,7 @@ func testInitFlags() {
 
 	flag.BoolVar(&testSkipIntf, "tf", false, "Skip Interfaces")
 	flag.BoolVar(&testUseReset, "tr", false, "Use Reset")
+	flag.BoolVar(&testUseParallel, "tp", false, "Run tests in parallel")
 	flag.IntVar(&testNumRepeatString, "trs", 8, "Create string variables by repeating a string N times")
 	flag.BoolVar(&bIgnore, "tm", true, "(Deprecated) Use Must(En|De)code")
 
@@ -150,9 +156,10 @@ func testInitFlags() {
 }
 
 func benchInitFlags() {
+	flag.BoolVar(&testBenchmarkNoConfig, "bnc", false, "benchmarks: do not make configuration changes for fair benchmarking")
 	// flags reproduced here for compatibility (duplicate some in testInitFlags)
-	flag.BoolVar(&testMapStringKeyOnly, "bs", false, "use maps with string keys only")
-	flag.IntVar(&testDepth, "bd", 1, "Bench Depth")
+	flag.BoolVar(&testMapStringKeyOnly, "bs", false, "benchmarks: use maps with string keys only")
+	flag.IntVar(&testDepth, "bd", 1, "Benchmarks: Test Struc Depth")
 }
 
 func testHEDGet(h Handle) *testHED {
@@ -189,7 +196,7 @@ func testSharedCodecEncode(ts interface{}, bsIn []byte, fn func([]byte) *bytes.B
 	// bs = make([]byte, 0, approxSize)
 	var e *Encoder
 	var buf *bytes.Buffer
-	if testUseReset {
+	if testUseReset && !testUseParallel {
 		e = testHEDGet(h).E
 	} else {
 		e = NewEncoder(nil, h)
@@ -223,7 +230,7 @@ func testSharedCodecEncode(ts interface{}, bsIn []byte, fn func([]byte) *bytes.B
 
 func testSharedCodecDecoder(bs []byte, h Handle, bh *BasicHandle) (d *Decoder, oldReadBufferSize int) {
 	// var buf *bytes.Reader
-	if testUseReset {
+	if testUseReset && !testUseParallel {
 		d = testHEDGet(h).D
 	} else {
 		d = NewDecoder(nil, h)
@@ -5,9 +5,11 @@
 
 package codec
 
-import "time"
-import "reflect"
-import "bytes"
+import (
+	"bytes"
+	"reflect"
+	"time"
+)
 
 type stringSlice []string
 
@@ -41,11 +43,11 @@ func (p intSlice) Less(i, j int) bool {
 	return p[uint(i)] < p[uint(j)]
 }
 
-type int64Slice []int64
+type int32Slice []int32
 
-func (p int64Slice) Len() int      { return len(p) }
-func (p int64Slice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
-func (p int64Slice) Less(i, j int) bool {
+func (p int32Slice) Len() int      { return len(p) }
+func (p int32Slice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p int32Slice) Less(i, j int) bool {
 	return p[uint(i)] < p[uint(j)]
 }
 
@@ -61,6 +63,18 @@ func (p stringRvSlice) Less(i, j int) bool {
 	return p[uint(i)].v < p[uint(j)].v
 }
 
+type stringIntf struct {
+	v string
+	i interface{}
+}
+type stringIntfSlice []stringIntf
+
+func (p stringIntfSlice) Len() int      { return len(p) }
+func (p stringIntfSlice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p stringIntfSlice) Less(i, j int) bool {
+	return p[uint(i)].v < p[uint(j)].v
+}
+
 type float64Rv struct {
 	v float64
 	r reflect.Value
@@ -97,18 +111,6 @@ func (p int64RvSlice) Less(i, j int) bool {
 	return p[uint(i)].v < p[uint(j)].v
 }
 
-type boolRv struct {
-	v bool
-	r reflect.Value
-}
-type boolRvSlice []boolRv
-
-func (p boolRvSlice) Len() int      { return len(p) }
-func (p boolRvSlice) Swap(i, j int) { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
-func (p boolRvSlice) Less(i, j int) bool {
-	return !p[uint(i)].v && p[uint(j)].v
-}
-
 type timeRv struct {
 	v time.Time
 	r reflect.Value
@@ -1,3 +1,4 @@
+//go:build go1.6 && (codecgen || generated)
 // +build go1.6
 // +build codecgen generated
 
@@ -8,6 +9,7 @@ package codec
 import (
 	"errors"
 	"runtime"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -29,7 +31,8 @@ const (
 )
 
 var (
-	errCodecSelferOnlyMapOrArrayEncodeToStruct19780 = errors.New(`only encoded map or array can be decoded into a struct`)
+	errCodecSelferOnlyMapOrArrayEncodeToStruct19780                = errors.New(`only encoded map or array can be decoded into a struct`)
+	_                                               sort.Interface = nil
 )
 
 type codecSelfer19780 struct{}
@@ -37,17 +40,42 @@ type codecSelfer19780 struct{}
 func codecSelfer19780False() bool { return false }
 func codecSelfer19780True() bool  { return true }
 
+type codecSelfer19780stringSlice []string
+
+func (p codecSelfer19780stringSlice) Len() int           { return len(p) }
+func (p codecSelfer19780stringSlice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19780stringSlice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19780uint64Slice []uint64
+
+func (p codecSelfer19780uint64Slice) Len() int           { return len(p) }
+func (p codecSelfer19780uint64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19780uint64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19780int64Slice []int64
+
+func (p codecSelfer19780int64Slice) Len() int           { return len(p) }
+func (p codecSelfer19780int64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19780int64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
+type codecSelfer19780float64Slice []float64
+
+func (p codecSelfer19780float64Slice) Len() int           { return len(p) }
+func (p codecSelfer19780float64Slice) Swap(i, j int)      { p[uint(i)], p[uint(j)] = p[uint(j)], p[uint(i)] }
+func (p codecSelfer19780float64Slice) Less(i, j int) bool { return p[uint(i)] < p[uint(j)] }
+
 func init() {
-	if GenVersion != 20 {
+	if GenVersion != 28 {
 		_, file, _, _ := runtime.Caller(0)
 		ver := strconv.FormatInt(int64(GenVersion), 10)
-		panic(errors.New("codecgen version mismatch: current: 20, need " + ver + ". Re-generate file: " + file))
+		panic(errors.New("codecgen version mismatch: current: 28, need " + ver + ". Re-generate file: " + file))
 	}
 	if false { // reference the types, but skip this branch at build/run time
 		var _ time.Time
 	}
 }
 
+func (wrapSliceUint64) codecSelferViaCodecgen() {}
 func (x wrapSliceUint64) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -55,7 +83,7 @@ func (x wrapSliceUint64) CodecEncodeSelf(e *Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		h.encwrapSliceUint64((wrapSliceUint64)(x), e)
+		z.F.EncSliceUint64V(([]uint64)(x), e)
 	} // end block: if x slice == nil
 }
 
@@ -63,9 +91,10 @@ func (x *wrapSliceUint64) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	h.decwrapSliceUint64((*wrapSliceUint64)(x), d)
+	z.F.DecSliceUint64X((*[]uint64)(x), d)
 }
 
+func (wrapSliceString) codecSelferViaCodecgen() {}
 func (x wrapSliceString) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -73,7 +102,7 @@ func (x wrapSliceString) CodecEncodeSelf(e *Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		h.encwrapSliceString((wrapSliceString)(x), e)
+		z.F.EncSliceStringV(([]string)(x), e)
 	} // end block: if x slice == nil
 }
 
@@ -81,9 +110,10 @@ func (x *wrapSliceString) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	h.decwrapSliceString((*wrapSliceString)(x), d)
+	z.F.DecSliceStringX((*[]string)(x), d)
 }
 
+func (wrapUint64) codecSelferViaCodecgen() {}
 func (x wrapUint64) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -98,6 +128,7 @@ func (x *wrapUint64) CodecDecodeSelf(d *Decoder) {
 	*x = (wrapUint64)(r.DecodeUint64())
 }
 
+func (wrapString) codecSelferViaCodecgen() {}
 func (x wrapString) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -109,9 +140,10 @@ func (x *wrapString) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	*x = (wrapString)(string(r.DecodeStringAsBytes()))
+	*x = (wrapString)(z.DecStringZC(r.DecodeStringAsBytes()))
 }
 
+func (wrapUint64Slice) codecSelferViaCodecgen() {}
 func (x wrapUint64Slice) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -130,6 +162,7 @@ func (x *wrapUint64Slice) CodecDecodeSelf(d *Decoder) {
 	h.decwrapUint64Slice((*wrapUint64Slice)(x), d)
 }
 
+func (wrapStringSlice) codecSelferViaCodecgen() {}
 func (x wrapStringSlice) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -148,10 +181,15 @@ func (x *wrapStringSlice) CodecDecodeSelf(d *Decoder) {
 	h.decwrapStringSlice((*wrapStringSlice)(x), d)
 }
 
+func (stringUint64T) codecSelferViaCodecgen() {}
 func (x *stringUint64T) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -167,22 +205,41 @@ func (x *stringUint64T) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"U\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"U\"")
+				} else {
+					r.EncodeString(`U`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.U))
 			} else {
-				r.EncodeString(`U`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"U\"")
+				} else {
+					r.EncodeString(`U`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.U))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.U))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -218,26 +275,17 @@ func (x *stringUint64T) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "U":
 			x.U = (uint64)(r.DecodeUint64())
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -249,40 +297,23 @@ func (x *stringUint64T) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.U = (uint64)(r.DecodeUint64())
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -292,10 +323,192 @@ func (x *stringUint64T) IsCodecEmpty() bool {
 	return !(x.S != "" || x.U != 0 || false)
 }
 
+func (AnonInTestStrucSlim) codecSelferViaCodecgen() {}
+func (x *AnonInTestStrucSlim) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		var yyn4 bool = x.P == nil
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(2)
+			z.EncWriteArrayElem()
+			r.EncodeString(string(x.S))
+			if yyn4 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				yy6 := *x.P
+				r.EncodeString(string(yy6))
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(2)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn4 {
+					r.EncodeNil()
+				} else {
+					yy8 := *x.P
+					r.EncodeString(string(yy8))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+			} else {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn4 {
+					r.EncodeNil()
+				} else {
+					yy12 := *x.P
+					r.EncodeString(string(yy12))
+				}
+			}
+			z.EncWriteMapEnd()
+		}
+	}
+}
+
+func (x *AnonInTestStrucSlim) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	yyct2 := r.ContainerType()
+	if yyct2 == codecSelferValueTypeNil19780 {
+		*(x) = AnonInTestStrucSlim{}
+	} else if yyct2 == codecSelferValueTypeMap19780 {
+		yyl2 := z.DecReadMapStart()
+		if yyl2 == 0 {
+		} else {
+			x.codecDecodeSelfFromMap(yyl2, d)
+		}
+		z.DecReadMapEnd()
+	} else if yyct2 == codecSelferValueTypeArray19780 {
+		yyl2 := z.DecReadArrayStart()
+		if yyl2 != 0 {
+			x.codecDecodeSelfFromArray(yyl2, d)
+		}
+		z.DecReadArrayEnd()
+	} else {
+		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+	}
+}
+
+func (x *AnonInTestStrucSlim) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
+		z.DecReadMapElemKey()
+		yys3 := r.DecodeStringAsBytes()
+		z.DecReadMapElemValue()
+		switch string(yys3) {
+		case "S":
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+		case "P":
+			if r.TryNil() {
+				if x.P != nil { // remove the if-true
+					x.P = nil
+				}
+			} else {
+				if x.P == nil {
+					x.P = new(string)
+				}
+				*x.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+			}
+		default:
+			z.DecStructFieldNotFound(-1, string(yys3))
+		} // end switch yys3
+	} // end for yyj3
+}
+
+func (x *AnonInTestStrucSlim) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj7++
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
+	if yyb7 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.P != nil { // remove the if-true
+			x.P = nil
+		}
+	} else {
+		if x.P == nil {
+			x.P = new(string)
+		}
+		*x.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	}
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
+	}
+}
+
+func (x *AnonInTestStrucSlim) IsCodecEmpty() bool {
+	return !(x.S != "" || false)
+}
+
+func (AnonInTestStruc) codecSelferViaCodecgen() {}
 func (x *AnonInTestStruc) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -303,7 +516,7 @@ func (x *AnonInTestStruc) CodecEncodeSelf(e *Encoder) {
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(15)
+			z.EncWriteArrayStart(17)
 			z.EncWriteArrayElem()
 			r.EncodeString(string(x.AS))
 			z.EncWriteArrayElem()
@@ -340,17 +553,26 @@ func (x *AnonInTestStruc) CodecEncodeSelf(e *Encoder) {
 			if x.AF32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSlicefloat32(([]float32)(x.AF32slice), e)
+				z.F.EncSliceFloat32V(x.AF32slice, e)
 			} // end block: if x.AF32slice slice == nil
 			z.EncWriteArrayElem()
-			if x.AMSU16 == nil {
+			if x.AMSS == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
-			} // end block: if x.AMSU16 map == nil
+				z.F.EncMapStringStringV(x.AMSS, e)
+			} // end block: if x.AMSS map == nil
 			z.EncWriteArrayElem()
-			yy28 := &x.AI64arr0
-			h.encArray0int64((*[0]int64)(yy28), e)
+			if x.AMSU64 == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncMapStringUint64V(x.AMSU64, e)
+			} // end block: if x.AMSU64 map == nil
+			z.EncWriteArrayElem()
+			yy31 := &x.AI64arr8
+			h.encArray8int64((*[8]int64)(yy31), e)
+			z.EncWriteArrayElem()
+			yy33 := &x.AI64arr0
+			h.encArray0int64((*[0]int64)(yy33), e)
 			z.EncWriteArrayElem()
 			if x.AI64slice0 == nil {
 				r.EncodeNil()
@@ -364,181 +586,387 @@ func (x *AnonInTestStruc) CodecEncodeSelf(e *Encoder) {
 				z.F.EncSliceUint64V(x.AUi64sliceN, e)
 			} // end block: if x.AUi64sliceN slice == nil
 			z.EncWriteArrayElem()
-			if x.AMSU16N == nil {
+			if x.AMSU64N == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
-			} // end block: if x.AMSU16N map == nil
+				z.F.EncMapStringUint64V(x.AMSU64N, e)
+			} // end block: if x.AMSU64N map == nil
 			z.EncWriteArrayElem()
-			if x.AMSU16E == nil {
+			if x.AMSU64E == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
-			} // end block: if x.AMSU16E map == nil
+				z.F.EncMapStringUint64V(x.AMSU64E, e)
+			} // end block: if x.AMSU64E map == nil
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(15)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AS\"")
-			} else {
-				r.EncodeString(`AS`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.AS))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64\"")
-			} else {
-				r.EncodeString(`AI64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.AI64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI16\"")
-			} else {
-				r.EncodeString(`AI16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.AI16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64\"")
-			} else {
-				r.EncodeString(`AUi64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.AUi64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"ASslice\"")
-			} else {
-				r.EncodeString(`ASslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.ASslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.ASslice, e)
-			} // end block: if x.ASslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice\"")
-			} else {
-				r.EncodeString(`AI64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AI64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.AI64slice, e)
-			} // end block: if x.AI64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64slice\"")
-			} else {
-				r.EncodeString(`AUi64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AUi64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.AUi64slice, e)
-			} // end block: if x.AUi64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF64slice\"")
-			} else {
-				r.EncodeString(`AF64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AF64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceFloat64V(x.AF64slice, e)
-			} // end block: if x.AF64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF32slice\"")
-			} else {
-				r.EncodeString(`AF32slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AF32slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicefloat32(([]float32)(x.AF32slice), e)
-			} // end block: if x.AF32slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16\"")
-			} else {
-				r.EncodeString(`AMSU16`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AMSU16 == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
-			} // end block: if x.AMSU16 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64arr0\"")
-			} else {
-				r.EncodeString(`AI64arr0`)
-			}
-			z.EncWriteMapElemValue()
-			yy44 := &x.AI64arr0
-			h.encArray0int64((*[0]int64)(yy44), e)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice0\"")
-			} else {
-				r.EncodeString(`AI64slice0`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AI64slice0 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.AI64slice0, e)
-			} // end block: if x.AI64slice0 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64sliceN\"")
+			z.EncWriteMapStart(17)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.AF32slice, e)
+				} // end block: if x.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.AF64slice, e)
+				} // end block: if x.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy43 := &x.AI64arr0
+				h.encArray0int64((*[0]int64)(yy43), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy45 := &x.AI64arr8
+				h.encArray8int64((*[8]int64)(yy45), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AI64slice, e)
+				} // end block: if x.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AI64slice0, e)
+				} // end block: if x.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.AMSS, e)
+				} // end block: if x.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64, e)
+				} // end block: if x.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64E, e)
+				} // end block: if x.AMSU64E map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64N, e)
+				} // end block: if x.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.ASslice, e)
+				} // end block: if x.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AUi64slice, e)
+				} // end block: if x.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AUi64sliceN == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AUi64sliceN, e)
+				} // end block: if x.AUi64sliceN slice == nil
 			} else {
-				r.EncodeString(`AUi64sliceN`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.ASslice, e)
+				} // end block: if x.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AI64slice, e)
+				} // end block: if x.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AUi64slice, e)
+				} // end block: if x.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.AF64slice, e)
+				} // end block: if x.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.AF32slice, e)
+				} // end block: if x.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.AMSS, e)
+				} // end block: if x.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64, e)
+				} // end block: if x.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy69 := &x.AI64arr8
+				h.encArray8int64((*[8]int64)(yy69), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy71 := &x.AI64arr0
+				h.encArray0int64((*[0]int64)(yy71), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AI64slice0, e)
+				} // end block: if x.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AUi64sliceN == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AUi64sliceN, e)
+				} // end block: if x.AUi64sliceN slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64N, e)
+				} // end block: if x.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AMSU64E, e)
+				} // end block: if x.AMSU64E map == nil
 			}
-			z.EncWriteMapElemValue()
-			if x.AUi64sliceN == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.AUi64sliceN, e)
-			} // end block: if x.AUi64sliceN slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16N\"")
-			} else {
-				r.EncodeString(`AMSU16N`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AMSU16N == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
-			} // end block: if x.AMSU16N map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16E\"")
-			} else {
-				r.EncodeString(`AMSU16E`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AMSU16E == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
-			} // end block: if x.AMSU16E map == nil
 			z.EncWriteMapEnd()
 		}
 	}
@@ -574,22 +1002,13 @@ func (x *AnonInTestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "AS":
-			x.AS = (string)(string(r.DecodeStringAsBytes()))
+			x.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "AI64":
 			x.AI64 = (int64)(r.DecodeInt64())
 		case "AI16":
@@ -605,21 +1024,25 @@ func (x *AnonInTestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "AF64slice":
 			z.F.DecSliceFloat64X(&x.AF64slice, d)
 		case "AF32slice":
-			h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
-		case "AMSU16":
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+			z.F.DecSliceFloat32X(&x.AF32slice, d)
+		case "AMSS":
+			z.F.DecMapStringStringX(&x.AMSS, d)
+		case "AMSU64":
+			z.F.DecMapStringUint64X(&x.AMSU64, d)
+		case "AI64arr8":
+			h.decArray8int64((*[8]int64)(&x.AI64arr8), d)
 		case "AI64arr0":
 			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
 		case "AI64slice0":
 			z.F.DecSliceInt64X(&x.AI64slice0, d)
 		case "AUi64sliceN":
 			z.F.DecSliceUint64X(&x.AUi64sliceN, d)
-		case "AMSU16N":
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
-		case "AMSU16E":
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+		case "AMSU64N":
+			z.F.DecMapStringUint64X(&x.AMSU64N, d)
+		case "AMSU64E":
+			z.F.DecMapStringUint64X(&x.AMSU64E, d)
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -628,212 +1051,164 @@ func (x *AnonInTestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj30 int
-	var yyb30 bool
-	var yyhl30 bool = l >= 0
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	var yyj34 int
+	var yyb34 bool
+	var yyhl34 bool = l >= 0
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.AS = (string)(string(r.DecodeStringAsBytes()))
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	x.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AI64 = (int64)(r.DecodeInt64())
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AUi64 = (uint64)(r.DecodeUint64())
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.ASslice, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.AI64slice, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.AUi64slice, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceFloat64X(&x.AF64slice, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
+	z.F.DecSliceFloat32X(&x.AF32slice, d)
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb30 {
+	z.DecReadArrayElem()
+	z.F.DecMapStringStringX(&x.AMSS, d)
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
+	z.F.DecMapStringUint64X(&x.AMSU64, d)
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb30 {
+	z.DecReadArrayElem()
+	h.decArray8int64((*[8]int64)(&x.AI64arr8), d)
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.AI64slice0, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.AUi64sliceN, d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
-	if yyb30 {
+	z.F.DecMapStringUint64X(&x.AMSU64N, d)
+	yyj34++
+	yyb34 = !z.DecContainerNext(yyj34, l, yyhl34)
+	if yyb34 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
-	for {
-		yyj30++
-		if yyhl30 {
-			yyb30 = yyj30 > l
-		} else {
-			yyb30 = z.DecCheckBreak()
-		}
-		if yyb30 {
-			break
-		}
+	z.F.DecMapStringUint64X(&x.AMSU64E, d)
+	yyj34++
+	for ; z.DecContainerNext(yyj34, l, yyhl34); yyj34++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj30-1, "")
+		z.DecStructFieldNotFound(yyj34-1, "")
 	}
 }
 
 func (x *AnonInTestStruc) IsCodecEmpty() bool {
-	return !(x.AS != "" || x.AI64 != 0 || x.AI16 != 0 || x.AUi64 != 0 || len(x.ASslice) != 0 || len(x.AI64slice) != 0 || len(x.AUi64slice) != 0 || len(x.AF64slice) != 0 || len(x.AF32slice) != 0 || len(x.AMSU16) != 0 || len(x.AI64arr0) != 0 || len(x.AI64slice0) != 0 || len(x.AUi64sliceN) != 0 || len(x.AMSU16N) != 0 || len(x.AMSU16E) != 0 || false)
+	return !(x.AS != "" || x.AI64 != 0 || x.AI16 != 0 || x.AUi64 != 0 || len(x.ASslice) != 0 || len(x.AI64slice) != 0 || len(x.AUi64slice) != 0 || len(x.AF64slice) != 0 || len(x.AF32slice) != 0 || len(x.AMSS) != 0 || len(x.AMSU64) != 0 || x.AI64arr8 != [8]int64{} || false || len(x.AI64slice0) != 0 || len(x.AUi64sliceN) != 0 || len(x.AMSU64N) != 0 || len(x.AMSU64E) != 0 || false)
 }
 
+func (testSimpleFields) codecSelferViaCodecgen() {}
 func (x *testSimpleFields) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -865,11 +1240,11 @@ func (x *testSimpleFields) CodecEncodeSelf(e *Encoder) {
 				z.F.EncSliceStringV(x.Sslice, e)
 			} // end block: if x.Sslice slice == nil
 			z.EncWriteArrayElem()
-			if x.I16slice == nil {
+			if x.I32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSliceint16(([]int16)(x.I16slice), e)
-			} // end block: if x.I16slice slice == nil
+				z.F.EncSliceInt32V(x.I32slice, e)
+			} // end block: if x.I32slice slice == nil
 			z.EncWriteArrayElem()
 			if x.Ui64slice == nil {
 				r.EncodeNil()
@@ -907,186 +1282,361 @@ func (x *testSimpleFields) CodecEncodeSelf(e *Encoder) {
 				x.WrapSliceString.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if x.Msi64 == nil {
+			if x.Msint == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapStringInt64V(x.Msi64, e)
-			} // end block: if x.Msi64 map == nil
+				z.F.EncMapStringIntV(x.Msint, e)
+			} // end block: if x.Msint map == nil
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(17)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64\"")
-			} else {
-				r.EncodeString(`I64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I8\"")
-			} else {
-				r.EncodeString(`I8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64\"")
-			} else {
-				r.EncodeString(`Ui64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8\"")
-			} else {
-				r.EncodeString(`Ui8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F64\"")
-			} else {
-				r.EncodeString(`F64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat64(float64(x.F64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F32\"")
-			} else {
-				r.EncodeString(`F32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat32(float32(x.F32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Sslice\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.Bslice, e)
+				} // end block: if x.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.I32slice, e)
+				} // end block: if x.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+				} // end block: if x.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.Msint, e)
+				} // end block: if x.Msint map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Sslice, e)
+				} // end block: if x.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.Ui64slice, e)
+				} // end block: if x.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+				} // end block: if x.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt52 := z.Extension(x.WrapSliceInt64); yyxt52 != nil {
+					z.EncExtension(x.WrapSliceInt64, yyxt52)
+				} else {
+					x.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt53 := z.Extension(x.WrapSliceString); yyxt53 != nil {
+					z.EncExtension(x.WrapSliceString, yyxt53)
+				} else {
+					x.WrapSliceString.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeString(`Sslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Sslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.Sslice, e)
-			} // end block: if x.Sslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16slice\"")
-			} else {
-				r.EncodeString(`I16slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.I16slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSliceint16(([]int16)(x.I16slice), e)
-			} // end block: if x.I16slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64slice\"")
-			} else {
-				r.EncodeString(`Ui64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Ui64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.Ui64slice, e)
-			} // end block: if x.Ui64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8slice\"")
-			} else {
-				r.EncodeString(`Ui8slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Ui8slice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.Ui8slice))
-			} // end block: if x.Ui8slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Bslice\"")
-			} else {
-				r.EncodeString(`Bslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Bslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBoolV(x.Bslice, e)
-			} // end block: if x.Bslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Iptrslice\"")
-			} else {
-				r.EncodeString(`Iptrslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Iptrslice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
-			} // end block: if x.Iptrslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceInt64\"")
-			} else {
-				r.EncodeString(`WrapSliceInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt51 := z.Extension(x.WrapSliceInt64); yyxt51 != nil {
-				z.EncExtension(x.WrapSliceInt64, yyxt51)
-			} else {
-				x.WrapSliceInt64.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceString\"")
-			} else {
-				r.EncodeString(`WrapSliceString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt52 := z.Extension(x.WrapSliceString); yyxt52 != nil {
-				z.EncExtension(x.WrapSliceString, yyxt52)
-			} else {
-				x.WrapSliceString.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Msi64\"")
-			} else {
-				r.EncodeString(`Msi64`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Sslice, e)
+				} // end block: if x.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.I32slice, e)
+				} // end block: if x.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.Ui64slice, e)
+				} // end block: if x.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+				} // end block: if x.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.Bslice, e)
+				} // end block: if x.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+				} // end block: if x.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt68 := z.Extension(x.WrapSliceInt64); yyxt68 != nil {
+					z.EncExtension(x.WrapSliceInt64, yyxt68)
+				} else {
+					x.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt69 := z.Extension(x.WrapSliceString); yyxt69 != nil {
+					z.EncExtension(x.WrapSliceString, yyxt69)
+				} else {
+					x.WrapSliceString.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.Msint, e)
+				} // end block: if x.Msint map == nil
 			}
-			z.EncWriteMapElemValue()
-			if x.Msi64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringInt64V(x.Msi64, e)
-			} // end block: if x.Msi64 map == nil
 			z.EncWriteMapEnd()
 		}
 	}
@@ -1122,22 +1672,13 @@ func (x *testSimpleFields) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I64":
 			x.I64 = (int64)(r.DecodeInt64())
 		case "I8":
@@ -1154,12 +1695,12 @@ func (x *testSimpleFields) codecDecodeSelfFromMap(l int, d *Decoder) {
 			x.B = (bool)(r.DecodeBool())
 		case "Sslice":
 			z.F.DecSliceStringX(&x.Sslice, d)
-		case "I16slice":
-			h.decSliceint16((*[]int16)(&x.I16slice), d)
+		case "I32slice":
+			z.F.DecSliceInt32X(&x.I32slice, d)
 		case "Ui64slice":
 			z.F.DecSliceUint64X(&x.Ui64slice, d)
 		case "Ui8slice":
-			x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+			x.Ui8slice = z.DecodeBytesInto(([]byte)(x.Ui8slice))
 		case "Bslice":
 			z.F.DecSliceBoolX(&x.Bslice, d)
 		case "Iptrslice":
@@ -1176,10 +1717,10 @@ func (x *testSimpleFields) codecDecodeSelfFromMap(l int, d *Decoder) {
 			} else {
 				x.WrapSliceString.CodecDecodeSelf(d)
 			}
-		case "Msi64":
-			z.F.DecMapStringInt64X(&x.Msi64, d)
+		case "Msint":
+			z.F.DecMapStringIntX(&x.Msint, d)
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -1191,24 +1732,15 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj30 int
 	var yyb30 bool
 	var yyhl30 bool = l >= 0
-	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1216,11 +1748,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.I64 = (int64)(r.DecodeInt64())
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1228,11 +1756,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1240,11 +1764,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.Ui64 = (uint64)(r.DecodeUint64())
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1252,11 +1772,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1264,11 +1780,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.F64 = (float64)(r.DecodeFloat64())
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1276,11 +1788,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.F32 = (float32)(z.DecDecodeFloat32())
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1288,11 +1796,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1300,23 +1804,15 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.Sslice, d)
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSliceint16((*[]int16)(&x.I16slice), d)
+	z.F.DecSliceInt32X(&x.I32slice, d)
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1324,23 +1820,15 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.Ui64slice, d)
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+	x.Ui8slice = z.DecodeBytesInto(([]byte)(x.Ui8slice))
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1348,11 +1836,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.F.DecSliceBoolX(&x.Bslice, d)
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1360,11 +1844,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1376,11 +1856,7 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 		x.WrapSliceInt64.CodecDecodeSelf(d)
 	}
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
@@ -1392,49 +1868,44 @@ func (x *testSimpleFields) codecDecodeSelfFromArray(l int, d *Decoder) {
 		x.WrapSliceString.CodecDecodeSelf(d)
 	}
 	yyj30++
-	if yyhl30 {
-		yyb30 = yyj30 > l
-	} else {
-		yyb30 = z.DecCheckBreak()
-	}
+	yyb30 = !z.DecContainerNext(yyj30, l, yyhl30)
 	if yyb30 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringInt64X(&x.Msi64, d)
-	for {
-		yyj30++
-		if yyhl30 {
-			yyb30 = yyj30 > l
-		} else {
-			yyb30 = z.DecCheckBreak()
-		}
-		if yyb30 {
-			break
-		}
+	z.F.DecMapStringIntX(&x.Msint, d)
+	yyj30++
+	for ; z.DecContainerNext(yyj30, l, yyhl30); yyj30++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj30-1, "")
 	}
 }
 
 func (x *testSimpleFields) IsCodecEmpty() bool {
-	return !(x.S != "" || x.I64 != 0 || x.I8 != 0 || x.Ui64 != 0 || x.Ui8 != 0 || x.F64 != 0 || x.F32 != 0 || bool(x.B) || len(x.Sslice) != 0 || len(x.I16slice) != 0 || len(x.Ui64slice) != 0 || len(x.Ui8slice) != 0 || len(x.Bslice) != 0 || len(x.Iptrslice) != 0 || len(x.WrapSliceInt64) != 0 || len(x.WrapSliceString) != 0 || len(x.Msi64) != 0 || false)
+	return !(x.S != "" || x.I64 != 0 || x.I8 != 0 || x.Ui64 != 0 || x.Ui8 != 0 || x.F64 != 0 || x.F32 != 0 || bool(x.B) || len(x.Sslice) != 0 || len(x.I32slice) != 0 || len(x.Ui64slice) != 0 || len(x.Ui8slice) != 0 || len(x.Bslice) != 0 || len(x.Iptrslice) != 0 || len(x.WrapSliceInt64) != 0 || len(x.WrapSliceString) != 0 || len(x.Msint) != 0 || false)
 }
 
+func (TestStrucCommon) codecSelferViaCodecgen() {}
 func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
 		yy2arr2 := z.EncBasicHandle().StructToArray
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
-		var yyn54 bool = x.Nint64 == nil
+		var yyn54 bool = x.AnonInTestStrucSlim == nil || x.AnonInTestStrucSlim.P == nil
+		var yyn55 bool = x.NotAnonSlim == nil
+		var yyn58 bool = x.Nint64 == nil
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(52)
+			z.EncWriteArrayStart(56)
 			z.EncWriteArrayElem()
 			r.EncodeString(string(x.S))
 			z.EncWriteArrayElem()
@@ -1482,11 +1953,11 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 				z.F.EncSliceInt64V(x.I64slice, e)
 			} // end block: if x.I64slice slice == nil
 			z.EncWriteArrayElem()
-			if x.I16slice == nil {
+			if x.I32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSliceint16(([]int16)(x.I16slice), e)
-			} // end block: if x.I16slice slice == nil
+				z.F.EncSliceInt32V(x.I32slice, e)
+			} // end block: if x.I32slice slice == nil
 			z.EncWriteArrayElem()
 			if x.Ui64slice == nil {
 				r.EncodeNil()
@@ -1524,23 +1995,23 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 				h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
 			} // end block: if x.Iptrslice slice == nil
 			z.EncWriteArrayElem()
-			if yyxt81 := z.Extension(x.WrapSliceInt64); yyxt81 != nil {
-				z.EncExtension(x.WrapSliceInt64, yyxt81)
+			if yyxt85 := z.Extension(x.WrapSliceInt64); yyxt85 != nil {
+				z.EncExtension(x.WrapSliceInt64, yyxt85)
 			} else {
 				x.WrapSliceInt64.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if yyxt82 := z.Extension(x.WrapSliceString); yyxt82 != nil {
-				z.EncExtension(x.WrapSliceString, yyxt82)
+			if yyxt86 := z.Extension(x.WrapSliceString); yyxt86 != nil {
+				z.EncExtension(x.WrapSliceString, yyxt86)
 			} else {
 				x.WrapSliceString.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
-			if x.Msi64 == nil {
+			if x.Msint == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapStringInt64V(x.Msi64, e)
-			} // end block: if x.Msi64 map == nil
+				z.F.EncMapStringIntV(x.Msint, e)
+			} // end block: if x.Msint map == nil
 			z.EncWriteArrayElem()
 			if x.Msbytes == nil {
 				r.EncodeNil()
@@ -1548,11 +2019,11 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 				z.F.EncMapStringBytesV(x.Msbytes, e)
 			} // end block: if x.Msbytes map == nil
 			z.EncWriteArrayElem()
-			yy85 := &x.Simplef
-			if yyxt86 := z.Extension(yy85); yyxt86 != nil {
-				z.EncExtension(yy85, yyxt86)
+			yy89 := &x.Simplef
+			if yyxt90 := z.Extension(yy89); yyxt90 != nil {
+				z.EncExtension(yy89, yyxt90)
 			} else {
-				yy85.CodecEncodeSelf(e)
+				yy89.CodecEncodeSelf(e)
 			}
 			z.EncWriteArrayElem()
 			if x.SstrUi64T == nil {
@@ -1602,17 +2073,26 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 			if x.AnonInTestStruc.AF32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSlicefloat32(([]float32)(x.AnonInTestStruc.AF32slice), e)
+				z.F.EncSliceFloat32V(x.AnonInTestStruc.AF32slice, e)
 			} // end block: if x.AnonInTestStruc.AF32slice slice == nil
 			z.EncWriteArrayElem()
-			if x.AnonInTestStruc.AMSU16 == nil {
+			if x.AnonInTestStruc.AMSS == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16), e)
-			} // end block: if x.AnonInTestStruc.AMSU16 map == nil
+				z.F.EncMapStringStringV(x.AnonInTestStruc.AMSS, e)
+			} // end block: if x.AnonInTestStruc.AMSS map == nil
 			z.EncWriteArrayElem()
-			yy99 := &x.AnonInTestStruc.AI64arr0
-			h.encArray0int64((*[0]int64)(yy99), e)
+			if x.AnonInTestStruc.AMSU64 == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64, e)
+			} // end block: if x.AnonInTestStruc.AMSU64 map == nil
+			z.EncWriteArrayElem()
+			yy104 := &x.AnonInTestStruc.AI64arr8
+			h.encArray8int64((*[8]int64)(yy104), e)
+			z.EncWriteArrayElem()
+			yy106 := &x.AnonInTestStruc.AI64arr0
+			h.encArray0int64((*[0]int64)(yy106), e)
 			z.EncWriteArrayElem()
 			if x.AnonInTestStruc.AI64slice0 == nil {
 				r.EncodeNil()
@@ -1626,23 +2106,42 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 				z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64sliceN, e)
 			} // end block: if x.AnonInTestStruc.AUi64sliceN slice == nil
 			z.EncWriteArrayElem()
-			if x.AnonInTestStruc.AMSU16N == nil {
+			if x.AnonInTestStruc.AMSU64N == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16N), e)
-			} // end block: if x.AnonInTestStruc.AMSU16N map == nil
+				z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64N, e)
+			} // end block: if x.AnonInTestStruc.AMSU64N map == nil
 			z.EncWriteArrayElem()
-			if x.AnonInTestStruc.AMSU16E == nil {
+			if x.AnonInTestStruc.AMSU64E == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16E), e)
-			} // end block: if x.AnonInTestStruc.AMSU16E map == nil
+				z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64E, e)
+			} // end block: if x.AnonInTestStruc.AMSU64E map == nil
 			z.EncWriteArrayElem()
-			yy105 := &x.NotAnon
-			if yyxt106 := z.Extension(yy105); yyxt106 != nil {
-				z.EncExtension(yy105, yyxt106)
+			yy112 := &x.NotAnon
+			if yyxt113 := z.Extension(yy112); yyxt113 != nil {
+				z.EncExtension(yy112, yyxt113)
+			} else {
+				yy112.CodecEncodeSelf(e)
+			}
+			if yyn54 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				yy114 := *x.AnonInTestStrucSlim.P
+				r.EncodeString(string(yy114))
+			}
+			if yyn55 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
 			} else {
-				yy105.CodecEncodeSelf(e)
+				z.EncWriteArrayElem()
+				if yyxt116 := z.Extension(x.NotAnonSlim); yyxt116 != nil {
+					z.EncExtension(x.NotAnonSlim, yyxt116)
+				} else {
+					x.NotAnonSlim.CodecEncodeSelf(e)
+				}
 			}
 			z.EncWriteArrayElem()
 			if x.Nmap == nil {
@@ -1656,556 +2155,1199 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 			} else {
 				r.EncodeStringBytesRaw([]byte(x.Nslice))
 			} // end block: if x.Nslice slice == nil
-			if yyn54 {
+			if yyn58 {
 				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
 				z.EncWriteArrayElem()
-				yy109 := *x.Nint64
-				r.EncodeInt(int64(yy109))
+				yy119 := *x.Nint64
+				r.EncodeInt(int64(yy119))
 			}
 			z.EncWriteArrayEnd()
 		} else {
-			z.EncWriteMapStart(52)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64\"")
-			} else {
-				r.EncodeString(`I64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I32\"")
-			} else {
-				r.EncodeString(`I32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16\"")
-			} else {
-				r.EncodeString(`I16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I8\"")
-			} else {
-				r.EncodeString(`I8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64n\"")
-			} else {
-				r.EncodeString(`I64n`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I64n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I32n\"")
-			} else {
-				r.EncodeString(`I32n`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I32n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16n\"")
-			} else {
-				r.EncodeString(`I16n`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I16n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I8n\"")
-			} else {
-				r.EncodeString(`I8n`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I8n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64\"")
-			} else {
-				r.EncodeString(`Ui64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui32\"")
-			} else {
-				r.EncodeString(`Ui32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui16\"")
-			} else {
-				r.EncodeString(`Ui16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8\"")
-			} else {
-				r.EncodeString(`Ui8`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Ui8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F64\"")
-			} else {
-				r.EncodeString(`F64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat64(float64(x.F64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F32\"")
-			} else {
-				r.EncodeString(`F32`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat32(float32(x.F32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"By\"")
-			} else {
-				r.EncodeString(`By`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.By))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Sslice\"")
-			} else {
-				r.EncodeString(`Sslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Sslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.Sslice, e)
-			} // end block: if x.Sslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64slice\"")
-			} else {
-				r.EncodeString(`I64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.I64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.I64slice, e)
-			} // end block: if x.I64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16slice\"")
-			} else {
-				r.EncodeString(`I16slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.I16slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSliceint16(([]int16)(x.I16slice), e)
-			} // end block: if x.I16slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64slice\"")
-			} else {
-				r.EncodeString(`Ui64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Ui64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.Ui64slice, e)
-			} // end block: if x.Ui64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8slice\"")
-			} else {
-				r.EncodeString(`Ui8slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Ui8slice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.Ui8slice))
-			} // end block: if x.Ui8slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Bslice\"")
-			} else {
-				r.EncodeString(`Bslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Bslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBoolV(x.Bslice, e)
-			} // end block: if x.Bslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Byslice\"")
-			} else {
-				r.EncodeString(`Byslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Byslice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.Byslice))
-			} // end block: if x.Byslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"BytesSlice\"")
-			} else {
-				r.EncodeString(`BytesSlice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.BytesSlice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBytesV(x.BytesSlice, e)
-			} // end block: if x.BytesSlice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Iptrslice\"")
-			} else {
-				r.EncodeString(`Iptrslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Iptrslice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
-			} // end block: if x.Iptrslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceInt64\"")
-			} else {
-				r.EncodeString(`WrapSliceInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt137 := z.Extension(x.WrapSliceInt64); yyxt137 != nil {
-				z.EncExtension(x.WrapSliceInt64, yyxt137)
-			} else {
-				x.WrapSliceInt64.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceString\"")
-			} else {
-				r.EncodeString(`WrapSliceString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt138 := z.Extension(x.WrapSliceString); yyxt138 != nil {
-				z.EncExtension(x.WrapSliceString, yyxt138)
-			} else {
-				x.WrapSliceString.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Msi64\"")
-			} else {
-				r.EncodeString(`Msi64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Msi64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringInt64V(x.Msi64, e)
-			} // end block: if x.Msi64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Msbytes\"")
-			} else {
-				r.EncodeString(`Msbytes`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Msbytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBytesV(x.Msbytes, e)
-			} // end block: if x.Msbytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Simplef\"")
-			} else {
-				r.EncodeString(`Simplef`)
-			}
-			z.EncWriteMapElemValue()
-			yy141 := &x.Simplef
-			if yyxt142 := z.Extension(yy141); yyxt142 != nil {
-				z.EncExtension(yy141, yyxt142)
-			} else {
-				yy141.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"SstrUi64T\"")
-			} else {
-				r.EncodeString(`SstrUi64T`)
-			}
-			z.EncWriteMapElemValue()
-			if x.SstrUi64T == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
-			} // end block: if x.SstrUi64T slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"MstrUi64T\"")
-			} else {
-				r.EncodeString(`MstrUi64T`)
-			}
-			z.EncWriteMapElemValue()
-			if x.MstrUi64T == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.MstrUi64T), e)
-			} // end block: if x.MstrUi64T map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AS\"")
-			} else {
-				r.EncodeString(`AS`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.AnonInTestStruc.AS))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64\"")
-			} else {
-				r.EncodeString(`AI64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.AnonInTestStruc.AI64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI16\"")
-			} else {
-				r.EncodeString(`AI16`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.AnonInTestStruc.AI16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64\"")
-			} else {
-				r.EncodeString(`AUi64`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.AnonInTestStruc.AUi64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"ASslice\"")
-			} else {
-				r.EncodeString(`ASslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.ASslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.AnonInTestStruc.ASslice, e)
-			} // end block: if x.AnonInTestStruc.ASslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice\"")
-			} else {
-				r.EncodeString(`AI64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AI64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice, e)
-			} // end block: if x.AnonInTestStruc.AI64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64slice\"")
-			} else {
-				r.EncodeString(`AUi64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AUi64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64slice, e)
-			} // end block: if x.AnonInTestStruc.AUi64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF64slice\"")
-			} else {
-				r.EncodeString(`AF64slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AF64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceFloat64V(x.AnonInTestStruc.AF64slice, e)
-			} // end block: if x.AnonInTestStruc.AF64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF32slice\"")
-			} else {
-				r.EncodeString(`AF32slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AF32slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicefloat32(([]float32)(x.AnonInTestStruc.AF32slice), e)
-			} // end block: if x.AnonInTestStruc.AF32slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16\"")
-			} else {
-				r.EncodeString(`AMSU16`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AMSU16 == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16), e)
-			} // end block: if x.AnonInTestStruc.AMSU16 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64arr0\"")
-			} else {
-				r.EncodeString(`AI64arr0`)
-			}
-			z.EncWriteMapElemValue()
-			yy155 := &x.AnonInTestStruc.AI64arr0
-			h.encArray0int64((*[0]int64)(yy155), e)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice0\"")
-			} else {
-				r.EncodeString(`AI64slice0`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AI64slice0 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice0, e)
-			} // end block: if x.AnonInTestStruc.AI64slice0 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64sliceN\"")
-			} else {
-				r.EncodeString(`AUi64sliceN`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AUi64sliceN == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64sliceN, e)
-			} // end block: if x.AnonInTestStruc.AUi64sliceN slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16N\"")
-			} else {
-				r.EncodeString(`AMSU16N`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AMSU16N == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16N), e)
-			} // end block: if x.AnonInTestStruc.AMSU16N map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16E\"")
-			} else {
-				r.EncodeString(`AMSU16E`)
-			}
-			z.EncWriteMapElemValue()
-			if x.AnonInTestStruc.AMSU16E == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.AnonInTestStruc.AMSU16E), e)
-			} // end block: if x.AnonInTestStruc.AMSU16E map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"NotAnon\"")
-			} else {
-				r.EncodeString(`NotAnon`)
-			}
-			z.EncWriteMapElemValue()
-			yy161 := &x.NotAnon
-			if yyxt162 := z.Extension(yy161); yyxt162 != nil {
-				z.EncExtension(yy161, yyxt162)
-			} else {
-				yy161.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nmap\"")
-			} else {
-				r.EncodeString(`Nmap`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Nmap == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBoolV(x.Nmap, e)
-			} // end block: if x.Nmap map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nslice\"")
-			} else {
-				r.EncodeString(`Nslice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Nslice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.Nslice))
-			} // end block: if x.Nslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nint64\"")
-			} else {
-				r.EncodeString(`Nint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn54 {
-				r.EncodeNil()
+			z.EncWriteMapStart(56)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.AnonInTestStruc.AF32slice, e)
+				} // end block: if x.AnonInTestStruc.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.AnonInTestStruc.AF64slice, e)
+				} // end block: if x.AnonInTestStruc.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AnonInTestStruc.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AnonInTestStruc.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy125 := &x.AnonInTestStruc.AI64arr0
+				h.encArray0int64((*[0]int64)(yy125), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy127 := &x.AnonInTestStruc.AI64arr8
+				h.encArray8int64((*[8]int64)(yy127), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice, e)
+				} // end block: if x.AnonInTestStruc.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice0, e)
+				} // end block: if x.AnonInTestStruc.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.AnonInTestStruc.AMSS, e)
+				} // end block: if x.AnonInTestStruc.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64, e)
+				} // end block: if x.AnonInTestStruc.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64E, e)
+				} // end block: if x.AnonInTestStruc.AMSU64E map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64N, e)
+				} // end block: if x.AnonInTestStruc.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.AnonInTestStruc.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.AnonInTestStruc.ASslice, e)
+				} // end block: if x.AnonInTestStruc.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.AnonInTestStruc.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64slice, e)
+				} // end block: if x.AnonInTestStruc.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AUi64sliceN == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64sliceN, e)
+				} // end block: if x.AnonInTestStruc.AUi64sliceN slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.Bslice, e)
+				} // end block: if x.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"By\"")
+				} else {
+					r.EncodeString(`By`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.By))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Byslice\"")
+				} else {
+					r.EncodeString(`Byslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Byslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Byslice))
+				} // end block: if x.Byslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"BytesSlice\"")
+				} else {
+					r.EncodeString(`BytesSlice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.BytesSlice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.BytesSlice, e)
+				} // end block: if x.BytesSlice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16\"")
+				} else {
+					r.EncodeString(`I16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16n\"")
+				} else {
+					r.EncodeString(`I16n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I16n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32\"")
+				} else {
+					r.EncodeString(`I32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32n\"")
+				} else {
+					r.EncodeString(`I32n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I32n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.I32slice, e)
+				} // end block: if x.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64n\"")
+				} else {
+					r.EncodeString(`I64n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64slice\"")
+				} else {
+					r.EncodeString(`I64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.I64slice, e)
+				} // end block: if x.I64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8n\"")
+				} else {
+					r.EncodeString(`I8n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+				} // end block: if x.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msbytes\"")
+				} else {
+					r.EncodeString(`Msbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msbytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.Msbytes, e)
+				} // end block: if x.Msbytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.Msint, e)
+				} // end block: if x.Msint map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"MstrUi64T\"")
+				} else {
+					r.EncodeString(`MstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.MstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.MstrUi64T), e)
+				} // end block: if x.MstrUi64T map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nint64\"")
+				} else {
+					r.EncodeString(`Nint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy161 := *x.Nint64
+					r.EncodeInt(int64(yy161))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nmap\"")
+				} else {
+					r.EncodeString(`Nmap`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Nmap == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.Nmap, e)
+				} // end block: if x.Nmap map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnon\"")
+				} else {
+					r.EncodeString(`NotAnon`)
+				}
+				z.EncWriteMapElemValue()
+				yy164 := &x.NotAnon
+				if yyxt165 := z.Extension(yy164); yyxt165 != nil {
+					z.EncExtension(yy164, yyxt165)
+				} else {
+					yy164.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnonSlim\"")
+				} else {
+					r.EncodeString(`NotAnonSlim`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn55 {
+					r.EncodeNil()
+				} else {
+					if yyxt166 := z.Extension(x.NotAnonSlim); yyxt166 != nil {
+						z.EncExtension(x.NotAnonSlim, yyxt166)
+					} else {
+						x.NotAnonSlim.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nslice\"")
+				} else {
+					r.EncodeString(`Nslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Nslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Nslice))
+				} // end block: if x.Nslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy168 := *x.AnonInTestStrucSlim.P
+					r.EncodeString(string(yy168))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Simplef\"")
+				} else {
+					r.EncodeString(`Simplef`)
+				}
+				z.EncWriteMapElemValue()
+				yy171 := &x.Simplef
+				if yyxt172 := z.Extension(yy171); yyxt172 != nil {
+					z.EncExtension(yy171, yyxt172)
+				} else {
+					yy171.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Sslice, e)
+				} // end block: if x.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"SstrUi64T\"")
+				} else {
+					r.EncodeString(`SstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.SstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+				} // end block: if x.SstrUi64T slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui16\"")
+				} else {
+					r.EncodeString(`Ui16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui32\"")
+				} else {
+					r.EncodeString(`Ui32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.Ui64slice, e)
+				} // end block: if x.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+				} // end block: if x.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt181 := z.Extension(x.WrapSliceInt64); yyxt181 != nil {
+					z.EncExtension(x.WrapSliceInt64, yyxt181)
+				} else {
+					x.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt182 := z.Extension(x.WrapSliceString); yyxt182 != nil {
+					z.EncExtension(x.WrapSliceString, yyxt182)
+				} else {
+					x.WrapSliceString.CodecEncodeSelf(e)
+				}
 			} else {
-				yy165 := *x.Nint64
-				r.EncodeInt(int64(yy165))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32\"")
+				} else {
+					r.EncodeString(`I32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16\"")
+				} else {
+					r.EncodeString(`I16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64n\"")
+				} else {
+					r.EncodeString(`I64n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I64n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32n\"")
+				} else {
+					r.EncodeString(`I32n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I32n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16n\"")
+				} else {
+					r.EncodeString(`I16n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I16n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8n\"")
+				} else {
+					r.EncodeString(`I8n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I8n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui32\"")
+				} else {
+					r.EncodeString(`Ui32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui16\"")
+				} else {
+					r.EncodeString(`Ui16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"By\"")
+				} else {
+					r.EncodeString(`By`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.By))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.Sslice, e)
+				} // end block: if x.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64slice\"")
+				} else {
+					r.EncodeString(`I64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.I64slice, e)
+				} // end block: if x.I64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.I32slice, e)
+				} // end block: if x.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.Ui64slice, e)
+				} // end block: if x.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+				} // end block: if x.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.Bslice, e)
+				} // end block: if x.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Byslice\"")
+				} else {
+					r.EncodeString(`Byslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Byslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Byslice))
+				} // end block: if x.Byslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"BytesSlice\"")
+				} else {
+					r.EncodeString(`BytesSlice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.BytesSlice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.BytesSlice, e)
+				} // end block: if x.BytesSlice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+				} // end block: if x.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt209 := z.Extension(x.WrapSliceInt64); yyxt209 != nil {
+					z.EncExtension(x.WrapSliceInt64, yyxt209)
+				} else {
+					x.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt210 := z.Extension(x.WrapSliceString); yyxt210 != nil {
+					z.EncExtension(x.WrapSliceString, yyxt210)
+				} else {
+					x.WrapSliceString.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.Msint, e)
+				} // end block: if x.Msint map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msbytes\"")
+				} else {
+					r.EncodeString(`Msbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Msbytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.Msbytes, e)
+				} // end block: if x.Msbytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Simplef\"")
+				} else {
+					r.EncodeString(`Simplef`)
+				}
+				z.EncWriteMapElemValue()
+				yy213 := &x.Simplef
+				if yyxt214 := z.Extension(yy213); yyxt214 != nil {
+					z.EncExtension(yy213, yyxt214)
+				} else {
+					yy213.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"SstrUi64T\"")
+				} else {
+					r.EncodeString(`SstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.SstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+				} // end block: if x.SstrUi64T slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"MstrUi64T\"")
+				} else {
+					r.EncodeString(`MstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.MstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.MstrUi64T), e)
+				} // end block: if x.MstrUi64T map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.AnonInTestStruc.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AnonInTestStruc.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.AnonInTestStruc.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.AnonInTestStruc.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.AnonInTestStruc.ASslice, e)
+				} // end block: if x.AnonInTestStruc.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice, e)
+				} // end block: if x.AnonInTestStruc.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64slice, e)
+				} // end block: if x.AnonInTestStruc.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.AnonInTestStruc.AF64slice, e)
+				} // end block: if x.AnonInTestStruc.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.AnonInTestStruc.AF32slice, e)
+				} // end block: if x.AnonInTestStruc.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.AnonInTestStruc.AMSS, e)
+				} // end block: if x.AnonInTestStruc.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64, e)
+				} // end block: if x.AnonInTestStruc.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy228 := &x.AnonInTestStruc.AI64arr8
+				h.encArray8int64((*[8]int64)(yy228), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy230 := &x.AnonInTestStruc.AI64arr0
+				h.encArray0int64((*[0]int64)(yy230), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.AnonInTestStruc.AI64slice0, e)
+				} // end block: if x.AnonInTestStruc.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AUi64sliceN == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.AnonInTestStruc.AUi64sliceN, e)
+				} // end block: if x.AnonInTestStruc.AUi64sliceN slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64N, e)
+				} // end block: if x.AnonInTestStruc.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.AnonInTestStruc.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.AnonInTestStruc.AMSU64E, e)
+				} // end block: if x.AnonInTestStruc.AMSU64E map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnon\"")
+				} else {
+					r.EncodeString(`NotAnon`)
+				}
+				z.EncWriteMapElemValue()
+				yy236 := &x.NotAnon
+				if yyxt237 := z.Extension(yy236); yyxt237 != nil {
+					z.EncExtension(yy236, yyxt237)
+				} else {
+					yy236.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy238 := *x.AnonInTestStrucSlim.P
+					r.EncodeString(string(yy238))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnonSlim\"")
+				} else {
+					r.EncodeString(`NotAnonSlim`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn55 {
+					r.EncodeNil()
+				} else {
+					if yyxt240 := z.Extension(x.NotAnonSlim); yyxt240 != nil {
+						z.EncExtension(x.NotAnonSlim, yyxt240)
+					} else {
+						x.NotAnonSlim.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nmap\"")
+				} else {
+					r.EncodeString(`Nmap`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Nmap == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.Nmap, e)
+				} // end block: if x.Nmap map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nslice\"")
+				} else {
+					r.EncodeString(`Nslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Nslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.Nslice))
+				} // end block: if x.Nslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nint64\"")
+				} else {
+					r.EncodeString(`Nint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy243 := *x.Nint64
+					r.EncodeInt(int64(yy243))
+				}
 			}
 			z.EncWriteMapEnd()
 		}
@@ -2242,22 +3384,13 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I64":
 			x.I64 = (int64)(r.DecodeInt64())
 		case "I32":
@@ -2294,16 +3427,16 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			z.F.DecSliceStringX(&x.Sslice, d)
 		case "I64slice":
 			z.F.DecSliceInt64X(&x.I64slice, d)
-		case "I16slice":
-			h.decSliceint16((*[]int16)(&x.I16slice), d)
+		case "I32slice":
+			z.F.DecSliceInt32X(&x.I32slice, d)
 		case "Ui64slice":
 			z.F.DecSliceUint64X(&x.Ui64slice, d)
 		case "Ui8slice":
-			x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+			x.Ui8slice = z.DecodeBytesInto(([]byte)(x.Ui8slice))
 		case "Bslice":
 			z.F.DecSliceBoolX(&x.Bslice, d)
 		case "Byslice":
-			x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
+			x.Byslice = z.DecodeBytesInto(([]byte)(x.Byslice))
 		case "BytesSlice":
 			z.F.DecSliceBytesX(&x.BytesSlice, d)
 		case "Iptrslice":
@@ -2320,8 +3453,8 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			} else {
 				x.WrapSliceString.CodecDecodeSelf(d)
 			}
-		case "Msi64":
-			z.F.DecMapStringInt64X(&x.Msi64, d)
+		case "Msint":
+			z.F.DecMapStringIntX(&x.Msint, d)
 		case "Msbytes":
 			z.F.DecMapStringBytesX(&x.Msbytes, d)
 		case "Simplef":
@@ -2335,7 +3468,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "MstrUi64T":
 			h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.MstrUi64T), d)
 		case "AS":
-			x.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
+			x.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "AI64":
 			x.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
 		case "AI16":
@@ -2351,29 +3484,62 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "AF64slice":
 			z.F.DecSliceFloat64X(&x.AnonInTestStruc.AF64slice, d)
 		case "AF32slice":
-			h.decSlicefloat32((*[]float32)(&x.AnonInTestStruc.AF32slice), d)
-		case "AMSU16":
-			h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16), d)
+			z.F.DecSliceFloat32X(&x.AnonInTestStruc.AF32slice, d)
+		case "AMSS":
+			z.F.DecMapStringStringX(&x.AnonInTestStruc.AMSS, d)
+		case "AMSU64":
+			z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64, d)
+		case "AI64arr8":
+			h.decArray8int64((*[8]int64)(&x.AnonInTestStruc.AI64arr8), d)
 		case "AI64arr0":
 			h.decArray0int64((*[0]int64)(&x.AnonInTestStruc.AI64arr0), d)
 		case "AI64slice0":
 			z.F.DecSliceInt64X(&x.AnonInTestStruc.AI64slice0, d)
 		case "AUi64sliceN":
 			z.F.DecSliceUint64X(&x.AnonInTestStruc.AUi64sliceN, d)
-		case "AMSU16N":
-			h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16N), d)
-		case "AMSU16E":
-			h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16E), d)
+		case "AMSU64N":
+			z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64N, d)
+		case "AMSU64E":
+			z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64E, d)
 		case "NotAnon":
-			if yyxt80 := z.Extension(x.NotAnon); yyxt80 != nil {
-				z.DecExtension(&x.NotAnon, yyxt80)
+			if yyxt84 := z.Extension(x.NotAnon); yyxt84 != nil {
+				z.DecExtension(&x.NotAnon, yyxt84)
 			} else {
 				x.NotAnon.CodecDecodeSelf(d)
 			}
+		case "P":
+			if r.TryNil() {
+				if x.AnonInTestStrucSlim != nil && x.AnonInTestStrucSlim.P != nil { // remove the if-true
+					x.AnonInTestStrucSlim.P = nil
+				}
+			} else {
+				if x.AnonInTestStrucSlim == nil {
+					x.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
+				}
+				if x.AnonInTestStrucSlim.P == nil {
+					x.AnonInTestStrucSlim.P = new(string)
+				}
+				*x.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+			}
+		case "NotAnonSlim":
+			if r.TryNil() {
+				if x.NotAnonSlim != nil { // remove the if-true
+					x.NotAnonSlim = nil
+				}
+			} else {
+				if x.NotAnonSlim == nil {
+					x.NotAnonSlim = new(AnonInTestStrucSlim)
+				}
+				if yyxt88 := z.Extension(x.NotAnonSlim); yyxt88 != nil {
+					z.DecExtension(x.NotAnonSlim, yyxt88)
+				} else {
+					x.NotAnonSlim.CodecDecodeSelf(d)
+				}
+			}
 		case "Nmap":
 			z.F.DecMapStringBoolX(&x.Nmap, d)
 		case "Nslice":
-			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+			x.Nslice = z.DecodeBytesInto(([]byte)(x.Nslice))
 		case "Nint64":
 			if r.TryNil() {
 				if x.Nint64 != nil { // remove the if-true
@@ -2386,7 +3552,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 				*x.Nint64 = (int64)(r.DecodeInt64())
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -2395,1542 +3561,2112 @@ func (x *TestStrucCommon) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj87 int
-	var yyb87 bool
-	var yyhl87 bool = l >= 0
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	var yyj95 int
+	var yyb95 bool
+	var yyhl95 bool = l >= 0
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I64 = (int64)(r.DecodeInt64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I64n = (int64)(r.DecodeInt64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Ui64 = (uint64)(r.DecodeUint64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F64 = (float64)(r.DecodeFloat64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F32 = (float32)(z.DecDecodeFloat32())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.Sslice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.I64slice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSliceint16((*[]int16)(&x.I16slice), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecSliceInt32X(&x.I32slice, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.Ui64slice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	x.Ui8slice = z.DecodeBytesInto(([]byte)(x.Ui8slice))
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBoolX(&x.Bslice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	x.Byslice = z.DecodeBytesInto(([]byte)(x.Byslice))
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBytesX(&x.BytesSlice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt124 := z.Extension(x.WrapSliceInt64); yyxt124 != nil {
-		z.DecExtension(&x.WrapSliceInt64, yyxt124)
+	if yyxt132 := z.Extension(x.WrapSliceInt64); yyxt132 != nil {
+		z.DecExtension(&x.WrapSliceInt64, yyxt132)
 	} else {
 		x.WrapSliceInt64.CodecDecodeSelf(d)
 	}
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt126 := z.Extension(x.WrapSliceString); yyxt126 != nil {
-		z.DecExtension(&x.WrapSliceString, yyxt126)
+	if yyxt134 := z.Extension(x.WrapSliceString); yyxt134 != nil {
+		z.DecExtension(&x.WrapSliceString, yyxt134)
 	} else {
 		x.WrapSliceString.CodecDecodeSelf(d)
 	}
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringInt64X(&x.Msi64, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecMapStringIntX(&x.Msint, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringBytesX(&x.Msbytes, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt132 := z.Extension(x.Simplef); yyxt132 != nil {
-		z.DecExtension(&x.Simplef, yyxt132)
+	if yyxt140 := z.Extension(x.Simplef); yyxt140 != nil {
+		z.DecExtension(&x.Simplef, yyxt140)
 	} else {
 		x.Simplef.CodecDecodeSelf(d)
 	}
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.MstrUi64T), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	x.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AnonInTestStruc.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.AnonInTestStruc.AUi64 = (uint64)(r.DecodeUint64())
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.AnonInTestStruc.ASslice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.AnonInTestStruc.AI64slice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.AnonInTestStruc.AUi64slice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceFloat64X(&x.AnonInTestStruc.AF64slice, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSlicefloat32((*[]float32)(&x.AnonInTestStruc.AF32slice), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecSliceFloat32X(&x.AnonInTestStruc.AF32slice, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecMapStringStringX(&x.AnonInTestStruc.AMSS, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decArray0int64((*[0]int64)(&x.AnonInTestStruc.AI64arr0), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecSliceInt64X(&x.AnonInTestStruc.AI64slice0, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	h.decArray8int64((*[8]int64)(&x.AnonInTestStruc.AI64arr8), d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecSliceUint64X(&x.AnonInTestStruc.AUi64sliceN, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	h.decArray0int64((*[0]int64)(&x.AnonInTestStruc.AI64arr0), d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16N), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecSliceInt64X(&x.AnonInTestStruc.AI64slice0, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.AnonInTestStruc.AMSU16E), d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecSliceUint64X(&x.AnonInTestStruc.AUi64sliceN, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt164 := z.Extension(x.NotAnon); yyxt164 != nil {
-		z.DecExtension(&x.NotAnon, yyxt164)
-	} else {
-		x.NotAnon.CodecDecodeSelf(d)
-	}
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64N, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringBoolX(&x.Nmap, d)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
-	} else {
-		yyb87 = z.DecCheckBreak()
-	}
-	if yyb87 {
+	z.F.DecMapStringUint64X(&x.AnonInTestStruc.AMSU64E, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
-	yyj87++
-	if yyhl87 {
-		yyb87 = yyj87 > l
+	if yyxt176 := z.Extension(x.NotAnon); yyxt176 != nil {
+		z.DecExtension(&x.NotAnon, yyxt176)
 	} else {
-		yyb87 = z.DecCheckBreak()
+		x.NotAnon.CodecDecodeSelf(d)
 	}
-	if yyb87 {
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	if r.TryNil() {
-		if x.Nint64 != nil { // remove the if-true
-			x.Nint64 = nil
-		}
-	} else {
-		if x.Nint64 == nil {
-			x.Nint64 = new(int64)
-		}
-		*x.Nint64 = (int64)(r.DecodeInt64())
-	}
-	for {
-		yyj87++
-		if yyhl87 {
-			yyb87 = yyj87 > l
-		} else {
-			yyb87 = z.DecCheckBreak()
+		if x.AnonInTestStrucSlim != nil && x.AnonInTestStrucSlim.P != nil { // remove the if-true
+			x.AnonInTestStrucSlim.P = nil
 		}
-		if yyb87 {
-			break
+	} else {
+		if x.AnonInTestStrucSlim == nil {
+			x.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
 		}
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj87-1, "")
+		if x.AnonInTestStrucSlim.P == nil {
+			x.AnonInTestStrucSlim.P = new(string)
+		}
+		*x.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	}
-}
-
-func (x *TestStrucCommon) IsCodecEmpty() bool {
-	return !(x.S != "" || x.I64 != 0 || x.I32 != 0 || x.I16 != 0 || x.I8 != 0 || x.I64n != 0 || x.I32n != 0 || x.I16n != 0 || x.I8n != 0 || x.Ui64 != 0 || x.Ui32 != 0 || x.Ui16 != 0 || x.Ui8 != 0 || x.F64 != 0 || x.F32 != 0 || bool(x.B) || x.By != 0 || len(x.Sslice) != 0 || len(x.I64slice) != 0 || len(x.I16slice) != 0 || len(x.Ui64slice) != 0 || len(x.Ui8slice) != 0 || len(x.Bslice) != 0 || len(x.Byslice) != 0 || len(x.BytesSlice) != 0 || len(x.Iptrslice) != 0 || len(x.WrapSliceInt64) != 0 || len(x.WrapSliceString) != 0 || len(x.Msi64) != 0 || len(x.Msbytes) != 0 || !(x.Simplef.IsCodecEmpty()) || len(x.SstrUi64T) != 0 || len(x.MstrUi64T) != 0 || !(x.AnonInTestStruc.IsCodecEmpty()) || !(x.NotAnon.IsCodecEmpty()) || len(x.Nmap) != 0 || len(x.Nslice) != 0 || false)
-}
-
-func (x *TestStruc) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		var yyn54 bool = x.TestStrucCommon.Nint64 == nil
-		var yyn58 bool = x.Nteststruc == nil
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(56)
-			z.EncWriteArrayElem()
-			r.EncodeString(string(x.TestStrucCommon.S))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I64))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I32))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I16))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I8))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I64n))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I32n))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I16n))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.I8n))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
-			z.EncWriteArrayElem()
-			r.EncodeFloat64(float64(x.TestStrucCommon.F64))
-			z.EncWriteArrayElem()
-			r.EncodeFloat32(float32(x.TestStrucCommon.F32))
-			z.EncWriteArrayElem()
-			r.EncodeBool(bool(x.TestStrucCommon.B))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.By))
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Sslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
-			} // end block: if x.TestStrucCommon.Sslice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.I64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
-			} // end block: if x.TestStrucCommon.I64slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.I16slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSliceint16(([]int16)(x.TestStrucCommon.I16slice), e)
-			} // end block: if x.TestStrucCommon.I16slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Ui64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
-			} // end block: if x.TestStrucCommon.Ui64slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Ui8slice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
-			} // end block: if x.TestStrucCommon.Ui8slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Bslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
-			} // end block: if x.TestStrucCommon.Bslice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Byslice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
-			} // end block: if x.TestStrucCommon.Byslice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.BytesSlice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
-			} // end block: if x.TestStrucCommon.BytesSlice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Iptrslice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
-			} // end block: if x.TestStrucCommon.Iptrslice slice == nil
-			z.EncWriteArrayElem()
-			if yyxt85 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt85 != nil {
-				z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt85)
-			} else {
-				x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
-			}
-			z.EncWriteArrayElem()
-			if yyxt86 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt86 != nil {
-				z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt86)
-			} else {
-				x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
-			}
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Msi64 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringInt64V(x.TestStrucCommon.Msi64, e)
-			} // end block: if x.TestStrucCommon.Msi64 map == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Msbytes == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
-			} // end block: if x.TestStrucCommon.Msbytes map == nil
-			z.EncWriteArrayElem()
-			yy89 := &x.TestStrucCommon.Simplef
-			if yyxt90 := z.Extension(yy89); yyxt90 != nil {
-				z.EncExtension(yy89, yyxt90)
-			} else {
-				yy89.CodecEncodeSelf(e)
-			}
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.SstrUi64T == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
-			} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.MstrUi64T == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
-			} // end block: if x.TestStrucCommon.MstrUi64T map == nil
-			z.EncWriteArrayElem()
-			r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
-			z.EncWriteArrayElem()
-			r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
-			z.EncWriteArrayElem()
-			r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicefloat32(([]float32)(x.TestStrucCommon.AnonInTestStruc.AF32slice), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16 == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16 map == nil
-			z.EncWriteArrayElem()
-			yy103 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
-			h.encArray0int64((*[0]int64)(yy103), e)
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16N == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16N), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16N map == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16E == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16E), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16E map == nil
-			z.EncWriteArrayElem()
-			yy109 := &x.TestStrucCommon.NotAnon
-			if yyxt110 := z.Extension(yy109); yyxt110 != nil {
-				z.EncExtension(yy109, yyxt110)
-			} else {
-				yy109.CodecEncodeSelf(e)
-			}
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Nmap == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
-			} // end block: if x.TestStrucCommon.Nmap map == nil
-			z.EncWriteArrayElem()
-			if x.TestStrucCommon.Nslice == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
-			} // end block: if x.TestStrucCommon.Nslice slice == nil
-			if yyn54 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				yy113 := *x.TestStrucCommon.Nint64
-				r.EncodeInt(int64(yy113))
-			}
-			z.EncWriteArrayElem()
-			if x.Mtsptr == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
-			} // end block: if x.Mtsptr map == nil
-			z.EncWriteArrayElem()
-			if x.Mts == nil {
-				r.EncodeNil()
-			} else {
-				h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
-			} // end block: if x.Mts map == nil
-			z.EncWriteArrayElem()
-			if x.Its == nil {
-				r.EncodeNil()
-			} else {
-				h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
-			} // end block: if x.Its slice == nil
-			if yyn58 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyxt118 := z.Extension(x.Nteststruc); yyxt118 != nil {
-					z.EncExtension(x.Nteststruc, yyxt118)
-				} else {
-					x.Nteststruc.CodecEncodeSelf(e)
-				}
-			}
-			z.EncWriteArrayEnd()
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.NotAnonSlim != nil { // remove the if-true
+			x.NotAnonSlim = nil
+		}
+	} else {
+		if x.NotAnonSlim == nil {
+			x.NotAnonSlim = new(AnonInTestStrucSlim)
+		}
+		if yyxt180 := z.Extension(x.NotAnonSlim); yyxt180 != nil {
+			z.DecExtension(x.NotAnonSlim, yyxt180)
 		} else {
-			z.EncWriteMapStart(56)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
+			x.NotAnonSlim.CodecDecodeSelf(d)
+		}
+	}
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecMapStringBoolX(&x.Nmap, d)
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Nslice = z.DecodeBytesInto(([]byte)(x.Nslice))
+	yyj95++
+	yyb95 = !z.DecContainerNext(yyj95, l, yyhl95)
+	if yyb95 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.Nint64 != nil { // remove the if-true
+			x.Nint64 = nil
+		}
+	} else {
+		if x.Nint64 == nil {
+			x.Nint64 = new(int64)
+		}
+		*x.Nint64 = (int64)(r.DecodeInt64())
+	}
+	yyj95++
+	for ; z.DecContainerNext(yyj95, l, yyhl95); yyj95++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj95-1, "")
+	}
+}
+
+func (x *TestStrucCommon) IsCodecEmpty() bool {
+	return !(x.S != "" || x.I64 != 0 || x.I32 != 0 || x.I16 != 0 || x.I8 != 0 || x.I64n != 0 || x.I32n != 0 || x.I16n != 0 || x.I8n != 0 || x.Ui64 != 0 || x.Ui32 != 0 || x.Ui16 != 0 || x.Ui8 != 0 || x.F64 != 0 || x.F32 != 0 || bool(x.B) || x.By != 0 || len(x.Sslice) != 0 || len(x.I64slice) != 0 || len(x.I32slice) != 0 || len(x.Ui64slice) != 0 || len(x.Ui8slice) != 0 || len(x.Bslice) != 0 || len(x.Byslice) != 0 || len(x.BytesSlice) != 0 || len(x.Iptrslice) != 0 || len(x.WrapSliceInt64) != 0 || len(x.WrapSliceString) != 0 || len(x.Msint) != 0 || len(x.Msbytes) != 0 || !(x.Simplef.IsCodecEmpty()) || len(x.SstrUi64T) != 0 || len(x.MstrUi64T) != 0 || !(x.AnonInTestStruc.IsCodecEmpty()) || !(x.NotAnon.IsCodecEmpty()) || x.AnonInTestStrucSlim != nil && len(x.Nmap) != 0 || len(x.Nslice) != 0 || false)
+}
+
+func (TestStruc) codecSelferViaCodecgen() {}
+func (x *TestStruc) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		yy2arr2 := z.EncBasicHandle().StructToArray
+		_ = yy2arr2
+		const yyr2 bool = false // struct tag has 'toArray'
+		var yyn54 bool = x.TestStrucCommon.AnonInTestStrucSlim == nil || x.TestStrucCommon.AnonInTestStrucSlim.P == nil
+		var yyn55 bool = x.TestStrucCommon.NotAnonSlim == nil
+		var yyn58 bool = x.TestStrucCommon.Nint64 == nil
+		var yyn62 bool = x.Nteststruc == nil
+		if yyr2 || yy2arr2 {
+			z.EncWriteArrayStart(60)
+			z.EncWriteArrayElem()
 			r.EncodeString(string(x.TestStrucCommon.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64\"")
-			} else {
-				r.EncodeString(`I64`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I32\"")
-			} else {
-				r.EncodeString(`I32`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16\"")
-			} else {
-				r.EncodeString(`I16`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I8\"")
-			} else {
-				r.EncodeString(`I8`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64n\"")
-			} else {
-				r.EncodeString(`I64n`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I64n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I32n\"")
-			} else {
-				r.EncodeString(`I32n`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I32n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16n\"")
-			} else {
-				r.EncodeString(`I16n`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I16n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I8n\"")
-			} else {
-				r.EncodeString(`I8n`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.I8n))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64\"")
-			} else {
-				r.EncodeString(`Ui64`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui32\"")
-			} else {
-				r.EncodeString(`Ui32`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui16\"")
-			} else {
-				r.EncodeString(`Ui16`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8\"")
-			} else {
-				r.EncodeString(`Ui8`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F64\"")
-			} else {
-				r.EncodeString(`F64`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeFloat64(float64(x.TestStrucCommon.F64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F32\"")
-			} else {
-				r.EncodeString(`F32`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeFloat32(float32(x.TestStrucCommon.F32))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeBool(bool(x.TestStrucCommon.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"By\"")
-			} else {
-				r.EncodeString(`By`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.By))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Sslice\"")
-			} else {
-				r.EncodeString(`Sslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Sslice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
 			} // end block: if x.TestStrucCommon.Sslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I64slice\"")
-			} else {
-				r.EncodeString(`I64slice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.I64slice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
 			} // end block: if x.TestStrucCommon.I64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I16slice\"")
-			} else {
-				r.EncodeString(`I16slice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.TestStrucCommon.I16slice == nil {
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.I32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSliceint16(([]int16)(x.TestStrucCommon.I16slice), e)
-			} // end block: if x.TestStrucCommon.I16slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui64slice\"")
-			} else {
-				r.EncodeString(`Ui64slice`)
-			}
-			z.EncWriteMapElemValue()
+				z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+			} // end block: if x.TestStrucCommon.I32slice slice == nil
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Ui64slice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
 			} // end block: if x.TestStrucCommon.Ui64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ui8slice\"")
-			} else {
-				r.EncodeString(`Ui8slice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Ui8slice == nil {
 				r.EncodeNil()
 			} else {
 				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
 			} // end block: if x.TestStrucCommon.Ui8slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Bslice\"")
-			} else {
-				r.EncodeString(`Bslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Bslice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
 			} // end block: if x.TestStrucCommon.Bslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Byslice\"")
-			} else {
-				r.EncodeString(`Byslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Byslice == nil {
 				r.EncodeNil()
 			} else {
 				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
 			} // end block: if x.TestStrucCommon.Byslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"BytesSlice\"")
-			} else {
-				r.EncodeString(`BytesSlice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.BytesSlice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
 			} // end block: if x.TestStrucCommon.BytesSlice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Iptrslice\"")
-			} else {
-				r.EncodeString(`Iptrslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Iptrslice == nil {
 				r.EncodeNil()
 			} else {
 				h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
 			} // end block: if x.TestStrucCommon.Iptrslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceInt64\"")
-			} else {
-				r.EncodeString(`WrapSliceInt64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt145 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt145 != nil {
-				z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt145)
+			z.EncWriteArrayElem()
+			if yyxt89 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt89 != nil {
+				z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt89)
 			} else {
 				x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
 			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"WrapSliceString\"")
-			} else {
-				r.EncodeString(`WrapSliceString`)
-			}
-			z.EncWriteMapElemValue()
-			if yyxt146 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt146 != nil {
-				z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt146)
+			z.EncWriteArrayElem()
+			if yyxt90 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt90 != nil {
+				z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt90)
 			} else {
 				x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
 			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Msi64\"")
-			} else {
-				r.EncodeString(`Msi64`)
-			}
-			z.EncWriteMapElemValue()
-			if x.TestStrucCommon.Msi64 == nil {
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.Msint == nil {
 				r.EncodeNil()
 			} else {
-				z.F.EncMapStringInt64V(x.TestStrucCommon.Msi64, e)
-			} // end block: if x.TestStrucCommon.Msi64 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Msbytes\"")
-			} else {
-				r.EncodeString(`Msbytes`)
-			}
-			z.EncWriteMapElemValue()
+				z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+			} // end block: if x.TestStrucCommon.Msint map == nil
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Msbytes == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
 			} // end block: if x.TestStrucCommon.Msbytes map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Simplef\"")
-			} else {
-				r.EncodeString(`Simplef`)
-			}
-			z.EncWriteMapElemValue()
-			yy149 := &x.TestStrucCommon.Simplef
-			if yyxt150 := z.Extension(yy149); yyxt150 != nil {
-				z.EncExtension(yy149, yyxt150)
-			} else {
-				yy149.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"SstrUi64T\"")
+			z.EncWriteArrayElem()
+			yy93 := &x.TestStrucCommon.Simplef
+			if yyxt94 := z.Extension(yy93); yyxt94 != nil {
+				z.EncExtension(yy93, yyxt94)
 			} else {
-				r.EncodeString(`SstrUi64T`)
+				yy93.CodecEncodeSelf(e)
 			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.SstrUi64T == nil {
 				r.EncodeNil()
 			} else {
 				h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
 			} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"MstrUi64T\"")
-			} else {
-				r.EncodeString(`MstrUi64T`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.MstrUi64T == nil {
 				r.EncodeNil()
 			} else {
 				h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
 			} // end block: if x.TestStrucCommon.MstrUi64T map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AS\"")
-			} else {
-				r.EncodeString(`AS`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64\"")
-			} else {
-				r.EncodeString(`AI64`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI16\"")
-			} else {
-				r.EncodeString(`AI16`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64\"")
-			} else {
-				r.EncodeString(`AUi64`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"ASslice\"")
-			} else {
-				r.EncodeString(`ASslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice\"")
-			} else {
-				r.EncodeString(`AI64slice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64slice\"")
-			} else {
-				r.EncodeString(`AUi64slice`)
-			}
-			z.EncWriteMapElemValue()
+			} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF64slice\"")
-			} else {
-				r.EncodeString(`AF64slice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AF32slice\"")
-			} else {
-				r.EncodeString(`AF32slice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
 				r.EncodeNil()
 			} else {
-				h.encSlicefloat32(([]float32)(x.TestStrucCommon.AnonInTestStruc.AF32slice), e)
+				z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16\"")
-			} else {
-				r.EncodeString(`AMSU16`)
-			}
-			z.EncWriteMapElemValue()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16 == nil {
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16 map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64arr0\"")
-			} else {
-				r.EncodeString(`AI64arr0`)
-			}
-			z.EncWriteMapElemValue()
-			yy163 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
-			h.encArray0int64((*[0]int64)(yy163), e)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AI64slice0\"")
+				z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+				r.EncodeNil()
 			} else {
-				r.EncodeString(`AI64slice0`)
-			}
-			z.EncWriteMapElemValue()
+				z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
+			z.EncWriteArrayElem()
+			yy108 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+			h.encArray8int64((*[8]int64)(yy108), e)
+			z.EncWriteArrayElem()
+			yy110 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+			h.encArray0int64((*[0]int64)(yy110), e)
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AUi64sliceN\"")
-			} else {
-				r.EncodeString(`AUi64sliceN`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
 			} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16N\"")
-			} else {
-				r.EncodeString(`AMSU16N`)
-			}
-			z.EncWriteMapElemValue()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16N == nil {
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16N), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16N map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AMSU16E\"")
-			} else {
-				r.EncodeString(`AMSU16E`)
-			}
-			z.EncWriteMapElemValue()
-			if x.TestStrucCommon.AnonInTestStruc.AMSU16E == nil {
+				z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
+			z.EncWriteArrayElem()
+			if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
 				r.EncodeNil()
 			} else {
-				h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16E), e)
-			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16E map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"NotAnon\"")
+				z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+			} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
+			z.EncWriteArrayElem()
+			yy116 := &x.TestStrucCommon.NotAnon
+			if yyxt117 := z.Extension(yy116); yyxt117 != nil {
+				z.EncExtension(yy116, yyxt117)
 			} else {
-				r.EncodeString(`NotAnon`)
+				yy116.CodecEncodeSelf(e)
 			}
-			z.EncWriteMapElemValue()
-			yy169 := &x.TestStrucCommon.NotAnon
-			if yyxt170 := z.Extension(yy169); yyxt170 != nil {
-				z.EncExtension(yy169, yyxt170)
+			if yyn54 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
 			} else {
-				yy169.CodecEncodeSelf(e)
+				z.EncWriteArrayElem()
+				yy118 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+				r.EncodeString(string(yy118))
 			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nmap\"")
+			if yyn55 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
 			} else {
-				r.EncodeString(`Nmap`)
+				z.EncWriteArrayElem()
+				if yyxt120 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt120 != nil {
+					z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt120)
+				} else {
+					x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+				}
 			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Nmap == nil {
 				r.EncodeNil()
 			} else {
 				z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
 			} // end block: if x.TestStrucCommon.Nmap map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nslice\"")
-			} else {
-				r.EncodeString(`Nslice`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.TestStrucCommon.Nslice == nil {
 				r.EncodeNil()
 			} else {
 				r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
 			} // end block: if x.TestStrucCommon.Nslice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nint64\"")
-			} else {
-				r.EncodeString(`Nint64`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn54 {
+			if yyn58 {
+				z.EncWriteArrayElem()
 				r.EncodeNil()
 			} else {
-				yy173 := *x.TestStrucCommon.Nint64
-				r.EncodeInt(int64(yy173))
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Mtsptr\"")
-			} else {
-				r.EncodeString(`Mtsptr`)
+				z.EncWriteArrayElem()
+				yy123 := *x.TestStrucCommon.Nint64
+				r.EncodeInt(int64(yy123))
 			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.Mtsptr == nil {
 				r.EncodeNil()
 			} else {
 				h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
 			} // end block: if x.Mtsptr map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Mts\"")
-			} else {
-				r.EncodeString(`Mts`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.Mts == nil {
 				r.EncodeNil()
 			} else {
 				h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
 			} // end block: if x.Mts map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Its\"")
-			} else {
-				r.EncodeString(`Its`)
-			}
-			z.EncWriteMapElemValue()
+			z.EncWriteArrayElem()
 			if x.Its == nil {
 				r.EncodeNil()
 			} else {
 				h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
 			} // end block: if x.Its slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nteststruc\"")
+			if yyn62 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
 			} else {
-				r.EncodeString(`Nteststruc`)
+				z.EncWriteArrayElem()
+				if yyxt128 := z.Extension(x.Nteststruc); yyxt128 != nil {
+					z.EncExtension(x.Nteststruc, yyxt128)
+				} else {
+					x.Nteststruc.CodecEncodeSelf(e)
+				}
 			}
-			z.EncWriteMapElemValue()
-			if yyn58 {
-				r.EncodeNil()
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(60)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy133 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+				h.encArray0int64((*[0]int64)(yy133), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy135 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+				h.encArray8int64((*[8]int64)(yy135), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.TestStrucCommon.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
+				} // end block: if x.TestStrucCommon.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"By\"")
+				} else {
+					r.EncodeString(`By`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.By))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Byslice\"")
+				} else {
+					r.EncodeString(`Byslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Byslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
+				} // end block: if x.TestStrucCommon.Byslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"BytesSlice\"")
+				} else {
+					r.EncodeString(`BytesSlice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.BytesSlice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
+				} // end block: if x.TestStrucCommon.BytesSlice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.TestStrucCommon.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.TestStrucCommon.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16\"")
+				} else {
+					r.EncodeString(`I16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16n\"")
+				} else {
+					r.EncodeString(`I16n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I16n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32\"")
+				} else {
+					r.EncodeString(`I32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32n\"")
+				} else {
+					r.EncodeString(`I32n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I32n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+				} // end block: if x.TestStrucCommon.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64n\"")
+				} else {
+					r.EncodeString(`I64n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I64n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64slice\"")
+				} else {
+					r.EncodeString(`I64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.I64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
+				} // end block: if x.TestStrucCommon.I64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8n\"")
+				} else {
+					r.EncodeString(`I8n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I8n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
+				} // end block: if x.TestStrucCommon.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Its\"")
+				} else {
+					r.EncodeString(`Its`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Its == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+				} // end block: if x.Its slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msbytes\"")
+				} else {
+					r.EncodeString(`Msbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Msbytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
+				} // end block: if x.TestStrucCommon.Msbytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+				} // end block: if x.TestStrucCommon.Msint map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"MstrUi64T\"")
+				} else {
+					r.EncodeString(`MstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.MstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
+				} // end block: if x.TestStrucCommon.MstrUi64T map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Mts\"")
+				} else {
+					r.EncodeString(`Mts`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Mts == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+				} // end block: if x.Mts map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Mtsptr\"")
+				} else {
+					r.EncodeString(`Mtsptr`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Mtsptr == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
+				} // end block: if x.Mtsptr map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nint64\"")
+				} else {
+					r.EncodeString(`Nint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy172 := *x.TestStrucCommon.Nint64
+					r.EncodeInt(int64(yy172))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nmap\"")
+				} else {
+					r.EncodeString(`Nmap`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Nmap == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
+				} // end block: if x.TestStrucCommon.Nmap map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnon\"")
+				} else {
+					r.EncodeString(`NotAnon`)
+				}
+				z.EncWriteMapElemValue()
+				yy175 := &x.TestStrucCommon.NotAnon
+				if yyxt176 := z.Extension(yy175); yyxt176 != nil {
+					z.EncExtension(yy175, yyxt176)
+				} else {
+					yy175.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnonSlim\"")
+				} else {
+					r.EncodeString(`NotAnonSlim`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn55 {
+					r.EncodeNil()
+				} else {
+					if yyxt177 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt177 != nil {
+						z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt177)
+					} else {
+						x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nslice\"")
+				} else {
+					r.EncodeString(`Nslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Nslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
+				} // end block: if x.TestStrucCommon.Nslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nteststruc\"")
+				} else {
+					r.EncodeString(`Nteststruc`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn62 {
+					r.EncodeNil()
+				} else {
+					if yyxt179 := z.Extension(x.Nteststruc); yyxt179 != nil {
+						z.EncExtension(x.Nteststruc, yyxt179)
+					} else {
+						x.Nteststruc.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy180 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+					r.EncodeString(string(yy180))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.TestStrucCommon.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Simplef\"")
+				} else {
+					r.EncodeString(`Simplef`)
+				}
+				z.EncWriteMapElemValue()
+				yy183 := &x.TestStrucCommon.Simplef
+				if yyxt184 := z.Extension(yy183); yyxt184 != nil {
+					z.EncExtension(yy183, yyxt184)
+				} else {
+					yy183.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
+				} // end block: if x.TestStrucCommon.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"SstrUi64T\"")
+				} else {
+					r.EncodeString(`SstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.SstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
+				} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui16\"")
+				} else {
+					r.EncodeString(`Ui16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui32\"")
+				} else {
+					r.EncodeString(`Ui32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
+				} // end block: if x.TestStrucCommon.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
+				} // end block: if x.TestStrucCommon.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt193 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt193 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt193)
+				} else {
+					x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt194 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt194 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt194)
+				} else {
+					x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+				}
 			} else {
-				if yyxt178 := z.Extension(x.Nteststruc); yyxt178 != nil {
-					z.EncExtension(x.Nteststruc, yyxt178)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.TestStrucCommon.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64\"")
+				} else {
+					r.EncodeString(`I64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32\"")
+				} else {
+					r.EncodeString(`I32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16\"")
+				} else {
+					r.EncodeString(`I16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8\"")
+				} else {
+					r.EncodeString(`I8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64n\"")
+				} else {
+					r.EncodeString(`I64n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I64n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32n\"")
+				} else {
+					r.EncodeString(`I32n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I32n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I16n\"")
+				} else {
+					r.EncodeString(`I16n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I16n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I8n\"")
+				} else {
+					r.EncodeString(`I8n`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.I8n))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64\"")
+				} else {
+					r.EncodeString(`Ui64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui32\"")
+				} else {
+					r.EncodeString(`Ui32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui16\"")
+				} else {
+					r.EncodeString(`Ui16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8\"")
+				} else {
+					r.EncodeString(`Ui8`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F64\"")
+				} else {
+					r.EncodeString(`F64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.TestStrucCommon.F64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F32\"")
+				} else {
+					r.EncodeString(`F32`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat32(float32(x.TestStrucCommon.F32))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.TestStrucCommon.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"By\"")
+				} else {
+					r.EncodeString(`By`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.By))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sslice\"")
+				} else {
+					r.EncodeString(`Sslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
+				} // end block: if x.TestStrucCommon.Sslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I64slice\"")
+				} else {
+					r.EncodeString(`I64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.I64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
+				} // end block: if x.TestStrucCommon.I64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I32slice\"")
+				} else {
+					r.EncodeString(`I32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+				} // end block: if x.TestStrucCommon.I32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui64slice\"")
+				} else {
+					r.EncodeString(`Ui64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
+				} // end block: if x.TestStrucCommon.Ui64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ui8slice\"")
+				} else {
+					r.EncodeString(`Ui8slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
+				} // end block: if x.TestStrucCommon.Ui8slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Bslice\"")
+				} else {
+					r.EncodeString(`Bslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
+				} // end block: if x.TestStrucCommon.Bslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Byslice\"")
+				} else {
+					r.EncodeString(`Byslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Byslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
+				} // end block: if x.TestStrucCommon.Byslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"BytesSlice\"")
+				} else {
+					r.EncodeString(`BytesSlice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.BytesSlice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
+				} // end block: if x.TestStrucCommon.BytesSlice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Iptrslice\"")
+				} else {
+					r.EncodeString(`Iptrslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
+				} // end block: if x.TestStrucCommon.Iptrslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceInt64\"")
+				} else {
+					r.EncodeString(`WrapSliceInt64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt221 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt221 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt221)
+				} else {
+					x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"WrapSliceString\"")
+				} else {
+					r.EncodeString(`WrapSliceString`)
+				}
+				z.EncWriteMapElemValue()
+				if yyxt222 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt222 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt222)
+				} else {
+					x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msint\"")
+				} else {
+					r.EncodeString(`Msint`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+				} // end block: if x.TestStrucCommon.Msint map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Msbytes\"")
+				} else {
+					r.EncodeString(`Msbytes`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Msbytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
+				} // end block: if x.TestStrucCommon.Msbytes map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Simplef\"")
+				} else {
+					r.EncodeString(`Simplef`)
+				}
+				z.EncWriteMapElemValue()
+				yy225 := &x.TestStrucCommon.Simplef
+				if yyxt226 := z.Extension(yy225); yyxt226 != nil {
+					z.EncExtension(yy225, yyxt226)
+				} else {
+					yy225.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"SstrUi64T\"")
+				} else {
+					r.EncodeString(`SstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.SstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
+				} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"MstrUi64T\"")
+				} else {
+					r.EncodeString(`MstrUi64T`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.MstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
+				} // end block: if x.TestStrucCommon.MstrUi64T map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AS\"")
+				} else {
+					r.EncodeString(`AS`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64\"")
+				} else {
+					r.EncodeString(`AI64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI16\"")
+				} else {
+					r.EncodeString(`AI16`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64\"")
+				} else {
+					r.EncodeString(`AUi64`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ASslice\"")
+				} else {
+					r.EncodeString(`ASslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice\"")
+				} else {
+					r.EncodeString(`AI64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64slice\"")
+				} else {
+					r.EncodeString(`AUi64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF64slice\"")
+				} else {
+					r.EncodeString(`AF64slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AF32slice\"")
+				} else {
+					r.EncodeString(`AF32slice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSS\"")
+				} else {
+					r.EncodeString(`AMSS`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64\"")
+				} else {
+					r.EncodeString(`AMSU64`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr8\"")
+				} else {
+					r.EncodeString(`AI64arr8`)
+				}
+				z.EncWriteMapElemValue()
+				yy240 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+				h.encArray8int64((*[8]int64)(yy240), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64arr0\"")
+				} else {
+					r.EncodeString(`AI64arr0`)
+				}
+				z.EncWriteMapElemValue()
+				yy242 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+				h.encArray0int64((*[0]int64)(yy242), e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AI64slice0\"")
+				} else {
+					r.EncodeString(`AI64slice0`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AUi64sliceN\"")
+				} else {
+					r.EncodeString(`AUi64sliceN`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
+					r.EncodeNil()
 				} else {
-					x.Nteststruc.CodecEncodeSelf(e)
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64N\"")
+				} else {
+					r.EncodeString(`AMSU64N`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AMSU64E\"")
+				} else {
+					r.EncodeString(`AMSU64E`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnon\"")
+				} else {
+					r.EncodeString(`NotAnon`)
+				}
+				z.EncWriteMapElemValue()
+				yy248 := &x.TestStrucCommon.NotAnon
+				if yyxt249 := z.Extension(yy248); yyxt249 != nil {
+					z.EncExtension(yy248, yyxt249)
+				} else {
+					yy248.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"P\"")
+				} else {
+					r.EncodeString(`P`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn54 {
+					r.EncodeNil()
+				} else {
+					yy250 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+					r.EncodeString(string(yy250))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"NotAnonSlim\"")
+				} else {
+					r.EncodeString(`NotAnonSlim`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn55 {
+					r.EncodeNil()
+				} else {
+					if yyxt252 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt252 != nil {
+						z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt252)
+					} else {
+						x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nmap\"")
+				} else {
+					r.EncodeString(`Nmap`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Nmap == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
+				} // end block: if x.TestStrucCommon.Nmap map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nslice\"")
+				} else {
+					r.EncodeString(`Nslice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.TestStrucCommon.Nslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
+				} // end block: if x.TestStrucCommon.Nslice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nint64\"")
+				} else {
+					r.EncodeString(`Nint64`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn58 {
+					r.EncodeNil()
+				} else {
+					yy255 := *x.TestStrucCommon.Nint64
+					r.EncodeInt(int64(yy255))
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Mtsptr\"")
+				} else {
+					r.EncodeString(`Mtsptr`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Mtsptr == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
+				} // end block: if x.Mtsptr map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Mts\"")
+				} else {
+					r.EncodeString(`Mts`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Mts == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+				} // end block: if x.Mts map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Its\"")
+				} else {
+					r.EncodeString(`Its`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Its == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+				} // end block: if x.Its slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nteststruc\"")
+				} else {
+					r.EncodeString(`Nteststruc`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn62 {
+					r.EncodeNil()
+				} else {
+					if yyxt260 := z.Extension(x.Nteststruc); yyxt260 != nil {
+						z.EncExtension(x.Nteststruc, yyxt260)
+					} else {
+						x.Nteststruc.CodecEncodeSelf(e)
+					}
 				}
 			}
 			z.EncWriteMapEnd()
@@ -3968,22 +5704,13 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.TestStrucCommon.S = (string)(string(r.DecodeStringAsBytes()))
+			x.TestStrucCommon.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I64":
 			x.TestStrucCommon.I64 = (int64)(r.DecodeInt64())
 		case "I32":
@@ -4020,16 +5747,16 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 			z.F.DecSliceStringX(&x.TestStrucCommon.Sslice, d)
 		case "I64slice":
 			z.F.DecSliceInt64X(&x.TestStrucCommon.I64slice, d)
-		case "I16slice":
-			h.decSliceint16((*[]int16)(&x.TestStrucCommon.I16slice), d)
+		case "I32slice":
+			z.F.DecSliceInt32X(&x.TestStrucCommon.I32slice, d)
 		case "Ui64slice":
 			z.F.DecSliceUint64X(&x.TestStrucCommon.Ui64slice, d)
 		case "Ui8slice":
-			x.TestStrucCommon.Ui8slice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Ui8slice), false)
+			x.TestStrucCommon.Ui8slice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Ui8slice))
 		case "Bslice":
 			z.F.DecSliceBoolX(&x.TestStrucCommon.Bslice, d)
 		case "Byslice":
-			x.TestStrucCommon.Byslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Byslice), false)
+			x.TestStrucCommon.Byslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Byslice))
 		case "BytesSlice":
 			z.F.DecSliceBytesX(&x.TestStrucCommon.BytesSlice, d)
 		case "Iptrslice":
@@ -4046,8 +5773,8 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 			} else {
 				x.TestStrucCommon.WrapSliceString.CodecDecodeSelf(d)
 			}
-		case "Msi64":
-			z.F.DecMapStringInt64X(&x.TestStrucCommon.Msi64, d)
+		case "Msint":
+			z.F.DecMapStringIntX(&x.TestStrucCommon.Msint, d)
 		case "Msbytes":
 			z.F.DecMapStringBytesX(&x.TestStrucCommon.Msbytes, d)
 		case "Simplef":
@@ -4061,7 +5788,7 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "MstrUi64T":
 			h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.TestStrucCommon.MstrUi64T), d)
 		case "AS":
-			x.TestStrucCommon.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
+			x.TestStrucCommon.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "AI64":
 			x.TestStrucCommon.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
 		case "AI16":
@@ -4077,29 +5804,62 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "AF64slice":
 			z.F.DecSliceFloat64X(&x.TestStrucCommon.AnonInTestStruc.AF64slice, d)
 		case "AF32slice":
-			h.decSlicefloat32((*[]float32)(&x.TestStrucCommon.AnonInTestStruc.AF32slice), d)
-		case "AMSU16":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16), d)
+			z.F.DecSliceFloat32X(&x.TestStrucCommon.AnonInTestStruc.AF32slice, d)
+		case "AMSS":
+			z.F.DecMapStringStringX(&x.TestStrucCommon.AnonInTestStruc.AMSS, d)
+		case "AMSU64":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64, d)
+		case "AI64arr8":
+			h.decArray8int64((*[8]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr8), d)
 		case "AI64arr0":
 			h.decArray0int64((*[0]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr0), d)
 		case "AI64slice0":
 			z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice0, d)
 		case "AUi64sliceN":
 			z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, d)
-		case "AMSU16N":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16N), d)
-		case "AMSU16E":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16E), d)
+		case "AMSU64N":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64N, d)
+		case "AMSU64E":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64E, d)
 		case "NotAnon":
-			if yyxt80 := z.Extension(x.TestStrucCommon.NotAnon); yyxt80 != nil {
-				z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt80)
+			if yyxt84 := z.Extension(x.TestStrucCommon.NotAnon); yyxt84 != nil {
+				z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt84)
 			} else {
 				x.TestStrucCommon.NotAnon.CodecDecodeSelf(d)
 			}
+		case "P":
+			if r.TryNil() {
+				if x.TestStrucCommon.AnonInTestStrucSlim != nil && x.TestStrucCommon.AnonInTestStrucSlim.P != nil { // remove the if-true
+					x.TestStrucCommon.AnonInTestStrucSlim.P = nil
+				}
+			} else {
+				if x.TestStrucCommon.AnonInTestStrucSlim == nil {
+					x.TestStrucCommon.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
+				}
+				if x.TestStrucCommon.AnonInTestStrucSlim.P == nil {
+					x.TestStrucCommon.AnonInTestStrucSlim.P = new(string)
+				}
+				*x.TestStrucCommon.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+			}
+		case "NotAnonSlim":
+			if r.TryNil() {
+				if x.TestStrucCommon.NotAnonSlim != nil { // remove the if-true
+					x.TestStrucCommon.NotAnonSlim = nil
+				}
+			} else {
+				if x.TestStrucCommon.NotAnonSlim == nil {
+					x.TestStrucCommon.NotAnonSlim = new(AnonInTestStrucSlim)
+				}
+				if yyxt88 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt88 != nil {
+					z.DecExtension(x.TestStrucCommon.NotAnonSlim, yyxt88)
+				} else {
+					x.TestStrucCommon.NotAnonSlim.CodecDecodeSelf(d)
+				}
+			}
 		case "Nmap":
 			z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
 		case "Nslice":
-			x.TestStrucCommon.Nslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Nslice), false)
+			x.TestStrucCommon.Nslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Nslice))
 		case "Nint64":
 			if r.TryNil() {
 				if x.TestStrucCommon.Nint64 != nil { // remove the if-true
@@ -4126,14 +5886,14 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.Nteststruc == nil {
 					x.Nteststruc = new(TestStruc)
 				}
-				if yyxt94 := z.Extension(x.Nteststruc); yyxt94 != nil {
-					z.DecExtension(x.Nteststruc, yyxt94)
+				if yyxt102 := z.Extension(x.Nteststruc); yyxt102 != nil {
+					z.DecExtension(x.Nteststruc, yyxt102)
 				} else {
 					x.Nteststruc.CodecDecodeSelf(d)
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -4142,644 +5902,492 @@ func (x *TestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj95 int
-	var yyb95 bool
-	var yyhl95 bool = l >= 0
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	var yyj103 int
+	var yyb103 bool
+	var yyhl103 bool = l >= 0
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.S = (string)(string(r.DecodeStringAsBytes()))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	x.TestStrucCommon.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I64 = (int64)(r.DecodeInt64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I64n = (int64)(r.DecodeInt64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui64 = (uint64)(r.DecodeUint64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.F64 = (float64)(r.DecodeFloat64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.F32 = (float32)(z.DecDecodeFloat32())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.B = (bool)(r.DecodeBool())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.TestStrucCommon.Sslice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.I64slice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSliceint16((*[]int16)(&x.TestStrucCommon.I16slice), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	z.F.DecSliceInt32X(&x.TestStrucCommon.I32slice, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.Ui64slice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Ui8slice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Ui8slice), false)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	x.TestStrucCommon.Ui8slice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Ui8slice))
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBoolX(&x.TestStrucCommon.Bslice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Byslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Byslice), false)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	x.TestStrucCommon.Byslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Byslice))
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBytesX(&x.TestStrucCommon.BytesSlice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicePtrtoint64((*[]*int64)(&x.TestStrucCommon.Iptrslice), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt132 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt132 != nil {
-		z.DecExtension(&x.TestStrucCommon.WrapSliceInt64, yyxt132)
+	if yyxt140 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt140 != nil {
+		z.DecExtension(&x.TestStrucCommon.WrapSliceInt64, yyxt140)
 	} else {
 		x.TestStrucCommon.WrapSliceInt64.CodecDecodeSelf(d)
 	}
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt134 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt134 != nil {
-		z.DecExtension(&x.TestStrucCommon.WrapSliceString, yyxt134)
-	} else {
-		x.TestStrucCommon.WrapSliceString.CodecDecodeSelf(d)
-	}
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
+	if yyxt142 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt142 != nil {
+		z.DecExtension(&x.TestStrucCommon.WrapSliceString, yyxt142)
 	} else {
-		yyb95 = z.DecCheckBreak()
+		x.TestStrucCommon.WrapSliceString.CodecDecodeSelf(d)
 	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringInt64X(&x.TestStrucCommon.Msi64, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	z.F.DecMapStringIntX(&x.TestStrucCommon.Msint, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringBytesX(&x.TestStrucCommon.Msbytes, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt140 := z.Extension(x.TestStrucCommon.Simplef); yyxt140 != nil {
-		z.DecExtension(&x.TestStrucCommon.Simplef, yyxt140)
+	if yyxt148 := z.Extension(x.TestStrucCommon.Simplef); yyxt148 != nil {
+		z.DecExtension(&x.TestStrucCommon.Simplef, yyxt148)
 	} else {
 		x.TestStrucCommon.Simplef.CodecDecodeSelf(d)
 	}
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicestringUint64T((*[]stringUint64T)(&x.TestStrucCommon.SstrUi64T), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.TestStrucCommon.MstrUi64T), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	x.TestStrucCommon.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AUi64 = (uint64)(r.DecodeUint64())
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.TestStrucCommon.AnonInTestStruc.ASslice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64slice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceFloat64X(&x.TestStrucCommon.AnonInTestStruc.AF64slice, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSlicefloat32((*[]float32)(&x.TestStrucCommon.AnonInTestStruc.AF32slice), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
+	z.F.DecSliceFloat32X(&x.TestStrucCommon.AnonInTestStruc.AF32slice, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb95 {
+	z.DecReadArrayElem()
+	z.F.DecMapStringStringX(&x.TestStrucCommon.AnonInTestStruc.AMSS, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb95 {
+	z.DecReadArrayElem()
+	h.decArray8int64((*[8]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr8), d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray0int64((*[0]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr0), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice0, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16N), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64N, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16E), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64E, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt172 := z.Extension(x.TestStrucCommon.NotAnon); yyxt172 != nil {
-		z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt172)
+	if yyxt184 := z.Extension(x.TestStrucCommon.NotAnon); yyxt184 != nil {
+		z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt184)
 	} else {
 		x.TestStrucCommon.NotAnon.CodecDecodeSelf(d)
 	}
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.TestStrucCommon.AnonInTestStrucSlim != nil && x.TestStrucCommon.AnonInTestStrucSlim.P != nil { // remove the if-true
+			x.TestStrucCommon.AnonInTestStrucSlim.P = nil
+		}
 	} else {
-		yyb95 = z.DecCheckBreak()
+		if x.TestStrucCommon.AnonInTestStrucSlim == nil {
+			x.TestStrucCommon.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
+		}
+		if x.TestStrucCommon.AnonInTestStrucSlim.P == nil {
+			x.TestStrucCommon.AnonInTestStrucSlim.P = new(string)
+		}
+		*x.TestStrucCommon.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
+	if r.TryNil() {
+		if x.TestStrucCommon.NotAnonSlim != nil { // remove the if-true
+			x.TestStrucCommon.NotAnonSlim = nil
+		}
 	} else {
-		yyb95 = z.DecCheckBreak()
+		if x.TestStrucCommon.NotAnonSlim == nil {
+			x.TestStrucCommon.NotAnonSlim = new(AnonInTestStrucSlim)
+		}
+		if yyxt188 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt188 != nil {
+			z.DecExtension(x.TestStrucCommon.NotAnonSlim, yyxt188)
+		} else {
+			x.TestStrucCommon.NotAnonSlim.CodecDecodeSelf(d)
+		}
 	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Nslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Nslice), false)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
+	z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb95 {
+	z.DecReadArrayElem()
+	x.TestStrucCommon.Nslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Nslice))
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4794,49 +6402,33 @@ func (x *TestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.TestStrucCommon.Nint64 = (int64)(r.DecodeInt64())
 	}
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringPtrtoTestStruc((*map[string]*TestStruc)(&x.Mtsptr), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringTestStruc((*map[string]TestStruc)(&x.Mts), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicePtrtoTestStruc((*[]*TestStruc)(&x.Its), d)
-	yyj95++
-	if yyhl95 {
-		yyb95 = yyj95 > l
-	} else {
-		yyb95 = z.DecCheckBreak()
-	}
-	if yyb95 {
+	yyj103++
+	yyb103 = !z.DecContainerNext(yyj103, l, yyhl103)
+	if yyb103 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -4849,24 +6441,16 @@ func (x *TestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.Nteststruc == nil {
 			x.Nteststruc = new(TestStruc)
 		}
-		if yyxt186 := z.Extension(x.Nteststruc); yyxt186 != nil {
-			z.DecExtension(x.Nteststruc, yyxt186)
+		if yyxt202 := z.Extension(x.Nteststruc); yyxt202 != nil {
+			z.DecExtension(x.Nteststruc, yyxt202)
 		} else {
 			x.Nteststruc.CodecDecodeSelf(d)
 		}
 	}
-	for {
-		yyj95++
-		if yyhl95 {
-			yyb95 = yyj95 > l
-		} else {
-			yyb95 = z.DecCheckBreak()
-		}
-		if yyb95 {
-			break
-		}
+	yyj103++
+	for ; z.DecContainerNext(yyj103, l, yyhl103); yyj103++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj95-1, "")
+		z.DecStructFieldNotFound(yyj103-1, "")
 	}
 }
 
@@ -4874,10 +6458,15 @@ func (x *TestStruc) IsCodecEmpty() bool {
 	return !(!(x.TestStrucCommon.IsCodecEmpty()) || len(x.Mtsptr) != 0 || len(x.Mts) != 0 || len(x.Its) != 0 || false)
 }
 
+func (codecgenA) codecSelferViaCodecgen() {}
 func (x *codecgenA) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -4895,18 +6484,33 @@ func (x *codecgenA) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"ZZ\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ZZ\"")
+				} else {
+					r.EncodeString(`ZZ`)
+				}
+				z.EncWriteMapElemValue()
+				if x.ZZ == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.ZZ))
+				} // end block: if x.ZZ slice == nil
 			} else {
-				r.EncodeString(`ZZ`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"ZZ\"")
+				} else {
+					r.EncodeString(`ZZ`)
+				}
+				z.EncWriteMapElemValue()
+				if x.ZZ == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.ZZ))
+				} // end block: if x.ZZ slice == nil
 			}
-			z.EncWriteMapElemValue()
-			if x.ZZ == nil {
-				r.EncodeNil()
-			} else {
-				r.EncodeStringBytesRaw([]byte(x.ZZ))
-			} // end block: if x.ZZ slice == nil
 			z.EncWriteMapEnd()
 		}
 	}
@@ -4942,24 +6546,15 @@ func (x *codecgenA) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "ZZ":
-			x.ZZ = r.DecodeBytes(([]byte)(x.ZZ), false)
+			x.ZZ = z.DecodeBytesInto(([]byte)(x.ZZ))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -4971,28 +6566,15 @@ func (x *codecgenA) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.ZZ = r.DecodeBytes(([]byte)(x.ZZ), false)
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	x.ZZ = z.DecodeBytesInto(([]byte)(x.ZZ))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -5002,10 +6584,15 @@ func (x *codecgenA) IsCodecEmpty() bool {
 	return !(len(x.ZZ) != 0 || false)
 }
 
+func (codecgenB) codecSelferViaCodecgen() {}
 func (x *codecgenB) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5024,18 +6611,34 @@ func (x *codecgenB) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"AA\"")
-			} else {
-				r.EncodeString(`AA`)
-			}
-			z.EncWriteMapElemValue()
-			yy6 := &x.AA
-			if yyxt7 := z.Extension(yy6); yyxt7 != nil {
-				z.EncExtension(yy6, yyxt7)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AA\"")
+				} else {
+					r.EncodeString(`AA`)
+				}
+				z.EncWriteMapElemValue()
+				yy6 := &x.AA
+				if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+					z.EncExtension(yy6, yyxt7)
+				} else {
+					yy6.CodecEncodeSelf(e)
+				}
 			} else {
-				yy6.CodecEncodeSelf(e)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"AA\"")
+				} else {
+					r.EncodeString(`AA`)
+				}
+				z.EncWriteMapElemValue()
+				yy8 := &x.AA
+				if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+					z.EncExtension(yy8, yyxt9)
+				} else {
+					yy8.CodecEncodeSelf(e)
+				}
 			}
 			z.EncWriteMapEnd()
 		}
@@ -5072,20 +6675,11 @@ func (x *codecgenB) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "AA":
 			if yyxt5 := z.Extension(x.AA); yyxt5 != nil {
 				z.DecExtension(&x.AA, yyxt5)
@@ -5093,7 +6687,7 @@ func (x *codecgenB) codecDecodeSelfFromMap(l int, d *Decoder) {
 				x.AA.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5105,12 +6699,7 @@ func (x *codecgenB) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -5121,16 +6710,8 @@ func (x *codecgenB) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else {
 		x.AA.CodecDecodeSelf(d)
 	}
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -5140,10 +6721,15 @@ func (x *codecgenB) IsCodecEmpty() bool {
 	return !(!(x.AA.IsCodecEmpty()) || false)
 }
 
+func (codecgenC) codecSelferViaCodecgen() {}
 func (x *codecgenC) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5177,19 +6763,37 @@ func (x *codecgenC) CodecEncodeSelf(e *Encoder) {
 			}
 			z.EncWriteMapStart(yynn2)
 			yynn2 = 0
-			if yyq2[0] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"BB\"")
-				} else {
-					r.EncodeString(`BB`)
+			if z.EncBasicHandle().Canonical {
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"BB\"")
+					} else {
+						r.EncodeString(`BB`)
+					}
+					z.EncWriteMapElemValue()
+					yy6 := &x.BB
+					if yyxt7 := z.Extension(yy6); yyxt7 != nil {
+						z.EncExtension(yy6, yyxt7)
+					} else {
+						yy6.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				yy6 := &x.BB
-				if yyxt7 := z.Extension(yy6); yyxt7 != nil {
-					z.EncExtension(yy6, yyxt7)
-				} else {
-					yy6.CodecEncodeSelf(e)
+			} else {
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"BB\"")
+					} else {
+						r.EncodeString(`BB`)
+					}
+					z.EncWriteMapElemValue()
+					yy8 := &x.BB
+					if yyxt9 := z.Extension(yy8); yyxt9 != nil {
+						z.EncExtension(yy8, yyxt9)
+					} else {
+						yy8.CodecEncodeSelf(e)
+					}
 				}
 			}
 			z.EncWriteMapEnd()
@@ -5227,20 +6831,11 @@ func (x *codecgenC) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "BB":
 			if yyxt5 := z.Extension(x.BB); yyxt5 != nil {
 				z.DecExtension(&x.BB, yyxt5)
@@ -5248,7 +6843,7 @@ func (x *codecgenC) codecDecodeSelfFromMap(l int, d *Decoder) {
 				x.BB.CodecDecodeSelf(d)
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5260,12 +6855,7 @@ func (x *codecgenC) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -5276,16 +6866,8 @@ func (x *codecgenC) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else {
 		x.BB.CodecDecodeSelf(d)
 	}
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -5295,10 +6877,15 @@ func (x *codecgenC) IsCodecEmpty() bool {
 	return !(!(x.BB.IsCodecEmpty()) || false)
 }
 
+func (TestCodecgenG) codecSelferViaCodecgen() {}
 func (x *TestCodecgenG) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5312,14 +6899,25 @@ func (x *TestCodecgenG) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"TestCodecgenG\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestCodecgenG))
 			} else {
-				r.EncodeString(`TestCodecgenG`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestCodecgenG))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.TestCodecgenG))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -5355,24 +6953,15 @@ func (x *TestCodecgenG) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "TestCodecgenG":
 			x.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5384,28 +6973,15 @@ func (x *TestCodecgenG) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	x.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -5415,10 +6991,15 @@ func (x *TestCodecgenG) IsCodecEmpty() bool {
 	return !(x.TestCodecgenG != 0 || false)
 }
 
+func (codecgenH) codecSelferViaCodecgen() {}
 func (x *codecgenH) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5432,14 +7013,25 @@ func (x *codecgenH) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"TestCodecgenG\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestCodecgenG.TestCodecgenG))
 			} else {
-				r.EncodeString(`TestCodecgenG`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.TestCodecgenG.TestCodecgenG))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.TestCodecgenG.TestCodecgenG))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -5475,24 +7067,15 @@ func (x *codecgenH) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "TestCodecgenG":
 			x.TestCodecgenG.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5504,28 +7087,15 @@ func (x *codecgenH) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestCodecgenG.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -5535,10 +7105,15 @@ func (x *codecgenH) IsCodecEmpty() bool {
 	return !(!(x.TestCodecgenG.IsCodecEmpty()) || false)
 }
 
+func (codecgenI) codecSelferViaCodecgen() {}
 func (x *codecgenI) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5552,14 +7127,25 @@ func (x *codecgenI) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"TestCodecgenG\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.codecgenH.TestCodecgenG.TestCodecgenG))
 			} else {
-				r.EncodeString(`TestCodecgenG`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"TestCodecgenG\"")
+				} else {
+					r.EncodeString(`TestCodecgenG`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.codecgenH.TestCodecgenG.TestCodecgenG))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.codecgenH.TestCodecgenG.TestCodecgenG))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -5595,24 +7181,15 @@ func (x *codecgenI) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "TestCodecgenG":
 			x.codecgenH.TestCodecgenG.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5624,28 +7201,15 @@ func (x *codecgenI) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.codecgenH.TestCodecgenG.TestCodecgenG = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -5655,10 +7219,15 @@ func (x *codecgenI) IsCodecEmpty() bool {
 	return !(!(x.codecgenH.IsCodecEmpty()) || false)
 }
 
+func (codecgenK) codecSelferViaCodecgen() {}
 func (x *codecgenK) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5674,22 +7243,41 @@ func (x *codecgenK) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"X\"")
-			} else {
-				r.EncodeString(`X`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.X))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Y\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Y))
 			} else {
-				r.EncodeString(`Y`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.Y))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.Y))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -5725,26 +7313,17 @@ func (x *codecgenK) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "X":
 			x.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "Y":
-			x.Y = (string)(string(r.DecodeStringAsBytes()))
+			x.Y = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5756,12 +7335,7 @@ func (x *codecgenK) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -5769,27 +7343,15 @@ func (x *codecgenK) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.Y = (string)(string(r.DecodeStringAsBytes()))
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	x.Y = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -5799,10 +7361,15 @@ func (x *codecgenK) IsCodecEmpty() bool {
 	return !(x.X != 0 || x.Y != "" || false)
 }
 
+func (codecgenL) codecSelferViaCodecgen() {}
 func (x *codecgenL) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5818,22 +7385,41 @@ func (x *codecgenL) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"X\"")
-			} else {
-				r.EncodeString(`X`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.X))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Y\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Y))
 			} else {
-				r.EncodeString(`Y`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeUint(uint64(x.Y))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeUint(uint64(x.Y))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -5869,26 +7455,17 @@ func (x *codecgenL) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "X":
 			x.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "Y":
 			x.Y = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -5900,12 +7477,7 @@ func (x *codecgenL) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -5913,27 +7485,15 @@ func (x *codecgenL) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.Y = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -5943,10 +7503,15 @@ func (x *codecgenL) IsCodecEmpty() bool {
 	return !(x.X != 0 || x.Y != 0 || false)
 }
 
+func (codecgenM) codecSelferViaCodecgen() {}
 func (x *codecgenM) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -5962,22 +7527,41 @@ func (x *codecgenM) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"X\"")
-			} else {
-				r.EncodeString(`X`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.codecgenK.X))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Y\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.codecgenK.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.codecgenK.Y))
 			} else {
-				r.EncodeString(`Y`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"X\"")
+				} else {
+					r.EncodeString(`X`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.codecgenK.X))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Y\"")
+				} else {
+					r.EncodeString(`Y`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.codecgenK.Y))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.codecgenK.Y))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6013,26 +7597,17 @@ func (x *codecgenM) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "X":
 			x.codecgenK.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "Y":
-			x.codecgenK.Y = (string)(string(r.DecodeStringAsBytes()))
+			x.codecgenK.Y = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6044,12 +7619,7 @@ func (x *codecgenM) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -6057,27 +7627,15 @@ func (x *codecgenM) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.codecgenK.X = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.codecgenK.Y = (string)(string(r.DecodeStringAsBytes()))
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	x.codecgenK.Y = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -6087,10 +7645,15 @@ func (x *codecgenM) IsCodecEmpty() bool {
 	return !(!(x.codecgenK.IsCodecEmpty()) || false)
 }
 
+func (testStrucKeyTypeT0) codecSelferViaCodecgen() {}
 func (x *testStrucKeyTypeT0) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6104,14 +7667,25 @@ func (x *testStrucKeyTypeT0) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F\"")
+				} else {
+					r.EncodeString(`F`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
 			} else {
-				r.EncodeString(`F`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F\"")
+				} else {
+					r.EncodeString(`F`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.F))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6147,24 +7721,15 @@ func (x *testStrucKeyTypeT0) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "F":
 			x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6176,28 +7741,15 @@ func (x *testStrucKeyTypeT0) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6207,10 +7759,15 @@ func (x *testStrucKeyTypeT0) IsCodecEmpty() bool {
 	return !(x.F != 0 || false)
 }
 
+func (testStrucKeyTypeT1) codecSelferViaCodecgen() {}
 func (x *testStrucKeyTypeT1) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6224,14 +7781,25 @@ func (x *testStrucKeyTypeT1) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"FFFF\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFFF\"")
+				} else {
+					r.EncodeString(`FFFF`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
 			} else {
-				r.EncodeString(`FFFF`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"FFFF\"")
+				} else {
+					r.EncodeString(`FFFF`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.F))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6267,24 +7835,15 @@ func (x *testStrucKeyTypeT1) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "FFFF":
 			x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6296,28 +7855,15 @@ func (x *testStrucKeyTypeT1) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6327,10 +7873,15 @@ func (x *testStrucKeyTypeT1) IsCodecEmpty() bool {
 	return !(x.F != 0 || false)
 }
 
+func (testStrucKeyTypeT2) codecSelferViaCodecgen() {}
 func (x *testStrucKeyTypeT2) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6344,10 +7895,17 @@ func (x *testStrucKeyTypeT2) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			r.EncodeInt(z.M.Int(strconv.ParseInt(`-1`, 10, 64)))
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.F))
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeInt(z.M.Int(strconv.ParseInt(`-1`, 10, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeInt(z.M.Int(strconv.ParseInt(`-1`, 10, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			}
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6383,24 +7941,15 @@ func (x *testStrucKeyTypeT2) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(strconv.AppendInt(z.DecScratchArrayBuffer()[:0], r.DecodeInt64(), 10))
+		yys3 := strconv.AppendInt(z.DecScratchArrayBuffer()[:0], r.DecodeInt64(), 10)
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "-1":
 			x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6412,28 +7961,15 @@ func (x *testStrucKeyTypeT2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6443,10 +7979,15 @@ func (x *testStrucKeyTypeT2) IsCodecEmpty() bool {
 	return !(x.F != 0 || false)
 }
 
+func (testStrucKeyTypeT3) codecSelferViaCodecgen() {}
 func (x *testStrucKeyTypeT3) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6460,10 +8001,17 @@ func (x *testStrucKeyTypeT3) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			r.EncodeUint(z.M.Uint(strconv.ParseUint(`1`, 10, 64)))
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.F))
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeUint(z.M.Uint(strconv.ParseUint(`1`, 10, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeUint(z.M.Uint(strconv.ParseUint(`1`, 10, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			}
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6499,24 +8047,15 @@ func (x *testStrucKeyTypeT3) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(strconv.AppendUint(z.DecScratchArrayBuffer()[:0], r.DecodeUint64(), 10))
+		yys3 := strconv.AppendUint(z.DecScratchArrayBuffer()[:0], r.DecodeUint64(), 10)
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "1":
 			x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6528,28 +8067,15 @@ func (x *testStrucKeyTypeT3) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6559,10 +8085,15 @@ func (x *testStrucKeyTypeT3) IsCodecEmpty() bool {
 	return !(x.F != 0 || false)
 }
 
+func (testStrucKeyTypeT4) codecSelferViaCodecgen() {}
 func (x *testStrucKeyTypeT4) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6576,10 +8107,17 @@ func (x *testStrucKeyTypeT4) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			r.EncodeFloat64(z.M.Float(strconv.ParseFloat(`2.5`, 64)))
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.F))
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				r.EncodeFloat64(z.M.Float(strconv.ParseFloat(`2.5`, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			} else {
+				z.EncWriteMapElemKey()
+				r.EncodeFloat64(z.M.Float(strconv.ParseFloat(`2.5`, 64)))
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.F))
+			}
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6615,24 +8153,15 @@ func (x *testStrucKeyTypeT4) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(strconv.AppendFloat(z.DecScratchArrayBuffer()[:0], r.DecodeFloat64(), 'f', -1, 64))
+		yys3 := strconv.AppendFloat(z.DecScratchArrayBuffer()[:0], r.DecodeFloat64(), 'f', -1, 64)
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "2.5":
 			x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6644,28 +8173,15 @@ func (x *testStrucKeyTypeT4) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6675,6 +8191,7 @@ func (x *testStrucKeyTypeT4) IsCodecEmpty() bool {
 	return !(x.F != 0 || false)
 }
 
+func (Bbool) codecSelferViaCodecgen() {}
 func (x Bbool) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -6689,6 +8206,7 @@ func (x *Bbool) CodecDecodeSelf(d *Decoder) {
 	*x = (Bbool)(r.DecodeBool())
 }
 
+func (Aarray) codecSelferViaCodecgen() {}
 func (x *Aarray) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -6696,7 +8214,7 @@ func (x *Aarray) CodecEncodeSelf(e *Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		h.encAarray((*Aarray)(x), e)
+		z.F.EncSliceStringV(([]string)(x[:]), e)
 	}
 }
 
@@ -6704,9 +8222,10 @@ func (x *Aarray) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	h.decAarray((*Aarray)(x), d)
+	z.F.DecSliceStringN(([]string)(x[:]), d)
 }
 
+func (Sstring) codecSelferViaCodecgen() {}
 func (x Sstring) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -6718,13 +8237,18 @@ func (x *Sstring) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	*x = (Sstring)(string(r.DecodeStringAsBytes()))
+	*x = (Sstring)(z.DecStringZC(r.DecodeStringAsBytes()))
 }
 
+func (Sstructsmall) codecSelferViaCodecgen() {}
 func (x *Sstructsmall) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6738,14 +8262,25 @@ func (x *Sstructsmall) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"A\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
 			} else {
-				r.EncodeString(`A`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.A))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -6781,24 +8316,15 @@ func (x *Sstructsmall) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "A":
 			x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -6810,28 +8336,15 @@ func (x *Sstructsmall) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj5 int
 	var yyb5 bool
 	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
-	}
+	yyb5 = !z.DecContainerNext(yyj5, l, yyhl5)
 	if yyb5 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
-		}
-		if yyb5 {
-			break
-		}
+	yyj5++
+	for ; z.DecContainerNext(yyj5, l, yyhl5); yyj5++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj5-1, "")
 	}
@@ -6841,10 +8354,15 @@ func (x *Sstructsmall) IsCodecEmpty() bool {
 	return !(x.A != 0 || false)
 }
 
+func (Sstructbig) codecSelferViaCodecgen() {}
 func (x *Sstructbig) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -6891,65 +8409,129 @@ func (x *Sstructbig) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(5)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"A\"")
-			} else {
-				r.EncodeString(`A`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.A))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ssmallptr\"")
-			} else {
-				r.EncodeString(`Ssmallptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn5 {
-				r.EncodeNil()
-			} else {
-				if yyxt16 := z.Extension(x.Ssmallptr); yyxt16 != nil {
-					z.EncExtension(x.Ssmallptr, yyxt16)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
 				} else {
-					x.Ssmallptr.CodecEncodeSelf(e)
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sptr\"")
+				} else {
+					r.EncodeString(`Sptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					if yyxt16 := z.Extension(x.Sptr); yyxt16 != nil {
+						z.EncExtension(x.Sptr, yyxt16)
+					} else {
+						x.Sptr.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmall\"")
+				} else {
+					r.EncodeString(`Ssmall`)
+				}
+				z.EncWriteMapElemValue()
+				yy17 := &x.Ssmall
+				if yyxt18 := z.Extension(yy17); yyxt18 != nil {
+					z.EncExtension(yy17, yyxt18)
+				} else {
+					yy17.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmallptr\"")
+				} else {
+					r.EncodeString(`Ssmallptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn5 {
+					r.EncodeNil()
+				} else {
+					if yyxt19 := z.Extension(x.Ssmallptr); yyxt19 != nil {
+						z.EncExtension(x.Ssmallptr, yyxt19)
+					} else {
+						x.Ssmallptr.CodecEncodeSelf(e)
+					}
 				}
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ssmall\"")
-			} else {
-				r.EncodeString(`Ssmall`)
-			}
-			z.EncWriteMapElemValue()
-			yy17 := &x.Ssmall
-			if yyxt18 := z.Extension(yy17); yyxt18 != nil {
-				z.EncExtension(yy17, yyxt18)
-			} else {
-				yy17.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Sptr\"")
-			} else {
-				r.EncodeString(`Sptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn7 {
-				r.EncodeNil()
 			} else {
-				if yyxt19 := z.Extension(x.Sptr); yyxt19 != nil {
-					z.EncExtension(x.Sptr, yyxt19)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
 				} else {
-					x.Sptr.CodecEncodeSelf(e)
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmallptr\"")
+				} else {
+					r.EncodeString(`Ssmallptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn5 {
+					r.EncodeNil()
+				} else {
+					if yyxt22 := z.Extension(x.Ssmallptr); yyxt22 != nil {
+						z.EncExtension(x.Ssmallptr, yyxt22)
+					} else {
+						x.Ssmallptr.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmall\"")
+				} else {
+					r.EncodeString(`Ssmall`)
+				}
+				z.EncWriteMapElemValue()
+				yy23 := &x.Ssmall
+				if yyxt24 := z.Extension(yy23); yyxt24 != nil {
+					z.EncExtension(yy23, yyxt24)
+				} else {
+					yy23.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sptr\"")
+				} else {
+					r.EncodeString(`Sptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					if yyxt25 := z.Extension(x.Sptr); yyxt25 != nil {
+						z.EncExtension(x.Sptr, yyxt25)
+					} else {
+						x.Sptr.CodecEncodeSelf(e)
+					}
 				}
 			}
 			z.EncWriteMapEnd()
@@ -6987,20 +8569,11 @@ func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "A":
 			x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "B":
@@ -7042,7 +8615,7 @@ func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -7054,12 +8627,7 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj12 int
 	var yyb12 bool
 	var yyhl12 bool = l >= 0
-	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7067,11 +8635,7 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7079,11 +8643,7 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7104,11 +8664,7 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 	}
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7120,11 +8676,7 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 		x.Ssmall.CodecDecodeSelf(d)
 	}
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7144,16 +8696,8 @@ func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
 			x.Sptr.CodecDecodeSelf(d)
 		}
 	}
-	for {
-		yyj12++
-		if yyhl12 {
-			yyb12 = yyj12 > l
-		} else {
-			yyb12 = z.DecCheckBreak()
-		}
-		if yyb12 {
-			break
-		}
+	yyj12++
+	for ; z.DecContainerNext(yyj12, l, yyhl12); yyj12++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj12-1, "")
 	}
@@ -7163,10 +8707,15 @@ func (x *Sstructbig) IsCodecEmpty() bool {
 	return !(x.A != 0 || bool(x.B) || !(x.Ssmall.IsCodecEmpty()) || false)
 }
 
+func (SstructbigToArray) codecSelferViaCodecgen() {}
 func (x *SstructbigToArray) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -7189,89 +8738,153 @@ func (x *SstructbigToArray) CodecEncodeSelf(e *Encoder) {
 				if yyxt10 := z.Extension(x.Ssmallptr); yyxt10 != nil {
 					z.EncExtension(x.Ssmallptr, yyxt10)
 				} else {
-					x.Ssmallptr.CodecEncodeSelf(e)
+					x.Ssmallptr.CodecEncodeSelf(e)
+				}
+			}
+			z.EncWriteArrayElem()
+			yy11 := &x.Ssmall
+			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
+				z.EncExtension(yy11, yyxt12)
+			} else {
+				yy11.CodecEncodeSelf(e)
+			}
+			if yyn7 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyxt13 := z.Extension(x.Sptr); yyxt13 != nil {
+					z.EncExtension(x.Sptr, yyxt13)
+				} else {
+					x.Sptr.CodecEncodeSelf(e)
+				}
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			z.EncWriteMapStart(5)
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sptr\"")
+				} else {
+					r.EncodeString(`Sptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					if yyxt16 := z.Extension(x.Sptr); yyxt16 != nil {
+						z.EncExtension(x.Sptr, yyxt16)
+					} else {
+						x.Sptr.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmall\"")
+				} else {
+					r.EncodeString(`Ssmall`)
+				}
+				z.EncWriteMapElemValue()
+				yy17 := &x.Ssmall
+				if yyxt18 := z.Extension(yy17); yyxt18 != nil {
+					z.EncExtension(yy17, yyxt18)
+				} else {
+					yy17.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmallptr\"")
+				} else {
+					r.EncodeString(`Ssmallptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn5 {
+					r.EncodeNil()
+				} else {
+					if yyxt19 := z.Extension(x.Ssmallptr); yyxt19 != nil {
+						z.EncExtension(x.Ssmallptr, yyxt19)
+					} else {
+						x.Ssmallptr.CodecEncodeSelf(e)
+					}
 				}
-			}
-			z.EncWriteArrayElem()
-			yy11 := &x.Ssmall
-			if yyxt12 := z.Extension(yy11); yyxt12 != nil {
-				z.EncExtension(yy11, yyxt12)
-			} else {
-				yy11.CodecEncodeSelf(e)
-			}
-			if yyn7 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
 			} else {
-				z.EncWriteArrayElem()
-				if yyxt13 := z.Extension(x.Sptr); yyxt13 != nil {
-					z.EncExtension(x.Sptr, yyxt13)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
 				} else {
-					x.Sptr.CodecEncodeSelf(e)
+					r.EncodeString(`A`)
 				}
-			}
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(5)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"A\"")
-			} else {
-				r.EncodeString(`A`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.A))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ssmallptr\"")
-			} else {
-				r.EncodeString(`Ssmallptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn5 {
-				r.EncodeNil()
-			} else {
-				if yyxt16 := z.Extension(x.Ssmallptr); yyxt16 != nil {
-					z.EncExtension(x.Ssmallptr, yyxt16)
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.A))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
 				} else {
-					x.Ssmallptr.CodecEncodeSelf(e)
+					r.EncodeString(`B`)
 				}
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ssmall\"")
-			} else {
-				r.EncodeString(`Ssmall`)
-			}
-			z.EncWriteMapElemValue()
-			yy17 := &x.Ssmall
-			if yyxt18 := z.Extension(yy17); yyxt18 != nil {
-				z.EncExtension(yy17, yyxt18)
-			} else {
-				yy17.CodecEncodeSelf(e)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Sptr\"")
-			} else {
-				r.EncodeString(`Sptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn7 {
-				r.EncodeNil()
-			} else {
-				if yyxt19 := z.Extension(x.Sptr); yyxt19 != nil {
-					z.EncExtension(x.Sptr, yyxt19)
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmallptr\"")
 				} else {
-					x.Sptr.CodecEncodeSelf(e)
+					r.EncodeString(`Ssmallptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn5 {
+					r.EncodeNil()
+				} else {
+					if yyxt22 := z.Extension(x.Ssmallptr); yyxt22 != nil {
+						z.EncExtension(x.Ssmallptr, yyxt22)
+					} else {
+						x.Ssmallptr.CodecEncodeSelf(e)
+					}
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ssmall\"")
+				} else {
+					r.EncodeString(`Ssmall`)
+				}
+				z.EncWriteMapElemValue()
+				yy23 := &x.Ssmall
+				if yyxt24 := z.Extension(yy23); yyxt24 != nil {
+					z.EncExtension(yy23, yyxt24)
+				} else {
+					yy23.CodecEncodeSelf(e)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Sptr\"")
+				} else {
+					r.EncodeString(`Sptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					if yyxt25 := z.Extension(x.Sptr); yyxt25 != nil {
+						z.EncExtension(x.Sptr, yyxt25)
+					} else {
+						x.Sptr.CodecEncodeSelf(e)
+					}
 				}
 			}
 			z.EncWriteMapEnd()
@@ -7309,20 +8922,11 @@ func (x *SstructbigToArray) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "A":
 			x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "B":
@@ -7364,7 +8968,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -7376,12 +8980,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj12 int
 	var yyb12 bool
 	var yyhl12 bool = l >= 0
-	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7389,11 +8988,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7401,11 +8996,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7426,11 +9017,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 	}
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7442,11 +9029,7 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 		x.Ssmall.CodecDecodeSelf(d)
 	}
 	yyj12++
-	if yyhl12 {
-		yyb12 = yyj12 > l
-	} else {
-		yyb12 = z.DecCheckBreak()
-	}
+	yyb12 = !z.DecContainerNext(yyj12, l, yyhl12)
 	if yyb12 {
 		z.DecReadArrayEnd()
 		return
@@ -7466,16 +9049,8 @@ func (x *SstructbigToArray) codecDecodeSelfFromArray(l int, d *Decoder) {
 			x.Sptr.CodecDecodeSelf(d)
 		}
 	}
-	for {
-		yyj12++
-		if yyhl12 {
-			yyb12 = yyj12 > l
-		} else {
-			yyb12 = z.DecCheckBreak()
-		}
-		if yyb12 {
-			break
-		}
+	yyj12++
+	for ; z.DecContainerNext(yyj12, l, yyhl12); yyj12++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj12-1, "")
 	}
@@ -7485,10 +9060,15 @@ func (x *SstructbigToArray) IsCodecEmpty() bool {
 	return !(x.A != 0 || bool(x.B) || !(x.Ssmall.IsCodecEmpty()) || false)
 }
 
+func (tLowerFirstLetter) codecSelferViaCodecgen() {}
 func (x *tLowerFirstLetter) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -7504,22 +9084,41 @@ func (x *tLowerFirstLetter) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I\"")
-			} else {
-				r.EncodeString(`I`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
 			} else {
-				r.EncodeString(`S`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -7555,26 +9154,17 @@ func (x *tLowerFirstLetter) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "I":
 			x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -7586,12 +9176,7 @@ func (x *tLowerFirstLetter) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
@@ -7599,27 +9184,15 @@ func (x *tLowerFirstLetter) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -7629,6 +9202,7 @@ func (x *tLowerFirstLetter) IsCodecEmpty() bool {
 	return !(x.I != 0 || x.S != "" || false)
 }
 
+func (wrapInt64) codecSelferViaCodecgen() {}
 func (x wrapInt64) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7643,6 +9217,7 @@ func (x *wrapInt64) CodecDecodeSelf(d *Decoder) {
 	*x = (wrapInt64)(r.DecodeInt64())
 }
 
+func (wrapUint8) codecSelferViaCodecgen() {}
 func (x wrapUint8) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7657,6 +9232,7 @@ func (x *wrapUint8) CodecDecodeSelf(d *Decoder) {
 	*x = (wrapUint8)(z.C.UintV(r.DecodeUint64(), 8))
 }
 
+func (wrapBytes) codecSelferViaCodecgen() {}
 func (x wrapBytes) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7664,7 +9240,7 @@ func (x wrapBytes) CodecEncodeSelf(e *Encoder) {
 	if x == nil {
 		r.EncodeNil()
 	} else {
-		h.encwrapBytes((wrapBytes)(x), e)
+		z.F.EncSliceUint8V(([]uint8)(x), e)
 	} // end block: if x slice == nil
 }
 
@@ -7672,9 +9248,10 @@ func (x *wrapBytes) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	h.decwrapBytes((*wrapBytes)(x), d)
+	z.F.DecSliceUint8X((*[]uint8)(x), d)
 }
 
+func (testMarshalAsJSON) codecSelferViaCodecgen() {}
 func (x testMarshalAsJSON) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7697,6 +9274,7 @@ func (x *testMarshalAsJSON) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
+func (testMarshalAsBinary) codecSelferViaCodecgen() {}
 func (x testMarshalAsBinary) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7707,7 +9285,7 @@ func (x testMarshalAsBinary) CodecEncodeSelf(e *Encoder) {
 		if x == nil {
 			r.EncodeNil()
 		} else {
-			h.enctestMarshalAsBinary((testMarshalAsBinary)(x), e)
+			z.F.EncSliceUint8V(([]uint8)(x), e)
 		} // end block: if x slice == nil
 	}
 }
@@ -7719,10 +9297,11 @@ func (x *testMarshalAsBinary) CodecDecodeSelf(d *Decoder) {
 	if z.DecBinary() {
 		z.DecBinaryUnmarshal(x)
 	} else {
-		h.dectestMarshalAsBinary((*testMarshalAsBinary)(x), d)
+		z.F.DecSliceUint8X((*[]uint8)(x), d)
 	}
 }
 
+func (testMarshalAsText) codecSelferViaCodecgen() {}
 func (x testMarshalAsText) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7741,10 +9320,11 @@ func (x *testMarshalAsText) CodecDecodeSelf(d *Decoder) {
 	if !z.DecBinary() {
 		z.DecTextUnmarshal(x)
 	} else {
-		*x = (testMarshalAsText)(string(r.DecodeStringAsBytes()))
+		*x = (testMarshalAsText)(z.DecStringZC(r.DecodeStringAsBytes()))
 	}
 }
 
+func (testUintToBytes) codecSelferViaCodecgen() {}
 func (x testUintToBytes) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -7759,10 +9339,15 @@ func (x *testUintToBytes) CodecDecodeSelf(d *Decoder) {
 	*x = (testUintToBytes)(z.C.UintV(r.DecodeUint64(), 32))
 }
 
+func (AnonInTestStrucIntf) codecSelferViaCodecgen() {}
 func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -7787,7 +9372,7 @@ func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayElem()
 			z.EncFallback(x.Nintf)
 			z.EncWriteArrayElem()
-			if !z.EncBasicHandle().TimeNotBuiltin {
+			if z.EncBasicHandle().TimeBuiltin() {
 				r.EncodeTime(x.T)
 			} else if yyxt11 := z.Extension(x.T); yyxt11 != nil {
 				z.EncExtension(x.T, yyxt11)
@@ -7804,7 +9389,7 @@ func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
 			} else {
 				z.EncWriteArrayElem()
 				yy12 := *x.Tptr
-				if !z.EncBasicHandle().TimeNotBuiltin {
+				if z.EncBasicHandle().TimeBuiltin() {
 					r.EncodeTime(yy12)
 				} else if yyxt13 := z.Extension(yy12); yyxt13 != nil {
 					z.EncExtension(yy12, yyxt13)
@@ -7819,77 +9404,153 @@ func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(5)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Islice\"")
-			} else {
-				r.EncodeString(`Islice`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Islice == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncSliceIntfV(x.Islice, e)
-			} // end block: if x.Islice slice == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Ms\"")
-			} else {
-				r.EncodeString(`Ms`)
-			}
-			z.EncWriteMapElemValue()
-			if x.Ms == nil {
-				r.EncodeNil()
-			} else {
-				z.F.EncMapStringIntfV(x.Ms, e)
-			} // end block: if x.Ms map == nil
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Nintf\"")
-			} else {
-				r.EncodeString(`Nintf`)
-			}
-			z.EncWriteMapElemValue()
-			z.EncFallback(x.Nintf)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"T\"")
-			} else {
-				r.EncodeString(`T`)
-			}
-			z.EncWriteMapElemValue()
-			if !z.EncBasicHandle().TimeNotBuiltin {
-				r.EncodeTime(x.T)
-			} else if yyxt17 := z.Extension(x.T); yyxt17 != nil {
-				z.EncExtension(x.T, yyxt17)
-			} else if z.EncBinary() {
-				z.EncBinaryMarshal(x.T)
-			} else if !z.EncBinary() && z.IsJSONHandle() {
-				z.EncJSONMarshal(x.T)
-			} else {
-				z.EncFallback(x.T)
-			}
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"Tptr\"")
-			} else {
-				r.EncodeString(`Tptr`)
-			}
-			z.EncWriteMapElemValue()
-			if yyn7 {
-				r.EncodeNil()
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Islice\"")
+				} else {
+					r.EncodeString(`Islice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Islice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntfV(x.Islice, e)
+				} // end block: if x.Islice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ms\"")
+				} else {
+					r.EncodeString(`Ms`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ms == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntfV(x.Ms, e)
+				} // end block: if x.Ms map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nintf\"")
+				} else {
+					r.EncodeString(`Nintf`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.Nintf)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"T\"")
+				} else {
+					r.EncodeString(`T`)
+				}
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.T)
+				} else if yyxt17 := z.Extension(x.T); yyxt17 != nil {
+					z.EncExtension(x.T, yyxt17)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.T)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.T)
+				} else {
+					z.EncFallback(x.T)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tptr\"")
+				} else {
+					r.EncodeString(`Tptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					yy18 := *x.Tptr
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(yy18)
+					} else if yyxt19 := z.Extension(yy18); yyxt19 != nil {
+						z.EncExtension(yy18, yyxt19)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(yy18)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy18)
+					} else {
+						z.EncFallback(yy18)
+					}
+				}
 			} else {
-				yy18 := *x.Tptr
-				if !z.EncBasicHandle().TimeNotBuiltin {
-					r.EncodeTime(yy18)
-				} else if yyxt19 := z.Extension(yy18); yyxt19 != nil {
-					z.EncExtension(yy18, yyxt19)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Islice\"")
+				} else {
+					r.EncodeString(`Islice`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Islice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntfV(x.Islice, e)
+				} // end block: if x.Islice slice == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Ms\"")
+				} else {
+					r.EncodeString(`Ms`)
+				}
+				z.EncWriteMapElemValue()
+				if x.Ms == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntfV(x.Ms, e)
+				} // end block: if x.Ms map == nil
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Nintf\"")
+				} else {
+					r.EncodeString(`Nintf`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.Nintf)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"T\"")
+				} else {
+					r.EncodeString(`T`)
+				}
+				z.EncWriteMapElemValue()
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.T)
+				} else if yyxt23 := z.Extension(x.T); yyxt23 != nil {
+					z.EncExtension(x.T, yyxt23)
 				} else if z.EncBinary() {
-					z.EncBinaryMarshal(yy18)
+					z.EncBinaryMarshal(x.T)
 				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(yy18)
+					z.EncJSONMarshal(x.T)
+				} else {
+					z.EncFallback(x.T)
+				}
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"Tptr\"")
 				} else {
-					z.EncFallback(yy18)
+					r.EncodeString(`Tptr`)
+				}
+				z.EncWriteMapElemValue()
+				if yyn7 {
+					r.EncodeNil()
+				} else {
+					yy24 := *x.Tptr
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(yy24)
+					} else if yyxt25 := z.Extension(yy24); yyxt25 != nil {
+						z.EncExtension(yy24, yyxt25)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(yy24)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy24)
+					} else {
+						z.EncFallback(yy24)
+					}
 				}
 			}
 			z.EncWriteMapEnd()
@@ -7927,20 +9588,11 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "Islice":
 			z.F.DecSliceIntfX(&x.Islice, d)
 		case "Ms":
@@ -7948,7 +9600,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "Nintf":
 			z.DecFallback(&x.Nintf, true)
 		case "T":
-			if !z.DecBasicHandle().TimeNotBuiltin {
+			if z.DecBasicHandle().TimeBuiltin() {
 				x.T = r.DecodeTime()
 			} else if yyxt11 := z.Extension(x.T); yyxt11 != nil {
 				z.DecExtension(&x.T, yyxt11)
@@ -7968,7 +9620,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.Tptr == nil {
 					x.Tptr = new(time.Time)
 				}
-				if !z.DecBasicHandle().TimeNotBuiltin {
+				if z.DecBasicHandle().TimeBuiltin() {
 					*x.Tptr = r.DecodeTime()
 				} else if yyxt13 := z.Extension(x.Tptr); yyxt13 != nil {
 					z.DecExtension(x.Tptr, yyxt13)
@@ -7981,7 +9633,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 				}
 			}
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -7993,12 +9645,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj14 int
 	var yyb14 bool
 	var yyhl14 bool = l >= 0
-	yyj14++
-	if yyhl14 {
-		yyb14 = yyj14 > l
-	} else {
-		yyb14 = z.DecCheckBreak()
-	}
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
 	if yyb14 {
 		z.DecReadArrayEnd()
 		return
@@ -8006,11 +9653,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.F.DecSliceIntfX(&x.Islice, d)
 	yyj14++
-	if yyhl14 {
-		yyb14 = yyj14 > l
-	} else {
-		yyb14 = z.DecCheckBreak()
-	}
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
 	if yyb14 {
 		z.DecReadArrayEnd()
 		return
@@ -8018,11 +9661,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.F.DecMapStringIntfX(&x.Ms, d)
 	yyj14++
-	if yyhl14 {
-		yyb14 = yyj14 > l
-	} else {
-		yyb14 = z.DecCheckBreak()
-	}
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
 	if yyb14 {
 		z.DecReadArrayEnd()
 		return
@@ -8030,17 +9669,13 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.DecFallback(&x.Nintf, true)
 	yyj14++
-	if yyhl14 {
-		yyb14 = yyj14 > l
-	} else {
-		yyb14 = z.DecCheckBreak()
-	}
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
 	if yyb14 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if !z.DecBasicHandle().TimeNotBuiltin {
+	if z.DecBasicHandle().TimeBuiltin() {
 		x.T = r.DecodeTime()
 	} else if yyxt22 := z.Extension(x.T); yyxt22 != nil {
 		z.DecExtension(&x.T, yyxt22)
@@ -8049,326 +9684,55 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else if !z.DecBinary() && z.IsJSONHandle() {
 		z.DecJSONUnmarshal(&x.T)
 	} else {
-		z.DecFallback(&x.T, false)
-	}
-	yyj14++
-	if yyhl14 {
-		yyb14 = yyj14 > l
-	} else {
-		yyb14 = z.DecCheckBreak()
-	}
-	if yyb14 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if r.TryNil() {
-		if x.Tptr != nil { // remove the if-true
-			x.Tptr = nil
-		}
-	} else {
-		if x.Tptr == nil {
-			x.Tptr = new(time.Time)
-		}
-		if !z.DecBasicHandle().TimeNotBuiltin {
-			*x.Tptr = r.DecodeTime()
-		} else if yyxt24 := z.Extension(x.Tptr); yyxt24 != nil {
-			z.DecExtension(x.Tptr, yyxt24)
-		} else if z.DecBinary() {
-			z.DecBinaryUnmarshal(x.Tptr)
-		} else if !z.DecBinary() && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(x.Tptr)
-		} else {
-			z.DecFallback(x.Tptr, false)
-		}
-	}
-	for {
-		yyj14++
-		if yyhl14 {
-			yyb14 = yyj14 > l
-		} else {
-			yyb14 = z.DecCheckBreak()
-		}
-		if yyb14 {
-			break
-		}
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj14-1, "")
-	}
-}
-
-func (x *AnonInTestStrucIntf) IsCodecEmpty() bool {
-	return !(len(x.Islice) != 0 || len(x.Ms) != 0 || x.Nintf != nil || !(x.T.IsZero()) || false)
-}
-
-func (x *missingFielderT1) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(2)
-			z.EncWriteArrayElem()
-			r.EncodeString(string(x.S))
-			z.EncWriteArrayElem()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapEnd()
-		}
-	}
-}
-
-func (x *missingFielderT1) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil19780 {
-		*(x) = missingFielderT1{}
-	} else if yyct2 == codecSelferValueTypeMap19780 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray19780 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
-		z.DecReadArrayEnd()
-	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
-	}
-}
-
-func (x *missingFielderT1) codecDecodeSelfFromMap(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
-		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
-		z.DecReadMapElemValue()
-		switch yys3 {
-		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
-		case "B":
-			x.B = (bool)(r.DecodeBool())
-		default:
-			z.DecStructFieldNotFound(-1, yys3)
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj6 int
-	var yyb6 bool
-	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
-	if yyb6 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
-	if yyb6 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	x.B = (bool)(r.DecodeBool())
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj6-1, "")
-	}
-}
-
-func (x *missingFielderT1) IsCodecEmpty() bool {
-	return !(x.S != "" || bool(x.B) || false)
-}
-
-func (x *missingFielderT11) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		yy2arr2 := z.EncBasicHandle().StructToArray
-		_ = yy2arr2
-		const yyr2 bool = false // struct tag has 'toArray'
-		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(1)
-			z.EncWriteArrayElem()
-			r.EncodeString(string(x.S2))
-			z.EncWriteArrayEnd()
-		} else {
-			z.EncWriteMapStart(1)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S2\"")
-			} else {
-				r.EncodeString(`S2`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S2))
-			z.EncWriteMapEnd()
-		}
-	}
-}
-
-func (x *missingFielderT11) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	yyct2 := r.ContainerType()
-	if yyct2 == codecSelferValueTypeNil19780 {
-		*(x) = missingFielderT11{}
-	} else if yyct2 == codecSelferValueTypeMap19780 {
-		yyl2 := z.DecReadMapStart()
-		if yyl2 == 0 {
-		} else {
-			x.codecDecodeSelfFromMap(yyl2, d)
-		}
-		z.DecReadMapEnd()
-	} else if yyct2 == codecSelferValueTypeArray19780 {
-		yyl2 := z.DecReadArrayStart()
-		if yyl2 != 0 {
-			x.codecDecodeSelfFromArray(yyl2, d)
-		}
-		z.DecReadArrayEnd()
-	} else {
-		panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
-	}
-}
-
-func (x *missingFielderT11) codecDecodeSelfFromMap(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
-		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
-		z.DecReadMapElemValue()
-		switch yys3 {
-		case "S2":
-			x.S2 = (string)(string(r.DecodeStringAsBytes()))
-		default:
-			z.DecStructFieldNotFound(-1, yys3)
-		} // end switch yys3
-	} // end for yyj3
-}
-
-func (x *missingFielderT11) codecDecodeSelfFromArray(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-	var yyj5 int
-	var yyb5 bool
-	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
-	} else {
-		yyb5 = z.DecCheckBreak()
+		z.DecFallback(&x.T, false)
 	}
-	if yyb5 {
+	yyj14++
+	yyb14 = !z.DecContainerNext(yyj14, l, yyhl14)
+	if yyb14 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S2 = (string)(string(r.DecodeStringAsBytes()))
-	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
-		} else {
-			yyb5 = z.DecCheckBreak()
+	if r.TryNil() {
+		if x.Tptr != nil { // remove the if-true
+			x.Tptr = nil
 		}
-		if yyb5 {
-			break
+	} else {
+		if x.Tptr == nil {
+			x.Tptr = new(time.Time)
+		}
+		if z.DecBasicHandle().TimeBuiltin() {
+			*x.Tptr = r.DecodeTime()
+		} else if yyxt24 := z.Extension(x.Tptr); yyxt24 != nil {
+			z.DecExtension(x.Tptr, yyxt24)
+		} else if z.DecBinary() {
+			z.DecBinaryUnmarshal(x.Tptr)
+		} else if !z.DecBinary() && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(x.Tptr)
+		} else {
+			z.DecFallback(x.Tptr, false)
 		}
+	}
+	yyj14++
+	for ; z.DecContainerNext(yyj14, l, yyhl14); yyj14++ {
 		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj5-1, "")
+		z.DecStructFieldNotFound(yyj14-1, "")
 	}
 }
 
-func (x *missingFielderT11) IsCodecEmpty() bool {
-	return !(x.S2 != "" || false)
+func (x *AnonInTestStrucIntf) IsCodecEmpty() bool {
+	return !(len(x.Islice) != 0 || len(x.Ms) != 0 || x.Nintf != nil || !(x.T.IsZero()) || false)
 }
 
+func (missingFielderT2) codecSelferViaCodecgen() {}
 func (x *missingFielderT2) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -8388,38 +9752,73 @@ func (x *missingFielderT2) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(4)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
-			} else {
-				r.EncodeString(`B`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"F\"")
-			} else {
-				r.EncodeString(`F`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeFloat64(float64(x.F))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F\"")
+				} else {
+					r.EncodeString(`F`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
 			} else {
-				r.EncodeString(`I`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"F\"")
+				} else {
+					r.EncodeString(`F`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeFloat64(float64(x.F))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -8455,22 +9854,13 @@ func (x *missingFielderT2) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "B":
 			x.B = (bool)(r.DecodeBool())
 		case "F":
@@ -8478,7 +9868,7 @@ func (x *missingFielderT2) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "I":
 			x.I = (int64)(r.DecodeInt64())
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -8490,24 +9880,15 @@ func (x *missingFielderT2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj8 int
 	var yyb8 bool
 	var yyhl8 bool = l >= 0
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
@@ -8515,11 +9896,7 @@ func (x *missingFielderT2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
 	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
@@ -8527,27 +9904,15 @@ func (x *missingFielderT2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.F = (float64)(r.DecodeFloat64())
 	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.I = (int64)(r.DecodeInt64())
-	for {
-		yyj8++
-		if yyhl8 {
-			yyb8 = yyj8 > l
-		} else {
-			yyb8 = z.DecCheckBreak()
-		}
-		if yyb8 {
-			break
-		}
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj8-1, "")
 	}
@@ -8557,10 +9922,15 @@ func (x *missingFielderT2) IsCodecEmpty() bool {
 	return !(x.S != "" || bool(x.B) || x.F != 0 || x.I != 0 || false)
 }
 
+func (testSelfExtHelper) codecSelferViaCodecgen() {}
 func (x *testSelfExtHelper) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -8578,30 +9948,57 @@ func (x *testSelfExtHelper) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(3)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I\"")
-			} else {
-				r.EncodeString(`I`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.I))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
 			} else {
-				r.EncodeString(`B`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.B))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.B))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -8637,28 +10034,19 @@ func (x *testSelfExtHelper) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.S = (string)(string(r.DecodeStringAsBytes()))
+			x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I":
 			x.I = (int64)(r.DecodeInt64())
 		case "B":
 			x.B = (bool)(r.DecodeBool())
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -8670,24 +10058,15 @@ func (x *testSelfExtHelper) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj7 int
 	var yyb7 bool
 	var yyhl7 bool = l >= 0
-	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.S = (string)(string(r.DecodeStringAsBytes()))
+	x.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
@@ -8695,27 +10074,15 @@ func (x *testSelfExtHelper) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.I = (int64)(r.DecodeInt64())
 	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.B = (bool)(r.DecodeBool())
-	for {
-		yyj7++
-		if yyhl7 {
-			yyb7 = yyj7 > l
-		} else {
-			yyb7 = z.DecCheckBreak()
-		}
-		if yyb7 {
-			break
-		}
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj7-1, "")
 	}
@@ -8725,10 +10092,15 @@ func (x *testSelfExtHelper) IsCodecEmpty() bool {
 	return !(x.S != "" || x.I != 0 || bool(x.B) || false)
 }
 
+func (TestSelfExtImpl) codecSelferViaCodecgen() {}
 func (x *TestSelfExtImpl) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -8746,30 +10118,57 @@ func (x *TestSelfExtImpl) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(3)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"S\"")
-			} else {
-				r.EncodeString(`S`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.testSelfExtHelper.S))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"I\"")
-			} else {
-				r.EncodeString(`I`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeInt(int64(x.testSelfExtHelper.I))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.testSelfExtHelper.B))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.testSelfExtHelper.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.testSelfExtHelper.S))
 			} else {
-				r.EncodeString(`B`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"S\"")
+				} else {
+					r.EncodeString(`S`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.testSelfExtHelper.S))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"I\"")
+				} else {
+					r.EncodeString(`I`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeInt(int64(x.testSelfExtHelper.I))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.testSelfExtHelper.B))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.testSelfExtHelper.B))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -8805,28 +10204,19 @@ func (x *TestSelfExtImpl) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.testSelfExtHelper.S = (string)(string(r.DecodeStringAsBytes()))
+			x.testSelfExtHelper.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I":
 			x.testSelfExtHelper.I = (int64)(r.DecodeInt64())
 		case "B":
 			x.testSelfExtHelper.B = (bool)(r.DecodeBool())
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -8838,24 +10228,15 @@ func (x *TestSelfExtImpl) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj7 int
 	var yyb7 bool
 	var yyhl7 bool = l >= 0
-	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.testSelfExtHelper.S = (string)(string(r.DecodeStringAsBytes()))
+	x.testSelfExtHelper.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
@@ -8863,27 +10244,15 @@ func (x *TestSelfExtImpl) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	x.testSelfExtHelper.I = (int64)(r.DecodeInt64())
 	yyj7++
-	if yyhl7 {
-		yyb7 = yyj7 > l
-	} else {
-		yyb7 = z.DecCheckBreak()
-	}
+	yyb7 = !z.DecContainerNext(yyj7, l, yyhl7)
 	if yyb7 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.testSelfExtHelper.B = (bool)(r.DecodeBool())
-	for {
-		yyj7++
-		if yyhl7 {
-			yyb7 = yyj7 > l
-		} else {
-			yyb7 = z.DecCheckBreak()
-		}
-		if yyb7 {
-			break
-		}
+	yyj7++
+	for ; z.DecContainerNext(yyj7, l, yyhl7); yyj7++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj7-1, "")
 	}
@@ -8893,10 +10262,15 @@ func (x *TestSelfExtImpl) IsCodecEmpty() bool {
 	return !(!(x.testSelfExtHelper.IsCodecEmpty()) || false)
 }
 
+func (TestSelfExtImpl2) codecSelferViaCodecgen() {}
 func (x *TestSelfExtImpl2) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -8912,22 +10286,41 @@ func (x *TestSelfExtImpl2) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"M\"")
-			} else {
-				r.EncodeString(`M`)
-			}
-			z.EncWriteMapElemValue()
-			r.EncodeString(string(x.M))
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"O\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"M\"")
+				} else {
+					r.EncodeString(`M`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.M))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"O\"")
+				} else {
+					r.EncodeString(`O`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.O))
 			} else {
-				r.EncodeString(`O`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"M\"")
+				} else {
+					r.EncodeString(`M`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeString(string(x.M))
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"O\"")
+				} else {
+					r.EncodeString(`O`)
+				}
+				z.EncWriteMapElemValue()
+				r.EncodeBool(bool(x.O))
 			}
-			z.EncWriteMapElemValue()
-			r.EncodeBool(bool(x.O))
 			z.EncWriteMapEnd()
 		}
 	}
@@ -8963,26 +10356,17 @@ func (x *TestSelfExtImpl2) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "M":
-			x.M = (string)(string(r.DecodeStringAsBytes()))
+			x.M = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "O":
 			x.O = (bool)(r.DecodeBool())
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -8994,40 +10378,23 @@ func (x *TestSelfExtImpl2) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj6 int
 	var yyb6 bool
 	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.M = (string)(string(r.DecodeStringAsBytes()))
+	x.M = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
-	} else {
-		yyb6 = z.DecCheckBreak()
-	}
+	yyb6 = !z.DecContainerNext(yyj6, l, yyhl6)
 	if yyb6 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.O = (bool)(r.DecodeBool())
-	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
-		} else {
-			yyb6 = z.DecCheckBreak()
-		}
-		if yyb6 {
-			break
-		}
+	yyj6++
+	for ; z.DecContainerNext(yyj6, l, yyhl6); yyj6++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj6-1, "")
 	}
@@ -9037,10 +10404,15 @@ func (x *TestSelfExtImpl2) IsCodecEmpty() bool {
 	return !(x.M != "" || bool(x.O) || false)
 }
 
+func (TestTwoNakedInterfaces) codecSelferViaCodecgen() {}
 func (x *TestTwoNakedInterfaces) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
@@ -9056,22 +10428,41 @@ func (x *TestTwoNakedInterfaces) CodecEncodeSelf(e *Encoder) {
 			z.EncWriteArrayEnd()
 		} else {
 			z.EncWriteMapStart(2)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"A\"")
-			} else {
-				r.EncodeString(`A`)
-			}
-			z.EncWriteMapElemValue()
-			z.EncFallback(x.A)
-			z.EncWriteMapElemKey()
-			if z.IsJSONHandle() {
-				z.WriteStr("\"B\"")
+			if z.EncBasicHandle().Canonical {
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.A)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.B)
 			} else {
-				r.EncodeString(`B`)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"A\"")
+				} else {
+					r.EncodeString(`A`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.A)
+				z.EncWriteMapElemKey()
+				if z.IsJSONHandle() {
+					z.EncWr().WriteStr("\"B\"")
+				} else {
+					r.EncodeString(`B`)
+				}
+				z.EncWriteMapElemValue()
+				z.EncFallback(x.B)
 			}
-			z.EncWriteMapElemValue()
-			z.EncFallback(x.B)
 			z.EncWriteMapEnd()
 		}
 	}
@@ -9107,26 +10498,17 @@ func (x *TestTwoNakedInterfaces) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "A":
 			z.DecFallback(&x.A, true)
 		case "B":
 			z.DecFallback(&x.B, true)
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -9138,12 +10520,7 @@ func (x *TestTwoNakedInterfaces) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var yyj8 int
 	var yyb8 bool
 	var yyhl8 bool = l >= 0
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
@@ -9151,27 +10528,15 @@ func (x *TestTwoNakedInterfaces) codecDecodeSelfFromArray(l int, d *Decoder) {
 	z.DecReadArrayElem()
 	z.DecFallback(&x.A, true)
 	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = z.DecCheckBreak()
-	}
+	yyb8 = !z.DecContainerNext(yyj8, l, yyhl8)
 	if yyb8 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.DecFallback(&x.B, true)
-	for {
-		yyj8++
-		if yyhl8 {
-			yyb8 = yyj8 > l
-		} else {
-			yyb8 = z.DecCheckBreak()
-		}
-		if yyb8 {
-			break
-		}
+	yyj8++
+	for ; z.DecContainerNext(yyj8, l, yyhl8); yyj8++ {
 		z.DecReadArrayElem()
 		z.DecStructFieldNotFound(yyj8-1, "")
 	}
@@ -9181,25 +10546,32 @@ func (x *TestTwoNakedInterfaces) IsCodecEmpty() bool {
 	return !(x.A != nil || x.B != nil || false)
 }
 
+func (TestStrucFlex) codecSelferViaCodecgen() {}
 func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
+	if z.EncBasicHandle().CheckCircularRef {
+		z.EncEncode(x)
+		return
+	}
 	if x == nil {
 		r.EncodeNil()
 	} else {
 		yy2arr2 := z.EncBasicHandle().StructToArray
 		_ = yy2arr2
 		const yyr2 bool = false // struct tag has 'toArray'
-		var yyn54 bool = x.TestStrucCommon.Nint64 == nil
-		var yyn72 bool = x.Ttimeptr == nil
-		var yyn73 bool = x.AnonInTestStrucIntf == nil
-		var yyn74 bool = x.AnonInTestStrucIntf == nil
-		var yyn75 bool = x.AnonInTestStrucIntf == nil
-		var yyn76 bool = x.AnonInTestStrucIntf == nil
-		var yyn77 bool = x.AnonInTestStrucIntf == nil || x.AnonInTestStrucIntf.Tptr == nil
-		var yyn81 bool = x.Nteststruc == nil
-		var yyq2 = [83]bool{ // should field at this index be written?
+		var yyn54 bool = x.TestStrucCommon.AnonInTestStrucSlim == nil || x.TestStrucCommon.AnonInTestStrucSlim.P == nil
+		var yyn55 bool = x.TestStrucCommon.NotAnonSlim == nil
+		var yyn58 bool = x.TestStrucCommon.Nint64 == nil
+		var yyn81 bool = x.Ttimeptr == nil
+		var yyn82 bool = x.AnonInTestStrucIntf == nil
+		var yyn83 bool = x.AnonInTestStrucIntf == nil
+		var yyn84 bool = x.AnonInTestStrucIntf == nil
+		var yyn85 bool = x.AnonInTestStrucIntf == nil
+		var yyn86 bool = x.AnonInTestStrucIntf == nil || x.AnonInTestStrucIntf.Tptr == nil
+		var yyn91 bool = x.Nteststruc == nil
+		var yyq2 = [95]bool{ // should field at this index be written?
 			x.S != "",                   // S
 			x.I64 != 0,                  // I64
 			x.I32 != 0,                  // I32
@@ -9219,7 +10591,7 @@ func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 			x.By != 0,                   // By
 			len(x.Sslice) != 0,          // Sslice
 			len(x.I64slice) != 0,        // I64slice
-			len(x.I16slice) != 0,        // I16slice
+			len(x.I32slice) != 0,        // I32slice
 			len(x.Ui64slice) != 0,       // Ui64slice
 			len(x.Ui8slice) != 0,        // Ui8slice
 			len(x.Bslice) != 0,          // Bslice
@@ -9228,7 +10600,7 @@ func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 			len(x.Iptrslice) != 0,       // Iptrslice
 			len(x.WrapSliceInt64) != 0,  // WrapSliceInt64
 			len(x.WrapSliceString) != 0, // WrapSliceString
-			len(x.Msi64) != 0,           // Msi64
+			len(x.Msint) != 0,           // Msint
 			len(x.Msbytes) != 0,         // Msbytes
 			!(x.Simplef.IsCodecEmpty()), // Simplef
 			len(x.SstrUi64T) != 0,       // SstrUi64T
@@ -9242,39 +10614,49 @@ func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 			len(x.AUi64slice) != 0,      // AUi64slice
 			len(x.AF64slice) != 0,       // AF64slice
 			len(x.AF32slice) != 0,       // AF32slice
-			len(x.AMSU16) != 0,          // AMSU16
-			len(x.AI64arr0) != 0,        // AI64arr0
+			len(x.AMSS) != 0,            // AMSS
+			len(x.AMSU64) != 0,          // AMSU64
+			x.AI64arr8 != [8]int64{},    // AI64arr8
+			false,                       // AI64arr0
 			len(x.AI64slice0) != 0,      // AI64slice0
 			len(x.AUi64sliceN) != 0,     // AUi64sliceN
-			len(x.AMSU16N) != 0,         // AMSU16N
-			len(x.AMSU16E) != 0,         // AMSU16E
+			len(x.AMSU64N) != 0,         // AMSU64N
+			len(x.AMSU64E) != 0,         // AMSU64E
 			!(x.NotAnon.IsCodecEmpty()), // NotAnon
-			len(x.Nmap) != 0,            // Nmap
-			len(x.Nslice) != 0,          // Nslice
-			x.Nint64 != nil,             // Nint64
-			len(x.Chstr) != 0,           // Chstr
-			len(x.Mis) != 0,             // Mis
-			len(x.Mbu64) != 0,           // Mbu64
-			len(x.Miwu64s) != 0,         // Miwu64s
-			len(x.Mfwss) != 0,           // Mfwss
-			len(x.Mf32wss) != 0,         // Mf32wss
-			len(x.Mui2wss) != 0,         // Mui2wss
-			len(x.Msu2wss) != 0,         // Msu2wss
-			x.Ci64 != 0,                 // Ci64
-			len(x.Swrapbytes) != 0,      // Swrapbytes
-			len(x.Swrapuint8) != 0,      // Swrapuint8
-			len(x.ArrStrUi64T) != 0,     // ArrStrUi64T
-			len(x.Ui64array) != 0,       // Ui64array
-			len(x.Ui64slicearray) != 0,  // Ui64slicearray
-			len(x.SintfAarray) != 0,     // SintfAarray
-			len(x.MstrUi64TSelf) != 0,   // MstrUi64TSelf
-			!(x.Ttime.IsZero()),         // Ttime
-			x.Ttimeptr != nil,           // Ttimeptr
+			x.TestStrucCommon.AnonInTestStrucSlim != nil && x.P != nil, // P
+			x.NotAnonSlim != nil,                // NotAnonSlim
+			len(x.Nmap) != 0,                    // Nmap
+			len(x.Nslice) != 0,                  // Nslice
+			x.Nint64 != nil,                     // Nint64
+			len(x.Chstr) != 0,                   // Chstr
+			len(x.Mis) != 0,                     // Mis
+			len(x.Mbu64) != 0,                   // Mbu64
+			len(x.Mu8e) != 0,                    // Mu8e
+			len(x.Mu8u64) != 0,                  // Mu8u64
+			len(x.Msp2ss) != 0,                  // Msp2ss
+			len(x.Mip2ss) != 0,                  // Mip2ss
+			len(x.Ms2misu) != 0,                 // Ms2misu
+			len(x.Miwu64s) != 0,                 // Miwu64s
+			len(x.Mfwss) != 0,                   // Mfwss
+			len(x.Mf32wss) != 0,                 // Mf32wss
+			len(x.Mui2wss) != 0,                 // Mui2wss
+			len(x.Msu2wss) != 0,                 // Msu2wss
+			x.Ci64 != 0,                         // Ci64
+			len(x.Swrapbytes) != 0,              // Swrapbytes
+			len(x.Swrapuint8) != 0,              // Swrapuint8
+			x.ArrStrUi64T != [4]stringUint64T{}, // ArrStrUi64T
+			x.Ui64array != [4]uint64{},          // Ui64array
+			len(x.Ui64slicearray) != 0,          // Ui64slicearray
+			len(x.SintfAarray) != 0,             // SintfAarray
+			len(x.MstrUi64TSelf) != 0,           // MstrUi64TSelf
+			!(x.Ttime.IsZero()),                 // Ttime
+			x.Ttimeptr != nil,                   // Ttimeptr
 			x.AnonInTestStrucIntf != nil && len(x.Islice) != 0, // Islice
 			x.AnonInTestStrucIntf != nil && len(x.Ms) != 0,     // Ms
 			x.AnonInTestStrucIntf != nil && x.Nintf != nil,     // Nintf
 			x.AnonInTestStrucIntf != nil && !(x.T.IsZero()),    // T
 			x.AnonInTestStrucIntf != nil && x.Tptr != nil,      // Tptr
+			len(x.Msu) != 0,     // Msu
 			len(x.Mtsptr) != 0,  // Mtsptr
 			len(x.Mts) != 0,     // Mts
 			len(x.Its) != 0,     // Its
@@ -9283,1930 +10665,3493 @@ func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 			x.MarT != "",        // MarT
 			len(x.MarB) != 0,    // MarB
 			x.XuintToBytes != 0, // XuintToBytes
+			x.Cmplx64 != 0,      // Cmplx64
+			x.Cmplx128 != 0,     // Cmplx128
 		}
 		_ = yyq2
 		if yyr2 || yy2arr2 {
-			z.EncWriteArrayStart(83)
+			z.EncWriteArrayStart(95)
+			z.EncWriteArrayElem()
+			if yyq2[0] {
+				r.EncodeString(string(x.TestStrucCommon.S))
+			} else {
+				r.EncodeString("")
+			}
+			z.EncWriteArrayElem()
+			if yyq2[1] {
+				r.EncodeInt(int64(x.TestStrucCommon.I64))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[2] {
+				r.EncodeInt(int64(x.TestStrucCommon.I32))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[3] {
+				r.EncodeInt(int64(x.TestStrucCommon.I16))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[4] {
+				r.EncodeInt(int64(x.TestStrucCommon.I8))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[5] {
+				r.EncodeInt(int64(x.TestStrucCommon.I64n))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[6] {
+				r.EncodeInt(int64(x.TestStrucCommon.I32n))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[7] {
+				r.EncodeInt(int64(x.TestStrucCommon.I16n))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[8] {
+				r.EncodeInt(int64(x.TestStrucCommon.I8n))
+			} else {
+				r.EncodeInt(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[9] {
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
+			} else {
+				r.EncodeUint(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[10] {
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
+			} else {
+				r.EncodeUint(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[11] {
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
+			} else {
+				r.EncodeUint(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[12] {
+				r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
+			} else {
+				r.EncodeUint(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[13] {
+				r.EncodeFloat64(float64(x.TestStrucCommon.F64))
+			} else {
+				r.EncodeFloat64(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[14] {
+				r.EncodeFloat32(float32(x.TestStrucCommon.F32))
+			} else {
+				r.EncodeFloat32(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[15] {
+				r.EncodeBool(bool(x.TestStrucCommon.B))
+			} else {
+				r.EncodeBool(false)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[16] {
+				r.EncodeUint(uint64(x.TestStrucCommon.By))
+			} else {
+				r.EncodeUint(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[17] {
+				if x.TestStrucCommon.Sslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
+				} // end block: if x.TestStrucCommon.Sslice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[18] {
+				if x.TestStrucCommon.I64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
+				} // end block: if x.TestStrucCommon.I64slice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[19] {
+				if x.TestStrucCommon.I32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+				} // end block: if x.TestStrucCommon.I32slice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[20] {
+				if x.TestStrucCommon.Ui64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
+				} // end block: if x.TestStrucCommon.Ui64slice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[21] {
+				if x.TestStrucCommon.Ui8slice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
+				} // end block: if x.TestStrucCommon.Ui8slice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[22] {
+				if x.TestStrucCommon.Bslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
+				} // end block: if x.TestStrucCommon.Bslice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[23] {
+				if x.TestStrucCommon.Byslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
+				} // end block: if x.TestStrucCommon.Byslice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[24] {
+				if x.TestStrucCommon.BytesSlice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
+				} // end block: if x.TestStrucCommon.BytesSlice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[25] {
+				if x.TestStrucCommon.Iptrslice == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
+				} // end block: if x.TestStrucCommon.Iptrslice slice == nil
+			} else {
+				r.EncodeNil()
+			}
 			z.EncWriteArrayElem()
-			if yyq2[0] {
-				r.EncodeString(string(x.TestStrucCommon.S))
+			if yyq2[26] {
+				if yyxt124 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt124 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt124)
+				} else {
+					x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeString("")
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[1] {
-				r.EncodeInt(int64(x.TestStrucCommon.I64))
+			if yyq2[27] {
+				if yyxt125 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt125 != nil {
+					z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt125)
+				} else {
+					x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[2] {
-				r.EncodeInt(int64(x.TestStrucCommon.I32))
+			if yyq2[28] {
+				if x.TestStrucCommon.Msint == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+				} // end block: if x.TestStrucCommon.Msint map == nil
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[3] {
-				r.EncodeInt(int64(x.TestStrucCommon.I16))
+			if yyq2[29] {
+				if x.TestStrucCommon.Msbytes == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
+				} // end block: if x.TestStrucCommon.Msbytes map == nil
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[4] {
-				r.EncodeInt(int64(x.TestStrucCommon.I8))
+			if yyq2[30] {
+				yy128 := &x.TestStrucCommon.Simplef
+				if yyxt129 := z.Extension(yy128); yyxt129 != nil {
+					z.EncExtension(yy128, yyxt129)
+				} else {
+					yy128.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[5] {
-				r.EncodeInt(int64(x.TestStrucCommon.I64n))
+			if yyq2[31] {
+				if x.TestStrucCommon.SstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
+				} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[6] {
-				r.EncodeInt(int64(x.TestStrucCommon.I32n))
+			if yyq2[32] {
+				if x.TestStrucCommon.MstrUi64T == nil {
+					r.EncodeNil()
+				} else {
+					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
+				} // end block: if x.TestStrucCommon.MstrUi64T map == nil
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[7] {
-				r.EncodeInt(int64(x.TestStrucCommon.I16n))
+			if yyq2[33] {
+				r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
+			} else {
+				r.EncodeString("")
+			}
+			z.EncWriteArrayElem()
+			if yyq2[34] {
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
 			} else {
 				r.EncodeInt(0)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[8] {
-				r.EncodeInt(int64(x.TestStrucCommon.I8n))
+			if yyq2[35] {
+				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
 			} else {
 				r.EncodeInt(0)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[9] {
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
+			if yyq2[36] {
+				r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
 			} else {
 				r.EncodeUint(0)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[10] {
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
+			if yyq2[37] {
+				if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
 			} else {
-				r.EncodeUint(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[11] {
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
+			if yyq2[38] {
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
 			} else {
-				r.EncodeUint(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[12] {
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
+			if yyq2[39] {
+				if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
 			} else {
-				r.EncodeUint(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[13] {
-				r.EncodeFloat64(float64(x.TestStrucCommon.F64))
+			if yyq2[40] {
+				if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
 			} else {
-				r.EncodeFloat64(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[14] {
-				r.EncodeFloat32(float32(x.TestStrucCommon.F32))
+			if yyq2[41] {
+				if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
 			} else {
-				r.EncodeFloat32(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[15] {
-				r.EncodeBool(bool(x.TestStrucCommon.B))
+			if yyq2[42] {
+				if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
 			} else {
-				r.EncodeBool(false)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[16] {
-				r.EncodeUint(uint64(x.TestStrucCommon.By))
+			if yyq2[43] {
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
 			} else {
-				r.EncodeUint(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[17] {
-				if x.TestStrucCommon.Sslice == nil {
+			if yyq2[44] {
+				yy143 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+				h.encArray8int64((*[8]int64)(yy143), e)
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[45] {
+				yy145 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+				h.encArray0int64((*[0]int64)(yy145), e)
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[46] {
+				if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
-				} // end block: if x.TestStrucCommon.Sslice slice == nil
+					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[18] {
-				if x.TestStrucCommon.I64slice == nil {
+			if yyq2[47] {
+				if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
-				} // end block: if x.TestStrucCommon.I64slice slice == nil
+					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[48] {
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[49] {
+				if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[50] {
+				yy151 := &x.TestStrucCommon.NotAnon
+				if yyxt152 := z.Extension(yy151); yyxt152 != nil {
+					z.EncExtension(yy151, yyxt152)
+				} else {
+					yy151.CodecEncodeSelf(e)
+				}
+			} else {
+				r.EncodeNil()
+			}
+			if yyn54 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[51] {
+					yy153 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+					r.EncodeString(string(yy153))
+				} else {
+					r.EncodeNil()
+				}
+			}
+			if yyn55 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[52] {
+					if yyxt155 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt155 != nil {
+						z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt155)
+					} else {
+						x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			}
+			z.EncWriteArrayElem()
+			if yyq2[53] {
+				if x.TestStrucCommon.Nmap == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
+				} // end block: if x.TestStrucCommon.Nmap map == nil
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[54] {
+				if x.TestStrucCommon.Nslice == nil {
+					r.EncodeNil()
+				} else {
+					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
+				} // end block: if x.TestStrucCommon.Nslice slice == nil
+			} else {
+				r.EncodeNil()
+			}
+			if yyn58 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[55] {
+					yy158 := *x.TestStrucCommon.Nint64
+					r.EncodeInt(int64(yy158))
+				} else {
+					r.EncodeNil()
+				}
+			}
+			z.EncWriteArrayElem()
+			if yyq2[56] {
+				h.encChanstring((chan string)(x.Chstr), e)
+			} else {
+				r.EncodeNil()
+			}
+			z.EncWriteArrayElem()
+			if yyq2[57] {
+				if x.Mis == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncMapIntStringV(x.Mis, e)
+				} // end block: if x.Mis map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[19] {
-				if x.TestStrucCommon.I16slice == nil {
+			if yyq2[58] {
+				if x.Mbu64 == nil {
 					r.EncodeNil()
 				} else {
-					h.encSliceint16(([]int16)(x.TestStrucCommon.I16slice), e)
-				} // end block: if x.TestStrucCommon.I16slice slice == nil
+					h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
+				} // end block: if x.Mbu64 map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[20] {
-				if x.TestStrucCommon.Ui64slice == nil {
+			if yyq2[59] {
+				if x.Mu8e == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
-				} // end block: if x.TestStrucCommon.Ui64slice slice == nil
+					h.encMapuint8c3RydWN0IHt9((map[uint8]struct{})(x.Mu8e), e)
+				} // end block: if x.Mu8e map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[21] {
-				if x.TestStrucCommon.Ui8slice == nil {
+			if yyq2[60] {
+				if x.Mu8u64 == nil {
 					r.EncodeNil()
 				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
-				} // end block: if x.TestStrucCommon.Ui8slice slice == nil
+					h.encMapuint8stringUint64T((map[uint8]stringUint64T)(x.Mu8u64), e)
+				} // end block: if x.Mu8u64 map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[22] {
-				if x.TestStrucCommon.Bslice == nil {
+			if yyq2[61] {
+				if x.Msp2ss == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
-				} // end block: if x.TestStrucCommon.Bslice slice == nil
+					h.encMapPtrtostringSlicestring((map[*string][]string)(x.Msp2ss), e)
+				} // end block: if x.Msp2ss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[23] {
-				if x.TestStrucCommon.Byslice == nil {
+			if yyq2[62] {
+				if x.Mip2ss == nil {
 					r.EncodeNil()
 				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
-				} // end block: if x.TestStrucCommon.Byslice slice == nil
+					h.encMapPtrtouint64Slicestring((map[*uint64][]string)(x.Mip2ss), e)
+				} // end block: if x.Mip2ss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[24] {
-				if x.TestStrucCommon.BytesSlice == nil {
+			if yyq2[63] {
+				if x.Ms2misu == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
-				} // end block: if x.TestStrucCommon.BytesSlice slice == nil
+					h.encMapstringMapuint64stringUint64T((map[string]map[uint64]stringUint64T)(x.Ms2misu), e)
+				} // end block: if x.Ms2misu map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[25] {
-				if x.TestStrucCommon.Iptrslice == nil {
+			if yyq2[64] {
+				if x.Miwu64s == nil {
 					r.EncodeNil()
 				} else {
-					h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
-				} // end block: if x.TestStrucCommon.Iptrslice slice == nil
+					h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+				} // end block: if x.Miwu64s map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[26] {
-				if yyxt112 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt112 != nil {
-					z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt112)
+			if yyq2[65] {
+				if x.Mfwss == nil {
+					r.EncodeNil()
 				} else {
-					x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
-				}
+					h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+				} // end block: if x.Mfwss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[27] {
-				if yyxt113 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt113 != nil {
-					z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt113)
+			if yyq2[66] {
+				if x.Mf32wss == nil {
+					r.EncodeNil()
 				} else {
-					x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
-				}
+					h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+				} // end block: if x.Mf32wss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[28] {
-				if x.TestStrucCommon.Msi64 == nil {
+			if yyq2[67] {
+				if x.Mui2wss == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringInt64V(x.TestStrucCommon.Msi64, e)
-				} // end block: if x.TestStrucCommon.Msi64 map == nil
+					h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+				} // end block: if x.Mui2wss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[29] {
-				if x.TestStrucCommon.Msbytes == nil {
+			if yyq2[68] {
+				if x.Msu2wss == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
-				} // end block: if x.TestStrucCommon.Msbytes map == nil
+					h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+				} // end block: if x.Msu2wss map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[30] {
-				yy116 := &x.TestStrucCommon.Simplef
-				if yyxt117 := z.Extension(yy116); yyxt117 != nil {
-					z.EncExtension(yy116, yyxt117)
+			if yyq2[69] {
+				if yyxt173 := z.Extension(x.Ci64); yyxt173 != nil {
+					z.EncExtension(x.Ci64, yyxt173)
 				} else {
-					yy116.CodecEncodeSelf(e)
+					x.Ci64.CodecEncodeSelf(e)
 				}
 			} else {
-				r.EncodeNil()
+				r.EncodeInt(0)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[31] {
-				if x.TestStrucCommon.SstrUi64T == nil {
+			if yyq2[70] {
+				if x.Swrapbytes == nil {
 					r.EncodeNil()
 				} else {
-					h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
-				} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
+					h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+				} // end block: if x.Swrapbytes slice == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[32] {
-				if x.TestStrucCommon.MstrUi64T == nil {
+			if yyq2[71] {
+				if x.Swrapuint8 == nil {
 					r.EncodeNil()
 				} else {
-					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
-				} // end block: if x.TestStrucCommon.MstrUi64T map == nil
+					h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+				} // end block: if x.Swrapuint8 slice == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[33] {
-				r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
+			if yyq2[72] {
+				yy176 := &x.ArrStrUi64T
+				h.encArray4stringUint64T((*[4]stringUint64T)(yy176), e)
 			} else {
-				r.EncodeString("")
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[34] {
-				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
+			if yyq2[73] {
+				yy178 := &x.Ui64array
+				h.encArray4uint64((*[4]uint64)(yy178), e)
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[35] {
-				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
+			if yyq2[74] {
+				if x.Ui64slicearray == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
+				} // end block: if x.Ui64slicearray slice == nil
 			} else {
-				r.EncodeInt(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[36] {
-				r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
+			if yyq2[75] {
+				if x.SintfAarray == nil {
+					r.EncodeNil()
+				} else {
+					z.F.EncSliceIntfV(x.SintfAarray, e)
+				} // end block: if x.SintfAarray slice == nil
 			} else {
-				r.EncodeUint(0)
+				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[37] {
-				if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+			if yyq2[76] {
+				if x.MstrUi64TSelf == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
+					h.encMapstringUint64TPtrtostringUint64T((map[stringUint64T]*stringUint64T)(x.MstrUi64TSelf), e)
+				} // end block: if x.MstrUi64TSelf map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[38] {
-				if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
-					r.EncodeNil()
+			if yyq2[77] {
+				if z.EncBasicHandle().TimeBuiltin() {
+					r.EncodeTime(x.Ttime)
+				} else if yyxt183 := z.Extension(x.Ttime); yyxt183 != nil {
+					z.EncExtension(x.Ttime, yyxt183)
+				} else if z.EncBinary() {
+					z.EncBinaryMarshal(x.Ttime)
+				} else if !z.EncBinary() && z.IsJSONHandle() {
+					z.EncJSONMarshal(x.Ttime)
 				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
+					z.EncFallback(x.Ttime)
+				}
 			} else {
 				r.EncodeNil()
 			}
-			z.EncWriteArrayElem()
-			if yyq2[39] {
-				if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+			if yyn81 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[78] {
+					yy184 := *x.Ttimeptr
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(yy184)
+					} else if yyxt185 := z.Extension(yy184); yyxt185 != nil {
+						z.EncExtension(yy184, yyxt185)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(yy184)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy184)
+					} else {
+						z.EncFallback(yy184)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			}
+			if yyn82 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[79] {
+					if x.AnonInTestStrucIntf.Islice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntfV(x.AnonInTestStrucIntf.Islice, e)
+					} // end block: if x.AnonInTestStrucIntf.Islice slice == nil
+				} else {
+					r.EncodeNil()
+				}
+			}
+			if yyn83 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[80] {
+					if x.AnonInTestStrucIntf.Ms == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntfV(x.AnonInTestStrucIntf.Ms, e)
+					} // end block: if x.AnonInTestStrucIntf.Ms map == nil
+				} else {
 					r.EncodeNil()
+				}
+			}
+			if yyn84 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[81] {
+					z.EncFallback(x.AnonInTestStrucIntf.Nintf)
 				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
+					r.EncodeNil()
+				}
+			}
+			if yyn85 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
 			} else {
+				z.EncWriteArrayElem()
+				if yyq2[82] {
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(x.AnonInTestStrucIntf.T)
+					} else if yyxt189 := z.Extension(x.AnonInTestStrucIntf.T); yyxt189 != nil {
+						z.EncExtension(x.AnonInTestStrucIntf.T, yyxt189)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(x.AnonInTestStrucIntf.T)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(x.AnonInTestStrucIntf.T)
+					} else {
+						z.EncFallback(x.AnonInTestStrucIntf.T)
+					}
+				} else {
+					r.EncodeNil()
+				}
+			}
+			if yyn86 {
+				z.EncWriteArrayElem()
 				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[83] {
+					yy190 := *x.AnonInTestStrucIntf.Tptr
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(yy190)
+					} else if yyxt191 := z.Extension(yy190); yyxt191 != nil {
+						z.EncExtension(yy190, yyxt191)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(yy190)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(yy190)
+					} else {
+						z.EncFallback(yy190)
+					}
+				} else {
+					r.EncodeNil()
+				}
 			}
 			z.EncWriteArrayElem()
-			if yyq2[40] {
-				if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+			if yyq2[84] {
+				if x.Msu == nil {
 					r.EncodeNil()
 				} else {
-					z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
+					h.encMapwrapStringInterface((map[wrapString]interface{})(x.Msu), e)
+				} // end block: if x.Msu map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[41] {
-				if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+			if yyq2[85] {
+				if x.Mtsptr == nil {
 					r.EncodeNil()
 				} else {
-					h.encSlicefloat32(([]float32)(x.TestStrucCommon.AnonInTestStruc.AF32slice), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
+					h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+				} // end block: if x.Mtsptr map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[42] {
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16 == nil {
+			if yyq2[86] {
+				if x.Mts == nil {
 					r.EncodeNil()
 				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16 map == nil
+					h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+				} // end block: if x.Mts map == nil
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[43] {
-				yy130 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
-				h.encArray0int64((*[0]int64)(yy130), e)
+			if yyq2[87] {
+				if x.Its == nil {
+					r.EncodeNil()
+				} else {
+					h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+				} // end block: if x.Its slice == nil
 			} else {
 				r.EncodeNil()
 			}
-			z.EncWriteArrayElem()
-			if yyq2[44] {
-				if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
+			if yyn91 {
+				z.EncWriteArrayElem()
+				r.EncodeNil()
+			} else {
+				z.EncWriteArrayElem()
+				if yyq2[88] {
+					if yyxt196 := z.Extension(x.Nteststruc); yyxt196 != nil {
+						z.EncExtension(x.Nteststruc, yyxt196)
+					} else {
+						x.Nteststruc.CodecEncodeSelf(e)
+					}
+				} else {
 					r.EncodeNil()
+				}
+			}
+			z.EncWriteArrayElem()
+			if yyq2[89] {
+				if yyxt197 := z.Extension(x.MarJ); yyxt197 != nil {
+					z.EncExtension(x.MarJ, yyxt197)
 				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
+					x.MarJ.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeNil()
+				r.EncodeBool(false)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[45] {
-				if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
-					r.EncodeNil()
+			if yyq2[90] {
+				if yyxt198 := z.Extension(x.MarT); yyxt198 != nil {
+					z.EncExtension(x.MarT, yyxt198)
 				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
+					x.MarT.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeNil()
+				r.EncodeString("")
 			}
 			z.EncWriteArrayElem()
-			if yyq2[46] {
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16N == nil {
-					r.EncodeNil()
+			if yyq2[91] {
+				if yyxt199 := z.Extension(x.MarB); yyxt199 != nil {
+					z.EncExtension(x.MarB, yyxt199)
 				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16N), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16N map == nil
+					x.MarB.CodecEncodeSelf(e)
+				}
 			} else {
 				r.EncodeNil()
 			}
 			z.EncWriteArrayElem()
-			if yyq2[47] {
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16E == nil {
-					r.EncodeNil()
+			if yyq2[92] {
+				if yyxt200 := z.Extension(x.XuintToBytes); yyxt200 != nil {
+					z.EncExtension(x.XuintToBytes, yyxt200)
 				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16E), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16E map == nil
+					x.XuintToBytes.CodecEncodeSelf(e)
+				}
 			} else {
-				r.EncodeNil()
+				r.EncodeUint(0)
 			}
 			z.EncWriteArrayElem()
-			if yyq2[48] {
-				yy136 := &x.TestStrucCommon.NotAnon
-				if yyxt137 := z.Extension(yy136); yyxt137 != nil {
-					z.EncExtension(yy136, yyxt137)
-				} else {
-					yy136.CodecEncodeSelf(e)
+			if yyq2[93] {
+				z.EncEncodeComplex64(complex64(x.Cmplx64))
+			} else {
+				z.EncEncodeComplex64(0)
+			}
+			z.EncWriteArrayElem()
+			if yyq2[94] {
+				z.EncEncodeComplex128(complex128(x.Cmplx128))
+			} else {
+				z.EncEncodeComplex128(0)
+			}
+			z.EncWriteArrayEnd()
+		} else {
+			var yynn2 int
+			for _, b := range yyq2 {
+				if b {
+					yynn2++
+				}
+			}
+			z.EncWriteMapStart(yynn2)
+			yynn2 = 0
+			if z.EncBasicHandle().Canonical {
+				if yyq2[41] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AF32slice\"")
+					} else {
+						r.EncodeString(`AF32slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
+				}
+				if yyq2[40] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AF64slice\"")
+					} else {
+						r.EncodeString(`AF64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
+				}
+				if yyq2[35] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI16\"")
+					} else {
+						r.EncodeString(`AI16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
+				}
+				if yyq2[34] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64\"")
+					} else {
+						r.EncodeString(`AI64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
+				}
+				if yyq2[45] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64arr0\"")
+					} else {
+						r.EncodeString(`AI64arr0`)
+					}
+					z.EncWriteMapElemValue()
+					yy207 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+					h.encArray0int64((*[0]int64)(yy207), e)
+				}
+				if yyq2[44] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64arr8\"")
+					} else {
+						r.EncodeString(`AI64arr8`)
+					}
+					z.EncWriteMapElemValue()
+					yy209 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+					h.encArray8int64((*[8]int64)(yy209), e)
+				}
+				if yyq2[38] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64slice\"")
+					} else {
+						r.EncodeString(`AI64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
+				}
+				if yyq2[46] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64slice0\"")
+					} else {
+						r.EncodeString(`AI64slice0`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
+				}
+				if yyq2[42] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSS\"")
+					} else {
+						r.EncodeString(`AMSS`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
+				}
+				if yyq2[43] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64\"")
+					} else {
+						r.EncodeString(`AMSU64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
+				}
+				if yyq2[49] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64E\"")
+					} else {
+						r.EncodeString(`AMSU64E`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
+				}
+				if yyq2[48] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64N\"")
+					} else {
+						r.EncodeString(`AMSU64N`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
+				}
+				if yyq2[33] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AS\"")
+					} else {
+						r.EncodeString(`AS`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
+				}
+				if yyq2[37] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"ASslice\"")
+					} else {
+						r.EncodeString(`ASslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
+				}
+				if yyq2[36] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64\"")
+					} else {
+						r.EncodeString(`AUi64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
+				}
+				if yyq2[39] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64slice\"")
+					} else {
+						r.EncodeString(`AUi64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
+				}
+				if yyq2[47] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64sliceN\"")
+					} else {
+						r.EncodeString(`AUi64sliceN`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
+				}
+				if yyq2[72] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"ArrStrUi64T\"")
+					} else {
+						r.EncodeString(`ArrStrUi64T`)
+					}
+					z.EncWriteMapElemValue()
+					yy222 := &x.ArrStrUi64T
+					h.encArray4stringUint64T((*[4]stringUint64T)(yy222), e)
+				}
+				if yyq2[15] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"B\"")
+					} else {
+						r.EncodeString(`B`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeBool(bool(x.TestStrucCommon.B))
+				}
+				if yyq2[22] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Bslice\"")
+					} else {
+						r.EncodeString(`Bslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
+					} // end block: if x.TestStrucCommon.Bslice slice == nil
+				}
+				if yyq2[16] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"By\"")
+					} else {
+						r.EncodeString(`By`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.By))
+				}
+				if yyq2[23] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Byslice\"")
+					} else {
+						r.EncodeString(`Byslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Byslice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
+					} // end block: if x.TestStrucCommon.Byslice slice == nil
+				}
+				if yyq2[24] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"BytesSlice\"")
+					} else {
+						r.EncodeString(`BytesSlice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.BytesSlice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
+					} // end block: if x.TestStrucCommon.BytesSlice slice == nil
+				}
+				if yyq2[56] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Chstr\"")
+					} else {
+						r.EncodeString(`Chstr`)
+					}
+					z.EncWriteMapElemValue()
+					h.encChanstring((chan string)(x.Chstr), e)
+				}
+				if yyq2[69] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ci64\"")
+					} else {
+						r.EncodeString(`Ci64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt230 := z.Extension(x.Ci64); yyxt230 != nil {
+						z.EncExtension(x.Ci64, yyxt230)
+					} else {
+						x.Ci64.CodecEncodeSelf(e)
+					}
+				}
+				if yyq2[94] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Cmplx128\"")
+					} else {
+						r.EncodeString(`Cmplx128`)
+					}
+					z.EncWriteMapElemValue()
+					z.EncEncodeComplex128(complex128(x.Cmplx128))
+				}
+				if yyq2[93] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Cmplx64\"")
+					} else {
+						r.EncodeString(`Cmplx64`)
+					}
+					z.EncWriteMapElemValue()
+					z.EncEncodeComplex64(complex64(x.Cmplx64))
+				}
+				if yyq2[14] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"F32\"")
+					} else {
+						r.EncodeString(`F32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeFloat32(float32(x.TestStrucCommon.F32))
+				}
+				if yyq2[13] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"F64\"")
+					} else {
+						r.EncodeString(`F64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeFloat64(float64(x.TestStrucCommon.F64))
+				}
+				if yyq2[3] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I16\"")
+					} else {
+						r.EncodeString(`I16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I16))
+				}
+				if yyq2[7] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I16n\"")
+					} else {
+						r.EncodeString(`I16n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I16n))
+				}
+				if yyq2[2] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32\"")
+					} else {
+						r.EncodeString(`I32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I32))
+				}
+				if yyq2[6] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32n\"")
+					} else {
+						r.EncodeString(`I32n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I32n))
+				}
+				if yyq2[19] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32slice\"")
+					} else {
+						r.EncodeString(`I32slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.I32slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+					} // end block: if x.TestStrucCommon.I32slice slice == nil
+				}
+				if yyq2[1] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64\"")
+					} else {
+						r.EncodeString(`I64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I64))
+				}
+				if yyq2[5] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64n\"")
+					} else {
+						r.EncodeString(`I64n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I64n))
+				}
+				if yyq2[18] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64slice\"")
+					} else {
+						r.EncodeString(`I64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.I64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
+					} // end block: if x.TestStrucCommon.I64slice slice == nil
+				}
+				if yyq2[4] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I8\"")
+					} else {
+						r.EncodeString(`I8`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I8))
+				}
+				if yyq2[8] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I8n\"")
+					} else {
+						r.EncodeString(`I8n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I8n))
+				}
+				if yyq2[25] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Iptrslice\"")
+					} else {
+						r.EncodeString(`Iptrslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
+					} // end block: if x.TestStrucCommon.Iptrslice slice == nil
+				}
+				if yyq2[79] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Islice\"")
+					} else {
+						r.EncodeString(`Islice`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn82 {
+						r.EncodeNil()
+					} else {
+						if x.AnonInTestStrucIntf.Islice == nil {
+							r.EncodeNil()
+						} else {
+							z.F.EncSliceIntfV(x.AnonInTestStrucIntf.Islice, e)
+						} // end block: if x.AnonInTestStrucIntf.Islice slice == nil
+					}
+				}
+				if yyq2[87] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Its\"")
+					} else {
+						r.EncodeString(`Its`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Its == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+					} // end block: if x.Its slice == nil
+				}
+				if yyq2[91] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarB\"")
+					} else {
+						r.EncodeString(`MarB`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt248 := z.Extension(x.MarB); yyxt248 != nil {
+						z.EncExtension(x.MarB, yyxt248)
+					} else {
+						x.MarB.CodecEncodeSelf(e)
+					}
+				}
+				if yyq2[89] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarJ\"")
+					} else {
+						r.EncodeString(`MarJ`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt249 := z.Extension(x.MarJ); yyxt249 != nil {
+						z.EncExtension(x.MarJ, yyxt249)
+					} else {
+						x.MarJ.CodecEncodeSelf(e)
+					}
+				}
+				if yyq2[90] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarT\"")
+					} else {
+						r.EncodeString(`MarT`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt250 := z.Extension(x.MarT); yyxt250 != nil {
+						z.EncExtension(x.MarT, yyxt250)
+					} else {
+						x.MarT.CodecEncodeSelf(e)
+					}
+				}
+				if yyq2[58] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mbu64\"")
+					} else {
+						r.EncodeString(`Mbu64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mbu64 == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
+					} // end block: if x.Mbu64 map == nil
+				}
+				if yyq2[66] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mf32wss\"")
+					} else {
+						r.EncodeString(`Mf32wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mf32wss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+					} // end block: if x.Mf32wss map == nil
+				}
+				if yyq2[65] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mfwss\"")
+					} else {
+						r.EncodeString(`Mfwss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mfwss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+					} // end block: if x.Mfwss map == nil
+				}
+				if yyq2[62] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mip2ss\"")
+					} else {
+						r.EncodeString(`Mip2ss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mip2ss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapPtrtouint64Slicestring((map[*uint64][]string)(x.Mip2ss), e)
+					} // end block: if x.Mip2ss map == nil
+				}
+				if yyq2[57] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mis\"")
+					} else {
+						r.EncodeString(`Mis`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mis == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntStringV(x.Mis, e)
+					} // end block: if x.Mis map == nil
+				}
+				if yyq2[64] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Miwu64s\"")
+					} else {
+						r.EncodeString(`Miwu64s`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Miwu64s == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+					} // end block: if x.Miwu64s map == nil
+				}
+				if yyq2[80] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ms\"")
+					} else {
+						r.EncodeString(`Ms`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn83 {
+						r.EncodeNil()
+					} else {
+						if x.AnonInTestStrucIntf.Ms == nil {
+							r.EncodeNil()
+						} else {
+							z.F.EncMapStringIntfV(x.AnonInTestStrucIntf.Ms, e)
+						} // end block: if x.AnonInTestStrucIntf.Ms map == nil
+					}
+				}
+				if yyq2[63] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ms2misu\"")
+					} else {
+						r.EncodeString(`Ms2misu`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Ms2misu == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringMapuint64stringUint64T((map[string]map[uint64]stringUint64T)(x.Ms2misu), e)
+					} // end block: if x.Ms2misu map == nil
 				}
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[49] {
-				if x.TestStrucCommon.Nmap == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
-				} // end block: if x.TestStrucCommon.Nmap map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[50] {
-				if x.TestStrucCommon.Nslice == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
-				} // end block: if x.TestStrucCommon.Nslice slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			if yyn54 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[51] {
-					yy140 := *x.TestStrucCommon.Nint64
-					r.EncodeInt(int64(yy140))
-				} else {
-					r.EncodeNil()
+				if yyq2[29] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msbytes\"")
+					} else {
+						r.EncodeString(`Msbytes`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Msbytes == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
+					} // end block: if x.TestStrucCommon.Msbytes map == nil
 				}
-			}
-			z.EncWriteArrayElem()
-			if yyq2[52] {
-				h.encChanstring((chan string)(x.Chstr), e)
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[53] {
-				if x.Mis == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapIntStringV(x.Mis, e)
-				} // end block: if x.Mis map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[54] {
-				if x.Mbu64 == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
-				} // end block: if x.Mbu64 map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[55] {
-				if x.Miwu64s == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
-				} // end block: if x.Miwu64s map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[56] {
-				if x.Mfwss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
-				} // end block: if x.Mfwss map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[57] {
-				if x.Mf32wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
-				} // end block: if x.Mf32wss map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[58] {
-				if x.Mui2wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
-				} // end block: if x.Mui2wss map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[59] {
-				if x.Msu2wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
-				} // end block: if x.Msu2wss map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[60] {
-				if yyxt150 := z.Extension(x.Ci64); yyxt150 != nil {
-					z.EncExtension(x.Ci64, yyxt150)
-				} else {
-					x.Ci64.CodecEncodeSelf(e)
+				if yyq2[28] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msint\"")
+					} else {
+						r.EncodeString(`Msint`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Msint == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+					} // end block: if x.TestStrucCommon.Msint map == nil
 				}
-			} else {
-				r.EncodeInt(0)
-			}
-			z.EncWriteArrayElem()
-			if yyq2[61] {
-				if x.Swrapbytes == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
-				} // end block: if x.Swrapbytes slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[62] {
-				if x.Swrapuint8 == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
-				} // end block: if x.Swrapuint8 slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[63] {
-				yy153 := &x.ArrStrUi64T
-				h.encArray4stringUint64T((*[4]stringUint64T)(yy153), e)
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[64] {
-				yy155 := &x.Ui64array
-				h.encArray4uint64((*[4]uint64)(yy155), e)
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[65] {
-				if x.Ui64slicearray == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
-				} // end block: if x.Ui64slicearray slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[66] {
-				if x.SintfAarray == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceIntfV(x.SintfAarray, e)
-				} // end block: if x.SintfAarray slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[67] {
-				if x.MstrUi64TSelf == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringUint64TPtrtostringUint64T((map[stringUint64T]*stringUint64T)(x.MstrUi64TSelf), e)
-				} // end block: if x.MstrUi64TSelf map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[68] {
-				if !z.EncBasicHandle().TimeNotBuiltin {
-					r.EncodeTime(x.Ttime)
-				} else if yyxt160 := z.Extension(x.Ttime); yyxt160 != nil {
-					z.EncExtension(x.Ttime, yyxt160)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.Ttime)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.Ttime)
-				} else {
-					z.EncFallback(x.Ttime)
+				if yyq2[61] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msp2ss\"")
+					} else {
+						r.EncodeString(`Msp2ss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msp2ss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapPtrtostringSlicestring((map[*string][]string)(x.Msp2ss), e)
+					} // end block: if x.Msp2ss map == nil
 				}
-			} else {
-				r.EncodeNil()
-			}
-			if yyn72 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[69] {
-					yy161 := *x.Ttimeptr
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(yy161)
-					} else if yyxt162 := z.Extension(yy161); yyxt162 != nil {
-						z.EncExtension(yy161, yyxt162)
-					} else if z.EncBinary() {
-						z.EncBinaryMarshal(yy161)
-					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy161)
+				if yyq2[32] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MstrUi64T\"")
 					} else {
-						z.EncFallback(yy161)
+						r.EncodeString(`MstrUi64T`)
 					}
-				} else {
-					r.EncodeNil()
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.MstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
+					} // end block: if x.TestStrucCommon.MstrUi64T map == nil
 				}
-			}
-			if yyn73 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[70] {
-					if x.AnonInTestStrucIntf.Islice == nil {
+				if yyq2[76] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MstrUi64TSelf\"")
+					} else {
+						r.EncodeString(`MstrUi64TSelf`)
+					}
+					z.EncWriteMapElemValue()
+					if x.MstrUi64TSelf == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringUint64TPtrtostringUint64T((map[stringUint64T]*stringUint64T)(x.MstrUi64TSelf), e)
+					} // end block: if x.MstrUi64TSelf map == nil
+				}
+				if yyq2[84] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msu\"")
+					} else {
+						r.EncodeString(`Msu`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msu == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapwrapStringInterface((map[wrapString]interface{})(x.Msu), e)
+					} // end block: if x.Msu map == nil
+				}
+				if yyq2[68] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msu2wss\"")
+					} else {
+						r.EncodeString(`Msu2wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msu2wss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+					} // end block: if x.Msu2wss map == nil
+				}
+				if yyq2[86] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mts\"")
+					} else {
+						r.EncodeString(`Mts`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mts == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+					} // end block: if x.Mts map == nil
+				}
+				if yyq2[85] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mtsptr\"")
+					} else {
+						r.EncodeString(`Mtsptr`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mtsptr == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+					} // end block: if x.Mtsptr map == nil
+				}
+				if yyq2[59] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mu8e\"")
+					} else {
+						r.EncodeString(`Mu8e`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mu8e == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint8c3RydWN0IHt9((map[uint8]struct{})(x.Mu8e), e)
+					} // end block: if x.Mu8e map == nil
+				}
+				if yyq2[60] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mu8u64\"")
+					} else {
+						r.EncodeString(`Mu8u64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mu8u64 == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint8stringUint64T((map[uint8]stringUint64T)(x.Mu8u64), e)
+					} // end block: if x.Mu8u64 map == nil
+				}
+				if yyq2[67] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mui2wss\"")
+					} else {
+						r.EncodeString(`Mui2wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mui2wss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+					} // end block: if x.Mui2wss map == nil
+				}
+				if yyq2[55] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nint64\"")
+					} else {
+						r.EncodeString(`Nint64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn58 {
 						r.EncodeNil()
 					} else {
-						z.F.EncSliceIntfV(x.AnonInTestStrucIntf.Islice, e)
-					} // end block: if x.AnonInTestStrucIntf.Islice slice == nil
-				} else {
-					r.EncodeNil()
+						yy271 := *x.TestStrucCommon.Nint64
+						r.EncodeInt(int64(yy271))
+					}
 				}
-			}
-			if yyn74 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[71] {
-					if x.AnonInTestStrucIntf.Ms == nil {
+				if yyq2[81] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nintf\"")
+					} else {
+						r.EncodeString(`Nintf`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn84 {
 						r.EncodeNil()
 					} else {
-						z.F.EncMapStringIntfV(x.AnonInTestStrucIntf.Ms, e)
-					} // end block: if x.AnonInTestStrucIntf.Ms map == nil
-				} else {
-					r.EncodeNil()
+						z.EncFallback(x.AnonInTestStrucIntf.Nintf)
+					}
 				}
-			}
-			if yyn75 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[72] {
-					z.EncFallback(x.AnonInTestStrucIntf.Nintf)
-				} else {
-					r.EncodeNil()
+				if yyq2[53] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nmap\"")
+					} else {
+						r.EncodeString(`Nmap`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
+					} // end block: if x.TestStrucCommon.Nmap map == nil
 				}
-			}
-			if yyn76 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[73] {
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(x.AnonInTestStrucIntf.T)
-					} else if yyxt166 := z.Extension(x.AnonInTestStrucIntf.T); yyxt166 != nil {
-						z.EncExtension(x.AnonInTestStrucIntf.T, yyxt166)
-					} else if z.EncBinary() {
-						z.EncBinaryMarshal(x.AnonInTestStrucIntf.T)
-					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(x.AnonInTestStrucIntf.T)
+				if yyq2[50] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"NotAnon\"")
 					} else {
-						z.EncFallback(x.AnonInTestStrucIntf.T)
+						r.EncodeString(`NotAnon`)
+					}
+					z.EncWriteMapElemValue()
+					yy275 := &x.TestStrucCommon.NotAnon
+					if yyxt276 := z.Extension(yy275); yyxt276 != nil {
+						z.EncExtension(yy275, yyxt276)
+					} else {
+						yy275.CodecEncodeSelf(e)
 					}
-				} else {
-					r.EncodeNil()
 				}
-			}
-			if yyn77 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[74] {
-					yy167 := *x.AnonInTestStrucIntf.Tptr
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(yy167)
-					} else if yyxt168 := z.Extension(yy167); yyxt168 != nil {
-						z.EncExtension(yy167, yyxt168)
-					} else if z.EncBinary() {
-						z.EncBinaryMarshal(yy167)
-					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy167)
+				if yyq2[52] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"NotAnonSlim\"")
 					} else {
-						z.EncFallback(yy167)
+						r.EncodeString(`NotAnonSlim`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn55 {
+						r.EncodeNil()
+					} else {
+						if yyxt277 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt277 != nil {
+							z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt277)
+						} else {
+							x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			}
-			z.EncWriteArrayElem()
-			if yyq2[75] {
-				if x.Mtsptr == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
-				} // end block: if x.Mtsptr map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[76] {
-				if x.Mts == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
-				} // end block: if x.Mts map == nil
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[77] {
-				if x.Its == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
-				} // end block: if x.Its slice == nil
-			} else {
-				r.EncodeNil()
-			}
-			if yyn81 {
-				z.EncWriteArrayElem()
-				r.EncodeNil()
-			} else {
-				z.EncWriteArrayElem()
-				if yyq2[78] {
-					if yyxt172 := z.Extension(x.Nteststruc); yyxt172 != nil {
-						z.EncExtension(x.Nteststruc, yyxt172)
+				if yyq2[54] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nslice\"")
 					} else {
-						x.Nteststruc.CodecEncodeSelf(e)
+						r.EncodeString(`Nslice`)
 					}
-				} else {
-					r.EncodeNil()
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
+					} // end block: if x.TestStrucCommon.Nslice slice == nil
 				}
-			}
-			z.EncWriteArrayElem()
-			if yyq2[79] {
-				if yyxt173 := z.Extension(x.MarJ); yyxt173 != nil {
-					z.EncExtension(x.MarJ, yyxt173)
-				} else {
-					x.MarJ.CodecEncodeSelf(e)
+				if yyq2[88] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nteststruc\"")
+					} else {
+						r.EncodeString(`Nteststruc`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn91 {
+						r.EncodeNil()
+					} else {
+						if yyxt279 := z.Extension(x.Nteststruc); yyxt279 != nil {
+							z.EncExtension(x.Nteststruc, yyxt279)
+						} else {
+							x.Nteststruc.CodecEncodeSelf(e)
+						}
+					}
 				}
-			} else {
-				r.EncodeBool(false)
-			}
-			z.EncWriteArrayElem()
-			if yyq2[80] {
-				if yyxt174 := z.Extension(x.MarT); yyxt174 != nil {
-					z.EncExtension(x.MarT, yyxt174)
-				} else {
-					x.MarT.CodecEncodeSelf(e)
+				if yyq2[51] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"P\"")
+					} else {
+						r.EncodeString(`P`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn54 {
+						r.EncodeNil()
+					} else {
+						yy280 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+						r.EncodeString(string(yy280))
+					}
 				}
-			} else {
-				r.EncodeString("")
-			}
-			z.EncWriteArrayElem()
-			if yyq2[81] {
-				if yyxt175 := z.Extension(x.MarB); yyxt175 != nil {
-					z.EncExtension(x.MarB, yyxt175)
-				} else {
-					x.MarB.CodecEncodeSelf(e)
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"S\"")
+					} else {
+						r.EncodeString(`S`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.TestStrucCommon.S))
 				}
-			} else {
-				r.EncodeNil()
-			}
-			z.EncWriteArrayElem()
-			if yyq2[82] {
-				if yyxt176 := z.Extension(x.XuintToBytes); yyxt176 != nil {
-					z.EncExtension(x.XuintToBytes, yyxt176)
-				} else {
-					x.XuintToBytes.CodecEncodeSelf(e)
+				if yyq2[30] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Simplef\"")
+					} else {
+						r.EncodeString(`Simplef`)
+					}
+					z.EncWriteMapElemValue()
+					yy283 := &x.TestStrucCommon.Simplef
+					if yyxt284 := z.Extension(yy283); yyxt284 != nil {
+						z.EncExtension(yy283, yyxt284)
+					} else {
+						yy283.CodecEncodeSelf(e)
+					}
 				}
-			} else {
-				r.EncodeUint(0)
-			}
-			z.EncWriteArrayEnd()
-		} else {
-			var yynn2 int
-			for _, b := range yyq2 {
-				if b {
-					yynn2++
+				if yyq2[75] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"SintfAarray\"")
+					} else {
+						r.EncodeString(`SintfAarray`)
+					}
+					z.EncWriteMapElemValue()
+					if x.SintfAarray == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntfV(x.SintfAarray, e)
+					} // end block: if x.SintfAarray slice == nil
 				}
-			}
-			z.EncWriteMapStart(yynn2)
-			yynn2 = 0
-			if yyq2[0] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
-				} else {
-					r.EncodeString(`S`)
+				if yyq2[17] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Sslice\"")
+					} else {
+						r.EncodeString(`Sslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
+					} // end block: if x.TestStrucCommon.Sslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.TestStrucCommon.S))
-			}
-			if yyq2[1] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64\"")
-				} else {
-					r.EncodeString(`I64`)
+				if yyq2[31] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"SstrUi64T\"")
+					} else {
+						r.EncodeString(`SstrUi64T`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
+					} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I64))
-			}
-			if yyq2[2] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32\"")
-				} else {
-					r.EncodeString(`I32`)
+				if yyq2[70] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Swrapbytes\"")
+					} else {
+						r.EncodeString(`Swrapbytes`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Swrapbytes == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+					} // end block: if x.Swrapbytes slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I32))
-			}
-			if yyq2[3] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16\"")
-				} else {
-					r.EncodeString(`I16`)
+				if yyq2[71] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Swrapuint8\"")
+					} else {
+						r.EncodeString(`Swrapuint8`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Swrapuint8 == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+					} // end block: if x.Swrapuint8 slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I16))
-			}
-			if yyq2[4] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8\"")
-				} else {
-					r.EncodeString(`I8`)
+				if yyq2[82] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"T\"")
+					} else {
+						r.EncodeString(`T`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn85 {
+						r.EncodeNil()
+					} else {
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(x.AnonInTestStrucIntf.T)
+						} else if yyxt290 := z.Extension(x.AnonInTestStrucIntf.T); yyxt290 != nil {
+							z.EncExtension(x.AnonInTestStrucIntf.T, yyxt290)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(x.AnonInTestStrucIntf.T)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(x.AnonInTestStrucIntf.T)
+						} else {
+							z.EncFallback(x.AnonInTestStrucIntf.T)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I8))
-			}
-			if yyq2[5] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64n\"")
-				} else {
-					r.EncodeString(`I64n`)
+				if yyq2[83] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Tptr\"")
+					} else {
+						r.EncodeString(`Tptr`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn86 {
+						r.EncodeNil()
+					} else {
+						yy291 := *x.AnonInTestStrucIntf.Tptr
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(yy291)
+						} else if yyxt292 := z.Extension(yy291); yyxt292 != nil {
+							z.EncExtension(yy291, yyxt292)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(yy291)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(yy291)
+						} else {
+							z.EncFallback(yy291)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I64n))
-			}
-			if yyq2[6] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32n\"")
-				} else {
-					r.EncodeString(`I32n`)
+				if yyq2[77] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ttime\"")
+					} else {
+						r.EncodeString(`Ttime`)
+					}
+					z.EncWriteMapElemValue()
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(x.Ttime)
+					} else if yyxt293 := z.Extension(x.Ttime); yyxt293 != nil {
+						z.EncExtension(x.Ttime, yyxt293)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(x.Ttime)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(x.Ttime)
+					} else {
+						z.EncFallback(x.Ttime)
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I32n))
-			}
-			if yyq2[7] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16n\"")
-				} else {
-					r.EncodeString(`I16n`)
+				if yyq2[78] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ttimeptr\"")
+					} else {
+						r.EncodeString(`Ttimeptr`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn81 {
+						r.EncodeNil()
+					} else {
+						yy294 := *x.Ttimeptr
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(yy294)
+						} else if yyxt295 := z.Extension(yy294); yyxt295 != nil {
+							z.EncExtension(yy294, yyxt295)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(yy294)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(yy294)
+						} else {
+							z.EncFallback(yy294)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I16n))
-			}
-			if yyq2[8] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8n\"")
-				} else {
-					r.EncodeString(`I8n`)
+				if yyq2[11] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui16\"")
+					} else {
+						r.EncodeString(`Ui16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.I8n))
-			}
-			if yyq2[9] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64\"")
-				} else {
-					r.EncodeString(`Ui64`)
+				if yyq2[10] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui32\"")
+					} else {
+						r.EncodeString(`Ui32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
-			}
-			if yyq2[10] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui32\"")
-				} else {
-					r.EncodeString(`Ui32`)
+				if yyq2[9] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64\"")
+					} else {
+						r.EncodeString(`Ui64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
-			}
-			if yyq2[11] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui16\"")
-				} else {
-					r.EncodeString(`Ui16`)
+				if yyq2[73] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64array\"")
+					} else {
+						r.EncodeString(`Ui64array`)
+					}
+					z.EncWriteMapElemValue()
+					yy299 := &x.Ui64array
+					h.encArray4uint64((*[4]uint64)(yy299), e)
+				}
+				if yyq2[20] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64slice\"")
+					} else {
+						r.EncodeString(`Ui64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
+					} // end block: if x.TestStrucCommon.Ui64slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
-			}
-			if yyq2[12] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8\"")
-				} else {
-					r.EncodeString(`Ui8`)
+				if yyq2[74] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64slicearray\"")
+					} else {
+						r.EncodeString(`Ui64slicearray`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Ui64slicearray == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
+					} // end block: if x.Ui64slicearray slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
-			}
-			if yyq2[13] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F64\"")
-				} else {
-					r.EncodeString(`F64`)
+				if yyq2[12] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui8\"")
+					} else {
+						r.EncodeString(`Ui8`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeFloat64(float64(x.TestStrucCommon.F64))
-			}
-			if yyq2[14] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F32\"")
-				} else {
-					r.EncodeString(`F32`)
+				if yyq2[21] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui8slice\"")
+					} else {
+						r.EncodeString(`Ui8slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Ui8slice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
+					} // end block: if x.TestStrucCommon.Ui8slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeFloat32(float32(x.TestStrucCommon.F32))
-			}
-			if yyq2[15] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeString(`B`)
+				if yyq2[26] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"WrapSliceInt64\"")
+					} else {
+						r.EncodeString(`WrapSliceInt64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt305 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt305 != nil {
+						z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt305)
+					} else {
+						x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeBool(bool(x.TestStrucCommon.B))
-			}
-			if yyq2[16] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"By\"")
-				} else {
-					r.EncodeString(`By`)
+				if yyq2[27] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"WrapSliceString\"")
+					} else {
+						r.EncodeString(`WrapSliceString`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt306 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt306 != nil {
+						z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt306)
+					} else {
+						x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.By))
-			}
-			if yyq2[17] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sslice\"")
-				} else {
-					r.EncodeString(`Sslice`)
+				if yyq2[92] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"XuintToBytes\"")
+					} else {
+						r.EncodeString(`XuintToBytes`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt307 := z.Extension(x.XuintToBytes); yyxt307 != nil {
+						z.EncExtension(x.XuintToBytes, yyxt307)
+					} else {
+						x.XuintToBytes.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Sslice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
-				} // end block: if x.TestStrucCommon.Sslice slice == nil
-			}
-			if yyq2[18] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64slice\"")
-				} else {
-					r.EncodeString(`I64slice`)
+			} else {
+				if yyq2[0] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"S\"")
+					} else {
+						r.EncodeString(`S`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.TestStrucCommon.S))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.I64slice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
-				} // end block: if x.TestStrucCommon.I64slice slice == nil
-			}
-			if yyq2[19] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16slice\"")
-				} else {
-					r.EncodeString(`I16slice`)
+				if yyq2[1] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64\"")
+					} else {
+						r.EncodeString(`I64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I64))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.I16slice == nil {
-					r.EncodeNil()
-				} else {
-					h.encSliceint16(([]int16)(x.TestStrucCommon.I16slice), e)
-				} // end block: if x.TestStrucCommon.I16slice slice == nil
-			}
-			if yyq2[20] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64slice\"")
-				} else {
-					r.EncodeString(`Ui64slice`)
+				if yyq2[2] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32\"")
+					} else {
+						r.EncodeString(`I32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I32))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Ui64slice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
-				} // end block: if x.TestStrucCommon.Ui64slice slice == nil
-			}
-			if yyq2[21] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8slice\"")
-				} else {
-					r.EncodeString(`Ui8slice`)
+				if yyq2[3] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I16\"")
+					} else {
+						r.EncodeString(`I16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I16))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Ui8slice == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
-				} // end block: if x.TestStrucCommon.Ui8slice slice == nil
-			}
-			if yyq2[22] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Bslice\"")
-				} else {
-					r.EncodeString(`Bslice`)
+				if yyq2[4] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I8\"")
+					} else {
+						r.EncodeString(`I8`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I8))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Bslice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
-				} // end block: if x.TestStrucCommon.Bslice slice == nil
-			}
-			if yyq2[23] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Byslice\"")
-				} else {
-					r.EncodeString(`Byslice`)
+				if yyq2[5] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64n\"")
+					} else {
+						r.EncodeString(`I64n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I64n))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Byslice == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
-				} // end block: if x.TestStrucCommon.Byslice slice == nil
-			}
-			if yyq2[24] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"BytesSlice\"")
-				} else {
-					r.EncodeString(`BytesSlice`)
+				if yyq2[6] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32n\"")
+					} else {
+						r.EncodeString(`I32n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I32n))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.BytesSlice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
-				} // end block: if x.TestStrucCommon.BytesSlice slice == nil
-			}
-			if yyq2[25] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Iptrslice\"")
-				} else {
-					r.EncodeString(`Iptrslice`)
+				if yyq2[7] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I16n\"")
+					} else {
+						r.EncodeString(`I16n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I16n))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Iptrslice == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
-				} // end block: if x.TestStrucCommon.Iptrslice slice == nil
-			}
-			if yyq2[26] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceInt64\"")
-				} else {
-					r.EncodeString(`WrapSliceInt64`)
+				if yyq2[8] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I8n\"")
+					} else {
+						r.EncodeString(`I8n`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.I8n))
 				}
-				z.EncWriteMapElemValue()
-				if yyxt203 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt203 != nil {
-					z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt203)
-				} else {
-					x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+				if yyq2[9] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64\"")
+					} else {
+						r.EncodeString(`Ui64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui64))
 				}
-			}
-			if yyq2[27] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceString\"")
-				} else {
-					r.EncodeString(`WrapSliceString`)
+				if yyq2[10] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui32\"")
+					} else {
+						r.EncodeString(`Ui32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui32))
 				}
-				z.EncWriteMapElemValue()
-				if yyxt204 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt204 != nil {
-					z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt204)
-				} else {
-					x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+				if yyq2[11] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui16\"")
+					} else {
+						r.EncodeString(`Ui16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui16))
 				}
-			}
-			if yyq2[28] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msi64\"")
-				} else {
-					r.EncodeString(`Msi64`)
+				if yyq2[12] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui8\"")
+					} else {
+						r.EncodeString(`Ui8`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.Ui8))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Msi64 == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapStringInt64V(x.TestStrucCommon.Msi64, e)
-				} // end block: if x.TestStrucCommon.Msi64 map == nil
-			}
-			if yyq2[29] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msbytes\"")
-				} else {
-					r.EncodeString(`Msbytes`)
+				if yyq2[13] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"F64\"")
+					} else {
+						r.EncodeString(`F64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeFloat64(float64(x.TestStrucCommon.F64))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Msbytes == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
-				} // end block: if x.TestStrucCommon.Msbytes map == nil
-			}
-			if yyq2[30] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Simplef\"")
-				} else {
-					r.EncodeString(`Simplef`)
+				if yyq2[14] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"F32\"")
+					} else {
+						r.EncodeString(`F32`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeFloat32(float32(x.TestStrucCommon.F32))
 				}
-				z.EncWriteMapElemValue()
-				yy207 := &x.TestStrucCommon.Simplef
-				if yyxt208 := z.Extension(yy207); yyxt208 != nil {
-					z.EncExtension(yy207, yyxt208)
-				} else {
-					yy207.CodecEncodeSelf(e)
+				if yyq2[15] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"B\"")
+					} else {
+						r.EncodeString(`B`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeBool(bool(x.TestStrucCommon.B))
 				}
-			}
-			if yyq2[31] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"SstrUi64T\"")
-				} else {
-					r.EncodeString(`SstrUi64T`)
+				if yyq2[16] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"By\"")
+					} else {
+						r.EncodeString(`By`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.By))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.SstrUi64T == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
-				} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
-			}
-			if yyq2[32] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"MstrUi64T\"")
-				} else {
-					r.EncodeString(`MstrUi64T`)
+				if yyq2[17] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Sslice\"")
+					} else {
+						r.EncodeString(`Sslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(x.TestStrucCommon.Sslice, e)
+					} // end block: if x.TestStrucCommon.Sslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.MstrUi64T == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
-				} // end block: if x.TestStrucCommon.MstrUi64T map == nil
-			}
-			if yyq2[33] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AS\"")
-				} else {
-					r.EncodeString(`AS`)
+				if yyq2[18] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I64slice\"")
+					} else {
+						r.EncodeString(`I64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.I64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.I64slice, e)
+					} // end block: if x.TestStrucCommon.I64slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
-			}
-			if yyq2[34] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64\"")
-				} else {
-					r.EncodeString(`AI64`)
+				if yyq2[19] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"I32slice\"")
+					} else {
+						r.EncodeString(`I32slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.I32slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt32V(x.TestStrucCommon.I32slice, e)
+					} // end block: if x.TestStrucCommon.I32slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
-			}
-			if yyq2[35] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI16\"")
-				} else {
-					r.EncodeString(`AI16`)
+				if yyq2[20] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64slice\"")
+					} else {
+						r.EncodeString(`Ui64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.Ui64slice, e)
+					} // end block: if x.TestStrucCommon.Ui64slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
-			}
-			if yyq2[36] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64\"")
-				} else {
-					r.EncodeString(`AUi64`)
+				if yyq2[21] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui8slice\"")
+					} else {
+						r.EncodeString(`Ui8slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Ui8slice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Ui8slice))
+					} // end block: if x.TestStrucCommon.Ui8slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
-			}
-			if yyq2[37] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"ASslice\"")
-				} else {
-					r.EncodeString(`ASslice`)
+				if yyq2[22] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Bslice\"")
+					} else {
+						r.EncodeString(`Bslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBoolV(x.TestStrucCommon.Bslice, e)
+					} // end block: if x.TestStrucCommon.Bslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
-			}
-			if yyq2[38] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64slice\"")
-				} else {
-					r.EncodeString(`AI64slice`)
+				if yyq2[23] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Byslice\"")
+					} else {
+						r.EncodeString(`Byslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Byslice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Byslice))
+					} // end block: if x.TestStrucCommon.Byslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
-			}
-			if yyq2[39] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64slice\"")
-				} else {
-					r.EncodeString(`AUi64slice`)
+				if yyq2[24] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"BytesSlice\"")
+					} else {
+						r.EncodeString(`BytesSlice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.BytesSlice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceBytesV(x.TestStrucCommon.BytesSlice, e)
+					} // end block: if x.TestStrucCommon.BytesSlice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
-			}
-			if yyq2[40] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF64slice\"")
-				} else {
-					r.EncodeString(`AF64slice`)
+				if yyq2[25] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Iptrslice\"")
+					} else {
+						r.EncodeString(`Iptrslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoint64(([]*int64)(x.TestStrucCommon.Iptrslice), e)
+					} // end block: if x.TestStrucCommon.Iptrslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
-			}
-			if yyq2[41] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF32slice\"")
-				} else {
-					r.EncodeString(`AF32slice`)
+				if yyq2[26] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"WrapSliceInt64\"")
+					} else {
+						r.EncodeString(`WrapSliceInt64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt334 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt334 != nil {
+						z.EncExtension(x.TestStrucCommon.WrapSliceInt64, yyxt334)
+					} else {
+						x.TestStrucCommon.WrapSliceInt64.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicefloat32(([]float32)(x.TestStrucCommon.AnonInTestStruc.AF32slice), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
-			}
-			if yyq2[42] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16\"")
-				} else {
-					r.EncodeString(`AMSU16`)
+				if yyq2[27] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"WrapSliceString\"")
+					} else {
+						r.EncodeString(`WrapSliceString`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt335 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt335 != nil {
+						z.EncExtension(x.TestStrucCommon.WrapSliceString, yyxt335)
+					} else {
+						x.TestStrucCommon.WrapSliceString.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16 map == nil
-			}
-			if yyq2[43] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64arr0\"")
-				} else {
-					r.EncodeString(`AI64arr0`)
+				if yyq2[28] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msint\"")
+					} else {
+						r.EncodeString(`Msint`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Msint == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringIntV(x.TestStrucCommon.Msint, e)
+					} // end block: if x.TestStrucCommon.Msint map == nil
 				}
-				z.EncWriteMapElemValue()
-				yy221 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
-				h.encArray0int64((*[0]int64)(yy221), e)
-			}
-			if yyq2[44] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64slice0\"")
-				} else {
-					r.EncodeString(`AI64slice0`)
+				if yyq2[29] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msbytes\"")
+					} else {
+						r.EncodeString(`Msbytes`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Msbytes == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBytesV(x.TestStrucCommon.Msbytes, e)
+					} // end block: if x.TestStrucCommon.Msbytes map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
-			}
-			if yyq2[45] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64sliceN\"")
-				} else {
-					r.EncodeString(`AUi64sliceN`)
+				if yyq2[30] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Simplef\"")
+					} else {
+						r.EncodeString(`Simplef`)
+					}
+					z.EncWriteMapElemValue()
+					yy338 := &x.TestStrucCommon.Simplef
+					if yyxt339 := z.Extension(yy338); yyxt339 != nil {
+						z.EncExtension(yy338, yyxt339)
+					} else {
+						yy338.CodecEncodeSelf(e)
+					}
+				}
+				if yyq2[31] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"SstrUi64T\"")
+					} else {
+						r.EncodeString(`SstrUi64T`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicestringUint64T(([]stringUint64T)(x.TestStrucCommon.SstrUi64T), e)
+					} // end block: if x.TestStrucCommon.SstrUi64T slice == nil
+				}
+				if yyq2[32] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MstrUi64T\"")
+					} else {
+						r.EncodeString(`MstrUi64T`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.MstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringPtrtostringUint64T((map[string]*stringUint64T)(x.TestStrucCommon.MstrUi64T), e)
+					} // end block: if x.TestStrucCommon.MstrUi64T map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
-			}
-			if yyq2[46] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16N\"")
-				} else {
-					r.EncodeString(`AMSU16N`)
+				if yyq2[33] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AS\"")
+					} else {
+						r.EncodeString(`AS`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeString(string(x.TestStrucCommon.AnonInTestStruc.AS))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16N == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16N), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16N map == nil
-			}
-			if yyq2[47] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16E\"")
-				} else {
-					r.EncodeString(`AMSU16E`)
+				if yyq2[34] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64\"")
+					} else {
+						r.EncodeString(`AI64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI64))
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.AnonInTestStruc.AMSU16E == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringuint16((map[string]uint16)(x.TestStrucCommon.AnonInTestStruc.AMSU16E), e)
-				} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU16E map == nil
-			}
-			if yyq2[48] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"NotAnon\"")
-				} else {
-					r.EncodeString(`NotAnon`)
+				if yyq2[35] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI16\"")
+					} else {
+						r.EncodeString(`AI16`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeInt(int64(x.TestStrucCommon.AnonInTestStruc.AI16))
 				}
-				z.EncWriteMapElemValue()
-				yy227 := &x.TestStrucCommon.NotAnon
-				if yyxt228 := z.Extension(yy227); yyxt228 != nil {
-					z.EncExtension(yy227, yyxt228)
-				} else {
-					yy227.CodecEncodeSelf(e)
+				if yyq2[36] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64\"")
+					} else {
+						r.EncodeString(`AUi64`)
+					}
+					z.EncWriteMapElemValue()
+					r.EncodeUint(uint64(x.TestStrucCommon.AnonInTestStruc.AUi64))
 				}
-			}
-			if yyq2[49] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nmap\"")
-				} else {
-					r.EncodeString(`Nmap`)
+				if yyq2[37] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"ASslice\"")
+					} else {
+						r.EncodeString(`ASslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.ASslice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceStringV(x.TestStrucCommon.AnonInTestStruc.ASslice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.ASslice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Nmap == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
-				} // end block: if x.TestStrucCommon.Nmap map == nil
-			}
-			if yyq2[50] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nslice\"")
-				} else {
-					r.EncodeString(`Nslice`)
+				if yyq2[38] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64slice\"")
+					} else {
+						r.EncodeString(`AI64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AI64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.TestStrucCommon.Nslice == nil {
-					r.EncodeNil()
-				} else {
-					r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
-				} // end block: if x.TestStrucCommon.Nslice slice == nil
-			}
-			if yyq2[51] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nint64\"")
-				} else {
-					r.EncodeString(`Nint64`)
+				if yyq2[39] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64slice\"")
+					} else {
+						r.EncodeString(`AUi64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn54 {
-					r.EncodeNil()
-				} else {
-					yy231 := *x.TestStrucCommon.Nint64
-					r.EncodeInt(int64(yy231))
+				if yyq2[40] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AF64slice\"")
+					} else {
+						r.EncodeString(`AF64slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat64V(x.TestStrucCommon.AnonInTestStruc.AF64slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AF64slice slice == nil
 				}
-			}
-			if yyq2[52] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Chstr\"")
-				} else {
-					r.EncodeString(`Chstr`)
+				if yyq2[41] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AF32slice\"")
+					} else {
+						r.EncodeString(`AF32slice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceFloat32V(x.TestStrucCommon.AnonInTestStruc.AF32slice, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AF32slice slice == nil
 				}
-				z.EncWriteMapElemValue()
-				h.encChanstring((chan string)(x.Chstr), e)
-			}
-			if yyq2[53] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mis\"")
-				} else {
-					r.EncodeString(`Mis`)
+				if yyq2[42] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSS\"")
+					} else {
+						r.EncodeString(`AMSS`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSS == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringStringV(x.TestStrucCommon.AnonInTestStruc.AMSS, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSS map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Mis == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncMapIntStringV(x.Mis, e)
-				} // end block: if x.Mis map == nil
-			}
-			if yyq2[54] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mbu64\"")
-				} else {
-					r.EncodeString(`Mbu64`)
+				if yyq2[43] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64\"")
+					} else {
+						r.EncodeString(`AMSU64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64 map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Mbu64 == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
-				} // end block: if x.Mbu64 map == nil
-			}
-			if yyq2[55] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Miwu64s\"")
-				} else {
-					r.EncodeString(`Miwu64s`)
+				if yyq2[44] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64arr8\"")
+					} else {
+						r.EncodeString(`AI64arr8`)
+					}
+					z.EncWriteMapElemValue()
+					yy353 := &x.TestStrucCommon.AnonInTestStruc.AI64arr8
+					h.encArray8int64((*[8]int64)(yy353), e)
+				}
+				if yyq2[45] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64arr0\"")
+					} else {
+						r.EncodeString(`AI64arr0`)
+					}
+					z.EncWriteMapElemValue()
+					yy355 := &x.TestStrucCommon.AnonInTestStruc.AI64arr0
+					h.encArray0int64((*[0]int64)(yy355), e)
+				}
+				if yyq2[46] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AI64slice0\"")
+					} else {
+						r.EncodeString(`AI64slice0`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AI64slice0 == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceInt64V(x.TestStrucCommon.AnonInTestStruc.AI64slice0, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AI64slice0 slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Miwu64s == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
-				} // end block: if x.Miwu64s map == nil
-			}
-			if yyq2[56] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mfwss\"")
-				} else {
-					r.EncodeString(`Mfwss`)
+				if yyq2[47] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AUi64sliceN\"")
+					} else {
+						r.EncodeString(`AUi64sliceN`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceUint64V(x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AUi64sliceN slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Mfwss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
-				} // end block: if x.Mfwss map == nil
-			}
-			if yyq2[57] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mf32wss\"")
-				} else {
-					r.EncodeString(`Mf32wss`)
+				if yyq2[48] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64N\"")
+					} else {
+						r.EncodeString(`AMSU64N`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64N == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64N, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64N map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Mf32wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
-				} // end block: if x.Mf32wss map == nil
-			}
-			if yyq2[58] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mui2wss\"")
-				} else {
-					r.EncodeString(`Mui2wss`)
+				if yyq2[49] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"AMSU64E\"")
+					} else {
+						r.EncodeString(`AMSU64E`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.AnonInTestStruc.AMSU64E == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringUint64V(x.TestStrucCommon.AnonInTestStruc.AMSU64E, e)
+					} // end block: if x.TestStrucCommon.AnonInTestStruc.AMSU64E map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Mui2wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
-				} // end block: if x.Mui2wss map == nil
-			}
-			if yyq2[59] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msu2wss\"")
-				} else {
-					r.EncodeString(`Msu2wss`)
+				if yyq2[50] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"NotAnon\"")
+					} else {
+						r.EncodeString(`NotAnon`)
+					}
+					z.EncWriteMapElemValue()
+					yy361 := &x.TestStrucCommon.NotAnon
+					if yyxt362 := z.Extension(yy361); yyxt362 != nil {
+						z.EncExtension(yy361, yyxt362)
+					} else {
+						yy361.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.Msu2wss == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
-				} // end block: if x.Msu2wss map == nil
-			}
-			if yyq2[60] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ci64\"")
-				} else {
-					r.EncodeString(`Ci64`)
+				if yyq2[51] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"P\"")
+					} else {
+						r.EncodeString(`P`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn54 {
+						r.EncodeNil()
+					} else {
+						yy363 := *x.TestStrucCommon.AnonInTestStrucSlim.P
+						r.EncodeString(string(yy363))
+					}
 				}
-				z.EncWriteMapElemValue()
-				if yyxt241 := z.Extension(x.Ci64); yyxt241 != nil {
-					z.EncExtension(x.Ci64, yyxt241)
-				} else {
-					x.Ci64.CodecEncodeSelf(e)
+				if yyq2[52] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"NotAnonSlim\"")
+					} else {
+						r.EncodeString(`NotAnonSlim`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn55 {
+						r.EncodeNil()
+					} else {
+						if yyxt365 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt365 != nil {
+							z.EncExtension(x.TestStrucCommon.NotAnonSlim, yyxt365)
+						} else {
+							x.TestStrucCommon.NotAnonSlim.CodecEncodeSelf(e)
+						}
+					}
 				}
-			}
-			if yyq2[61] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Swrapbytes\"")
-				} else {
-					r.EncodeString(`Swrapbytes`)
+				if yyq2[53] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nmap\"")
+					} else {
+						r.EncodeString(`Nmap`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapStringBoolV(x.TestStrucCommon.Nmap, e)
+					} // end block: if x.TestStrucCommon.Nmap map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Swrapbytes == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
-				} // end block: if x.Swrapbytes slice == nil
-			}
-			if yyq2[62] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Swrapuint8\"")
-				} else {
-					r.EncodeString(`Swrapuint8`)
+				if yyq2[54] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nslice\"")
+					} else {
+						r.EncodeString(`Nslice`)
+					}
+					z.EncWriteMapElemValue()
+					if x.TestStrucCommon.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						r.EncodeStringBytesRaw([]byte(x.TestStrucCommon.Nslice))
+					} // end block: if x.TestStrucCommon.Nslice slice == nil
+				}
+				if yyq2[55] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nint64\"")
+					} else {
+						r.EncodeString(`Nint64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn58 {
+						r.EncodeNil()
+					} else {
+						yy368 := *x.TestStrucCommon.Nint64
+						r.EncodeInt(int64(yy368))
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.Swrapuint8 == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
-				} // end block: if x.Swrapuint8 slice == nil
-			}
-			if yyq2[63] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"ArrStrUi64T\"")
-				} else {
-					r.EncodeString(`ArrStrUi64T`)
+				if yyq2[56] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Chstr\"")
+					} else {
+						r.EncodeString(`Chstr`)
+					}
+					z.EncWriteMapElemValue()
+					h.encChanstring((chan string)(x.Chstr), e)
 				}
-				z.EncWriteMapElemValue()
-				yy244 := &x.ArrStrUi64T
-				h.encArray4stringUint64T((*[4]stringUint64T)(yy244), e)
-			}
-			if yyq2[64] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64array\"")
-				} else {
-					r.EncodeString(`Ui64array`)
+				if yyq2[57] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mis\"")
+					} else {
+						r.EncodeString(`Mis`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mis == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncMapIntStringV(x.Mis, e)
+					} // end block: if x.Mis map == nil
 				}
-				z.EncWriteMapElemValue()
-				yy246 := &x.Ui64array
-				h.encArray4uint64((*[4]uint64)(yy246), e)
-			}
-			if yyq2[65] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64slicearray\"")
-				} else {
-					r.EncodeString(`Ui64slicearray`)
+				if yyq2[58] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mbu64\"")
+					} else {
+						r.EncodeString(`Mbu64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mbu64 == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
+					} // end block: if x.Mbu64 map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.Ui64slicearray == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
-				} // end block: if x.Ui64slicearray slice == nil
-			}
-			if yyq2[66] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"SintfAarray\"")
-				} else {
-					r.EncodeString(`SintfAarray`)
+				if yyq2[59] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mu8e\"")
+					} else {
+						r.EncodeString(`Mu8e`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mu8e == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint8c3RydWN0IHt9((map[uint8]struct{})(x.Mu8e), e)
+					} // end block: if x.Mu8e map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.SintfAarray == nil {
-					r.EncodeNil()
-				} else {
-					z.F.EncSliceIntfV(x.SintfAarray, e)
-				} // end block: if x.SintfAarray slice == nil
-			}
-			if yyq2[67] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"MstrUi64TSelf\"")
-				} else {
-					r.EncodeString(`MstrUi64TSelf`)
+				if yyq2[60] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mu8u64\"")
+					} else {
+						r.EncodeString(`Mu8u64`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mu8u64 == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint8stringUint64T((map[uint8]stringUint64T)(x.Mu8u64), e)
+					} // end block: if x.Mu8u64 map == nil
 				}
-				z.EncWriteMapElemValue()
-				if x.MstrUi64TSelf == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringUint64TPtrtostringUint64T((map[stringUint64T]*stringUint64T)(x.MstrUi64TSelf), e)
-				} // end block: if x.MstrUi64TSelf map == nil
-			}
-			if yyq2[68] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ttime\"")
-				} else {
-					r.EncodeString(`Ttime`)
+				if yyq2[61] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msp2ss\"")
+					} else {
+						r.EncodeString(`Msp2ss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msp2ss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapPtrtostringSlicestring((map[*string][]string)(x.Msp2ss), e)
+					} // end block: if x.Msp2ss map == nil
 				}
-				z.EncWriteMapElemValue()
-				if !z.EncBasicHandle().TimeNotBuiltin {
-					r.EncodeTime(x.Ttime)
-				} else if yyxt251 := z.Extension(x.Ttime); yyxt251 != nil {
-					z.EncExtension(x.Ttime, yyxt251)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.Ttime)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.Ttime)
-				} else {
-					z.EncFallback(x.Ttime)
+				if yyq2[62] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mip2ss\"")
+					} else {
+						r.EncodeString(`Mip2ss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mip2ss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapPtrtouint64Slicestring((map[*uint64][]string)(x.Mip2ss), e)
+					} // end block: if x.Mip2ss map == nil
 				}
-			}
-			if yyq2[69] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ttimeptr\"")
-				} else {
-					r.EncodeString(`Ttimeptr`)
+				if yyq2[63] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ms2misu\"")
+					} else {
+						r.EncodeString(`Ms2misu`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Ms2misu == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringMapuint64stringUint64T((map[string]map[uint64]stringUint64T)(x.Ms2misu), e)
+					} // end block: if x.Ms2misu map == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn72 {
-					r.EncodeNil()
-				} else {
-					yy252 := *x.Ttimeptr
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(yy252)
-					} else if yyxt253 := z.Extension(yy252); yyxt253 != nil {
-						z.EncExtension(yy252, yyxt253)
-					} else if z.EncBinary() {
-						z.EncBinaryMarshal(yy252)
-					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy252)
+				if yyq2[64] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Miwu64s\"")
 					} else {
-						z.EncFallback(yy252)
+						r.EncodeString(`Miwu64s`)
 					}
+					z.EncWriteMapElemValue()
+					if x.Miwu64s == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+					} // end block: if x.Miwu64s map == nil
 				}
-			}
-			if yyq2[70] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Islice\"")
-				} else {
-					r.EncodeString(`Islice`)
+				if yyq2[65] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mfwss\"")
+					} else {
+						r.EncodeString(`Mfwss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mfwss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+					} // end block: if x.Mfwss map == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn73 {
-					r.EncodeNil()
-				} else {
-					if x.AnonInTestStrucIntf.Islice == nil {
+				if yyq2[66] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mf32wss\"")
+					} else {
+						r.EncodeString(`Mf32wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mf32wss == nil {
 						r.EncodeNil()
 					} else {
-						z.F.EncSliceIntfV(x.AnonInTestStrucIntf.Islice, e)
-					} // end block: if x.AnonInTestStrucIntf.Islice slice == nil
+						h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+					} // end block: if x.Mf32wss map == nil
 				}
-			}
-			if yyq2[71] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ms\"")
-				} else {
-					r.EncodeString(`Ms`)
+				if yyq2[67] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mui2wss\"")
+					} else {
+						r.EncodeString(`Mui2wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mui2wss == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+					} // end block: if x.Mui2wss map == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn74 {
-					r.EncodeNil()
-				} else {
-					if x.AnonInTestStrucIntf.Ms == nil {
+				if yyq2[68] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msu2wss\"")
+					} else {
+						r.EncodeString(`Msu2wss`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msu2wss == nil {
 						r.EncodeNil()
 					} else {
-						z.F.EncMapStringIntfV(x.AnonInTestStrucIntf.Ms, e)
-					} // end block: if x.AnonInTestStrucIntf.Ms map == nil
+						h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+					} // end block: if x.Msu2wss map == nil
 				}
-			}
-			if yyq2[72] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nintf\"")
-				} else {
-					r.EncodeString(`Nintf`)
+				if yyq2[69] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ci64\"")
+					} else {
+						r.EncodeString(`Ci64`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt383 := z.Extension(x.Ci64); yyxt383 != nil {
+						z.EncExtension(x.Ci64, yyxt383)
+					} else {
+						x.Ci64.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if yyn75 {
-					r.EncodeNil()
-				} else {
-					z.EncFallback(x.AnonInTestStrucIntf.Nintf)
+				if yyq2[70] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Swrapbytes\"")
+					} else {
+						r.EncodeString(`Swrapbytes`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Swrapbytes == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+					} // end block: if x.Swrapbytes slice == nil
 				}
-			}
-			if yyq2[73] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"T\"")
-				} else {
-					r.EncodeString(`T`)
+				if yyq2[71] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Swrapuint8\"")
+					} else {
+						r.EncodeString(`Swrapuint8`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Swrapuint8 == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+					} // end block: if x.Swrapuint8 slice == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn76 {
-					r.EncodeNil()
-				} else {
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(x.AnonInTestStrucIntf.T)
-					} else if yyxt257 := z.Extension(x.AnonInTestStrucIntf.T); yyxt257 != nil {
-						z.EncExtension(x.AnonInTestStrucIntf.T, yyxt257)
-					} else if z.EncBinary() {
-						z.EncBinaryMarshal(x.AnonInTestStrucIntf.T)
-					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(x.AnonInTestStrucIntf.T)
+				if yyq2[72] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"ArrStrUi64T\"")
 					} else {
-						z.EncFallback(x.AnonInTestStrucIntf.T)
+						r.EncodeString(`ArrStrUi64T`)
 					}
+					z.EncWriteMapElemValue()
+					yy386 := &x.ArrStrUi64T
+					h.encArray4stringUint64T((*[4]stringUint64T)(yy386), e)
 				}
-			}
-			if yyq2[74] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Tptr\"")
-				} else {
-					r.EncodeString(`Tptr`)
+				if yyq2[73] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64array\"")
+					} else {
+						r.EncodeString(`Ui64array`)
+					}
+					z.EncWriteMapElemValue()
+					yy388 := &x.Ui64array
+					h.encArray4uint64((*[4]uint64)(yy388), e)
 				}
-				z.EncWriteMapElemValue()
-				if yyn77 {
-					r.EncodeNil()
-				} else {
-					yy258 := *x.AnonInTestStrucIntf.Tptr
-					if !z.EncBasicHandle().TimeNotBuiltin {
-						r.EncodeTime(yy258)
-					} else if yyxt259 := z.Extension(yy258); yyxt259 != nil {
-						z.EncExtension(yy258, yyxt259)
+				if yyq2[74] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ui64slicearray\"")
+					} else {
+						r.EncodeString(`Ui64slicearray`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Ui64slicearray == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
+					} // end block: if x.Ui64slicearray slice == nil
+				}
+				if yyq2[75] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"SintfAarray\"")
+					} else {
+						r.EncodeString(`SintfAarray`)
+					}
+					z.EncWriteMapElemValue()
+					if x.SintfAarray == nil {
+						r.EncodeNil()
+					} else {
+						z.F.EncSliceIntfV(x.SintfAarray, e)
+					} // end block: if x.SintfAarray slice == nil
+				}
+				if yyq2[76] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MstrUi64TSelf\"")
+					} else {
+						r.EncodeString(`MstrUi64TSelf`)
+					}
+					z.EncWriteMapElemValue()
+					if x.MstrUi64TSelf == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringUint64TPtrtostringUint64T((map[stringUint64T]*stringUint64T)(x.MstrUi64TSelf), e)
+					} // end block: if x.MstrUi64TSelf map == nil
+				}
+				if yyq2[77] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ttime\"")
+					} else {
+						r.EncodeString(`Ttime`)
+					}
+					z.EncWriteMapElemValue()
+					if z.EncBasicHandle().TimeBuiltin() {
+						r.EncodeTime(x.Ttime)
+					} else if yyxt393 := z.Extension(x.Ttime); yyxt393 != nil {
+						z.EncExtension(x.Ttime, yyxt393)
 					} else if z.EncBinary() {
-						z.EncBinaryMarshal(yy258)
+						z.EncBinaryMarshal(x.Ttime)
 					} else if !z.EncBinary() && z.IsJSONHandle() {
-						z.EncJSONMarshal(yy258)
+						z.EncJSONMarshal(x.Ttime)
 					} else {
-						z.EncFallback(yy258)
+						z.EncFallback(x.Ttime)
 					}
 				}
-			}
-			if yyq2[75] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mtsptr\"")
-				} else {
-					r.EncodeString(`Mtsptr`)
+				if yyq2[78] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ttimeptr\"")
+					} else {
+						r.EncodeString(`Ttimeptr`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn81 {
+						r.EncodeNil()
+					} else {
+						yy394 := *x.Ttimeptr
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(yy394)
+						} else if yyxt395 := z.Extension(yy394); yyxt395 != nil {
+							z.EncExtension(yy394, yyxt395)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(yy394)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(yy394)
+						} else {
+							z.EncFallback(yy394)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.Mtsptr == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
-				} // end block: if x.Mtsptr map == nil
-			}
-			if yyq2[76] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mts\"")
-				} else {
-					r.EncodeString(`Mts`)
+				if yyq2[79] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Islice\"")
+					} else {
+						r.EncodeString(`Islice`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn82 {
+						r.EncodeNil()
+					} else {
+						if x.AnonInTestStrucIntf.Islice == nil {
+							r.EncodeNil()
+						} else {
+							z.F.EncSliceIntfV(x.AnonInTestStrucIntf.Islice, e)
+						} // end block: if x.AnonInTestStrucIntf.Islice slice == nil
+					}
+				}
+				if yyq2[80] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Ms\"")
+					} else {
+						r.EncodeString(`Ms`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn83 {
+						r.EncodeNil()
+					} else {
+						if x.AnonInTestStrucIntf.Ms == nil {
+							r.EncodeNil()
+						} else {
+							z.F.EncMapStringIntfV(x.AnonInTestStrucIntf.Ms, e)
+						} // end block: if x.AnonInTestStrucIntf.Ms map == nil
+					}
+				}
+				if yyq2[81] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nintf\"")
+					} else {
+						r.EncodeString(`Nintf`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn84 {
+						r.EncodeNil()
+					} else {
+						z.EncFallback(x.AnonInTestStrucIntf.Nintf)
+					}
+				}
+				if yyq2[82] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"T\"")
+					} else {
+						r.EncodeString(`T`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn85 {
+						r.EncodeNil()
+					} else {
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(x.AnonInTestStrucIntf.T)
+						} else if yyxt399 := z.Extension(x.AnonInTestStrucIntf.T); yyxt399 != nil {
+							z.EncExtension(x.AnonInTestStrucIntf.T, yyxt399)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(x.AnonInTestStrucIntf.T)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(x.AnonInTestStrucIntf.T)
+						} else {
+							z.EncFallback(x.AnonInTestStrucIntf.T)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.Mts == nil {
-					r.EncodeNil()
-				} else {
-					h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
-				} // end block: if x.Mts map == nil
-			}
-			if yyq2[77] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Its\"")
-				} else {
-					r.EncodeString(`Its`)
+				if yyq2[83] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Tptr\"")
+					} else {
+						r.EncodeString(`Tptr`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn86 {
+						r.EncodeNil()
+					} else {
+						yy400 := *x.AnonInTestStrucIntf.Tptr
+						if z.EncBasicHandle().TimeBuiltin() {
+							r.EncodeTime(yy400)
+						} else if yyxt401 := z.Extension(yy400); yyxt401 != nil {
+							z.EncExtension(yy400, yyxt401)
+						} else if z.EncBinary() {
+							z.EncBinaryMarshal(yy400)
+						} else if !z.EncBinary() && z.IsJSONHandle() {
+							z.EncJSONMarshal(yy400)
+						} else {
+							z.EncFallback(yy400)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				if x.Its == nil {
-					r.EncodeNil()
-				} else {
-					h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
-				} // end block: if x.Its slice == nil
-			}
-			if yyq2[78] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nteststruc\"")
-				} else {
-					r.EncodeString(`Nteststruc`)
+				if yyq2[84] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Msu\"")
+					} else {
+						r.EncodeString(`Msu`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Msu == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapwrapStringInterface((map[wrapString]interface{})(x.Msu), e)
+					} // end block: if x.Msu map == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyn81 {
-					r.EncodeNil()
-				} else {
-					if yyxt263 := z.Extension(x.Nteststruc); yyxt263 != nil {
-						z.EncExtension(x.Nteststruc, yyxt263)
+				if yyq2[85] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mtsptr\"")
 					} else {
-						x.Nteststruc.CodecEncodeSelf(e)
+						r.EncodeString(`Mtsptr`)
 					}
+					z.EncWriteMapElemValue()
+					if x.Mtsptr == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+					} // end block: if x.Mtsptr map == nil
 				}
-			}
-			if yyq2[79] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"MarJ\"")
-				} else {
-					r.EncodeString(`MarJ`)
+				if yyq2[86] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Mts\"")
+					} else {
+						r.EncodeString(`Mts`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Mts == nil {
+						r.EncodeNil()
+					} else {
+						h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+					} // end block: if x.Mts map == nil
 				}
-				z.EncWriteMapElemValue()
-				if yyxt264 := z.Extension(x.MarJ); yyxt264 != nil {
-					z.EncExtension(x.MarJ, yyxt264)
-				} else {
-					x.MarJ.CodecEncodeSelf(e)
+				if yyq2[87] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Its\"")
+					} else {
+						r.EncodeString(`Its`)
+					}
+					z.EncWriteMapElemValue()
+					if x.Its == nil {
+						r.EncodeNil()
+					} else {
+						h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+					} // end block: if x.Its slice == nil
 				}
-			}
-			if yyq2[80] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"MarT\"")
-				} else {
-					r.EncodeString(`MarT`)
+				if yyq2[88] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Nteststruc\"")
+					} else {
+						r.EncodeString(`Nteststruc`)
+					}
+					z.EncWriteMapElemValue()
+					if yyn91 {
+						r.EncodeNil()
+					} else {
+						if yyxt406 := z.Extension(x.Nteststruc); yyxt406 != nil {
+							z.EncExtension(x.Nteststruc, yyxt406)
+						} else {
+							x.Nteststruc.CodecEncodeSelf(e)
+						}
+					}
 				}
-				z.EncWriteMapElemValue()
-				if yyxt265 := z.Extension(x.MarT); yyxt265 != nil {
-					z.EncExtension(x.MarT, yyxt265)
-				} else {
-					x.MarT.CodecEncodeSelf(e)
+				if yyq2[89] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarJ\"")
+					} else {
+						r.EncodeString(`MarJ`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt407 := z.Extension(x.MarJ); yyxt407 != nil {
+						z.EncExtension(x.MarJ, yyxt407)
+					} else {
+						x.MarJ.CodecEncodeSelf(e)
+					}
 				}
-			}
-			if yyq2[81] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"MarB\"")
-				} else {
-					r.EncodeString(`MarB`)
+				if yyq2[90] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarT\"")
+					} else {
+						r.EncodeString(`MarT`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt408 := z.Extension(x.MarT); yyxt408 != nil {
+						z.EncExtension(x.MarT, yyxt408)
+					} else {
+						x.MarT.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if yyxt266 := z.Extension(x.MarB); yyxt266 != nil {
-					z.EncExtension(x.MarB, yyxt266)
-				} else {
-					x.MarB.CodecEncodeSelf(e)
+				if yyq2[91] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"MarB\"")
+					} else {
+						r.EncodeString(`MarB`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt409 := z.Extension(x.MarB); yyxt409 != nil {
+						z.EncExtension(x.MarB, yyxt409)
+					} else {
+						x.MarB.CodecEncodeSelf(e)
+					}
 				}
-			}
-			if yyq2[82] {
-				z.EncWriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"XuintToBytes\"")
-				} else {
-					r.EncodeString(`XuintToBytes`)
+				if yyq2[92] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"XuintToBytes\"")
+					} else {
+						r.EncodeString(`XuintToBytes`)
+					}
+					z.EncWriteMapElemValue()
+					if yyxt410 := z.Extension(x.XuintToBytes); yyxt410 != nil {
+						z.EncExtension(x.XuintToBytes, yyxt410)
+					} else {
+						x.XuintToBytes.CodecEncodeSelf(e)
+					}
 				}
-				z.EncWriteMapElemValue()
-				if yyxt267 := z.Extension(x.XuintToBytes); yyxt267 != nil {
-					z.EncExtension(x.XuintToBytes, yyxt267)
-				} else {
-					x.XuintToBytes.CodecEncodeSelf(e)
+				if yyq2[93] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Cmplx64\"")
+					} else {
+						r.EncodeString(`Cmplx64`)
+					}
+					z.EncWriteMapElemValue()
+					z.EncEncodeComplex64(complex64(x.Cmplx64))
+				}
+				if yyq2[94] {
+					z.EncWriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.EncWr().WriteStr("\"Cmplx128\"")
+					} else {
+						r.EncodeString(`Cmplx128`)
+					}
+					z.EncWriteMapElemValue()
+					z.EncEncodeComplex128(complex128(x.Cmplx128))
 				}
 			}
 			z.EncWriteMapEnd()
@@ -11244,22 +14189,13 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 	var yyhl3 bool = l >= 0
-	for yyj3 := 0; ; yyj3++ {
-		if yyhl3 {
-			if yyj3 >= l {
-				break
-			}
-		} else {
-			if z.DecCheckBreak() {
-				break
-			}
-		}
+	for yyj3 := 0; z.DecContainerNext(yyj3, l, yyhl3); yyj3++ {
 		z.DecReadMapElemKey()
-		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3 := r.DecodeStringAsBytes()
 		z.DecReadMapElemValue()
-		switch yys3 {
+		switch string(yys3) {
 		case "S":
-			x.TestStrucCommon.S = (string)(string(r.DecodeStringAsBytes()))
+			x.TestStrucCommon.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "I64":
 			x.TestStrucCommon.I64 = (int64)(r.DecodeInt64())
 		case "I32":
@@ -11296,16 +14232,16 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 			z.F.DecSliceStringX(&x.TestStrucCommon.Sslice, d)
 		case "I64slice":
 			z.F.DecSliceInt64X(&x.TestStrucCommon.I64slice, d)
-		case "I16slice":
-			h.decSliceint16((*[]int16)(&x.TestStrucCommon.I16slice), d)
+		case "I32slice":
+			z.F.DecSliceInt32X(&x.TestStrucCommon.I32slice, d)
 		case "Ui64slice":
 			z.F.DecSliceUint64X(&x.TestStrucCommon.Ui64slice, d)
 		case "Ui8slice":
-			x.TestStrucCommon.Ui8slice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Ui8slice), false)
+			x.TestStrucCommon.Ui8slice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Ui8slice))
 		case "Bslice":
 			z.F.DecSliceBoolX(&x.TestStrucCommon.Bslice, d)
 		case "Byslice":
-			x.TestStrucCommon.Byslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Byslice), false)
+			x.TestStrucCommon.Byslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Byslice))
 		case "BytesSlice":
 			z.F.DecSliceBytesX(&x.TestStrucCommon.BytesSlice, d)
 		case "Iptrslice":
@@ -11322,8 +14258,8 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 			} else {
 				x.TestStrucCommon.WrapSliceString.CodecDecodeSelf(d)
 			}
-		case "Msi64":
-			z.F.DecMapStringInt64X(&x.TestStrucCommon.Msi64, d)
+		case "Msint":
+			z.F.DecMapStringIntX(&x.TestStrucCommon.Msint, d)
 		case "Msbytes":
 			z.F.DecMapStringBytesX(&x.TestStrucCommon.Msbytes, d)
 		case "Simplef":
@@ -11337,7 +14273,7 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "MstrUi64T":
 			h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.TestStrucCommon.MstrUi64T), d)
 		case "AS":
-			x.TestStrucCommon.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
+			x.TestStrucCommon.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 		case "AI64":
 			x.TestStrucCommon.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
 		case "AI16":
@@ -11353,29 +14289,62 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "AF64slice":
 			z.F.DecSliceFloat64X(&x.TestStrucCommon.AnonInTestStruc.AF64slice, d)
 		case "AF32slice":
-			h.decSlicefloat32((*[]float32)(&x.TestStrucCommon.AnonInTestStruc.AF32slice), d)
-		case "AMSU16":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16), d)
+			z.F.DecSliceFloat32X(&x.TestStrucCommon.AnonInTestStruc.AF32slice, d)
+		case "AMSS":
+			z.F.DecMapStringStringX(&x.TestStrucCommon.AnonInTestStruc.AMSS, d)
+		case "AMSU64":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64, d)
+		case "AI64arr8":
+			h.decArray8int64((*[8]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr8), d)
 		case "AI64arr0":
 			h.decArray0int64((*[0]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr0), d)
 		case "AI64slice0":
 			z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice0, d)
 		case "AUi64sliceN":
 			z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, d)
-		case "AMSU16N":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16N), d)
-		case "AMSU16E":
-			h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16E), d)
+		case "AMSU64N":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64N, d)
+		case "AMSU64E":
+			z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64E, d)
 		case "NotAnon":
-			if yyxt80 := z.Extension(x.TestStrucCommon.NotAnon); yyxt80 != nil {
-				z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt80)
+			if yyxt84 := z.Extension(x.TestStrucCommon.NotAnon); yyxt84 != nil {
+				z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt84)
 			} else {
 				x.TestStrucCommon.NotAnon.CodecDecodeSelf(d)
 			}
+		case "P":
+			if r.TryNil() {
+				if x.TestStrucCommon.AnonInTestStrucSlim != nil && x.TestStrucCommon.AnonInTestStrucSlim.P != nil { // remove the if-true
+					x.TestStrucCommon.AnonInTestStrucSlim.P = nil
+				}
+			} else {
+				if x.TestStrucCommon.AnonInTestStrucSlim == nil {
+					x.TestStrucCommon.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
+				}
+				if x.TestStrucCommon.AnonInTestStrucSlim.P == nil {
+					x.TestStrucCommon.AnonInTestStrucSlim.P = new(string)
+				}
+				*x.TestStrucCommon.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+			}
+		case "NotAnonSlim":
+			if r.TryNil() {
+				if x.TestStrucCommon.NotAnonSlim != nil { // remove the if-true
+					x.TestStrucCommon.NotAnonSlim = nil
+				}
+			} else {
+				if x.TestStrucCommon.NotAnonSlim == nil {
+					x.TestStrucCommon.NotAnonSlim = new(AnonInTestStrucSlim)
+				}
+				if yyxt88 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt88 != nil {
+					z.DecExtension(x.TestStrucCommon.NotAnonSlim, yyxt88)
+				} else {
+					x.TestStrucCommon.NotAnonSlim.CodecDecodeSelf(d)
+				}
+			}
 		case "Nmap":
 			z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
 		case "Nslice":
-			x.TestStrucCommon.Nslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Nslice), false)
+			x.TestStrucCommon.Nslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Nslice))
 		case "Nint64":
 			if r.TryNil() {
 				if x.TestStrucCommon.Nint64 != nil { // remove the if-true
@@ -11393,6 +14362,16 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 			z.F.DecMapIntStringX(&x.Mis, d)
 		case "Mbu64":
 			h.decMapboolc3RydWN0IHt9((*map[bool]struct{})(&x.Mbu64), d)
+		case "Mu8e":
+			h.decMapuint8c3RydWN0IHt9((*map[uint8]struct{})(&x.Mu8e), d)
+		case "Mu8u64":
+			h.decMapuint8stringUint64T((*map[uint8]stringUint64T)(&x.Mu8u64), d)
+		case "Msp2ss":
+			h.decMapPtrtostringSlicestring((*map[*string][]string)(&x.Msp2ss), d)
+		case "Mip2ss":
+			h.decMapPtrtouint64Slicestring((*map[*uint64][]string)(&x.Mip2ss), d)
+		case "Ms2misu":
+			h.decMapstringMapuint64stringUint64T((*map[string]map[uint64]stringUint64T)(&x.Ms2misu), d)
 		case "Miwu64s":
 			h.decMapintwrapUint64Slice((*map[int]wrapUint64Slice)(&x.Miwu64s), d)
 		case "Mfwss":
@@ -11404,8 +14383,8 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "Msu2wss":
 			h.decMapstringUint64TwrapStringSlice((*map[stringUint64T]wrapStringSlice)(&x.Msu2wss), d)
 		case "Ci64":
-			if yyxt104 := z.Extension(x.Ci64); yyxt104 != nil {
-				z.DecExtension(&x.Ci64, yyxt104)
+			if yyxt122 := z.Extension(x.Ci64); yyxt122 != nil {
+				z.DecExtension(&x.Ci64, yyxt122)
 			} else {
 				x.Ci64.CodecDecodeSelf(d)
 			}
@@ -11424,10 +14403,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 		case "MstrUi64TSelf":
 			h.decMapstringUint64TPtrtostringUint64T((*map[stringUint64T]*stringUint64T)(&x.MstrUi64TSelf), d)
 		case "Ttime":
-			if !z.DecBasicHandle().TimeNotBuiltin {
+			if z.DecBasicHandle().TimeBuiltin() {
 				x.Ttime = r.DecodeTime()
-			} else if yyxt120 := z.Extension(x.Ttime); yyxt120 != nil {
-				z.DecExtension(&x.Ttime, yyxt120)
+			} else if yyxt138 := z.Extension(x.Ttime); yyxt138 != nil {
+				z.DecExtension(&x.Ttime, yyxt138)
 			} else if z.DecBinary() {
 				z.DecBinaryUnmarshal(&x.Ttime)
 			} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -11444,10 +14423,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.Ttimeptr == nil {
 					x.Ttimeptr = new(time.Time)
 				}
-				if !z.DecBasicHandle().TimeNotBuiltin {
+				if z.DecBasicHandle().TimeBuiltin() {
 					*x.Ttimeptr = r.DecodeTime()
-				} else if yyxt122 := z.Extension(x.Ttimeptr); yyxt122 != nil {
-					z.DecExtension(x.Ttimeptr, yyxt122)
+				} else if yyxt140 := z.Extension(x.Ttimeptr); yyxt140 != nil {
+					z.DecExtension(x.Ttimeptr, yyxt140)
 				} else if z.DecBinary() {
 					z.DecBinaryUnmarshal(x.Ttimeptr)
 				} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -11498,10 +14477,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.AnonInTestStrucIntf == nil {
 					x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
 				}
-				if !z.DecBasicHandle().TimeNotBuiltin {
+				if z.DecBasicHandle().TimeBuiltin() {
 					x.AnonInTestStrucIntf.T = r.DecodeTime()
-				} else if yyxt130 := z.Extension(x.AnonInTestStrucIntf.T); yyxt130 != nil {
-					z.DecExtension(&x.AnonInTestStrucIntf.T, yyxt130)
+				} else if yyxt148 := z.Extension(x.AnonInTestStrucIntf.T); yyxt148 != nil {
+					z.DecExtension(&x.AnonInTestStrucIntf.T, yyxt148)
 				} else if z.DecBinary() {
 					z.DecBinaryUnmarshal(&x.AnonInTestStrucIntf.T)
 				} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -11522,10 +14501,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.AnonInTestStrucIntf.Tptr == nil {
 					x.AnonInTestStrucIntf.Tptr = new(time.Time)
 				}
-				if !z.DecBasicHandle().TimeNotBuiltin {
+				if z.DecBasicHandle().TimeBuiltin() {
 					*x.AnonInTestStrucIntf.Tptr = r.DecodeTime()
-				} else if yyxt132 := z.Extension(x.AnonInTestStrucIntf.Tptr); yyxt132 != nil {
-					z.DecExtension(x.AnonInTestStrucIntf.Tptr, yyxt132)
+				} else if yyxt150 := z.Extension(x.AnonInTestStrucIntf.Tptr); yyxt150 != nil {
+					z.DecExtension(x.AnonInTestStrucIntf.Tptr, yyxt150)
 				} else if z.DecBinary() {
 					z.DecBinaryUnmarshal(x.AnonInTestStrucIntf.Tptr)
 				} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -11534,6 +14513,8 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 					z.DecFallback(x.AnonInTestStrucIntf.Tptr, false)
 				}
 			}
+		case "Msu":
+			h.decMapwrapStringInterface((*map[wrapString]interface{})(&x.Msu), d)
 		case "Mtsptr":
 			h.decMapstringPtrtoTestStrucFlex((*map[string]*TestStrucFlex)(&x.Mtsptr), d)
 		case "Mts":
@@ -11549,38 +14530,42 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 				if x.Nteststruc == nil {
 					x.Nteststruc = new(TestStrucFlex)
 				}
-				if yyxt140 := z.Extension(x.Nteststruc); yyxt140 != nil {
-					z.DecExtension(x.Nteststruc, yyxt140)
+				if yyxt160 := z.Extension(x.Nteststruc); yyxt160 != nil {
+					z.DecExtension(x.Nteststruc, yyxt160)
 				} else {
 					x.Nteststruc.CodecDecodeSelf(d)
 				}
 			}
 		case "MarJ":
-			if yyxt142 := z.Extension(x.MarJ); yyxt142 != nil {
-				z.DecExtension(&x.MarJ, yyxt142)
+			if yyxt162 := z.Extension(x.MarJ); yyxt162 != nil {
+				z.DecExtension(&x.MarJ, yyxt162)
 			} else {
 				x.MarJ.CodecDecodeSelf(d)
 			}
 		case "MarT":
-			if yyxt144 := z.Extension(x.MarT); yyxt144 != nil {
-				z.DecExtension(&x.MarT, yyxt144)
+			if yyxt164 := z.Extension(x.MarT); yyxt164 != nil {
+				z.DecExtension(&x.MarT, yyxt164)
 			} else {
 				x.MarT.CodecDecodeSelf(d)
 			}
 		case "MarB":
-			if yyxt146 := z.Extension(x.MarB); yyxt146 != nil {
-				z.DecExtension(&x.MarB, yyxt146)
+			if yyxt166 := z.Extension(x.MarB); yyxt166 != nil {
+				z.DecExtension(&x.MarB, yyxt166)
 			} else {
 				x.MarB.CodecDecodeSelf(d)
 			}
 		case "XuintToBytes":
-			if yyxt148 := z.Extension(x.XuintToBytes); yyxt148 != nil {
-				z.DecExtension(&x.XuintToBytes, yyxt148)
+			if yyxt168 := z.Extension(x.XuintToBytes); yyxt168 != nil {
+				z.DecExtension(&x.XuintToBytes, yyxt168)
 			} else {
 				x.XuintToBytes.CodecDecodeSelf(d)
 			}
+		case "Cmplx64":
+			x.Cmplx64 = (complex64)(complex(z.DecDecodeFloat32(), 0))
+		case "Cmplx128":
+			x.Cmplx128 = (complex128)(complex(r.DecodeFloat64(), 0))
 		default:
-			z.DecStructFieldNotFound(-1, yys3)
+			z.DecStructFieldNotFound(-1, string(yys3))
 		} // end switch yys3
 	} // end for yyj3
 }
@@ -11589,644 +14574,492 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	var yyj149 int
-	var yyb149 bool
-	var yyhl149 bool = l >= 0
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	var yyj171 int
+	var yyb171 bool
+	var yyhl171 bool = l >= 0
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.S = (string)(string(r.DecodeStringAsBytes()))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	x.TestStrucCommon.S = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I64 = (int64)(r.DecodeInt64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I64n = (int64)(r.DecodeInt64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui64 = (uint64)(r.DecodeUint64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.F64 = (float64)(r.DecodeFloat64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.F32 = (float32)(z.DecDecodeFloat32())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.B = (bool)(r.DecodeBool())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.TestStrucCommon.Sslice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.I64slice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSliceint16((*[]int16)(&x.TestStrucCommon.I16slice), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	z.F.DecSliceInt32X(&x.TestStrucCommon.I32slice, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.Ui64slice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Ui8slice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Ui8slice), false)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	x.TestStrucCommon.Ui8slice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Ui8slice))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBoolX(&x.TestStrucCommon.Bslice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Byslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Byslice), false)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	x.TestStrucCommon.Byslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Byslice))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceBytesX(&x.TestStrucCommon.BytesSlice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicePtrtoint64((*[]*int64)(&x.TestStrucCommon.Iptrslice), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt186 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt186 != nil {
-		z.DecExtension(&x.TestStrucCommon.WrapSliceInt64, yyxt186)
+	if yyxt208 := z.Extension(x.TestStrucCommon.WrapSliceInt64); yyxt208 != nil {
+		z.DecExtension(&x.TestStrucCommon.WrapSliceInt64, yyxt208)
 	} else {
 		x.TestStrucCommon.WrapSliceInt64.CodecDecodeSelf(d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt188 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt188 != nil {
-		z.DecExtension(&x.TestStrucCommon.WrapSliceString, yyxt188)
+	if yyxt210 := z.Extension(x.TestStrucCommon.WrapSliceString); yyxt210 != nil {
+		z.DecExtension(&x.TestStrucCommon.WrapSliceString, yyxt210)
 	} else {
 		x.TestStrucCommon.WrapSliceString.CodecDecodeSelf(d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringInt64X(&x.TestStrucCommon.Msi64, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	z.F.DecMapStringIntX(&x.TestStrucCommon.Msint, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecMapStringBytesX(&x.TestStrucCommon.Msbytes, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt194 := z.Extension(x.TestStrucCommon.Simplef); yyxt194 != nil {
-		z.DecExtension(&x.TestStrucCommon.Simplef, yyxt194)
+	if yyxt216 := z.Extension(x.TestStrucCommon.Simplef); yyxt216 != nil {
+		z.DecExtension(&x.TestStrucCommon.Simplef, yyxt216)
 	} else {
 		x.TestStrucCommon.Simplef.CodecDecodeSelf(d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicestringUint64T((*[]stringUint64T)(&x.TestStrucCommon.SstrUi64T), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringPtrtostringUint64T((*map[string]*stringUint64T)(&x.TestStrucCommon.MstrUi64T), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.AnonInTestStruc.AS = (string)(string(r.DecodeStringAsBytes()))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	x.TestStrucCommon.AnonInTestStruc.AS = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AI64 = (int64)(r.DecodeInt64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	x.TestStrucCommon.AnonInTestStruc.AUi64 = (uint64)(r.DecodeUint64())
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceStringX(&x.TestStrucCommon.AnonInTestStruc.ASslice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64slice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceFloat64X(&x.TestStrucCommon.AnonInTestStruc.AF64slice, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decSlicefloat32((*[]float32)(&x.TestStrucCommon.AnonInTestStruc.AF32slice), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	z.F.DecSliceFloat32X(&x.TestStrucCommon.AnonInTestStruc.AF32slice, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	z.F.DecMapStringStringX(&x.TestStrucCommon.AnonInTestStruc.AMSS, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	h.decArray8int64((*[8]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr8), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray0int64((*[0]int64)(&x.TestStrucCommon.AnonInTestStruc.AI64arr0), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceInt64X(&x.TestStrucCommon.AnonInTestStruc.AI64slice0, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceUint64X(&x.TestStrucCommon.AnonInTestStruc.AUi64sliceN, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16N), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64N, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapstringuint16((*map[string]uint16)(&x.TestStrucCommon.AnonInTestStruc.AMSU16E), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	z.F.DecMapStringUint64X(&x.TestStrucCommon.AnonInTestStruc.AMSU64E, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt226 := z.Extension(x.TestStrucCommon.NotAnon); yyxt226 != nil {
-		z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt226)
+	if yyxt252 := z.Extension(x.TestStrucCommon.NotAnon); yyxt252 != nil {
+		z.DecExtension(&x.TestStrucCommon.NotAnon, yyxt252)
 	} else {
 		x.TestStrucCommon.NotAnon.CodecDecodeSelf(d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.TestStrucCommon.AnonInTestStrucSlim != nil && x.TestStrucCommon.AnonInTestStrucSlim.P != nil { // remove the if-true
+			x.TestStrucCommon.AnonInTestStrucSlim.P = nil
+		}
 	} else {
-		yyb149 = z.DecCheckBreak()
+		if x.TestStrucCommon.AnonInTestStrucSlim == nil {
+			x.TestStrucCommon.AnonInTestStrucSlim = new(AnonInTestStrucSlim)
+		}
+		if x.TestStrucCommon.AnonInTestStrucSlim.P == nil {
+			x.TestStrucCommon.AnonInTestStrucSlim.P = new(string)
+		}
+		*x.TestStrucCommon.AnonInTestStrucSlim.P = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
+	if r.TryNil() {
+		if x.TestStrucCommon.NotAnonSlim != nil { // remove the if-true
+			x.TestStrucCommon.NotAnonSlim = nil
+		}
 	} else {
-		yyb149 = z.DecCheckBreak()
+		if x.TestStrucCommon.NotAnonSlim == nil {
+			x.TestStrucCommon.NotAnonSlim = new(AnonInTestStrucSlim)
+		}
+		if yyxt256 := z.Extension(x.TestStrucCommon.NotAnonSlim); yyxt256 != nil {
+			z.DecExtension(x.TestStrucCommon.NotAnonSlim, yyxt256)
+		} else {
+			x.TestStrucCommon.NotAnonSlim.CodecDecodeSelf(d)
+		}
 	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	x.TestStrucCommon.Nslice = r.DecodeBytes(([]byte)(x.TestStrucCommon.Nslice), false)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	z.F.DecMapStringBoolX(&x.TestStrucCommon.Nmap, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	x.TestStrucCommon.Nslice = z.DecodeBytesInto(([]byte)(x.TestStrucCommon.Nslice))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12241,217 +15074,189 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		*x.TestStrucCommon.Nint64 = (int64)(r.DecodeInt64())
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	h.decChanstring((*chan string)(&x.Chstr), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	z.F.DecMapIntStringX(&x.Mis, d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	h.decMapboolc3RydWN0IHt9((*map[bool]struct{})(&x.Mbu64), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decChanstring((*chan string)(&x.Chstr), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	h.decMapuint8c3RydWN0IHt9((*map[uint8]struct{})(&x.Mu8e), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	z.F.DecMapIntStringX(&x.Mis, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	h.decMapuint8stringUint64T((*map[uint8]stringUint64T)(&x.Mu8u64), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	h.decMapPtrtostringSlicestring((*map[*string][]string)(&x.Msp2ss), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	h.decMapboolc3RydWN0IHt9((*map[bool]struct{})(&x.Mbu64), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
+	h.decMapPtrtouint64Slicestring((*map[*uint64][]string)(&x.Mip2ss), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
-	if yyb149 {
+	z.DecReadArrayElem()
+	h.decMapstringMapuint64stringUint64T((*map[string]map[uint64]stringUint64T)(&x.Ms2misu), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapintwrapUint64Slice((*map[int]wrapUint64Slice)(&x.Miwu64s), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapfloat64wrapStringSlice((*map[float64]wrapStringSlice)(&x.Mfwss), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapfloat32wrapStringSlice((*map[float32]wrapStringSlice)(&x.Mf32wss), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapuint64wrapStringSlice((*map[uint64]wrapStringSlice)(&x.Mui2wss), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringUint64TwrapStringSlice((*map[stringUint64T]wrapStringSlice)(&x.Msu2wss), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt250 := z.Extension(x.Ci64); yyxt250 != nil {
-		z.DecExtension(&x.Ci64, yyxt250)
+	if yyxt290 := z.Extension(x.Ci64); yyxt290 != nil {
+		z.DecExtension(&x.Ci64, yyxt290)
 	} else {
 		x.Ci64.CodecDecodeSelf(d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicewrapBytes((*[]wrapBytes)(&x.Swrapbytes), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicewrapUint8((*[]wrapUint8)(&x.Swrapuint8), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray4stringUint64T((*[4]stringUint64T)(&x.ArrStrUi64T), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decArray4uint64((*[4]uint64)(&x.Ui64array), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decSlicePtrtoArray4uint64((*[]*[4]uint64)(&x.Ui64slicearray), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	z.F.DecSliceIntfX(&x.SintfAarray, d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
 	h.decMapstringUint64TPtrtostringUint64T((*map[stringUint64T]*stringUint64T)(&x.MstrUi64TSelf), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if !z.DecBasicHandle().TimeNotBuiltin {
+	if z.DecBasicHandle().TimeBuiltin() {
 		x.Ttime = r.DecodeTime()
-	} else if yyxt266 := z.Extension(x.Ttime); yyxt266 != nil {
-		z.DecExtension(&x.Ttime, yyxt266)
+	} else if yyxt306 := z.Extension(x.Ttime); yyxt306 != nil {
+		z.DecExtension(&x.Ttime, yyxt306)
 	} else if z.DecBinary() {
 		z.DecBinaryUnmarshal(&x.Ttime)
 	} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -12459,13 +15264,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else {
 		z.DecFallback(&x.Ttime, false)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12478,10 +15279,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.Ttimeptr == nil {
 			x.Ttimeptr = new(time.Time)
 		}
-		if !z.DecBasicHandle().TimeNotBuiltin {
+		if z.DecBasicHandle().TimeBuiltin() {
 			*x.Ttimeptr = r.DecodeTime()
-		} else if yyxt268 := z.Extension(x.Ttimeptr); yyxt268 != nil {
-			z.DecExtension(x.Ttimeptr, yyxt268)
+		} else if yyxt308 := z.Extension(x.Ttimeptr); yyxt308 != nil {
+			z.DecExtension(x.Ttimeptr, yyxt308)
 		} else if z.DecBinary() {
 			z.DecBinaryUnmarshal(x.Ttimeptr)
 		} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -12490,13 +15291,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 			z.DecFallback(x.Ttimeptr, false)
 		}
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12511,13 +15308,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecSliceIntfX(&x.AnonInTestStrucIntf.Islice, d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12532,13 +15325,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.F.DecMapStringIntfX(&x.AnonInTestStrucIntf.Ms, d)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12553,13 +15342,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		}
 		z.DecFallback(&x.AnonInTestStrucIntf.Nintf, true)
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12572,10 +15357,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.AnonInTestStrucIntf == nil {
 			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
 		}
-		if !z.DecBasicHandle().TimeNotBuiltin {
+		if z.DecBasicHandle().TimeBuiltin() {
 			x.AnonInTestStrucIntf.T = r.DecodeTime()
-		} else if yyxt276 := z.Extension(x.AnonInTestStrucIntf.T); yyxt276 != nil {
-			z.DecExtension(&x.AnonInTestStrucIntf.T, yyxt276)
+		} else if yyxt316 := z.Extension(x.AnonInTestStrucIntf.T); yyxt316 != nil {
+			z.DecExtension(&x.AnonInTestStrucIntf.T, yyxt316)
 		} else if z.DecBinary() {
 			z.DecBinaryUnmarshal(&x.AnonInTestStrucIntf.T)
 		} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -12584,13 +15369,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 			z.DecFallback(&x.AnonInTestStrucIntf.T, false)
 		}
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
@@ -12606,10 +15387,10 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 		if x.AnonInTestStrucIntf.Tptr == nil {
 			x.AnonInTestStrucIntf.Tptr = new(time.Time)
 		}
-		if !z.DecBasicHandle().TimeNotBuiltin {
+		if z.DecBasicHandle().TimeBuiltin() {
 			*x.AnonInTestStrucIntf.Tptr = r.DecodeTime()
-		} else if yyxt278 := z.Extension(x.AnonInTestStrucIntf.Tptr); yyxt278 != nil {
-			z.DecExtension(x.AnonInTestStrucIntf.Tptr, yyxt278)
+		} else if yyxt318 := z.Extension(x.AnonInTestStrucIntf.Tptr); yyxt318 != nil {
+			z.DecExtension(x.AnonInTestStrucIntf.Tptr, yyxt318)
 		} else if z.DecBinary() {
 			z.DecBinaryUnmarshal(x.AnonInTestStrucIntf.Tptr)
 		} else if !z.DecBinary() && z.IsJSONHandle() {
@@ -12618,334 +15399,132 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 			z.DecFallback(x.AnonInTestStrucIntf.Tptr, false)
 		}
 	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	h.decMapstringPtrtoTestStrucFlex((*map[string]*TestStrucFlex)(&x.Mtsptr), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	h.decMapstringTestStrucFlex((*map[string]TestStrucFlex)(&x.Mts), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
-		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	h.decSlicePtrtoTestStrucFlex((*[]*TestStrucFlex)(&x.Its), d)
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if r.TryNil() {
-		if x.Nteststruc != nil { // remove the if-true
-			x.Nteststruc = nil
-		}
-	} else {
-		if x.Nteststruc == nil {
-			x.Nteststruc = new(TestStrucFlex)
-		}
-		if yyxt286 := z.Extension(x.Nteststruc); yyxt286 != nil {
-			z.DecExtension(x.Nteststruc, yyxt286)
-		} else {
-			x.Nteststruc.CodecDecodeSelf(d)
-		}
-	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	h.decMapwrapStringInterface((*map[wrapString]interface{})(&x.Msu), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt288 := z.Extension(x.MarJ); yyxt288 != nil {
-		z.DecExtension(&x.MarJ, yyxt288)
-	} else {
-		x.MarJ.CodecDecodeSelf(d)
-	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	h.decMapstringPtrtoTestStrucFlex((*map[string]*TestStrucFlex)(&x.Mtsptr), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt290 := z.Extension(x.MarT); yyxt290 != nil {
-		z.DecExtension(&x.MarT, yyxt290)
-	} else {
-		x.MarT.CodecDecodeSelf(d)
-	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	h.decMapstringTestStrucFlex((*map[string]TestStrucFlex)(&x.Mts), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
 		return
 	}
 	z.DecReadArrayElem()
-	if yyxt292 := z.Extension(x.MarB); yyxt292 != nil {
-		z.DecExtension(&x.MarB, yyxt292)
-	} else {
-		x.MarB.CodecDecodeSelf(d)
-	}
-	yyj149++
-	if yyhl149 {
-		yyb149 = yyj149 > l
-	} else {
-		yyb149 = z.DecCheckBreak()
-	}
-	if yyb149 {
+	h.decSlicePtrtoTestStrucFlex((*[]*TestStrucFlex)(&x.Its), d)
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
 		z.DecReadArrayEnd()
-		return
-	}
-	z.DecReadArrayElem()
-	if yyxt294 := z.Extension(x.XuintToBytes); yyxt294 != nil {
-		z.DecExtension(&x.XuintToBytes, yyxt294)
-	} else {
-		x.XuintToBytes.CodecDecodeSelf(d)
-	}
-	for {
-		yyj149++
-		if yyhl149 {
-			yyb149 = yyj149 > l
-		} else {
-			yyb149 = z.DecCheckBreak()
-		}
-		if yyb149 {
-			break
-		}
-		z.DecReadArrayElem()
-		z.DecStructFieldNotFound(yyj149-1, "")
-	}
-}
-
-func (x *TestStrucFlex) IsCodecEmpty() bool {
-	return !(!(x.TestStrucCommon.IsCodecEmpty()) || len(x.Chstr) != 0 || len(x.Mis) != 0 || len(x.Mbu64) != 0 || len(x.Miwu64s) != 0 || len(x.Mfwss) != 0 || len(x.Mf32wss) != 0 || len(x.Mui2wss) != 0 || len(x.Msu2wss) != 0 || x.Ci64 != 0 || len(x.Swrapbytes) != 0 || len(x.Swrapuint8) != 0 || len(x.ArrStrUi64T) != 0 || len(x.Ui64array) != 0 || len(x.Ui64slicearray) != 0 || len(x.SintfAarray) != 0 || len(x.MstrUi64TSelf) != 0 || !(x.Ttime.IsZero()) || x.AnonInTestStrucIntf != nil && len(x.Mtsptr) != 0 || len(x.Mts) != 0 || len(x.Its) != 0 || bool(x.MarJ) || x.MarT != "" || len(x.MarB) != 0 || x.XuintToBytes != 0 || false)
-}
-
-func (x codecSelfer19780) encwrapSliceUint64(v wrapSliceUint64, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if v == nil {
-		r.EncodeNil()
-		return
-	}
-	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		z.EncWriteArrayElem()
-		r.EncodeUint(uint64(yyv1))
-	}
-	z.EncWriteArrayEnd()
-}
-
-func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyh1.IsNil {
-		if yyv1 != nil {
-			yyv1 = nil
-			yyc1 = true
-		}
-	} else if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []uint64{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]uint64, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
-		}
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]uint64, yyrl1)
-				yyc1 = true
-			}
-			yyh1.ElemContainerState(yyj1)
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
-				yyc1 = true
-			}
-			if yydb1 {
-				z.DecSwallow()
-			} else {
-				yyv1[yyj1] = (uint64)(r.DecodeUint64())
-			}
-		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]uint64, 0)
-			yyc1 = true
-		}
-	}
-	yyh1.End()
-	if yyc1 {
-		*v = yyv1
-	}
-}
-
-func (x codecSelfer19780) encwrapSliceString(v wrapSliceString, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if v == nil {
-		r.EncodeNil()
-		return
-	}
-	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		z.EncWriteArrayElem()
-		r.EncodeString(string(yyv1))
-	}
-	z.EncWriteArrayEnd()
-}
-
-func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyh1.IsNil {
-		if yyv1 != nil {
-			yyv1 = nil
-			yyc1 = true
-		}
-	} else if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []string{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]string, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
+		return
+	}
+	z.DecReadArrayElem()
+	if r.TryNil() {
+		if x.Nteststruc != nil { // remove the if-true
+			x.Nteststruc = nil
 		}
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]string, yyrl1)
-				yyc1 = true
-			}
-			yyh1.ElemContainerState(yyj1)
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, "")
-				yyc1 = true
-			}
-			if yydb1 {
-				z.DecSwallow()
-			} else {
-				yyv1[yyj1] = (string)(string(r.DecodeStringAsBytes()))
-			}
+	} else {
+		if x.Nteststruc == nil {
+			x.Nteststruc = new(TestStrucFlex)
 		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]string, 0)
-			yyc1 = true
+		if yyxt328 := z.Extension(x.Nteststruc); yyxt328 != nil {
+			z.DecExtension(x.Nteststruc, yyxt328)
+		} else {
+			x.Nteststruc.CodecDecodeSelf(d)
 		}
 	}
-	yyh1.End()
-	if yyc1 {
-		*v = yyv1
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt330 := z.Extension(x.MarJ); yyxt330 != nil {
+		z.DecExtension(&x.MarJ, yyxt330)
+	} else {
+		x.MarJ.CodecDecodeSelf(d)
+	}
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt332 := z.Extension(x.MarT); yyxt332 != nil {
+		z.DecExtension(&x.MarT, yyxt332)
+	} else {
+		x.MarT.CodecDecodeSelf(d)
+	}
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt334 := z.Extension(x.MarB); yyxt334 != nil {
+		z.DecExtension(&x.MarB, yyxt334)
+	} else {
+		x.MarB.CodecDecodeSelf(d)
+	}
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	if yyxt336 := z.Extension(x.XuintToBytes); yyxt336 != nil {
+		z.DecExtension(&x.XuintToBytes, yyxt336)
+	} else {
+		x.XuintToBytes.CodecDecodeSelf(d)
+	}
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
+	}
+	z.DecReadArrayElem()
+	x.Cmplx64 = (complex64)(complex(z.DecDecodeFloat32(), 0))
+	yyj171++
+	yyb171 = !z.DecContainerNext(yyj171, l, yyhl171)
+	if yyb171 {
+		z.DecReadArrayEnd()
+		return
 	}
+	z.DecReadArrayElem()
+	x.Cmplx128 = (complex128)(complex(r.DecodeFloat64(), 0))
+	yyj171++
+	for ; z.DecContainerNext(yyj171, l, yyhl171); yyj171++ {
+		z.DecReadArrayElem()
+		z.DecStructFieldNotFound(yyj171-1, "")
+	}
+}
+
+func (x *TestStrucFlex) IsCodecEmpty() bool {
+	return !(!(x.TestStrucCommon.IsCodecEmpty()) || len(x.Chstr) != 0 || len(x.Mis) != 0 || len(x.Mbu64) != 0 || len(x.Mu8e) != 0 || len(x.Mu8u64) != 0 || len(x.Msp2ss) != 0 || len(x.Mip2ss) != 0 || len(x.Ms2misu) != 0 || len(x.Miwu64s) != 0 || len(x.Mfwss) != 0 || len(x.Mf32wss) != 0 || len(x.Mui2wss) != 0 || len(x.Msu2wss) != 0 || x.Ci64 != 0 || len(x.Swrapbytes) != 0 || len(x.Swrapuint8) != 0 || x.ArrStrUi64T != [4]stringUint64T{} || x.Ui64array != [4]uint64{} || len(x.Ui64slicearray) != 0 || len(x.SintfAarray) != 0 || len(x.MstrUi64TSelf) != 0 || !(x.Ttime.IsZero()) || x.AnonInTestStrucIntf != nil && len(x.Msu) != 0 || len(x.Mtsptr) != 0 || len(x.Mts) != 0 || len(x.Its) != 0 || bool(x.MarJ) || x.MarT != "" || len(x.MarB) != 0 || x.XuintToBytes != 0 || x.Cmplx64 != 0 || x.Cmplx128 != 0 || false)
 }
 
 func (x codecSelfer19780) encwrapUint64Slice(v wrapUint64Slice, e *Encoder) {
@@ -12957,12 +15536,12 @@ func (x codecSelfer19780) encwrapUint64Slice(v wrapUint64Slice, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-			z.EncExtension(yyv1, yyxt2)
+		if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+			z.EncExtension(v[yyv1], yyxt2)
 		} else {
-			yyv1.CodecEncodeSelf(e)
+			v[yyv1].CodecEncodeSelf(e)
 		}
 	}
 	z.EncWriteArrayEnd()
@@ -13009,7 +15588,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
@@ -13039,7 +15618,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapUint64, 0)
+			yyv1 = []wrapUint64{}
 			yyc1 = true
 		}
 	}
@@ -13058,12 +15637,12 @@ func (x codecSelfer19780) encwrapStringSlice(v wrapStringSlice, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-			z.EncExtension(yyv1, yyxt2)
+		if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+			z.EncExtension(v[yyv1], yyxt2)
 		} else {
-			yyv1.CodecEncodeSelf(e)
+			v[yyv1].CodecEncodeSelf(e)
 		}
 	}
 	z.EncWriteArrayEnd()
@@ -13110,7 +15689,7 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
@@ -13140,7 +15719,7 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapString, 0)
+			yyv1 = []wrapString{}
 			yyc1 = true
 		}
 	}
@@ -13150,7 +15729,7 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSlicefloat32(v []float32, e *Encoder) {
+func (x codecSelfer19780) encArray8int64(v *[8]int64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
@@ -13159,148 +15738,41 @@ func (x codecSelfer19780) encSlicefloat32(v []float32, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		r.EncodeFloat32(float32(yyv1))
+		r.EncodeInt(int64(v[yyv1]))
 	}
 	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicefloat32(v *[]float32, d *Decoder) {
+func (x codecSelfer19780) decArray8int64(v *[8]int64, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 
-	yyv1 := *v
+	yyv1 := v
 	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyh1.IsNil {
-		if yyv1 != nil {
-			yyv1 = nil
-			yyc1 = true
-		}
-	} else if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []float32{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
+	if yyl1 == 0 {
 	} else {
 		yyhl1 := yyl1 > 0
 		var yyrl1 int
 		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]float32, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
-		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]float32, yyrl1)
-				yyc1 = true
-			}
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
-				yyc1 = true
+				z.DecArrayCannotExpand(len(v), yyj1+1)
+				yydb1 = true
 			}
 			if yydb1 {
 				z.DecSwallow()
 			} else {
-				yyv1[yyj1] = (float32)(z.DecDecodeFloat32())
+				yyv1[yyj1] = (int64)(r.DecodeInt64())
 			}
 		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]float32, 0)
-			yyc1 = true
-		}
 	}
 	yyh1.End()
-	if yyc1 {
-		*v = yyv1
-	}
-}
-
-func (x codecSelfer19780) encMapstringuint16(v map[string]uint16, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if v == nil {
-		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
-		r.EncodeUint(uint64(yyv1))
-	}
-	z.EncWriteMapEnd()
-}
-
-func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyl1 := z.DecReadMapStart()
-	if yyl1 == codecSelferDecContainerLenNil19780 {
-		*v = nil
-	} else {
-		if yyv1 == nil {
-			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 18)
-			yyv1 = make(map[string]uint16, yyrl1)
-			*v = yyv1
-		}
-		var yymk1 string
-		var yymv1 uint16
-		var yymg1, yymdn1 bool
-		if z.DecBasicHandle().MapValueReset {
-		}
-		if yyl1 != 0 {
-			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
-				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
-				if yymg1 {
-					yymv1 = yyv1[yymk1]
-				}
-				z.DecReadMapElemValue()
-				yymdn1 = false
-				yymv1 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-				if yymdn1 {
-					yyv1[yymk1] = 0
-				} else if yyv1 != nil {
-					yyv1[yymk1] = yymv1
-				}
-			}
-		} // else len==0: leave as-is (do not clear map entries)
-		z.DecReadMapEnd()
-	}
 }
 
 func (x codecSelfer19780) encArray0int64(v *[0]int64, e *Encoder) {
@@ -13312,9 +15784,9 @@ func (x codecSelfer19780) encArray0int64(v *[0]int64, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		r.EncodeInt(int64(yyv1))
+		r.EncodeInt(int64(v[yyv1]))
 	}
 	z.EncWriteArrayEnd()
 }
@@ -13332,7 +15804,7 @@ func (x codecSelfer19780) decArray0int64(v *[0]int64, d *Decoder) {
 		var yyrl1 int
 		_ = yyrl1
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
@@ -13349,99 +15821,6 @@ func (x codecSelfer19780) decArray0int64(v *[0]int64, d *Decoder) {
 	yyh1.End()
 }
 
-func (x codecSelfer19780) encSliceint16(v []int16, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Encoder(e)
-	_, _, _ = h, z, r
-	if v == nil {
-		r.EncodeNil()
-		return
-	}
-	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		z.EncWriteArrayElem()
-		r.EncodeInt(int64(yyv1))
-	}
-	z.EncWriteArrayEnd()
-}
-
-func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelper().Decoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyh1.IsNil {
-		if yyv1 != nil {
-			yyv1 = nil
-			yyc1 = true
-		}
-	} else if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []int16{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]int16, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
-		}
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]int16, yyrl1)
-				yyc1 = true
-			}
-			yyh1.ElemContainerState(yyj1)
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
-				yyc1 = true
-			}
-			if yydb1 {
-				z.DecSwallow()
-			} else {
-				yyv1[yyj1] = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-			}
-		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]int16, 0)
-			yyc1 = true
-		}
-	}
-	yyh1.End()
-	if yyc1 {
-		*v = yyv1
-	}
-}
-
 func (x codecSelfer19780) encSlicePtrtoint64(v []*int64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
@@ -13451,12 +15830,12 @@ func (x codecSelfer19780) encSlicePtrtoint64(v []*int64, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyv1 == nil {
+		if v[yyv1] == nil {
 			r.EncodeNil()
 		} else {
-			yy2 := *yyv1
+			yy2 := *v[yyv1]
 			r.EncodeInt(int64(yy2))
 		}
 	}
@@ -13504,7 +15883,7 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
@@ -13537,7 +15916,7 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*int64, 0)
+			yyv1 = []*int64{}
 			yyc1 = true
 		}
 	}
@@ -13556,9 +15935,9 @@ func (x codecSelfer19780) encSlicestringUint64T(v []stringUint64T, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		yy2 := &yyv1
+		yy2 := &v[yyv1]
 		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
 			z.EncExtension(yy2, yyxt3)
 		} else {
@@ -13609,7 +15988,7 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
@@ -13639,7 +16018,7 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]stringUint64T, 0)
+			yyv1 = []stringUint64T{}
 			yyc1 = true
 		}
 	}
@@ -13655,24 +16034,47 @@ func (x codecSelfer19780) encMapstringPtrtostringUint64T(v map[string]*stringUin
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
-		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-				z.EncExtension(yyv1, yyxt3)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			if yye1 == nil {
+				r.EncodeNil()
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+					z.EncExtension(yye1, yyxt2)
+				} else {
+					yye1.CodecEncodeSelf(e)
+				}
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+					z.EncExtension(yyv1, yyxt4)
+				} else {
+					yyv1.CodecEncodeSelf(e)
+				}
 			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringPtrtostringUint64T(v *map[string]*stringUint64T, d *Decoder) {
@@ -13698,9 +16100,9 @@ func (x codecSelfer19780) decMapstringPtrtostringUint64T(v *map[string]*stringUi
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 				yyms1 = true
 				if yymg1 {
 					yymv1, yymok1 = yyv1[yymk1]
@@ -13726,7 +16128,7 @@ func (x codecSelfer19780) decMapstringPtrtostringUint64T(v *map[string]*stringUi
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyms1 && yyv1 != nil {
+				} else if yyms1 {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -13741,24 +16143,47 @@ func (x codecSelfer19780) encMapstringPtrtoTestStruc(v map[string]*TestStruc, e
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
-		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-				z.EncExtension(yyv1, yyxt3)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			if yye1 == nil {
+				r.EncodeNil()
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+					z.EncExtension(yye1, yyxt2)
+				} else {
+					yye1.CodecEncodeSelf(e)
+				}
 			}
 		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+					z.EncExtension(yyv1, yyxt4)
+				} else {
+					yyv1.CodecEncodeSelf(e)
+				}
+			}
+		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringPtrtoTestStruc(v *map[string]*TestStruc, d *Decoder) {
@@ -13784,9 +16209,9 @@ func (x codecSelfer19780) decMapstringPtrtoTestStruc(v *map[string]*TestStruc, d
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 				yyms1 = true
 				if yymg1 {
 					yymv1, yymok1 = yyv1[yymk1]
@@ -13812,7 +16237,7 @@ func (x codecSelfer19780) decMapstringPtrtoTestStruc(v *map[string]*TestStruc, d
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyms1 && yyv1 != nil {
+				} else if yyms1 {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -13827,21 +16252,41 @@ func (x codecSelfer19780) encMapstringTestStruc(v map[string]TestStruc, e *Encod
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
-		yy3 := &yyv1
-		if yyxt4 := z.Extension(yy3); yyxt4 != nil {
-			z.EncExtension(yy3, yyxt4)
-		} else {
-			yy3.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			yy2 := &yye1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			yy5 := &yyv1
+			if yyxt6 := z.Extension(yy5); yyxt6 != nil {
+				z.EncExtension(yy5, yyxt6)
+			} else {
+				yy5.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Decoder) {
@@ -13855,7 +16300,7 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 		*v = nil
 	} else {
 		if yyv1 == nil {
-			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1224)
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1384)
 			yyv1 = make(map[string]TestStruc, yyrl1)
 			*v = yyv1
 		}
@@ -13867,9 +16312,9 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 				if yymg1 {
 					yymv1 = yyv1[yymk1]
 				} else {
@@ -13884,7 +16329,7 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 				}
 				if yymdn1 {
 					yyv1[yymk1] = TestStruc{}
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -13902,15 +16347,15 @@ func (x codecSelfer19780) encSlicePtrtoTestStruc(v []*TestStruc, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyv1 == nil {
+		if v[yyv1] == nil {
 			r.EncodeNil()
 		} else {
-			if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-				z.EncExtension(yyv1, yyxt2)
+			if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+				z.EncExtension(v[yyv1], yyxt2)
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				v[yyv1].CodecEncodeSelf(e)
 			}
 		}
 	}
@@ -13958,7 +16403,7 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
@@ -13995,9 +16440,104 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*TestStruc, 0)
+			yyv1 = []*TestStruc{}
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
+}
+
+func (x codecSelfer19780) encChanstring(v chan string, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+		return
+	}
+	if v == nil {
+		r.EncodeNil()
+	} else {
+		var sch1 []string
+
+	Lsch1:
+		switch timeout1 := z.EncBasicHandle().ChanRecvTimeout; {
+		case timeout1 == 0: // only consume available
+			for {
+				select {
+				case b1 := <-v:
+					sch1 = append(sch1, b1)
+				default:
+					break Lsch1
+				}
+			}
+		case timeout1 > 0: // consume until timeout
+			tt1 := time.NewTimer(timeout1)
+			for {
+				select {
+				case b1 := <-v:
+					sch1 = append(sch1, b1)
+				case <-tt1.C:
+					// close(tt.C)
+					break Lsch1
+				}
+			}
+		default: // consume until close
+			for b1 := range v {
+				sch1 = append(sch1, b1)
+			}
+		}
+		z.EncWriteArrayStart(len(sch1))
+		for yyv1 := range sch1 {
+			z.EncWriteArrayElem()
+			r.EncodeString(string(sch1[yyv1]))
+		}
+		z.EncWriteArrayEnd()
+	}
+}
+
+func (x codecSelfer19780) decChanstring(v *chan string, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyh1.IsNil {
+		if yyv1 != nil {
+			yyv1 = nil
+			yyc1 = true
+		}
+	} else if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = make(chan string, 0)
 			yyc1 = true
 		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		var yyj1 int
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				} else {
+					yyrl1 = 64
+				}
+				yyv1 = make(chan string, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+			var yyvcx1 string
+			yyvcx1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+			yyv1 <- yyvcx1
+		}
 	}
 	yyh1.End()
 	if yyc1 {
@@ -14005,203 +16545,536 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encAarray(v *Aarray, e *Encoder) {
+func (x codecSelfer19780) encMapboolc3RydWN0IHt9(v map[bool]struct{}, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeBool(bool(yyk1))
+			z.EncWriteMapElemValue()
+			yy3 := &yyv1
+			z.EncFallback(yy3)
+		}
+		z.EncWriteMapEnd()
+	}
+}
+
+func (x codecSelfer19780) decMapboolc3RydWN0IHt9(v *map[bool]struct{}, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
+		if yyv1 == nil {
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
+			yyv1 = make(map[bool]struct{}, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 bool
+		var yymv1 struct{}
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				yymk1 = (bool)(r.DecodeBool())
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = struct{}{}
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				z.DecFallback(&yymv1, false)
+				if yymdn1 {
+					yyv1[yymk1] = struct{}{}
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
+	}
+}
+
+func (x codecSelfer19780) encMapuint8c3RydWN0IHt9(v map[uint8]struct{}, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]uint64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, uint64(k))
+		}
+		sort.Sort(codecSelfer19780uint64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[uint8(yyv1)]
+			yy2 := &yye1
+			z.EncFallback(yy2)
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyk1))
+			z.EncWriteMapElemValue()
+			yy5 := &yyv1
+			z.EncFallback(yy5)
+		}
+		z.EncWriteMapEnd()
+	}
+}
+
+func (x codecSelfer19780) decMapuint8c3RydWN0IHt9(v *map[uint8]struct{}, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
+		if yyv1 == nil {
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
+			yyv1 = make(map[uint8]struct{}, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 uint8
+		var yymv1 struct{}
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				yymk1 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = struct{}{}
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				z.DecFallback(&yymv1, false)
+				if yymdn1 {
+					yyv1[yymk1] = struct{}{}
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
+	}
+}
+
+func (x codecSelfer19780) encMapuint8stringUint64T(v map[uint8]stringUint64T, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		z.EncWriteArrayElem()
-		r.EncodeString(string(yyv1))
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]uint64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, uint64(k))
+		}
+		sort.Sort(codecSelfer19780uint64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[uint8(yyv1)]
+			yy2 := &yye1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyk1))
+			z.EncWriteMapElemValue()
+			yy5 := &yyv1
+			if yyxt6 := z.Extension(yy5); yyxt6 != nil {
+				z.EncExtension(yy5, yyxt6)
+			} else {
+				yy5.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteArrayEnd()
 }
 
-func (x codecSelfer19780) decAarray(v *Aarray, d *Decoder) {
+func (x codecSelfer19780) decMapuint8stringUint64T(v *map[uint8]stringUint64T, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 
-	yyv1 := v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	if yyl1 == 0 {
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
 	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			yyh1.ElemContainerState(yyj1)
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				z.DecArrayCannotExpand(len(v), yyj1+1)
-				yydb1 = true
-			}
-			if yydb1 {
-				z.DecSwallow()
-			} else {
-				yyv1[yyj1] = (string)(string(r.DecodeStringAsBytes()))
-			}
+		if yyv1 == nil {
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 25)
+			yyv1 = make(map[uint8]stringUint64T, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 uint8
+		var yymv1 stringUint64T
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
 		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				yymk1 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = stringUint64T{}
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				if yyxt4 := z.Extension(yymv1); yyxt4 != nil {
+					z.DecExtension(&yymv1, yyxt4)
+				} else {
+					yymv1.CodecDecodeSelf(d)
+				}
+				if yymdn1 {
+					yyv1[yymk1] = stringUint64T{}
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
 	}
-	yyh1.End()
 }
 
-func (x codecSelfer19780) encwrapBytes(v wrapBytes, e *Encoder) {
+func (x codecSelfer19780) encMapPtrtostringSlicestring(v map[*string][]string, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			if yyk1 == nil {
+				r.EncodeNil()
+			} else {
+				yy2 := *yyk1
+				r.EncodeString(string(yy2))
+			}
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(yyv1, e)
+			} // end block: if yyv1 slice == nil
+		}
+		z.EncWriteMapEnd()
 	}
-	r.EncodeStringBytesRaw([]byte(v))
 }
 
-func (x codecSelfer19780) decwrapBytes(v *wrapBytes, d *Decoder) {
+func (x codecSelfer19780) decMapPtrtostringSlicestring(v *map[*string][]string, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	*v = r.DecodeBytes(*((*[]byte)(v)), false)
+
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
+		if yyv1 == nil {
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1 = make(map[*string][]string, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 *string
+		var yymv1 []string
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				if r.TryNil() {
+					*yymk1 = ""
+				} else {
+					if yymk1 == nil {
+						yymk1 = new(string)
+					}
+					*yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+				}
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = nil
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				z.F.DecSliceStringX(&yymv1, d)
+				if yymdn1 {
+					yyv1[yymk1] = nil
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
+	}
 }
 
-func (x codecSelfer19780) enctestMarshalAsBinary(v testMarshalAsBinary, e *Encoder) {
+func (x codecSelfer19780) encMapPtrtouint64Slicestring(v map[*uint64][]string, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			if yyk1 == nil {
+				r.EncodeNil()
+			} else {
+				yy2 := *yyk1
+				r.EncodeUint(uint64(yy2))
+			}
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				z.F.EncSliceStringV(yyv1, e)
+			} // end block: if yyv1 slice == nil
+		}
+		z.EncWriteMapEnd()
 	}
-	r.EncodeStringBytesRaw([]byte(v))
 }
 
-func (x codecSelfer19780) dectestMarshalAsBinary(v *testMarshalAsBinary, d *Decoder) {
+func (x codecSelfer19780) decMapPtrtouint64Slicestring(v *map[*uint64][]string, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
-	*v = r.DecodeBytes(*((*[]byte)(v)), false)
+
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
+		if yyv1 == nil {
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1 = make(map[*uint64][]string, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 *uint64
+		var yymv1 []string
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				if r.TryNil() {
+					*yymk1 = 0
+				} else {
+					if yymk1 == nil {
+						yymk1 = new(uint64)
+					}
+					*yymk1 = (uint64)(r.DecodeUint64())
+				}
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = nil
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				z.F.DecSliceStringX(&yymv1, d)
+				if yymdn1 {
+					yyv1[yymk1] = nil
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
+	}
 }
 
-func (x codecSelfer19780) encChanstring(v chan string, e *Encoder) {
+func (x codecSelfer19780) encMapstringMapuint64stringUint64T(v map[string]map[uint64]stringUint64T, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	if v == nil {
-		r.EncodeNil()
-	} else {
-		var sch1 []string
-
-	Lsch1:
-		switch timeout1 := z.EncBasicHandle().ChanRecvTimeout; {
-		case timeout1 == 0: // only consume available
-			for {
-				select {
-				case b1 := <-v:
-					sch1 = append(sch1, b1)
-				default:
-					break Lsch1
-				}
-			}
-		case timeout1 > 0: // consume until timeout
-			tt1 := time.NewTimer(timeout1)
-			for {
-				select {
-				case b1 := <-v:
-					sch1 = append(sch1, b1)
-				case <-tt1.C:
-					// close(tt.C)
-					break Lsch1
-				}
-			}
-		default: // consume until close
-			for b1 := range v {
-				sch1 = append(sch1, b1)
-			}
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			if yye1 == nil {
+				r.EncodeNil()
+			} else {
+				h.encMapuint64stringUint64T((map[uint64]stringUint64T)(yye1), e)
+			} // end block: if yye1 map == nil
 		}
-		z.EncWriteArrayStart(len(sch1))
-		for _, yyv1 := range sch1 {
-			z.EncWriteArrayElem()
-			r.EncodeString(string(yyv1))
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				h.encMapuint64stringUint64T((map[uint64]stringUint64T)(yyv1), e)
+			} // end block: if yyv1 map == nil
 		}
-		z.EncWriteArrayEnd()
+		z.EncWriteMapEnd()
 	}
 }
 
-func (x codecSelfer19780) decChanstring(v *chan string, d *Decoder) {
+func (x codecSelfer19780) decMapstringMapuint64stringUint64T(v *map[string]map[uint64]stringUint64T, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
 
 	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyh1.IsNil {
-		if yyv1 != nil {
-			yyv1 = nil
-			yyc1 = true
-		}
-	} else if yyl1 == 0 {
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
 		if yyv1 == nil {
-			yyv1 = make(chan string, 0)
-			yyc1 = true
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
+			yyv1 = make(map[string]map[uint64]stringUint64T, yyrl1)
+			*v = yyv1
 		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+		var yymk1 string
+		var yymv1 map[uint64]stringUint64T
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			yymg1 = true
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
 				} else {
-					yyrl1 = 64
+					yymv1 = nil
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				h.decMapuint64stringUint64T((*map[uint64]stringUint64T)(&yymv1), d)
+				if yymdn1 {
+					yyv1[yymk1] = nil
+				} else {
+					yyv1[yymk1] = yymv1
 				}
-				yyv1 = make(chan string, yyrl1)
-				yyc1 = true
 			}
-			yyh1.ElemContainerState(yyj1)
-			var yyvcx1 string
-			yyvcx1 = (string)(string(r.DecodeStringAsBytes()))
-			yyv1 <- yyvcx1
-		}
-	}
-	yyh1.End()
-	if yyc1 {
-		*v = yyv1
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
 	}
 }
 
-func (x codecSelfer19780) encMapboolc3RydWN0IHt9(v map[bool]struct{}, e *Encoder) {
+func (x codecSelfer19780) encMapuint64stringUint64T(v map[uint64]stringUint64T, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeBool(bool(yyk1))
-		z.EncWriteMapElemValue()
-		yy3 := &yyv1
-		z.EncFallback(yy3)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]uint64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, uint64(k))
+		}
+		sort.Sort(codecSelfer19780uint64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyv1))
+			z.EncWriteMapElemValue()
+			yye1 := v[uint64(yyv1)]
+			yy2 := &yye1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyk1))
+			z.EncWriteMapElemValue()
+			yy5 := &yyv1
+			if yyxt6 := z.Extension(yy5); yyxt6 != nil {
+				z.EncExtension(yy5, yyxt6)
+			} else {
+				yy5.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapboolc3RydWN0IHt9(v *map[bool]struct{}, d *Decoder) {
+func (x codecSelfer19780) decMapuint64stringUint64T(v *map[uint64]stringUint64T, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Decoder(d)
 	_, _, _ = h, z, r
@@ -14212,32 +17085,36 @@ func (x codecSelfer19780) decMapboolc3RydWN0IHt9(v *map[bool]struct{}, d *Decode
 		*v = nil
 	} else {
 		if yyv1 == nil {
-			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
-			yyv1 = make(map[bool]struct{}, yyrl1)
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1 = make(map[uint64]stringUint64T, yyrl1)
 			*v = yyv1
 		}
-		var yymk1 bool
-		var yymv1 struct{}
+		var yymk1 uint64
+		var yymv1 stringUint64T
 		var yymg1, yymdn1 bool
 		if z.DecBasicHandle().MapValueReset {
 			yymg1 = true
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (bool)(r.DecodeBool())
+				yymk1 = (uint64)(r.DecodeUint64())
 				if yymg1 {
 					yymv1 = yyv1[yymk1]
 				} else {
-					yymv1 = struct{}{}
+					yymv1 = stringUint64T{}
 				}
 				z.DecReadMapElemValue()
 				yymdn1 = false
-				z.DecFallback(&yymv1, false)
+				if yyxt4 := z.Extension(yymv1); yyxt4 != nil {
+					z.DecExtension(&yymv1, yyxt4)
+				} else {
+					yymv1.CodecDecodeSelf(d)
+				}
 				if yymdn1 {
-					yyv1[yymk1] = struct{}{}
-				} else if yyv1 != nil {
+					yyv1[yymk1] = stringUint64T{}
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14252,20 +17129,39 @@ func (x codecSelfer19780) encMapintwrapUint64Slice(v map[int]wrapUint64Slice, e
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeInt(int64(yyk1))
-		z.EncWriteMapElemValue()
-		if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-			z.EncExtension(yyv1, yyxt3)
-		} else {
-			yyv1.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]int64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, int64(k))
+		}
+		sort.Sort(codecSelfer19780int64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeInt(int64(yyv1))
+			z.EncWriteMapElemValue()
+			yye1 := v[int(yyv1)]
+			if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+				z.EncExtension(yye1, yyxt2)
+			} else {
+				yye1.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeInt(int64(yyk1))
+			z.EncWriteMapElemValue()
+			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+				z.EncExtension(yyv1, yyxt4)
+			} else {
+				yyv1.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapintwrapUint64Slice(v *map[int]wrapUint64Slice, d *Decoder) {
@@ -14291,7 +17187,7 @@ func (x codecSelfer19780) decMapintwrapUint64Slice(v *map[int]wrapUint64Slice, d
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				yymk1 = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 				if yymg1 {
@@ -14308,7 +17204,7 @@ func (x codecSelfer19780) decMapintwrapUint64Slice(v *map[int]wrapUint64Slice, d
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14323,20 +17219,39 @@ func (x codecSelfer19780) encMapfloat64wrapStringSlice(v map[float64]wrapStringS
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeFloat64(float64(yyk1))
-		z.EncWriteMapElemValue()
-		if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-			z.EncExtension(yyv1, yyxt3)
-		} else {
-			yyv1.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]float64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, float64(k))
+		}
+		sort.Sort(codecSelfer19780float64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeFloat64(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[float64(yyv1)]
+			if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+				z.EncExtension(yye1, yyxt2)
+			} else {
+				yye1.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeFloat64(float64(yyk1))
+			z.EncWriteMapElemValue()
+			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+				z.EncExtension(yyv1, yyxt4)
+			} else {
+				yyv1.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapfloat64wrapStringSlice(v *map[float64]wrapStringSlice, d *Decoder) {
@@ -14362,7 +17277,7 @@ func (x codecSelfer19780) decMapfloat64wrapStringSlice(v *map[float64]wrapString
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				yymk1 = (float64)(r.DecodeFloat64())
 				if yymg1 {
@@ -14379,7 +17294,7 @@ func (x codecSelfer19780) decMapfloat64wrapStringSlice(v *map[float64]wrapString
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14394,20 +17309,39 @@ func (x codecSelfer19780) encMapfloat32wrapStringSlice(v map[float32]wrapStringS
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeFloat32(float32(yyk1))
-		z.EncWriteMapElemValue()
-		if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-			z.EncExtension(yyv1, yyxt3)
-		} else {
-			yyv1.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]float64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, float64(k))
+		}
+		sort.Sort(codecSelfer19780float64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeFloat32(float32(yyv1))
+			z.EncWriteMapElemValue()
+			yye1 := v[float32(yyv1)]
+			if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+				z.EncExtension(yye1, yyxt2)
+			} else {
+				yye1.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeFloat32(float32(yyk1))
+			z.EncWriteMapElemValue()
+			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+				z.EncExtension(yyv1, yyxt4)
+			} else {
+				yyv1.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapfloat32wrapStringSlice(v *map[float32]wrapStringSlice, d *Decoder) {
@@ -14433,7 +17367,7 @@ func (x codecSelfer19780) decMapfloat32wrapStringSlice(v *map[float32]wrapString
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				yymk1 = (float32)(z.DecDecodeFloat32())
 				if yymg1 {
@@ -14450,7 +17384,7 @@ func (x codecSelfer19780) decMapfloat32wrapStringSlice(v *map[float32]wrapString
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14465,20 +17399,39 @@ func (x codecSelfer19780) encMapuint64wrapStringSlice(v map[uint64]wrapStringSli
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeUint(uint64(yyk1))
-		z.EncWriteMapElemValue()
-		if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-			z.EncExtension(yyv1, yyxt3)
-		} else {
-			yyv1.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]uint64, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, uint64(k))
+		}
+		sort.Sort(codecSelfer19780uint64Slice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyv1))
+			z.EncWriteMapElemValue()
+			yye1 := v[uint64(yyv1)]
+			if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+				z.EncExtension(yye1, yyxt2)
+			} else {
+				yye1.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeUint(uint64(yyk1))
+			z.EncWriteMapElemValue()
+			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+				z.EncExtension(yyv1, yyxt4)
+			} else {
+				yyv1.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapuint64wrapStringSlice(v *map[uint64]wrapStringSlice, d *Decoder) {
@@ -14504,7 +17457,7 @@ func (x codecSelfer19780) decMapuint64wrapStringSlice(v *map[uint64]wrapStringSl
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				yymk1 = (uint64)(r.DecodeUint64())
 				if yymg1 {
@@ -14521,7 +17474,7 @@ func (x codecSelfer19780) decMapuint64wrapStringSlice(v *map[uint64]wrapStringSl
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14536,25 +17489,27 @@ func (x codecSelfer19780) encMapstringUint64TwrapStringSlice(v map[stringUint64T
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		yy2 := &yyk1
-		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
-			z.EncExtension(yy2, yyxt3)
-		} else {
-			yy2.CodecEncodeSelf(e)
-		}
-		z.EncWriteMapElemValue()
-		if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
-			z.EncExtension(yyv1, yyxt4)
-		} else {
-			yyv1.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			yy2 := &yyk1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
+			}
+			z.EncWriteMapElemValue()
+			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+				z.EncExtension(yyv1, yyxt4)
+			} else {
+				yyv1.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringUint64TwrapStringSlice(v *map[stringUint64T]wrapStringSlice, d *Decoder) {
@@ -14580,7 +17535,7 @@ func (x codecSelfer19780) decMapstringUint64TwrapStringSlice(v *map[stringUint64
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				if yyxt3 := z.Extension(yymk1); yyxt3 != nil {
 					z.DecExtension(&yymk1, yyxt3)
@@ -14601,7 +17556,7 @@ func (x codecSelfer19780) decMapstringUint64TwrapStringSlice(v *map[stringUint64
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -14619,12 +17574,12 @@ func (x codecSelfer19780) encSlicewrapBytes(v []wrapBytes, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-			z.EncExtension(yyv1, yyxt2)
+		if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+			z.EncExtension(v[yyv1], yyxt2)
 		} else {
-			yyv1.CodecEncodeSelf(e)
+			v[yyv1].CodecEncodeSelf(e)
 		}
 	}
 	z.EncWriteArrayEnd()
@@ -14671,7 +17626,7 @@ func (x codecSelfer19780) decSlicewrapBytes(v *[]wrapBytes, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
@@ -14701,7 +17656,7 @@ func (x codecSelfer19780) decSlicewrapBytes(v *[]wrapBytes, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapBytes, 0)
+			yyv1 = []wrapBytes{}
 			yyc1 = true
 		}
 	}
@@ -14720,12 +17675,12 @@ func (x codecSelfer19780) encSlicewrapUint8(v []wrapUint8, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-			z.EncExtension(yyv1, yyxt2)
+		if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+			z.EncExtension(v[yyv1], yyxt2)
 		} else {
-			yyv1.CodecEncodeSelf(e)
+			v[yyv1].CodecEncodeSelf(e)
 		}
 	}
 	z.EncWriteArrayEnd()
@@ -14772,7 +17727,7 @@ func (x codecSelfer19780) decSlicewrapUint8(v *[]wrapUint8, d *Decoder) {
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
@@ -14802,7 +17757,7 @@ func (x codecSelfer19780) decSlicewrapUint8(v *[]wrapUint8, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapUint8, 0)
+			yyv1 = []wrapUint8{}
 			yyc1 = true
 		}
 	}
@@ -14821,9 +17776,9 @@ func (x codecSelfer19780) encArray4stringUint64T(v *[4]stringUint64T, e *Encoder
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		yy2 := &yyv1
+		yy2 := &v[yyv1]
 		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
 			z.EncExtension(yy2, yyxt3)
 		} else {
@@ -14846,7 +17801,7 @@ func (x codecSelfer19780) decArray4stringUint64T(v *[4]stringUint64T, d *Decoder
 		var yyrl1 int
 		_ = yyrl1
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
@@ -14876,9 +17831,9 @@ func (x codecSelfer19780) encArray4uint64(v *[4]uint64, e *Encoder) {
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		r.EncodeUint(uint64(yyv1))
+		r.EncodeUint(uint64(v[yyv1]))
 	}
 	z.EncWriteArrayEnd()
 }
@@ -14896,7 +17851,7 @@ func (x codecSelfer19780) decArray4uint64(v *[4]uint64, d *Decoder) {
 		var yyrl1 int
 		_ = yyrl1
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			yyh1.ElemContainerState(yyj1)
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
@@ -14922,12 +17877,12 @@ func (x codecSelfer19780) encSlicePtrtoArray4uint64(v []*[4]uint64, e *Encoder)
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyv1 == nil {
+		if v[yyv1] == nil {
 			r.EncodeNil()
 		} else {
-			h.encArray4uint64((*[4]uint64)(yyv1), e)
+			h.encArray4uint64((*[4]uint64)(v[yyv1]), e)
 		}
 	}
 	z.EncWriteArrayEnd()
@@ -14974,7 +17929,7 @@ func (x codecSelfer19780) decSlicePtrtoArray4uint64(v *[]*[4]uint64, d *Decoder)
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
@@ -15007,7 +17962,7 @@ func (x codecSelfer19780) decSlicePtrtoArray4uint64(v *[]*[4]uint64, d *Decoder)
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*[4]uint64, 0)
+			yyv1 = []*[4]uint64{}
 			yyc1 = true
 		}
 	}
@@ -15023,29 +17978,31 @@ func (x codecSelfer19780) encMapstringUint64TPtrtostringUint64T(v map[stringUint
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		yy2 := &yyk1
-		if yyxt3 := z.Extension(yy2); yyxt3 != nil {
-			z.EncExtension(yy2, yyxt3)
-		} else {
-			yy2.CodecEncodeSelf(e)
-		}
-		z.EncWriteMapElemValue()
-		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
-				z.EncExtension(yyv1, yyxt4)
+	} else if z.EncBasicHandle().Canonical {
+		z.EncEncodeMapNonNil(v)
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			yy2 := &yyk1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				yy2.CodecEncodeSelf(e)
+			}
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+					z.EncExtension(yyv1, yyxt4)
+				} else {
+					yyv1.CodecEncodeSelf(e)
+				}
 			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringUint64TPtrtostringUint64T(v *map[stringUint64T]*stringUint64T, d *Decoder) {
@@ -15071,7 +18028,7 @@ func (x codecSelfer19780) decMapstringUint64TPtrtostringUint64T(v *map[stringUin
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
 				if yyxt3 := z.Extension(yymk1); yyxt3 != nil {
 					z.DecExtension(&yymk1, yyxt3)
@@ -15103,7 +18060,7 @@ func (x codecSelfer19780) decMapstringUint64TPtrtostringUint64T(v *map[stringUin
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyms1 && yyv1 != nil {
+				} else if yyms1 {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -15112,30 +18069,152 @@ func (x codecSelfer19780) decMapstringUint64TPtrtostringUint64T(v *map[stringUin
 	}
 }
 
-func (x codecSelfer19780) encMapstringPtrtoTestStrucFlex(v map[string]*TestStrucFlex, e *Encoder) {
+func (x codecSelfer19780) encMapwrapStringInterface(v map[wrapString]interface{}, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelper().Encoder(e)
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
+	} else if z.EncBasicHandle().Canonical {
+		var yyvv1 wrapString
+		yyencfn1 := z.EncFnGivenAddr(&yyvv1)
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			yyvv1 = wrapString(yyv1)
+			z.EncEncodeNumBoolStrKindGivenAddr(&yyvv1, yyencfn1)
+			z.EncWriteMapElemValue()
+			yye1 := v[yyvv1]
+			if yye1 == nil {
+				r.EncodeNil()
+			} else {
+				z.EncFallback(yye1)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			if yyxt3 := z.Extension(yyk1); yyxt3 != nil {
+				z.EncExtension(yyk1, yyxt3)
+			} else {
+				yyk1.CodecEncodeSelf(e)
+			}
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				z.EncFallback(yyv1)
+			}
+		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
+}
+
+func (x codecSelfer19780) decMapwrapStringInterface(v *map[wrapString]interface{}, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Decoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyl1 := z.DecReadMapStart()
+	if yyl1 == codecSelferDecContainerLenNil19780 {
+		*v = nil
+	} else {
 		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			if yyxt3 := z.Extension(yyv1); yyxt3 != nil {
-				z.EncExtension(yyv1, yyxt3)
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 32)
+			yyv1 = make(map[wrapString]interface{}, yyrl1)
+			*v = yyv1
+		}
+		var yymk1 wrapString
+		var yymv1 interface{}
+		var yymg1, yymdn1 bool
+		if z.DecBasicHandle().MapValueReset {
+			if !z.DecBasicHandle().InterfaceReset {
+				yymg1 = true
+			}
+		}
+		if yyl1 != 0 {
+			yyhl1 := yyl1 > 0
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
+				z.DecReadMapElemKey()
+				if yyxt3 := z.Extension(yymk1); yyxt3 != nil {
+					z.DecExtension(&yymk1, yyxt3)
+				} else {
+					yymk1.CodecDecodeSelf(d)
+				}
+				if yymg1 {
+					yymv1 = yyv1[yymk1]
+				} else {
+					yymv1 = nil
+				}
+				z.DecReadMapElemValue()
+				yymdn1 = false
+				z.DecFallback(&yymv1, true)
+				if yymdn1 {
+					yyv1[yymk1] = nil
+				} else {
+					yyv1[yymk1] = yymv1
+				}
+			}
+		} // else len==0: leave as-is (do not clear map entries)
+		z.DecReadMapEnd()
+	}
+}
+
+func (x codecSelfer19780) encMapstringPtrtoTestStrucFlex(v map[string]*TestStrucFlex, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelper().Encoder(e)
+	_, _, _ = h, z, r
+	if v == nil {
+		r.EncodeNil()
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			if yye1 == nil {
+				r.EncodeNil()
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				if yyxt2 := z.Extension(yye1); yyxt2 != nil {
+					z.EncExtension(yye1, yyxt2)
+				} else {
+					yye1.CodecEncodeSelf(e)
+				}
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			if yyv1 == nil {
+				r.EncodeNil()
+			} else {
+				if yyxt4 := z.Extension(yyv1); yyxt4 != nil {
+					z.EncExtension(yyv1, yyxt4)
+				} else {
+					yyv1.CodecEncodeSelf(e)
+				}
 			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringPtrtoTestStrucFlex(v *map[string]*TestStrucFlex, d *Decoder) {
@@ -15161,9 +18240,9 @@ func (x codecSelfer19780) decMapstringPtrtoTestStrucFlex(v *map[string]*TestStru
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 				yyms1 = true
 				if yymg1 {
 					yymv1, yymok1 = yyv1[yymk1]
@@ -15189,7 +18268,7 @@ func (x codecSelfer19780) decMapstringPtrtoTestStrucFlex(v *map[string]*TestStru
 				}
 				if yymdn1 {
 					yyv1[yymk1] = nil
-				} else if yyms1 && yyv1 != nil {
+				} else if yyms1 {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -15204,21 +18283,41 @@ func (x codecSelfer19780) encMapstringTestStrucFlex(v map[string]TestStrucFlex,
 	_, _, _ = h, z, r
 	if v == nil {
 		r.EncodeNil()
-		return
-	}
-	z.EncWriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		z.EncWriteMapElemKey()
-		r.EncodeString(string(yyk1))
-		z.EncWriteMapElemValue()
-		yy3 := &yyv1
-		if yyxt4 := z.Extension(yy3); yyxt4 != nil {
-			z.EncExtension(yy3, yyxt4)
-		} else {
-			yy3.CodecEncodeSelf(e)
+	} else if z.EncBasicHandle().Canonical {
+		yys1 := make([]string, 0, len(v))
+		for k, _ := range v {
+			yys1 = append(yys1, string(k))
+		}
+		sort.Sort(codecSelfer19780stringSlice(yys1))
+		z.EncWriteMapStart(len(v))
+		for _, yyv1 := range yys1 {
+			z.EncWriteMapElemKey()
+			r.EncodeString(yyv1)
+			z.EncWriteMapElemValue()
+			yye1 := v[string(yyv1)]
+			yy2 := &yye1
+			if yyxt3 := z.Extension(yy2); yyxt3 != nil {
+				z.EncExtension(yy2, yyxt3)
+			} else {
+				yy2.CodecEncodeSelf(e)
+			}
+		}
+		z.EncWriteMapEnd()
+	} else {
+		z.EncWriteMapStart(len(v))
+		for yyk1, yyv1 := range v {
+			z.EncWriteMapElemKey()
+			r.EncodeString(string(yyk1))
+			z.EncWriteMapElemValue()
+			yy5 := &yyv1
+			if yyxt6 := z.Extension(yy5); yyxt6 != nil {
+				z.EncExtension(yy5, yyxt6)
+			} else {
+				yy5.CodecEncodeSelf(e)
+			}
 		}
+		z.EncWriteMapEnd()
 	}
-	z.EncWriteMapEnd()
 }
 
 func (x codecSelfer19780) decMapstringTestStrucFlex(v *map[string]TestStrucFlex, d *Decoder) {
@@ -15232,7 +18331,7 @@ func (x codecSelfer19780) decMapstringTestStrucFlex(v *map[string]TestStrucFlex,
 		*v = nil
 	} else {
 		if yyv1 == nil {
-			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1640)
+			yyrl1 := z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1880)
 			yyv1 = make(map[string]TestStrucFlex, yyrl1)
 			*v = yyv1
 		}
@@ -15244,9 +18343,9 @@ func (x codecSelfer19780) decMapstringTestStrucFlex(v *map[string]TestStrucFlex,
 		}
 		if yyl1 != 0 {
 			yyhl1 := yyl1 > 0
-			for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ {
+			for yyj1 := 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 				z.DecReadMapElemKey()
-				yymk1 = (string)(string(r.DecodeStringAsBytes()))
+				yymk1 = (string)(z.DecStringZC(r.DecodeStringAsBytes()))
 				if yymg1 {
 					yymv1 = yyv1[yymk1]
 				} else {
@@ -15261,7 +18360,7 @@ func (x codecSelfer19780) decMapstringTestStrucFlex(v *map[string]TestStrucFlex,
 				}
 				if yymdn1 {
 					yyv1[yymk1] = TestStrucFlex{}
-				} else if yyv1 != nil {
+				} else {
 					yyv1[yymk1] = yymv1
 				}
 			}
@@ -15279,15 +18378,15 @@ func (x codecSelfer19780) encSlicePtrtoTestStrucFlex(v []*TestStrucFlex, e *Enco
 		return
 	}
 	z.EncWriteArrayStart(len(v))
-	for _, yyv1 := range v {
+	for yyv1 := range v {
 		z.EncWriteArrayElem()
-		if yyv1 == nil {
+		if v[yyv1] == nil {
 			r.EncodeNil()
 		} else {
-			if yyxt2 := z.Extension(yyv1); yyxt2 != nil {
-				z.EncExtension(yyv1, yyxt2)
+			if yyxt2 := z.Extension(v[yyv1]); yyxt2 != nil {
+				z.EncExtension(v[yyv1], yyxt2)
 			} else {
-				yyv1.CodecEncodeSelf(e)
+				v[yyv1].CodecEncodeSelf(e)
 			}
 		}
 	}
@@ -15335,7 +18434,7 @@ func (x codecSelfer19780) decSlicePtrtoTestStrucFlex(v *[]*TestStrucFlex, d *Dec
 			}
 		}
 		var yyj1 int
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || z.DecCheckBreak()); yyj1++ { // bounds-check-elimination
+		for yyj1 = 0; z.DecContainerNext(yyj1, yyl1, yyhl1); yyj1++ {
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
 					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
@@ -15372,7 +18471,7 @@ func (x codecSelfer19780) decSlicePtrtoTestStrucFlex(v *[]*TestStrucFlex, d *Dec
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*TestStrucFlex, 0)
+			yyv1 = []*TestStrucFlex{}
 			yyc1 = true
 		}
 	}
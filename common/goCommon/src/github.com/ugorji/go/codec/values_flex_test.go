@@ -263,6 +263,11 @@ type TestStrucFlex struct {
 
 	Mis     map[int]string
 	Mbu64   map[bool]struct{}
+	Mu8e    map[byte]struct{}
+	Mu8u64  map[byte]stringUint64T
+	Msp2ss  map[*string][]string
+	Mip2ss  map[*uint64][]string
+	Ms2misu map[string]map[uint64]stringUint64T
 	Miwu64s map[int]wrapUint64Slice
 	Mfwss   map[float64]wrapStringSlice
 	Mf32wss map[float32]wrapStringSlice
@@ -295,7 +300,8 @@ type TestStrucFlex struct {
 	// make this one omitempty (so it is excluded if nil).
 	*AnonInTestStrucIntf `json:",omitempty"`
 
-	//M map[interface{}]interface{}  `json:"-",bson:"-"`
+	M          map[interface{}]interface{} `json:"-"`
+	Msu        map[wrapString]interface{}
 	Mtsptr     map[string]*TestStrucFlex
 	Mts        map[string]TestStrucFlex
 	Its        []*TestStrucFlex
@@ -309,6 +315,9 @@ type TestStrucFlex struct {
 
 	Ffunc       func() error // expect this to be skipped/ignored
 	Bboolignore bool         `codec:"-"` // expect this to be skipped/ignored
+
+	Cmplx64  complex64
+	Cmplx128 complex128
 }
 
 func newTestStrucFlex(depth, n int, bench, useInterface, useStringKeyOnly bool) (ts *TestStrucFlex) {
@@ -348,7 +357,20 @@ func newTestStrucFlex(depth, n int, bench, useInterface, useStringKeyOnly bool)
 			22:  "twenty two",
 			-44: "minus forty four",
 		},
-		Mbu64: map[bool]struct{}{false: {}, true: {}},
+
+		Ms2misu: map[string]map[uint64]stringUint64T{
+			"1":   {1: {"11", 11}},
+			"22":  {1: {"2222", 2222}},
+			"333": {1: {"333333", 333333}},
+		},
+
+		Mbu64:  map[bool]struct{}{false: {}, true: {}},
+		Mu8e:   map[byte]struct{}{1: {}, 2: {}, 3: {}, 4: {}},
+		Mu8u64: make(map[byte]stringUint64T),
+		Mip2ss: make(map[*uint64][]string),
+		Msp2ss: make(map[*string][]string),
+		M:      make(map[interface{}]interface{}),
+		Msu:    make(map[wrapString]interface{}),
 
 		Ci64: -22,
 		Swrapbytes: []wrapBytes{ // lengths of 1, 2, 4, 8, 16, 32, 64, 128, 256,
@@ -379,12 +401,31 @@ func newTestStrucFlex(depth, n int, bench, useInterface, useStringKeyOnly bool)
 		MarB: []byte("hello bytes"),
 
 		XuintToBytes: 16,
+
+		Cmplx64:  complex(16, 0),
+		Cmplx128: complex(1616, 0),
 	}
 
+	var strslice []string
 	for i := uint64(0); i < numStrUi64T; i++ {
-		ss := stringUint64T{S: strings.Repeat(strconv.FormatUint(i, 10), 4), U: i}
+		s := strings.Repeat(strconv.FormatUint(i, 10), 4)
+		ss := stringUint64T{S: s, U: i}
+		strslice = append(strslice, s)
 		// Ensure this is set to nil if decoding into a nil interface{}.
 		ts.MstrUi64TSelf[ss] = &ss
+		ts.Mu8u64[s[0]] = ss
+		ts.Mip2ss[&i] = strslice
+		ts.Msp2ss[&s] = strslice
+		// add some other values of maps and pointers into M
+		ts.M[s] = strslice
+		// cannot use this, as converting stringUint64T to interface{} returns map,
+		// DecodeNaked does this, causing "hash of unhashable value" as some maps cannot be map keys
+		// ts.M[ss] = &ss
+		ts.Msu[wrapString(s)] = &ss
+		s = s + "-map"
+		ss.S = s
+		ts.M[s] = map[string]string{s: s}
+		ts.Msu[wrapString(s)] = map[string]string{s: s}
 	}
 
 	numChanSend := cap(ts.Chstr) / 4 // 8
@@ -43,6 +43,11 @@ type stringUint64T struct {
 	U uint64
 }
 
+type AnonInTestStrucSlim struct {
+	S string
+	P *string
+}
+
 type AnonInTestStruc struct {
 	AS         string
 	AI64       int64
@@ -54,16 +59,19 @@ type AnonInTestStruc struct {
 	AF64slice  []float64
 	AF32slice  []float32
 
+	AMSS map[string]string
 	// AMI32U32  map[int32]uint32
 	// AMU32F64 map[uint32]float64 // json/bson do not like it
-	AMSU16 map[string]uint16
+	AMSU64 map[string]uint64
+
+	AI64arr8 [8]int64
 
 	// use these to test 0-len or nil slices/maps/arrays
 	AI64arr0    [0]int64
 	AI64slice0  []int64
 	AUi64sliceN []uint64
-	AMSU16N     map[string]uint16
-	AMSU16E     map[string]uint16
+	AMSU64N     map[string]uint64
+	AMSU64E     map[string]uint64
 }
 
 // testSimpleFields is a sub-set of TestStrucCommon
@@ -82,7 +90,7 @@ type testSimpleFields struct {
 	B bool
 
 	Sslice    []string
-	I16slice  []int16
+	I32slice  []int32
 	Ui64slice []uint64
 	Ui8slice  []uint8
 	Bslice    []bool
@@ -92,7 +100,7 @@ type testSimpleFields struct {
 	WrapSliceInt64  wrapSliceUint64
 	WrapSliceString wrapSliceString
 
-	Msi64 map[string]int64
+	Msint map[string]int
 }
 
 type TestStrucCommon struct {
@@ -121,7 +129,7 @@ type TestStrucCommon struct {
 
 	Sslice    []string
 	I64slice  []int64
-	I16slice  []int16
+	I32slice  []int32
 	Ui64slice []uint64
 	Ui8slice  []uint8
 	Bslice    []bool
@@ -134,7 +142,7 @@ type TestStrucCommon struct {
 	WrapSliceInt64  wrapSliceUint64
 	WrapSliceString wrapSliceString
 
-	Msi64 map[string]int64
+	Msint map[string]int
 
 	Msbytes map[string][]byte
 
@@ -147,6 +155,9 @@ type TestStrucCommon struct {
 
 	NotAnon AnonInTestStruc
 
+	*AnonInTestStrucSlim
+	NotAnonSlim *AnonInTestStrucSlim
+
 	// R          Raw // Testing Raw must be explicitly turned on, so use standalone test
 	// Rext RawExt // Testing RawExt is tricky, so use standalone test
 
@@ -208,7 +219,20 @@ func populateTestStrucCommon(ts *TestStrucCommon, n int, bench, useInterface, us
 			math.MaxUint16, math.MaxUint16 + 4, math.MaxUint16 - 4,
 			math.MaxUint32, math.MaxUint32 + 4, math.MaxUint32 - 4,
 		},
-		AMSU16: map[string]uint16{strRpt(n, "1"): 1, strRpt(n, "22"): 2, strRpt(n, "333"): 3, strRpt(n, "4444"): 4},
+		AMSU64: map[string]uint64{
+			strRpt(n, "1"):    1,
+			strRpt(n, "22"):   2,
+			strRpt(n, "333"):  3,
+			strRpt(n, "4444"): 4,
+		},
+		AMSS: map[string]string{
+			strRpt(n, "1"):    strRpt(n, "1"),
+			strRpt(n, "22"):   strRpt(n, "22"),
+			strRpt(n, "333"):  strRpt(n, "333"),
+			strRpt(n, "4444"): strRpt(n, "4444"),
+		},
+
+		AI64arr8: [...]int64{1, 8, 2, 7, 3, 6, 4, 5},
 
 		// Note: +/- inf, NaN, and other non-representable numbers should not be explicitly tested here
 
@@ -247,8 +271,8 @@ func populateTestStrucCommon(ts *TestStrucCommon, n int, bench, useInterface, us
 
 		AI64slice0:  []int64{},
 		AUi64sliceN: nil,
-		AMSU16N:     nil,
-		AMSU16E:     map[string]uint16{},
+		AMSU64N:     nil,
+		AMSU64E:     map[string]uint64{},
 	}
 
 	if !bench {
@@ -280,7 +304,7 @@ func populateTestStrucCommon(ts *TestStrucCommon, n int, bench, useInterface, us
 
 		Sslice:    []string{strRpt(n, "one"), strRpt(n, "two"), strRpt(n, "three")},
 		I64slice:  []int64{1111, 2222, 3333},
-		I16slice:  []int16{44, 55, 66},
+		I32slice:  []int32{44, 55, 66},
 		Ui64slice: []uint64{12121212, 34343434, 56565656},
 		Ui8slice:  []uint8{210, 211, 212},
 		Bslice:    []bool{true, false, true, false},
@@ -290,7 +314,7 @@ func populateTestStrucCommon(ts *TestStrucCommon, n int, bench, useInterface, us
 			[]byte(strRpt(n, "two")),
 			[]byte(strRpt(n, "\"three\"")),
 		},
-		Msi64: map[string]int64{
+		Msint: map[string]int{
 			strRpt(n, "one"):       1,
 			strRpt(n, "two"):       2,
 			strRpt(n, "\"three\""): 3,
@@ -324,12 +348,12 @@ func populateTestStrucCommon(ts *TestStrucCommon, n int, bench, useInterface, us
 			B: true,
 
 			Sslice:    []string{strRpt(n, "one"), strRpt(n, "two"), strRpt(n, "three")},
-			I16slice:  []int16{44, 55, 66},
+			I32slice:  []int32{44, 55, 66},
 			Ui64slice: []uint64{12121212, 34343434, 56565656},
 			Ui8slice:  []uint8{210, 211, 212},
 			Bslice:    []bool{true, false, true, false},
 
-			Msi64: map[string]int64{
+			Msint: map[string]int{
 				strRpt(n, "one"):       1,
 				strRpt(n, "two"):       2,
 				strRpt(n, "\"three\""): 3,
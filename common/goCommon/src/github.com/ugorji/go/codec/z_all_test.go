@@ -1,8 +1,8 @@
 // Copyright (c) 2012-2020 Ugorji Nwoke. All rights reserved.
 // Use of this source code is governed by a MIT license found in the LICENSE file.
 
-// +build alltests
-// +build go1.8
+//go:build alltests && go1.9
+// +build alltests,go1.9
 
 package codec
 
@@ -12,16 +12,17 @@ package codec
 //
 // Because build tags are a build time parameter, we will have to test out the
 // different tags separately.
-// Tags: x codecgen safe appengine notfastpath
+// Tags: x codecgen codec.safe codec.notfastpath appengine
 //
 // These tags should be added to alltests, e.g.
 //   go test '-tags=alltests x codecgen' -run=Suite -coverprofile=cov.out
 //
 // To run all tests before submitting code, run:
-//    a=( "" "safe" "codecgen" "notfastpath" "codecgen notfastpath" "codecgen safe" "safe notfastpath" )
+//    a=( "" "codec.safe" "codecgen" "codec.notfastpath" "codecgen codec.notfastpath" "codecgen codec.safe" "codec.safe codec.notfastpath" )
 //    for i in "${a[@]}"; do echo ">>>> TAGS: $i"; go test "-tags=alltests $i" -run=Suite; done
 //
-// This only works on go1.7 and above. This is when subtests and suites were supported.
+// This suite of tests requires support for subtests and suites,
+// and conseqneutly will only run on go1.7 and above.
 
 // find . -name "codec_test.go" | xargs grep -e '^func Test' | \
 //     cut -d '(' -f 1 | cut -d ' ' -f 2 | \
@@ -55,6 +56,7 @@ func testGroupResetFlags() {
 	testRpcBufsize = 2048
 	testUseIoEncDec = -1
 	testUseReset = false
+	testUseParallel = false
 	testMaxInitLen = 0
 	testUseIoWrapper = false
 	testNumRepeatString = 8
@@ -337,7 +339,7 @@ func testRpcGroup(t *testing.T) {
 
 func testNonHandlesGroup(t *testing.T) {
 	// grep "func Test" codec_test.go | grep -v -E '(Cbor|Json|Simple|Msgpack|Binc)'
-	t.Run("TestBufioDecReader", TestBufioDecReader)
+	// t.Run("TestBufioDecReader", TestBufioDecReader)
 	t.Run("TestAtomic", TestAtomic)
 	t.Run("TestAllEncCircularRef", TestAllEncCircularRef)
 	t.Run("TestAllAnonCycle", TestAllAnonCycle)
@@ -363,6 +365,7 @@ func TestCodecSuite(t *testing.T) {
 
 	testUseIoEncDec = 0
 	testUseReset = true
+	testUseParallel = true
 
 	testDecodeOptions.ZeroCopy = true
 	testDecodeOptions.InternString = true
@@ -495,6 +498,7 @@ func TestCodecSuite(t *testing.T) {
 	// ---
 	testUseIoEncDec = 16
 	testRPCOptions.RPCNoBuffer = false
+	testReinit()
 	testRpcBufsize = 0
 	fnRun("rpc-buf-0", testRpcGroup)
 	testRpcBufsize = 0
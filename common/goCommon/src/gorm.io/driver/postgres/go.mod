@@ -0,0 +1,17 @@
+module gorm.io/driver/postgres
+
+go 1.18
+
+require (
+	github.com/jackc/pgx/v5 v5.3.1
+	gorm.io/gorm v1.25.0
+)
+
+require (
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/crypto v0.8.0 // indirect
+	golang.org/x/text v0.9.0 // indirect
+)
@@ -0,0 +1,14 @@
+module modernc.org/libc
+
+go 1.18
+
+require (
+	github.com/dustin/go-humanize v1.0.1
+	github.com/google/uuid v1.3.0
+	github.com/mattn/go-isatty v0.0.16
+	golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab
+	modernc.org/mathutil v1.5.0
+	modernc.org/memory v1.5.0
+)
+
+require github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
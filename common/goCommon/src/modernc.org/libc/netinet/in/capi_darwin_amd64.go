@@ -0,0 +1,11 @@
+// Code generated by 'ccgo netinet/in/gen.c -crt-import-path  -export-defines  -export-enums  -export-externs X -export-fields F -export-structs  -export-typedefs  -header -hide _OSSwapInt16,_OSSwapInt32,_OSSwapInt64 -ignore-unsupported-alignment -o netinet/in/in_darwin_amd64.go -pkgname in', DO NOT EDIT.
+
+package in
+
+var CAPI = map[string]struct{}{
+	"__darwin_check_fd_set":          {},
+	"__darwin_check_fd_set_overflow": {},
+	"__darwin_fd_clr":                {},
+	"__darwin_fd_isset":              {},
+	"__darwin_fd_set":                {},
+}
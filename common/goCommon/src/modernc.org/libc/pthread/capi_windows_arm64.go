@@ -0,0 +1,9 @@
+// Code generated by 'ccgo pthread\gen.c -crt-import-path  -export-defines  -export-enums  -export-externs X -export-fields F -export-structs  -export-typedefs  -header -hide _OSSwapInt16,_OSSwapInt32,_OSSwapInt64 -o pthread\pthread_windows_arm64.go -pkgname pthread', DO NOT EDIT.
+
+package pthread
+
+var CAPI = map[string]struct{}{
+	"daylight": {},
+	"timezone": {},
+	"tzname":   {},
+}
@@ -0,0 +1,9 @@
+// Code generated by 'ccgo time/gen.c -crt-import-path  -export-defines  -export-enums  -export-externs X -export-fields F -export-structs  -export-typedefs  -header -hide _OSSwapInt16,_OSSwapInt32,_OSSwapInt64 -ignore-unsupported-alignment -o time/time_windows_386.go -pkgname time', DO NOT EDIT.
+
+package time
+
+var CAPI = map[string]struct{}{
+	"daylight": {},
+	"timezone": {},
+	"tzname":   {},
+}
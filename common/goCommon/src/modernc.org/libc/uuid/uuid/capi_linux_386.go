@@ -0,0 +1,5 @@
+// Code generated by 'ccgo uuid/uuid/gen.c -crt-import-path  -export-defines  -export-enums  -export-externs X -export-fields F -export-structs  -export-typedefs  -header -hide _OSSwapInt16,_OSSwapInt32,_OSSwapInt64 -o uuid/uuid/uuid_linux_386.go -pkgname uuid', DO NOT EDIT.
+
+package uuid
+
+var CAPI = map[string]struct{}{}